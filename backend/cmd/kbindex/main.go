@@ -0,0 +1,82 @@
+// kbindex はルールの資料の埋め込みベクトルを求め、ベクトル検索の索引を作成する
+//
+//	GEMINI_API_KEY=... go run ./cmd/kbindex -index knowledge_index.json
+//
+// 本文が変わっていない抜粋は保存済みのベクトルを使う（-full を指定するとすべて求め直す）。
+// 起動中のサーバーの索引を作り直す場合は ReindexKnowledge RPC を使う
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	"github.com/sirupsen/logrus"
+)
+
+func main() {
+	var (
+		dir       = flag.String("dir", os.Getenv("KNOWLEDGE_DIR"), "同梱の資料に加えて読み込むMarkdownのディレクトリ")
+		indexPath = flag.String("index", envOr("KNOWLEDGE_INDEX_PATH", "knowledge_index.json"), "索引を保存するファイル")
+		provider  = flag.String("provider", envOr("EMBEDDING_PROVIDER", "gemini"), "埋め込みモデル（gemini / local）")
+		full      = flag.Bool("full", false, "本文が変わっていない抜粋も埋め込みベクトルを求め直す")
+		timeout   = flag.Duration("timeout", 5*time.Minute, "打ち切るまでの時間")
+	)
+	flag.Parse()
+
+	if err := run(*dir, *indexPath, *provider, *full, *timeout); err != nil {
+		fmt.Fprintln(os.Stderr, "kbindex:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, indexPath, provider string, full bool, timeout time.Duration) error {
+	if provider == "off" {
+		return fmt.Errorf("-provider off has no embeddings to index (use gemini or local)")
+	}
+	apiKey := os.Getenv("GEMINI_API_KEY")
+	if provider == "gemini" && apiKey == "" {
+		return fmt.Errorf("GEMINI_API_KEY is required for -provider gemini")
+	}
+
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	embeddingClient, err := infrastructure.NewEmbeddingClient(provider, apiKey, logger)
+	if err != nil {
+		return err
+	}
+	if closer, ok := embeddingClient.(interface{ Close() error }); ok {
+		defer closer.Close()
+	}
+
+	knowledgeUsecase := usecase.NewKnowledgeUsecase(
+		infrastructure.NewMarkdownKnowledgeRepository(dir),
+		infrastructure.NewFileVectorIndexRepository(indexPath),
+		embeddingClient,
+		logger,
+	)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	output, err := knowledgeUsecase.Reindex(ctx, full)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("index:    %s\n", indexPath)
+	fmt.Printf("model:    %s\n", output.Model)
+	fmt.Printf("passages: %d (embedded %d, reused %d)\n", output.Passages, output.Embedded, output.Reused)
+	fmt.Printf("elapsed:  %s\n", output.Duration.Round(time.Millisecond))
+	return nil
+}
+
+// envOr は環境変数を取得し、存在しない場合はデフォルト値を返す
+func envOr(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
@@ -0,0 +1,127 @@
+// simbench は手牌のモンテカルロ・シミュレーションを実行し、結果と処理時間を表示するベンチマーク
+//
+//	go run ./cmd/simbench -hand 123m456p789s1234z5m -n 5000 -workers 1,2,4,8
+//
+// -workers に複数の並列数を指定すると、それぞれの処理時間を比較する（結果は並列数によらず同じ）
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+func main() {
+	var (
+		handStr     = flag.String("hand", "", "手牌（MPSZ表記の13枚または14枚、副露は指定できない）")
+		visibleStr  = flag.String("visible", "", "手牌以外で見えている牌（MPSZ表記）")
+		doraStr     = flag.String("dora", "", "ドラ表示牌（MPSZ表記）")
+		discardsStr = flag.String("discards", "", "自分の捨て牌（MPSZ表記）")
+		seatStr     = flag.String("seat", "2z", "自風（1z-4z）")
+		roundStr    = flag.String("round", "1z", "場風（1z-4z）")
+		ruleSetName = flag.String("rule-set", mahjong.RuleSetTenhou.Name, "ルールセット名")
+		policyName  = flag.String("policy", "efficiency", "打牌の方針（"+strings.Join(mahjong.DiscardPolicyNames(), " / ")+"）")
+		simulations = flag.Int("n", mahjong.MaxSimulations, "試行回数")
+		turns       = flag.Int("turns", mahjong.DefaultTurns, "残りのツモ回数")
+		seed        = flag.Uint64("seed", 1, "乱数の種")
+		workersStr  = flag.String("workers", "0", "並列数（カンマ区切りで複数指定すると比較する、0 は GOMAXPROCS）")
+		timeout     = flag.Duration("timeout", 0, "打ち切るまでの時間（0 の場合は打ち切らない）")
+	)
+	flag.Parse()
+
+	if err := run(*handStr, *visibleStr, *doraStr, *discardsStr, *seatStr, *roundStr, *ruleSetName, *policyName, *simulations, *turns, *seed, *workersStr, *timeout); err != nil {
+		fmt.Fprintln(os.Stderr, "simbench:", err)
+		os.Exit(1)
+	}
+}
+
+func run(handStr, visibleStr, doraStr, discardsStr, seatStr, roundStr, ruleSetName, policyName string, simulations, turns int, seed uint64, workersStr string, timeout time.Duration) error {
+	if handStr == "" {
+		return fmt.Errorf("-hand is required")
+	}
+	hand, err := mahjong.ParseHand(handStr, nil)
+	if err != nil {
+		return err
+	}
+	var parsed [3][]mahjong.Tile
+	for i, s := range []string{visibleStr, doraStr, discardsStr} {
+		if parsed[i], _, err = mahjong.ParseTiles(s); err != nil {
+			return err
+		}
+	}
+	visible, dora, discards := parsed[0], parsed[1], parsed[2]
+	seat, err := parseWind(seatStr)
+	if err != nil {
+		return err
+	}
+	round, err := parseWind(roundStr)
+	if err != nil {
+		return err
+	}
+
+	rules, ok := mahjong.LookupRuleSet(ruleSetName)
+	if !ok {
+		return fmt.Errorf("unknown rule set %q (use one of %s)", ruleSetName, strings.Join(mahjong.RuleSetNames(), ", "))
+	}
+	policy, ok := mahjong.LookupDiscardPolicy(policyName)
+	if !ok {
+		return fmt.Errorf("unknown policy %q (use one of %s)", policyName, strings.Join(mahjong.DiscardPolicyNames(), ", "))
+	}
+	var workers []int
+	for _, s := range strings.Split(workersStr, ",") {
+		n, err := strconv.Atoi(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("invalid workers %q: %w", s, err)
+		}
+		workers = append(workers, n)
+	}
+
+	winCtx := mahjong.WinContext{SeatWind: seat, RoundWind: round, DoraIndicators: dora}
+	// 自分の捨て牌とドラ表示牌も見えている牌として数える
+	seen := append(append(append([]mahjong.Tile{}, visible...), discards...), dora...)
+
+	fmt.Printf("手牌: %s  向聴数: %d  方針: %s  ルール: %s\n", mahjong.FormatTiles(hand.Concealed), mahjong.Shanten(hand.ConcealedCounts(), 0), policy.Name(), rules.Name)
+
+	var result mahjong.SimulationResult
+	for _, w := range workers {
+		opts := mahjong.SimulationOptions{Simulations: simulations, Turns: turns, Seed: seed, Policy: policy, Workers: w}.Normalize()
+		ctx, cancel := context.Background(), context.CancelFunc(func() {})
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+		start := time.Now()
+		result, err = mahjong.Simulate(ctx, hand, seen, discards, winCtx, opts, rules)
+		elapsed := time.Since(start)
+		cancel()
+		if err != nil {
+			return fmt.Errorf("workers=%d: %w", opts.Workers, err)
+		}
+		fmt.Printf("workers=%-3d %d回 %v（%.0f回/秒）\n", opts.Workers, opts.Simulations, elapsed.Round(time.Millisecond), float64(opts.Simulations)/elapsed.Seconds())
+	}
+
+	fmt.Printf("\n聴牌率 %.1f%%  和了率 %.1f%%（ツモ %.1f%%）  平均打点 %.0f  打点期待値 %.0f\n",
+		result.TenpaiRate*100, result.WinRate*100, result.TsumoRate*100, result.AverageWinPoints, result.ExpectedValue)
+	fmt.Println("巡目  聴牌率  和了率  打点期待値")
+	for _, t := range result.ByTurn {
+		fmt.Printf("%4d  %5.1f%%  %5.1f%%  %9.0f\n", t.Turn, t.TenpaiRate*100, t.WinRate*100, t.ExpectedValue)
+	}
+	return nil
+}
+
+// parseWind は1z-4zの表記を風牌に変換する
+func parseWind(s string) (mahjong.Tile, error) {
+	t, err := mahjong.ParseTile(s)
+	if err != nil {
+		return 0, err
+	}
+	if !t.IsWind() {
+		return 0, fmt.Errorf("%s is not a wind", s)
+	}
+	return t, nil
+}
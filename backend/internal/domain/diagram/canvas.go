@@ -0,0 +1,240 @@
+package diagram
+
+import (
+	"image/color"
+	"math"
+)
+
+// point は図の座標（拡大率1のピクセル）
+type point struct {
+	X, Y float64
+}
+
+// affine は座標の変換（x' = A*x + B*y + C, y' = D*x + E*y + F）
+type affine struct {
+	A, B, C, D, E, F float64
+}
+
+// identity は座標を変えない変換
+var identity = affine{A: 1, E: 1}
+
+// apply は座標を変換する
+func (m affine) apply(p point) point {
+	return point{m.A*p.X + m.B*p.Y + m.C, m.D*p.X + m.E*p.Y + m.F}
+}
+
+// then は m の後に n を行う変換を返す
+func (m affine) then(n affine) affine {
+	return affine{
+		A: n.A*m.A + n.B*m.D, B: n.A*m.B + n.B*m.E, C: n.A*m.C + n.B*m.F + n.C,
+		D: n.D*m.A + n.E*m.D, E: n.D*m.B + n.E*m.E, F: n.D*m.C + n.E*m.F + n.F,
+	}
+}
+
+// translate は平行移動
+func translate(x, y float64) affine {
+	return affine{A: 1, C: x, E: 1, F: y}
+}
+
+// rotate は原点を中心に時計回りに quarter×90度回転する（画面の座標は下向きが正）
+func rotate(quarter int) affine {
+	switch ((quarter % 4) + 4) % 4 {
+	case 1:
+		return affine{B: -1, D: 1}
+	case 2:
+		return affine{A: -1, E: -1}
+	case 3:
+		return affine{B: 1, D: -1}
+	default:
+		return identity
+	}
+}
+
+// scaleBy は原点を中心とした拡大
+func scaleBy(s float64) affine {
+	return affine{A: s, E: s}
+}
+
+// rotateAngle は原点を中心に時計回りに deg 度回転する
+func rotateAngle(deg float64) affine {
+	r := deg * math.Pi / 180
+	return affine{A: math.Cos(r), B: -math.Sin(r), D: math.Sin(r), E: math.Cos(r)}
+}
+
+// elementKind は図の要素の種類
+type elementKind int
+
+const (
+	elementPolygon  elementKind = iota // 塗りつぶした多角形
+	elementCircle                      // 塗りつぶした円
+	elementPolyline                    // 端と角を丸めた線
+	elementText                        // 文字列（ASCIIのみ）
+)
+
+// textAnchor は文字列の揃え
+type textAnchor string
+
+const (
+	anchorStart  textAnchor = "start"
+	anchorMiddle textAnchor = "middle"
+	anchorEnd    textAnchor = "end"
+)
+
+// element は図の要素（座標は変換済み）
+type element struct {
+	kind   elementKind
+	points []point
+	// radius は円の半径
+	radius float64
+	// width は線の太さ
+	width float64
+	color color.RGBA
+	// opacity は不透明度（0-1）
+	opacity float64
+
+	text   string
+	size   float64
+	anchor textAnchor
+	bold   bool
+}
+
+// Canvas は牌の図を組み立てる描画面
+// 要素は変換済みの座標で保持し、SVG とPNGで同じ図を出力する
+type Canvas struct {
+	width, height float64
+	elements      []element
+	transform     affine
+	// scale は変換の拡大率（円の半径と線の太さに掛ける）
+	scale float64
+}
+
+// newCanvas は幅と高さを指定して描画面を作成する
+func newCanvas(width, height float64) *Canvas {
+	return &Canvas{width: width, height: height, transform: identity, scale: 1}
+}
+
+// Width は図の幅を返す（拡大率1のピクセル）
+func (c *Canvas) Width() int {
+	return int(math.Ceil(c.width))
+}
+
+// Height は図の高さを返す（拡大率1のピクセル）
+func (c *Canvas) Height() int {
+	return int(math.Ceil(c.height))
+}
+
+// with は変換を加えた座標系で描く
+func (c *Canvas) with(m affine, s float64, draw func()) {
+	saved, savedScale := c.transform, c.scale
+	c.transform = m.then(c.transform)
+	c.scale *= s
+	draw()
+	c.transform, c.scale = saved, savedScale
+}
+
+// polygon は多角形を塗りつぶす
+func (c *Canvas) polygon(col color.RGBA, opacity float64, points ...point) {
+	transformed := make([]point, len(points))
+	for i, p := range points {
+		transformed[i] = c.transform.apply(p)
+	}
+	c.elements = append(c.elements, element{kind: elementPolygon, points: transformed, color: col, opacity: opacity})
+}
+
+// rect は長方形を塗りつぶす
+func (c *Canvas) rect(x, y, w, h float64, col color.RGBA, opacity float64) {
+	c.polygon(col, opacity, point{x, y}, point{x + w, y}, point{x + w, y + h}, point{x, y + h})
+}
+
+// roundRect は角を丸めた長方形を塗りつぶす
+func (c *Canvas) roundRect(x, y, w, h, r float64, col color.RGBA, opacity float64) {
+	const steps = 6
+	corners := []struct{ cx, cy, start float64 }{
+		{x + w - r, y + r, -90},
+		{x + w - r, y + h - r, 0},
+		{x + r, y + h - r, 90},
+		{x + r, y + r, 180},
+	}
+	var points []point
+	for _, corner := range corners {
+		for i := 0; i <= steps; i++ {
+			a := (corner.start + 90*float64(i)/steps) * math.Pi / 180
+			points = append(points, point{corner.cx + r*math.Cos(a), corner.cy + r*math.Sin(a)})
+		}
+	}
+	c.polygon(col, opacity, points...)
+}
+
+// circle は円を塗りつぶす
+func (c *Canvas) circle(x, y, r float64, col color.RGBA, opacity float64) {
+	c.elements = append(c.elements, element{
+		kind:    elementCircle,
+		points:  []point{c.transform.apply(point{x, y})},
+		radius:  r * c.scale,
+		color:   col,
+		opacity: opacity,
+	})
+}
+
+// stroke は端と角を丸めた線を引く
+func (c *Canvas) stroke(width float64, col color.RGBA, points ...point) {
+	transformed := make([]point, len(points))
+	for i, p := range points {
+		transformed[i] = c.transform.apply(p)
+	}
+	c.elements = append(c.elements, element{kind: elementPolyline, points: transformed, width: width * c.scale, color: col, opacity: 1})
+}
+
+// label は文字列を書く（y はベースライン。回転は反映せず位置だけを変換する）
+func (c *Canvas) label(x, y, size float64, col color.RGBA, anchor textAnchor, bold bool, text string) {
+	c.elements = append(c.elements, element{
+		kind:    elementText,
+		points:  []point{c.transform.apply(point{x, y})},
+		size:    size * c.scale,
+		color:   col,
+		opacity: 1,
+		text:    text,
+		anchor:  anchor,
+		bold:    bold,
+	})
+}
+
+// place は別の描画面の要素を (x, y) に配置して描き写す
+func (c *Canvas) place(other *Canvas, x, y float64) {
+	m := translate(x, y).then(c.transform)
+	for _, e := range other.elements {
+		moved := e
+		moved.points = make([]point, len(e.points))
+		for i, p := range e.points {
+			moved.points[i] = m.apply(p)
+		}
+		moved.radius *= c.scale
+		moved.width *= c.scale
+		moved.size *= c.scale
+		c.elements = append(c.elements, moved)
+	}
+}
+
+// Column は描画面を縦に gap ずつ空けて並べ、左を揃えた描画面を返す（nil は飛ばす）
+func Column(gap float64, canvases ...*Canvas) *Canvas {
+	var width, height float64
+	var parts []*Canvas
+	for _, part := range canvases {
+		if part == nil {
+			continue
+		}
+		if len(parts) > 0 {
+			height += gap
+		}
+		width = math.Max(width, part.width)
+		height += part.height
+		parts = append(parts, part)
+	}
+	column := newCanvas(width, height)
+	y := 0.0
+	for _, part := range parts {
+		column.place(part, 0, y)
+		y += part.height + gap
+	}
+	return column
+}
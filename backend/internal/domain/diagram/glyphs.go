@@ -0,0 +1,121 @@
+package diagram
+
+// 牌の字の画（10×10 の格子の座標で、1つの画を1本の線として引く）
+// フォントに頼らず SVG と PNG で同じ絵柄になるよう、字を線で近似する
+
+// numeralGlyphs は萬子の漢数字（一〜九）
+var numeralGlyphs = [9][][]point{
+	// 一
+	{{{1, 5}, {9, 5}}},
+	// 二
+	{{{2, 3}, {8, 3}}, {{1, 7.5}, {9, 7.5}}},
+	// 三
+	{{{2, 2}, {8, 2}}, {{2.5, 5}, {7.5, 5}}, {{1, 8.5}, {9, 8.5}}},
+	// 四
+	{
+		{{1.5, 1.5}, {1.5, 9}},
+		{{1.5, 1.5}, {8.5, 1.5}, {8.5, 9}},
+		{{1.5, 9}, {8.5, 9}},
+		{{4, 1.5}, {3.8, 5}, {2.8, 6.5}},
+		{{6, 1.5}, {6, 5.5}, {7, 6}},
+	},
+	// 五
+	{
+		{{2, 1.5}, {8, 1.5}},
+		{{4.5, 1.5}, {3.5, 8.5}},
+		{{2, 5}, {7, 5}, {6.5, 8.5}},
+		{{1, 8.5}, {9, 8.5}},
+	},
+	// 六
+	{
+		{{4.8, 0.8}, {5.6, 2}},
+		{{1, 3.5}, {9, 3.5}},
+		{{4, 5.5}, {2, 9}},
+		{{6, 5.5}, {8, 9}},
+	},
+	// 七
+	{
+		{{1, 4.5}, {9, 3}},
+		{{4, 1}, {4, 8}, {5, 9}, {9, 9}, {9, 7.5}},
+	},
+	// 八
+	{
+		{{4, 2}, {3.5, 6}, {1, 9}},
+		{{6, 2}, {6.5, 6}, {9, 9}},
+	},
+	// 九
+	{
+		{{1, 3.5}, {6, 3.5}, {6, 8.5}, {7, 9}, {9, 9}, {9, 7.5}},
+		{{4.5, 1}, {4, 6}, {1, 9}},
+	},
+}
+
+// manGlyph は萬子の「萬」
+var manGlyph = [][]point{
+	{{1, 1.5}, {9, 1.5}},
+	{{3.5, 0.3}, {3.5, 2.7}},
+	{{6.5, 0.3}, {6.5, 2.7}},
+	{{2.5, 3.5}, {7.5, 3.5}, {7.5, 6}, {2.5, 6}, {2.5, 3.5}},
+	{{2.5, 4.75}, {7.5, 4.75}},
+	{{5, 3.5}, {5, 7}},
+	{{1.5, 9.7}, {1.5, 7}, {8.5, 7}, {8.5, 9.7}, {7.5, 9.7}},
+	{{3, 9.2}, {5, 7.8}, {7, 9.2}},
+}
+
+// honorGlyphs は字牌の字（東南西北白発中の順。白は字を書かない）
+var honorGlyphs = [7][][]point{
+	// 東
+	{
+		{{1, 2.3}, {9, 2.3}},
+		{{5, 0.5}, {5, 9.5}},
+		{{2.5, 4}, {7.5, 4}, {7.5, 7}, {2.5, 7}, {2.5, 4}},
+		{{2.5, 5.5}, {7.5, 5.5}},
+		{{4.5, 7}, {1, 9.5}},
+		{{5.5, 7}, {9, 9.5}},
+	},
+	// 南
+	{
+		{{1, 2}, {9, 2}},
+		{{5, 0.5}, {5, 3.5}},
+		{{1.5, 9.5}, {1.5, 3.5}, {8.5, 3.5}, {8.5, 9.5}, {7.5, 9.5}},
+		{{3.5, 4.5}, {4.5, 5.5}},
+		{{6.5, 4.5}, {5.5, 5.5}},
+		{{3, 6.3}, {7, 6.3}},
+		{{3.5, 8}, {6.5, 8}},
+		{{5, 6.3}, {5, 9.5}},
+	},
+	// 西
+	{
+		{{1, 1.5}, {9, 1.5}},
+		{{1.5, 9.5}, {1.5, 4}, {8.5, 4}, {8.5, 9.5}},
+		{{1.5, 9}, {8.5, 9}},
+		{{4, 1.5}, {4, 5.5}, {2.5, 7.5}},
+		{{6, 1.5}, {6, 6.5}, {8.5, 6.5}},
+	},
+	// 北
+	{
+		{{3.5, 0.8}, {3.5, 9.3}},
+		{{1, 4}, {3.5, 4}},
+		{{1, 8}, {3.5, 6.5}},
+		{{6.5, 0.8}, {6.5, 8.5}, {7.5, 9.3}, {9.3, 9.3}, {9.3, 8}},
+		{{9, 3}, {6.5, 4.5}},
+	},
+	// 白
+	nil,
+	// 発
+	{
+		{{3, 0.5}, {1, 2.8}},
+		{{2, 1.3}, {4, 3}},
+		{{6, 0.5}, {8.5, 3}},
+		{{7.5, 0.8}, {9, 0.5}},
+		{{1, 4.2}, {9, 4.2}},
+		{{2, 6.5}, {8, 6.5}},
+		{{3.8, 4.2}, {3.8, 7.5}, {1.5, 9.5}},
+		{{6.3, 4.2}, {6.3, 9.5}},
+	},
+	// 中
+	{
+		{{1.5, 3}, {8.5, 3}, {8.5, 7}, {1.5, 7}, {1.5, 3}},
+		{{5, 0.5}, {5, 9.5}},
+	},
+}
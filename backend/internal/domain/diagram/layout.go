@@ -0,0 +1,278 @@
+package diagram
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// 手牌・河の並べ方（拡大率1のピクセル）
+const (
+	// drawnGap はツモ牌の前の間隔
+	drawnGap = 8
+	// meldGap は門前の牌と副露、副露どうしの間隔
+	meldGap = 10
+	// pondRowTiles は河の1段の枚数
+	pondRowTiles = 6
+)
+
+// 卓の図の大きさと配置
+const (
+	// boardSize は卓の1辺
+	boardSize = 800
+	// boardTileScale は卓に並べる牌の縮尺
+	boardTileScale = 0.8
+	// centerHalf は中央の表示の1辺の半分
+	centerHalf = 100
+	// boardMargin は卓の端と手牌の間隔
+	boardMargin = 10
+)
+
+// 卓の色
+var (
+	feltColor   = color.RGBA{0x1f, 0x5e, 0x3f, 0xff}
+	centerColor = color.RGBA{0x14, 0x3d, 0x29, 0xff}
+	textColor   = color.RGBA{0xf1, 0xf1, 0xe6, 0xff}
+)
+
+// Meld は描く副露
+type Meld struct {
+	Type  mahjong.MeldType
+	Faces []Face
+}
+
+// Discard は描く河の1枚
+type Discard struct {
+	Face
+	Tsumogiri bool
+	Called    bool
+	Riichi    bool
+}
+
+// Hand は門前の牌・ツモ牌・副露を左から並べた図を返す（drawn は nil の場合は描かない）
+// 副露は鳴いた相手が分からないため、鳴いた牌を左端に横向きで描き、暗槓は両端を伏せる
+func Hand(concealed []Face, drawn *Face, melds []Meld) *Canvas {
+	return handCanvas(concealed, 0, drawn, melds)
+}
+
+// handCanvas は手牌の図を作る（faceDown は門前の牌の代わりに伏せて描く枚数）
+func handCanvas(concealed []Face, faceDown int, drawn *Face, melds []Meld) *Canvas {
+	height := float64(tileH + tileDepth)
+	for _, m := range melds {
+		if m.Type == mahjong.MeldKakan {
+			height = math.Max(height, 2*tileW+tileDepth)
+		}
+	}
+
+	var ops []func(c *Canvas)
+	x := 0.0
+	for _, f := range concealed {
+		ops = append(ops, tileAt(x, height, f, tileStyle{}))
+		x += tileW
+	}
+	for range faceDown {
+		ops = append(ops, tileAt(x, height, Face{}, tileStyle{faceDown: true}))
+		x += tileW
+	}
+	if drawn != nil {
+		x += drawnGap
+		ops = append(ops, tileAt(x, height, *drawn, tileStyle{}))
+		x += tileW
+	}
+	for _, m := range melds {
+		if x > 0 {
+			x += meldGap
+		}
+		var meldOps []func(c *Canvas)
+		meldOps, x = meldTiles(x, height, m)
+		ops = append(ops, meldOps...)
+	}
+
+	c := newCanvas(x, height)
+	for _, op := range ops {
+		op(c)
+	}
+	return c
+}
+
+// tileAt は下端を bottom に揃えて (x, ·) に牌を描く処理を返す
+func tileAt(x, bottom float64, f Face, style tileStyle) func(c *Canvas) {
+	_, h := tileSize(style)
+	return func(c *Canvas) { c.drawTile(x, bottom-h, f, style) }
+}
+
+// meldTiles は x から副露を描く処理と、描いた後の右端を返す
+func meldTiles(x, bottom float64, m Meld) ([]func(c *Canvas), float64) {
+	var ops []func(c *Canvas)
+	sideways := tileStyle{sideways: true}
+	for i, f := range m.Faces {
+		switch {
+		case m.Type == mahjong.MeldAnkan:
+			ops = append(ops, tileAt(x, bottom, f, tileStyle{faceDown: i == 0 || i == len(m.Faces)-1}))
+			x += tileW
+		case m.Type == mahjong.MeldKakan && i == 1:
+			// 加槓した牌は鳴いた牌の上に重ねる（先に描き、下の牌で側面を隠す）
+			upper := tileAt(x-tileH, bottom-tileW, f, sideways)
+			ops = append([]func(c *Canvas){upper}, ops...)
+		case i == 0:
+			ops = append(ops, tileAt(x, bottom, f, sideways))
+			x += tileH
+		default:
+			ops = append(ops, tileAt(x, bottom, f, tileStyle{}))
+			x += tileW
+		}
+	}
+	return ops, x
+}
+
+// Pond は河を6枚ずつの段に並べた図を返す
+// 立直宣言牌は横向きにし、ツモ切りは薄く影を付け、鳴かれた牌は白く霞ませる
+func Pond(discards []Discard) *Canvas {
+	var (
+		width float64
+		ops   []func(c *Canvas)
+	)
+	rows := (len(discards) + pondRowTiles - 1) / pondRowTiles
+	for row := range rows {
+		// 下の段で上の段の側面を隠すため、上の段から描く
+		bottom := float64(row*tileH + tileH + tileDepth)
+		x := 0.0
+		for _, d := range discards[row*pondRowTiles : min(len(discards), (row+1)*pondRowTiles)] {
+			style := tileStyle{sideways: d.Riichi}
+			switch {
+			case d.Called:
+				style.shade, style.shadeOpacity = whiteColor, 0.55
+			case d.Tsumogiri:
+				style.shade, style.shadeOpacity = blackColor, 0.12
+			}
+			ops = append(ops, tileAt(x, bottom, d.Face, style))
+			w, _ := tileSize(style)
+			x += w
+		}
+		width = math.Max(width, x)
+	}
+
+	c := newCanvas(width, float64(rows*tileH+tileDepth))
+	for _, op := range ops {
+		op(c)
+	}
+	return c
+}
+
+// seatLetters は自風の略記（東南西北）
+var seatLetters = [4]string{"E", "S", "W", "N"}
+
+// roundNames は場風の名前（東南西北）
+var roundNames = [4]string{"East", "South", "West", "North"}
+
+// Board は局の状況を卓の図にする（players は卓の人数）
+// 質問者（観戦者の場合は東家）を下にして、他家を右・上・左（三人麻雀は右・左）に向かい合わせて並べる
+// 手牌が分からないプレイヤーは門前の牌を伏せて描き、赤ドラは枚数の分だけ5の牌に割り当てる
+func Board(state *mahjong.GameState, players int) *Canvas {
+	c := newCanvas(boardSize, boardSize)
+	c.rect(0, 0, boardSize, boardSize, feltColor, 1)
+	const mid = boardSize / 2
+	c.roundRect(mid-centerHalf, mid-centerHalf, 2*centerHalf, 2*centerHalf, 8, centerColor, 1)
+
+	viewer := state.Self
+	if viewer < mahjong.East {
+		viewer = mahjong.East
+	}
+	for i := range state.Players {
+		p := &state.Players[i]
+		relative := (int(p.Seat-viewer) + players) % players
+		quarter := []int{0, 3, 2, 1}[relative]
+		if players == 3 && relative == 2 {
+			quarter = 1
+		}
+		c.drawPlayer(p, quarter)
+	}
+	c.drawCenter(state)
+	return c
+}
+
+// drawPlayer はプレイヤーの手牌・河・点数を、下を向いた向きから quarter×90度（時計回り）回した位置に描く
+func (c *Canvas) drawPlayer(p *mahjong.PlayerState, quarter int) {
+	const mid = boardSize / 2
+	red := p.AkaDora
+	var hand *Canvas
+	melds := make([]Meld, len(p.Melds))
+	for i, m := range p.Melds {
+		melds[i] = Meld{Type: m.Type, Faces: facesWithRed(m.Tiles, &red)}
+	}
+	if len(p.Hand) > 0 {
+		hand = handCanvas(facesWithRed(p.Hand, &red), 0, nil, melds)
+	} else {
+		hand = handCanvas(nil, max(0, 13-3*len(melds)), nil, melds)
+	}
+	discards := make([]Discard, len(p.Discards))
+	for i, d := range p.Discards {
+		discards[i] = Discard{Face: Face{Tile: d.Tile}, Tsumogiri: d.Tsumogiri, Called: d.Called, Riichi: d.Riichi}
+	}
+	pond := Pond(discards)
+
+	frame := translate(-mid, -mid).then(rotate(quarter)).then(translate(mid, mid))
+	c.with(frame, 1, func() {
+		if p.RiichiTurn() > 0 {
+			// 立直棒
+			c.roundRect(mid-30, mid+centerHalf-10, 60, 6, 3, whiteColor, 1)
+			c.circle(mid, mid+centerHalf-7, 2, redColor, 1)
+		}
+		handX := mid - hand.width*boardTileScale/2
+		handY := boardSize - boardMargin - hand.height*boardTileScale
+		c.with(scaleBy(boardTileScale), boardTileScale, func() {
+			c.place(hand, handX/boardTileScale, handY/boardTileScale)
+			c.place(pond, (mid-pondRowTiles*tileW*boardTileScale/2)/boardTileScale, (mid+centerHalf+6)/boardTileScale)
+		})
+	})
+
+	// 点数は回さずに中央の表示の内側の辺に寄せて書く
+	text := fmt.Sprintf("%s %d", seatLetters[p.Seat-mahjong.East], p.Score)
+	switch ((quarter % 4) + 4) % 4 {
+	case 0:
+		c.label(mid, mid+centerHalf-26, 13, textColor, anchorMiddle, true, text)
+	case 1:
+		c.label(mid-centerHalf+16, mid+5, 13, textColor, anchorStart, true, text)
+	case 2:
+		c.label(mid, mid-centerHalf+36, 13, textColor, anchorMiddle, true, text)
+	case 3:
+		c.label(mid+centerHalf-16, mid+5, 13, textColor, anchorEnd, true, text)
+	}
+}
+
+// drawCenter は中央に場・本場・供託・残り山・ドラ表示牌を描く
+func (c *Canvas) drawCenter(state *mahjong.GameState) {
+	const mid = boardSize / 2
+	round := "?"
+	if state.RoundWind.IsWind() {
+		round = roundNames[state.RoundWind-mahjong.East]
+	}
+	c.label(mid, mid-34, 18, textColor, anchorMiddle, true, fmt.Sprintf("%s %d", round, state.Kyoku))
+	c.label(mid, mid-16, 11, textColor, anchorMiddle, false, fmt.Sprintf("Honba %d  Riichi %d", state.Honba, state.RiichiSticks))
+	if state.WallRemaining > 0 {
+		c.label(mid, mid-2, 11, textColor, anchorMiddle, false, fmt.Sprintf("Wall %d", state.WallRemaining))
+	}
+
+	const doraScale = 0.6
+	x := mid - float64(len(state.DoraIndicators))*tileW*doraScale/2
+	c.with(scaleBy(doraScale), doraScale, func() {
+		for i, t := range state.DoraIndicators {
+			c.drawTile(x/doraScale+float64(i*tileW), (mid+6)/doraScale, Face{Tile: t}, tileStyle{})
+		}
+	})
+}
+
+// facesWithRed は牌を描く牌にし、残りの赤ドラの枚数の分だけ5の牌を赤にする
+func facesWithRed(tiles []mahjong.Tile, red *int) []Face {
+	faces := make([]Face, len(tiles))
+	for i, t := range tiles {
+		faces[i] = Face{Tile: t}
+		if *red > 0 && !t.IsHonor() && t.Number() == 5 {
+			faces[i].Red = true
+			*red--
+		}
+	}
+	return faces
+}
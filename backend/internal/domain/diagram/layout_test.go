@@ -0,0 +1,211 @@
+package diagram
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// mustFaces はMPSZ表記の牌を描く牌にする
+func mustFaces(t *testing.T, s string) []Face {
+	t.Helper()
+	faces, err := ParseFaces(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return faces
+}
+
+func TestHandSize(t *testing.T) {
+	drawn := mustFaces(t, "5z")[0]
+	tests := []struct {
+		name       string
+		concealed  string
+		drawn      *Face
+		melds      []Meld
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "門前の13枚", concealed: "123m456p789s1122z", wantWidth: 13 * tileW, wantHeight: tileH + tileDepth},
+		{name: "ツモ牌", concealed: "123m456p789s1122z", drawn: &drawn, wantWidth: 14*tileW + drawnGap, wantHeight: tileH + tileDepth},
+		{name: "ポン", concealed: "123m456p789s1z", melds: []Meld{{Type: mahjong.MeldPon, Faces: mustFaces(t, "777z")}},
+			wantWidth: 10*tileW + meldGap + tileH + 2*tileW, wantHeight: tileH + tileDepth},
+		{name: "暗槓は横向きにしない", concealed: "123m456p789s1z", melds: []Meld{{Type: mahjong.MeldAnkan, Faces: mustFaces(t, "7777z")}},
+			wantWidth: 10*tileW + meldGap + 4*tileW, wantHeight: tileH + tileDepth},
+		{name: "加槓は重ねて高くなる", concealed: "123m456p789s1z", melds: []Meld{{Type: mahjong.MeldKakan, Faces: mustFaces(t, "7777z")}},
+			wantWidth: 10*tileW + meldGap + tileH + 2*tileW, wantHeight: 2*tileW + tileDepth},
+		{name: "副露だけ", melds: []Meld{{Type: mahjong.MeldChi, Faces: mustFaces(t, "406s")}, {Type: mahjong.MeldPon, Faces: mustFaces(t, "111z")}},
+			wantWidth: 2*(tileH+2*tileW) + meldGap, wantHeight: tileH + tileDepth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Hand(mustFaces(t, tt.concealed), tt.drawn, tt.melds)
+			if c.Width() != tt.wantWidth || c.Height() != tt.wantHeight {
+				t.Errorf("Hand() = %dx%d, want %dx%d", c.Width(), c.Height(), tt.wantWidth, tt.wantHeight)
+			}
+			assertInBounds(t, c)
+		})
+	}
+}
+
+func TestPondSize(t *testing.T) {
+	discard := func(s string, riichi bool) Discard {
+		return Discard{Face: mustFaces(t, s)[0], Riichi: riichi}
+	}
+	tests := []struct {
+		name       string
+		discards   []Discard
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "1段", discards: []Discard{discard("1m", false), discard("9p", false)}, wantWidth: 2 * tileW, wantHeight: tileH + tileDepth},
+		{name: "6枚ずつの段", discards: []Discard{
+			discard("1m", false), discard("2m", false), discard("3m", false), discard("4m", false),
+			discard("5m", false), discard("6m", false), discard("7m", false),
+		}, wantWidth: pondRowTiles * tileW, wantHeight: 2*tileH + tileDepth},
+		{name: "立直宣言牌は横向き", discards: []Discard{
+			discard("1m", false), discard("2m", true), discard("3m", false), discard("4m", false),
+			discard("5m", false), discard("6m", false),
+		}, wantWidth: 5*tileW + tileH, wantHeight: tileH + tileDepth},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Pond(tt.discards)
+			if c.Width() != tt.wantWidth || c.Height() != tt.wantHeight {
+				t.Errorf("Pond() = %dx%d, want %dx%d", c.Width(), c.Height(), tt.wantWidth, tt.wantHeight)
+			}
+			assertInBounds(t, c)
+		})
+	}
+}
+
+func TestColumn(t *testing.T) {
+	pond := Pond([]Discard{{Face: mustFaces(t, "1z")[0]}})
+	hand := Hand(mustFaces(t, "123m"), nil, nil)
+
+	c := Column(12, pond, nil, hand)
+	if c.Width() != hand.Width() || c.Height() != pond.Height()+12+hand.Height() {
+		t.Errorf("Column() = %dx%d, want %dx%d", c.Width(), c.Height(), hand.Width(), pond.Height()+12+hand.Height())
+	}
+	if len(c.elements) != len(pond.elements)+len(hand.elements) {
+		t.Errorf("Column() has %d elements, want %d", len(c.elements), len(pond.elements)+len(hand.elements))
+	}
+	// 下に置いた描画面は間隔の分だけずれる
+	if got, want := c.elements[len(pond.elements)].points[0].Y, hand.elements[0].points[0].Y+float64(pond.Height()+12); got != want {
+		t.Errorf("first hand point at y = %g, want %g", got, want)
+	}
+
+	if single := Column(12, nil, hand); single.Height() != hand.Height() {
+		t.Errorf("Column() with one canvas = %d high, want %d without the gap", single.Height(), hand.Height())
+	}
+}
+
+// testBoardState は北家が立直し、南家がポンしている東1局の局面を返す
+func testBoardState(t *testing.T) *mahjong.GameState {
+	t.Helper()
+	pon, err := mahjong.NewMeld(mahjong.MeldPon, []mahjong.Tile{mahjong.Red, mahjong.Red, mahjong.Red})
+	if err != nil {
+		t.Fatal(err)
+	}
+	hand, _, err := mahjong.ParseTiles("123m456p789s1122z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dora, _, err := mahjong.ParseTiles("3p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &mahjong.GameState{
+		RoundWind:      mahjong.East,
+		Kyoku:          1,
+		Honba:          2,
+		RiichiSticks:   1,
+		DoraIndicators: dora,
+		Players: []mahjong.PlayerState{
+			{Seat: mahjong.East, Score: 25000, Hand: hand},
+			{Seat: mahjong.South, Score: 25000, Melds: []mahjong.Meld{pon}, Discards: []mahjong.DiscardedTile{{Tile: mahjong.NewTile(mahjong.SuitMan, 9)}}},
+			{Seat: mahjong.West, Score: 26000},
+			{Seat: mahjong.North, Score: 23000, Discards: []mahjong.DiscardedTile{{Tile: mahjong.NewTile(mahjong.SuitSou, 9), Riichi: true}}},
+		},
+		Self:          mahjong.East,
+		WallRemaining: 60,
+	}
+}
+
+func TestBoard(t *testing.T) {
+	state := testBoardState(t)
+	c := Board(state, 4)
+	if c.Width() != boardSize || c.Height() != boardSize {
+		t.Errorf("Board() = %dx%d, want %dx%d", c.Width(), c.Height(), boardSize, boardSize)
+	}
+	assertInBounds(t, c)
+
+	svg := string(c.SVG(1))
+	for _, want := range []string{"East 1", "Honba 2  Riichi 1", "Wall 60", "E 25000", "S 25000", "W 26000", "N 23000"} {
+		if !strings.Contains(svg, ">"+want+"<") {
+			t.Errorf("board does not show %q", want)
+		}
+	}
+
+	// 三人麻雀は北家を描かない
+	state.Players = state.Players[:3]
+	sanma := string(Board(state, 3).SVG(1))
+	if strings.Contains(sanma, ">N 23000<") || !strings.Contains(sanma, ">W 26000<") {
+		t.Error("three-player board shows the wrong players")
+	}
+}
+
+func TestBoardViewer(t *testing.T) {
+	// 質問者の点数を下の辺に書く
+	state := testBoardState(t)
+	for _, self := range []mahjong.Tile{mahjong.West, -1} {
+		state.Self = self
+		bottom := "W 26000"
+		if self < mahjong.East {
+			bottom = "E 25000"
+		}
+		var got []string
+		for _, e := range Board(state, 4).elements {
+			if e.kind == elementText && strings.Contains(e.text, "000") && e.points[0].Y > boardSize/2+centerHalf/2 {
+				got = append(got, e.text)
+			}
+		}
+		if len(got) != 1 || got[0] != bottom {
+			t.Errorf("self %d: bottom scores = %q, want %q", self, got, bottom)
+		}
+	}
+}
+
+func TestFacesWithRed(t *testing.T) {
+	tiles, _, err := mahjong.ParseTiles("555m5z5p")
+	if err != nil {
+		t.Fatal(err)
+	}
+	red := 2
+	faces := facesWithRed(tiles, &red)
+	// 赤ドラは先に現れた数牌の5から割り当て、字牌には割り当てない
+	want := []bool{true, true, false, false, false}
+	for i, f := range faces {
+		if f.Red != want[i] {
+			t.Errorf("faces[%d].Red = %v, want %v", i, f.Red, want[i])
+		}
+	}
+	if red != 0 {
+		t.Errorf("%d red fives left, want 0", red)
+	}
+}
+
+// assertInBounds は要素の座標が描画面の内側にあるかを確かめる
+func assertInBounds(t *testing.T, c *Canvas) {
+	t.Helper()
+	const eps = 1e-9
+	for _, e := range c.elements {
+		for _, p := range e.points {
+			if p.X < -eps || p.Y < -eps || p.X > c.width+eps || p.Y > c.height+eps {
+				t.Errorf("point %+v is outside %gx%g", p, c.width, c.height)
+				return
+			}
+		}
+	}
+}
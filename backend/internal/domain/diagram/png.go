@@ -0,0 +1,149 @@
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"sync"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+	"golang.org/x/image/vector"
+)
+
+// PNG は図を拡大率 scale のPNGで返す（背景は透明）
+func (c *Canvas) PNG(scale float64) ([]byte, error) {
+	w, h := scaledSize(c.width, scale), scaledSize(c.height, scale)
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for _, e := range c.elements {
+		points := make([]point, len(e.points))
+		for i, p := range e.points {
+			points[i] = point{p.X * scale, p.Y * scale}
+		}
+		src := image.NewUniform(color.NRGBA{R: e.color.R, G: e.color.G, B: e.color.B, A: uint8(math.Round(255 * e.opacity))})
+		switch e.kind {
+		case elementPolygon:
+			z := vector.NewRasterizer(w, h)
+			addPolygon(z, points)
+			z.Draw(img, img.Bounds(), src, image.Point{})
+		case elementCircle:
+			z := vector.NewRasterizer(w, h)
+			addPolygon(z, circlePoints(points[0], e.radius*scale))
+			z.Draw(img, img.Bounds(), src, image.Point{})
+		case elementPolyline:
+			z := vector.NewRasterizer(w, h)
+			addStroke(z, points, e.width*scale)
+			z.Draw(img, img.Bounds(), src, image.Point{})
+		case elementText:
+			if err := drawText(img, points[0], e, scale, src); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var b bytes.Buffer
+	if err := png.Encode(&b, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// addPolygon は向きをそろえた多角形をラスタライザに加える
+// 重なった多角形の面積が打ち消し合わないよう、常に同じ向きで加える
+func addPolygon(z *vector.Rasterizer, points []point) {
+	if len(points) < 3 {
+		return
+	}
+	area := 0.0
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+		area += p.X*q.Y - q.X*p.Y
+	}
+	if area < 0 {
+		reversed := make([]point, len(points))
+		for i, p := range points {
+			reversed[len(points)-1-i] = p
+		}
+		points = reversed
+	}
+	z.MoveTo(float32(points[0].X), float32(points[0].Y))
+	for _, p := range points[1:] {
+		z.LineTo(float32(p.X), float32(p.Y))
+	}
+	z.ClosePath()
+}
+
+// circlePoints は円を近似する多角形の頂点を返す
+func circlePoints(center point, r float64) []point {
+	steps := max(16, int(r*2))
+	points := make([]point, steps)
+	for i := range points {
+		a := 2 * math.Pi * float64(i) / float64(steps)
+		points[i] = point{center.X + r*math.Cos(a), center.Y + r*math.Sin(a)}
+	}
+	return points
+}
+
+// addStroke は線分ごとの長方形と頂点の円で、端と角を丸めた線をラスタライザに加える
+func addStroke(z *vector.Rasterizer, points []point, width float64) {
+	half := width / 2
+	for i := 0; i+1 < len(points); i++ {
+		p, q := points[i], points[i+1]
+		length := math.Hypot(q.X-p.X, q.Y-p.Y)
+		if length == 0 {
+			continue
+		}
+		nx, ny := -(q.Y-p.Y)/length*half, (q.X-p.X)/length*half
+		addPolygon(z, []point{{p.X + nx, p.Y + ny}, {q.X + nx, q.Y + ny}, {q.X - nx, q.Y - ny}, {p.X - nx, p.Y - ny}})
+	}
+	for _, p := range points {
+		addPolygon(z, circlePoints(p, half))
+	}
+}
+
+// goFonts は文字列に使う Go フォント（標準と太字）
+var goFonts = sync.OnceValues(func() ([2]*opentype.Font, error) {
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return [2]*opentype.Font{}, err
+	}
+	return [2]*opentype.Font{regular, bold}, nil
+})
+
+// drawText は文字列を揃えに合わせて書く
+func drawText(img *image.RGBA, at point, e element, scale float64, src image.Image) error {
+	fonts, err := goFonts()
+	if err != nil {
+		return fmt.Errorf("failed to parse font: %w", err)
+	}
+	f := fonts[0]
+	if e.bold {
+		f = fonts[1]
+	}
+	face, err := opentype.NewFace(f, &opentype.FaceOptions{Size: e.size * scale, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return fmt.Errorf("failed to create font face: %w", err)
+	}
+	defer face.Close()
+
+	d := &font.Drawer{Dst: img, Src: src, Face: face}
+	x := at.X
+	switch e.anchor {
+	case anchorMiddle:
+		x -= float64(d.MeasureString(e.text)) / 64 / 2
+	case anchorEnd:
+		x -= float64(d.MeasureString(e.text)) / 64
+	}
+	d.Dot = fixed.Point26_6{X: fixed.Int26_6(x * 64), Y: fixed.Int26_6(at.Y * 64)}
+	d.DrawString(e.text)
+	return nil
+}
@@ -0,0 +1,125 @@
+package diagram
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"testing"
+)
+
+// decodePNG はPNGを読み込む
+func decodePNG(t *testing.T, data []byte) image.Image {
+	t.Helper()
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("png.Decode: %v", err)
+	}
+	return img
+}
+
+// nrgbaAt は (x, y) の色をアルファ乗算前の色で返す
+func nrgbaAt(img image.Image, x, y int) color.NRGBA {
+	return color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+}
+
+func TestCanvasPNG(t *testing.T) {
+	c := newCanvas(20, 10)
+	c.rect(0, 0, 8, 10, redColor, 1)
+	c.rect(12, 0, 8, 10, blueColor, 0.5)
+
+	tests := []struct {
+		name  string
+		scale float64
+	}{
+		{name: "等倍", scale: 1},
+		{name: "2倍", scale: 2},
+		{name: "端数の拡大", scale: 1.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := c.PNG(tt.scale)
+			if err != nil {
+				t.Fatalf("PNG: %v", err)
+			}
+			img := decodePNG(t, data)
+			px := func(v float64) int { return int(v * tt.scale) }
+			if got := img.Bounds().Size(); got.X != scaledSize(20, tt.scale) || got.Y != scaledSize(10, tt.scale) {
+				t.Fatalf("size = %v, want %dx%d", got, scaledSize(20, tt.scale), scaledSize(10, tt.scale))
+			}
+			if got := nrgbaAt(img, px(4), px(5)); got != (color.NRGBA{redColor.R, redColor.G, redColor.B, 0xff}) {
+				t.Errorf("opaque rect = %v, want %v", got, redColor)
+			}
+			// 背景は透明
+			if got := nrgbaAt(img, px(10), px(5)); got.A != 0 {
+				t.Errorf("background = %v, want transparent", got)
+			}
+			if got := nrgbaAt(img, px(16), px(5)); got.A < 0x7e || got.A > 0x81 || got.B < blueColor.B-2 {
+				t.Errorf("half transparent rect = %v, want %v at half opacity", got, blueColor)
+			}
+		})
+	}
+}
+
+func TestCanvasPNGShapes(t *testing.T) {
+	c := newCanvas(60, 30)
+	c.circle(10, 10, 6, greenColor, 1)
+	c.stroke(4, inkColor, point{20, 5}, point{40, 5}, point{40, 25})
+	c.label(50, 25, 12, inkColor, anchorEnd, true, "E")
+	data, err := c.PNG(1)
+	if err != nil {
+		t.Fatalf("PNG: %v", err)
+	}
+	img := decodePNG(t, data)
+
+	tests := []struct {
+		name   string
+		x, y   int
+		filled bool
+	}{
+		{name: "円の中心", x: 10, y: 10, filled: true},
+		{name: "円の外", x: 10, y: 18},
+		{name: "線の上", x: 30, y: 5, filled: true},
+		{name: "線の角", x: 40, y: 5, filled: true},
+		{name: "線の外", x: 30, y: 15},
+		{name: "右揃えの文字列の右", x: 55, y: 20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nrgbaAt(img, tt.x, tt.y); (got.A == 0xff) != tt.filled {
+				t.Errorf("pixel (%d, %d) = %v, filled %v", tt.x, tt.y, got, tt.filled)
+			}
+		})
+	}
+
+	// 文字列は終わりを x に揃えて左側に書く
+	inked := 0
+	for y := 10; y < 26; y++ {
+		for x := 38; x < 50; x++ {
+			if nrgbaAt(img, x, y).A > 0 {
+				inked++
+			}
+		}
+	}
+	if inked == 0 {
+		t.Error("label was not drawn left of its anchor")
+	}
+}
+
+func TestHandPNGMatchesSVGSize(t *testing.T) {
+	faces, err := ParseFaces("19m19p19s1234567z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := Hand(faces, &faces[0], nil)
+	data, err := c.PNG(2)
+	if err != nil {
+		t.Fatalf("PNG: %v", err)
+	}
+	_, root := parseSVG(t, c.SVG(2))
+	size := decodePNG(t, data).Bounds().Size()
+	if root["width"] != strconv.Itoa(size.X) || root["height"] != strconv.Itoa(size.Y) {
+		t.Errorf("PNG is %v, SVG is %sx%s", size, root["width"], root["height"])
+	}
+}
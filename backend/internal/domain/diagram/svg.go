@@ -0,0 +1,77 @@
+package diagram
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"image/color"
+	"math"
+	"strconv"
+)
+
+// svgFontFamily は文字列のフォント（PNG に使う Go フォントがなければ近い書体にする）
+const svgFontFamily = "Go, Helvetica, Arial, sans-serif"
+
+// SVG は図を拡大率 scale の SVG で返す
+func (c *Canvas) SVG(scale float64) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %s %s">`,
+		scaledSize(c.width, scale), scaledSize(c.height, scale), num(c.width), num(c.height))
+	for _, e := range c.elements {
+		switch e.kind {
+		case elementPolygon:
+			fmt.Fprintf(&b, `<polygon points="%s" fill="%s"%s/>`, svgPoints(e.points), hexColor(e.color), svgOpacity("fill-opacity", e.opacity))
+		case elementCircle:
+			fmt.Fprintf(&b, `<circle cx="%s" cy="%s" r="%s" fill="%s"%s/>`, num(e.points[0].X), num(e.points[0].Y), num(e.radius), hexColor(e.color), svgOpacity("fill-opacity", e.opacity))
+		case elementPolyline:
+			fmt.Fprintf(&b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="%s" stroke-linecap="round" stroke-linejoin="round"%s/>`,
+				svgPoints(e.points), hexColor(e.color), num(e.width), svgOpacity("stroke-opacity", e.opacity))
+		case elementText:
+			weight := ""
+			if e.bold {
+				weight = ` font-weight="bold"`
+			}
+			fmt.Fprintf(&b, `<text x="%s" y="%s" font-family="%s" font-size="%s"%s text-anchor="%s" fill="%s">%s</text>`,
+				num(e.points[0].X), num(e.points[0].Y), svgFontFamily, num(e.size), weight, e.anchor, hexColor(e.color), html.EscapeString(e.text))
+		}
+	}
+	b.WriteString("</svg>")
+	return b.Bytes()
+}
+
+// scaledSize は拡大した大きさのピクセル数を返す
+func scaledSize(size, scale float64) int {
+	return int(size*scale + 0.999)
+}
+
+// svgPoints は座標を points 属性の書式にする
+func svgPoints(points []point) string {
+	var b bytes.Buffer
+	for i, p := range points {
+		if i > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(num(p.X))
+		b.WriteByte(',')
+		b.WriteString(num(p.Y))
+	}
+	return b.String()
+}
+
+// svgOpacity は不透明でない場合の属性を返す
+func svgOpacity(name string, opacity float64) string {
+	if opacity >= 1 {
+		return ""
+	}
+	return fmt.Sprintf(` %s="%s"`, name, num(opacity))
+}
+
+// num は座標を小数第2位までの短い書式にする
+func num(v float64) string {
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+// hexColor は色を #rrggbb の書式にする
+func hexColor(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
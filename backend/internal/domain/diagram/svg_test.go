@@ -0,0 +1,134 @@
+package diagram
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"image/color"
+	"io"
+	"strings"
+	"testing"
+)
+
+// parseSVG はSVGをXMLとして読み、要素の名前ごとの数とルート要素の属性を返す
+func parseSVG(t *testing.T, data []byte) (map[string]int, map[string]string) {
+	t.Helper()
+	counts := map[string]int{}
+	root := map[string]string{}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return counts, root
+		}
+		if err != nil {
+			t.Fatalf("SVG is not well-formed XML: %v", err)
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if len(counts) == 0 {
+				for _, a := range start.Attr {
+					root[a.Name.Local] = a.Value
+				}
+			}
+			counts[start.Name.Local]++
+		}
+	}
+}
+
+func TestCanvasSVG(t *testing.T) {
+	c := newCanvas(20.5, 10)
+	c.rect(0, 0, 10, 10, redColor, 1)
+	c.circle(15, 5, 2, inkColor, 0.5)
+	c.stroke(1.5, blueColor, point{0, 0}, point{20, 10})
+	c.label(10, 8, 6, textColor, anchorMiddle, true, `<E & "S">`)
+
+	tests := []struct {
+		name       string
+		scale      float64
+		wantWidth  string
+		wantHeight string
+	}{
+		{name: "等倍", scale: 1, wantWidth: "21", wantHeight: "10"},
+		{name: "2倍", scale: 2, wantWidth: "41", wantHeight: "20"},
+		{name: "縮小", scale: 0.5, wantWidth: "11", wantHeight: "5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts, root := parseSVG(t, c.SVG(tt.scale))
+			// 拡大は大きさだけを変え、座標は viewBox で拡大率1のまま描く
+			if root["width"] != tt.wantWidth || root["height"] != tt.wantHeight || root["viewBox"] != "0 0 20.5 10" {
+				t.Errorf("root = %v, want %sx%s with viewBox 0 0 20.5 10", root, tt.wantWidth, tt.wantHeight)
+			}
+			for name, want := range map[string]int{"svg": 1, "polygon": 1, "circle": 1, "polyline": 1, "text": 1} {
+				if counts[name] != want {
+					t.Errorf("%d <%s> elements, want %d", counts[name], name, want)
+				}
+			}
+		})
+	}
+
+	svg := string(c.SVG(1))
+	for _, want := range []string{
+		`<polygon points="0,0 10,0 10,10 0,10" fill="#c62828"/>`,
+		`<circle cx="15" cy="5" r="2" fill="#1b1b1b" fill-opacity="0.5"/>`,
+		`stroke="#1f4e9c" stroke-width="1.5"`,
+		`font-weight="bold" text-anchor="middle"`,
+		`&lt;E &amp; &#34;S&#34;&gt;</text>`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG does not contain %s:\n%s", want, svg)
+		}
+	}
+}
+
+func TestCanvasSVGTransform(t *testing.T) {
+	// 変換した座標系で描いた要素は変換済みの座標で書き出す
+	c := newCanvas(100, 100)
+	c.with(translate(50, 50).then(identity), 1, func() {
+		c.with(scaleBy(2), 2, func() {
+			c.rect(0, 0, 5, 5, inkColor, 1)
+			c.circle(0, 0, 3, inkColor, 1)
+		})
+	})
+	c.with(rotate(1), 1, func() {
+		c.rect(10, 0, 1, 1, inkColor, 1)
+	})
+	svg := string(c.SVG(1))
+	for _, want := range []string{
+		`points="50,50 60,50 60,60 50,60"`,
+		`<circle cx="50" cy="50" r="6"`,
+		`points="0,10 0,11 -1,11 -1,10"`,
+	} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("SVG does not contain %s:\n%s", want, svg)
+		}
+	}
+	if c.transform != identity || c.scale != 1 {
+		t.Errorf("transform after drawing = %+v scale %g, want the identity", c.transform, c.scale)
+	}
+}
+
+func TestNum(t *testing.T) {
+	tests := []struct {
+		v    float64
+		want string
+	}{
+		{v: 0, want: "0"},
+		{v: 12, want: "12"},
+		{v: 1.5, want: "1.5"},
+		{v: 1.23456, want: "1.23"},
+		{v: -0.125, want: "-0.13"},
+		{v: 2.999, want: "3"},
+	}
+	for _, tt := range tests {
+		if got := num(tt.v); got != tt.want {
+			t.Errorf("num(%g) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestHexColor(t *testing.T) {
+	if got := hexColor(color.RGBA{0x0a, 0xbc, 0xff, 0x80}); got != "#0abcff" {
+		t.Errorf("hexColor() = %q, want #0abcff", got)
+	}
+}
@@ -0,0 +1,265 @@
+package diagram
+
+import (
+	"image/color"
+	"math"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// 牌の大きさ（拡大率1のピクセル）
+const (
+	tileW = 30
+	tileH = 40
+	// tileDepth は牌の厚み（面の下に見える側面の高さ）
+	tileDepth = 4
+	// tileRadius は牌の角の丸み
+	tileRadius = 3
+)
+
+// 牌の色
+var (
+	faceColor  = color.RGBA{0xfb, 0xf8, 0xef, 0xff}
+	edgeColor  = color.RGBA{0x8c, 0x7e, 0x66, 0xff}
+	sideColor  = color.RGBA{0xd9, 0x89, 0x2b, 0xff}
+	backColor  = color.RGBA{0xe3, 0x97, 0x3a, 0xff}
+	inkColor   = color.RGBA{0x1b, 0x1b, 0x1b, 0xff}
+	redColor   = color.RGBA{0xc6, 0x28, 0x28, 0xff}
+	greenColor = color.RGBA{0x1e, 0x7a, 0x3c, 0xff}
+	blueColor  = color.RGBA{0x1f, 0x4e, 0x9c, 0xff}
+	whiteColor = color.RGBA{0xff, 0xff, 0xff, 0xff}
+	blackColor = color.RGBA{0x00, 0x00, 0x00, 0xff}
+	frameColor = color.RGBA{0x5b, 0x8f, 0xd6, 0xff}
+)
+
+// Face は描く牌（赤ドラかを含む）
+type Face struct {
+	Tile mahjong.Tile
+	Red  bool
+}
+
+// ParseFaces はMPSZ表記（0 は赤5）の牌を表記の順に返す
+func ParseFaces(s string) ([]Face, error) {
+	tiles, _, err := mahjong.ParseTiles(s)
+	if err != nil {
+		return nil, err
+	}
+	// 表記の数字は牌と同じ順に並ぶため、数字の位置で赤5を見分ける
+	var reds []bool
+	for _, r := range strings.ReplaceAll(s, " ", "") {
+		if r >= '0' && r <= '9' {
+			reds = append(reds, r == '0')
+		}
+	}
+	faces := make([]Face, len(tiles))
+	for i, t := range tiles {
+		faces[i] = Face{Tile: t, Red: reds[i]}
+	}
+	return faces, nil
+}
+
+// tileStyle は牌の描き方
+type tileStyle struct {
+	// sideways は横向き（立直宣言牌・鳴いた牌）に描くか
+	sideways bool
+	// faceDown は裏向きに描くか
+	faceDown bool
+	// shade は面に重ねる色と不透明度（ツモ切り・鳴かれた牌を区別する。不透明度0は重ねない）
+	shade        color.RGBA
+	shadeOpacity float64
+}
+
+// tileSize は描き方に応じた牌の幅と高さ（厚みを含む）を返す
+func tileSize(style tileStyle) (float64, float64) {
+	if style.sideways {
+		return tileH, tileW + tileDepth
+	}
+	return tileW, tileH + tileDepth
+}
+
+// drawTile は (x, y) を左上として牌を描く
+func (c *Canvas) drawTile(x, y float64, f Face, style tileStyle) {
+	w, h := float64(tileW), float64(tileH)
+	if style.sideways {
+		w, h = h, w
+	}
+	c.roundRect(x, y+tileDepth, w, h, tileRadius, sideColor, 1)
+	c.roundRect(x, y, w, h, tileRadius, edgeColor, 1)
+	if style.faceDown {
+		c.roundRect(x+0.8, y+0.8, w-1.6, h-1.6, tileRadius-0.5, backColor, 1)
+		c.roundRect(x+3, y+3, w-6, h-6, tileRadius-1, whiteColor, 0.15)
+		return
+	}
+	c.roundRect(x+0.8, y+0.8, w-1.6, h-1.6, tileRadius-0.5, faceColor, 1)
+
+	// 絵柄は縦向きの牌の座標で描き、横向きの場合は反時計回りに倒す
+	m := translate(x, y)
+	if style.sideways {
+		m = rotate(3).then(translate(x, y+tileW))
+	}
+	c.with(m, 1, func() { c.drawArtwork(f) })
+	if style.shadeOpacity > 0 {
+		c.roundRect(x+0.8, y+0.8, w-1.6, h-1.6, tileRadius-0.5, style.shade, style.shadeOpacity)
+	}
+}
+
+// drawArtwork は縦向きの牌の座標（幅 tileW、高さ tileH）で牌の絵柄を描く
+func (c *Canvas) drawArtwork(f Face) {
+	n := f.Tile.Number()
+	switch f.Tile.Suit() {
+	case mahjong.SuitMan:
+		numeral := inkColor
+		if f.Red {
+			numeral = redColor
+		}
+		c.glyph(numeralGlyphs[n-1], 6, 4, 18, 14, 2.2, numeral)
+		c.glyph(manGlyph, 6, 21, 18, 15, 1.6, redColor)
+	case mahjong.SuitPin:
+		c.drawPin(n, f.Red)
+	case mahjong.SuitSou:
+		c.drawSou(n, f.Red)
+	default:
+		c.drawHonor(f.Tile)
+	}
+}
+
+// coin は筒子の1つの円
+type coin struct {
+	x, y, r float64
+	color   color.RGBA
+}
+
+// pinCoins は筒子の円の配置（数字ごと）
+var pinCoins = [9][]coin{
+	nil,
+	{{15, 11.5, 6.5, greenColor}, {15, 28.5, 6.5, blueColor}},
+	{{8, 9, 5, blueColor}, {15, 20, 5, redColor}, {22, 31, 5, greenColor}},
+	{{9, 11, 5.2, blueColor}, {21, 11, 5.2, greenColor}, {9, 29, 5.2, greenColor}, {21, 29, 5.2, blueColor}},
+	{{8.5, 9, 4.8, blueColor}, {21.5, 9, 4.8, greenColor}, {15, 20, 4.8, redColor}, {8.5, 31, 4.8, greenColor}, {21.5, 31, 4.8, blueColor}},
+	{{9.5, 8, 4.3, greenColor}, {20.5, 8, 4.3, greenColor}, {9.5, 21, 4.3, redColor}, {20.5, 21, 4.3, redColor}, {9.5, 31, 4.3, redColor}, {20.5, 31, 4.3, redColor}},
+	{{7.5, 6.5, 3.6, greenColor}, {15, 9.5, 3.6, greenColor}, {22.5, 12.5, 3.6, greenColor}, {9.5, 23, 4, redColor}, {20.5, 23, 4, redColor}, {9.5, 32, 4, redColor}, {20.5, 32, 4, redColor}},
+	{{9.5, 7, 3.9, blueColor}, {20.5, 7, 3.9, blueColor}, {9.5, 15.7, 3.9, blueColor}, {20.5, 15.7, 3.9, blueColor}, {9.5, 24.3, 3.9, blueColor}, {20.5, 24.3, 3.9, blueColor}, {9.5, 33, 3.9, blueColor}, {20.5, 33, 3.9, blueColor}},
+	{{7.5, 9, 3.7, blueColor}, {15, 9, 3.7, blueColor}, {22.5, 9, 3.7, blueColor}, {7.5, 20, 3.7, redColor}, {15, 20, 3.7, redColor}, {22.5, 20, 3.7, redColor}, {7.5, 31, 3.7, greenColor}, {15, 31, 3.7, greenColor}, {22.5, 31, 3.7, greenColor}},
+}
+
+// drawPin は筒子の絵柄を描く（赤5はすべての円を赤くする）
+func (c *Canvas) drawPin(n int, red bool) {
+	if n == 1 {
+		for _, layer := range []struct {
+			r     float64
+			color color.RGBA
+		}{{10.5, greenColor}, {9.2, whiteColor}, {8.2, blueColor}, {6, whiteColor}, {4.5, redColor}, {1.6, whiteColor}} {
+			c.circle(15, 20, layer.r, layer.color, 1)
+		}
+		return
+	}
+	for _, p := range pinCoins[n-1] {
+		col := p.color
+		if red {
+			col = redColor
+		}
+		c.circle(p.x, p.y, p.r, col, 1)
+		c.circle(p.x, p.y, p.r*0.62, whiteColor, 1)
+		c.circle(p.x, p.y, p.r*0.38, col, 1)
+	}
+}
+
+// stick は索子の1本の竹
+type stick struct {
+	x, y float64
+	// angle は時計回りの傾き（度）
+	angle float64
+	red   bool
+}
+
+// souSticks は索子の竹の配置（数字ごと、1索は鳥を描く）
+var souSticks = [9][]stick{
+	nil,
+	{{15, 12.5, 0, false}, {15, 27.5, 0, false}},
+	{{15, 12.5, 0, false}, {9.5, 27.5, 0, false}, {20.5, 27.5, 0, false}},
+	{{9.5, 12.5, 0, false}, {20.5, 12.5, 0, false}, {9.5, 27.5, 0, false}, {20.5, 27.5, 0, false}},
+	{{8, 11.5, 0, false}, {22, 11.5, 0, false}, {15, 20, 0, true}, {8, 28.5, 0, false}, {22, 28.5, 0, false}},
+	{{7.5, 12.5, 0, false}, {15, 12.5, 0, false}, {22.5, 12.5, 0, false}, {7.5, 27.5, 0, false}, {15, 27.5, 0, false}, {22.5, 27.5, 0, false}},
+	{{15, 8.5, 0, true}, {7.5, 20, 0, false}, {15, 20, 0, false}, {22.5, 20, 0, false}, {7.5, 31, 0, false}, {15, 31, 0, false}, {22.5, 31, 0, false}},
+	{{6.5, 12.5, 20, false}, {11.5, 12.5, -20, false}, {18.5, 12.5, 20, false}, {23.5, 12.5, -20, false}, {6.5, 27.5, -20, false}, {11.5, 27.5, 20, false}, {18.5, 27.5, -20, false}, {23.5, 27.5, 20, false}},
+	{{7.5, 8.5, 0, false}, {15, 8.5, 0, true}, {22.5, 8.5, 0, false}, {7.5, 20, 0, false}, {15, 20, 0, true}, {22.5, 20, 0, false}, {7.5, 31.5, 0, false}, {15, 31.5, 0, true}, {22.5, 31.5, 0, false}},
+}
+
+// drawSou は索子の絵柄を描く（赤5はすべての竹を赤くする）
+func (c *Canvas) drawSou(n int, red bool) {
+	if n == 1 {
+		c.drawBird()
+		return
+	}
+	length := 11.0
+	if n >= 7 && n != 8 {
+		length = 8.5
+	}
+	for _, s := range souSticks[n-1] {
+		col := greenColor
+		if s.red || red {
+			col = redColor
+		}
+		c.with(rotateAngle(s.angle).then(translate(s.x, s.y)), 1, func() {
+			c.roundRect(-1.8, -length/2, 3.6, length, 1.6, col, 1)
+			c.stroke(0.7, whiteColor, point{0, -length/2 + 1.5}, point{0, length/2 - 1.5})
+			c.rect(-1.8, -0.5, 3.6, 1, whiteColor, 1)
+		})
+	}
+}
+
+// drawBird は1索の鳥を描く
+func (c *Canvas) drawBird() {
+	// 尾羽
+	c.stroke(2.2, blueColor, point{16, 25}, point{24, 7})
+	c.stroke(2.2, greenColor, point{17, 26}, point{26.5, 13})
+	c.stroke(2.2, blueColor, point{17, 27}, point{26.5, 20.5})
+	// 胴と羽
+	c.polygon(greenColor, 1, ellipsePoints(13, 24, 7, 9)...)
+	c.polygon(color.RGBA{0x14, 0x5c, 0x2c, 0xff}, 1, ellipsePoints(15, 25, 3.8, 5.5)...)
+	// 頭とくちばし
+	c.circle(10, 12.5, 3.8, redColor, 1)
+	c.circle(9, 11.8, 0.9, whiteColor, 1)
+	c.polygon(color.RGBA{0xe0, 0xa1, 0x1b, 0xff}, 1, point{6.6, 11.8}, point{3.8, 13.6}, point{7, 14.6})
+	// 脚
+	c.stroke(1.4, redColor, point{11, 32}, point{10, 36.5})
+	c.stroke(1.4, redColor, point{15, 32}, point{16, 36.5})
+}
+
+// ellipsePoints は楕円を近似する多角形の頂点を返す
+func ellipsePoints(cx, cy, rx, ry float64) []point {
+	const steps = 24
+	points := make([]point, steps)
+	for i := range points {
+		a := 2 * math.Pi * float64(i) / steps
+		points[i] = point{cx + rx*math.Cos(a), cy + ry*math.Sin(a)}
+	}
+	return points
+}
+
+// drawHonor は字牌の絵柄を描く
+func (c *Canvas) drawHonor(t mahjong.Tile) {
+	switch t {
+	case mahjong.White:
+		// 白は枠だけを描く
+		c.stroke(1.2, frameColor, point{7, 8}, point{23, 8}, point{23, 32}, point{7, 32}, point{7, 8})
+	case mahjong.Green:
+		c.glyph(honorGlyphs[t-mahjong.East], 4, 5, 22, 30, 2.4, greenColor)
+	case mahjong.Red:
+		c.glyph(honorGlyphs[t-mahjong.East], 4, 5, 22, 30, 2.6, redColor)
+	default:
+		c.glyph(honorGlyphs[t-mahjong.East], 4, 5, 22, 30, 2.4, inkColor)
+	}
+}
+
+// glyph は 10×10 の格子で定義した字の画を (x, y, w, h) の範囲に引く
+func (c *Canvas) glyph(strokes [][]point, x, y, w, h, width float64, col color.RGBA) {
+	for _, s := range strokes {
+		points := make([]point, len(s))
+		for i, p := range s {
+			points[i] = point{x + p.X*w/10, y + p.Y*h/10}
+		}
+		c.stroke(width, col, points...)
+	}
+}
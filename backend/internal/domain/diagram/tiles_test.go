@@ -0,0 +1,83 @@
+package diagram
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+func TestParseFaces(t *testing.T) {
+	man5 := mahjong.NewTile(mahjong.SuitMan, 5)
+	tests := []struct {
+		name    string
+		input   string
+		want    []Face
+		wantErr error
+	}{
+		{name: "空", input: "", want: []Face{}},
+		{name: "表記の順", input: "31m2z", want: []Face{
+			{Tile: mahjong.NewTile(mahjong.SuitMan, 3)}, {Tile: mahjong.NewTile(mahjong.SuitMan, 1)}, {Tile: mahjong.South},
+		}},
+		{name: "赤5を見分ける", input: "505m", want: []Face{{Tile: man5}, {Tile: man5, Red: true}, {Tile: man5}}},
+		{name: "空白", input: "0p 5s", want: []Face{{Tile: mahjong.NewTile(mahjong.SuitPin, 5), Red: true}, {Tile: mahjong.NewTile(mahjong.SuitSou, 5)}}},
+		{name: "字牌の範囲外", input: "8z", wantErr: entity.ErrInvalidTileNotation},
+		{name: "牌の種類がない", input: "123", wantErr: entity.ErrInvalidTileNotation},
+		{name: "字牌の赤", input: "0z", wantErr: entity.ErrInvalidTileNotation},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFaces(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("ParseFaces(%q) error = %v, want %v", tt.input, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseFaces(%q): %v", tt.input, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFaces(%q) = %+v, want %+v", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTileSize(t *testing.T) {
+	if w, h := tileSize(tileStyle{}); w != tileW || h != tileH+tileDepth {
+		t.Errorf("upright tile = %gx%g, want %dx%d", w, h, tileW, tileH+tileDepth)
+	}
+	if w, h := tileSize(tileStyle{sideways: true}); w != tileH || h != tileW+tileDepth {
+		t.Errorf("sideways tile = %gx%g, want %dx%d", w, h, tileH, tileW+tileDepth)
+	}
+}
+
+func TestDrawTileStaysInBounds(t *testing.T) {
+	faces, err := ParseFaces("1234567890m1234567890p1234567890s1234567z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	styles := []tileStyle{{}, {sideways: true}, {faceDown: true}, {shade: blackColor, shadeOpacity: 0.12}}
+	for _, style := range styles {
+		w, h := tileSize(style)
+		for _, f := range faces {
+			c := newCanvas(w, h)
+			c.drawTile(0, 0, f, style)
+			if len(c.elements) == 0 {
+				t.Fatalf("%s (%+v) drew nothing", f.Tile, style)
+			}
+			// 絵柄を含めて牌の外にはみ出さない
+			for _, e := range c.elements {
+				for _, p := range e.points {
+					if p.X < -1e-9 || p.Y < -1e-9 || p.X > w+1e-9 || p.Y > h+1e-9 {
+						t.Errorf("%s (%+v) has a point %+v outside %gx%g", f.Tile, style, p, w, h)
+						break
+					}
+				}
+			}
+		}
+	}
+}
@@ -6,14 +6,25 @@ type AIRequest struct {
 	MaxTokens   int32
 	Temperature float32
 	Context     []string
+	// SystemPrompt はAIに与えるシステム指示（空の場合はプロバイダのデフォルト）
+	SystemPrompt string
+	// Tools はAIが回答の途中で呼び出せるツール
+	Tools []*Tool
+	// ResponseSchema は回答をJSONで出力させる場合の形式（nil の場合は自由な文章）
+	ResponseSchema *ResponseSchema
+	// Sample は同じリクエストから複数の回答を生成する場合の回答の番号
+	// （回答をキャッシュする場合に、同じ回答を使い回さないよう区別する）
+	Sample int
+	// Attachments は質問に添付する画像
+	Attachments []Attachment
 }
 
 // NewAIRequest は新しいAIRequestを作成する
 func NewAIRequest(prompt string) *AIRequest {
 	return &AIRequest{
 		Prompt:      prompt,
-		MaxTokens:   1000,    // デフォルト値
-		Temperature: 0.7,     // デフォルト値
+		MaxTokens:   1000, // デフォルト値
+		Temperature: 0.7,  // デフォルト値
 		Context:     []string{},
 	}
 }
@@ -2,10 +2,100 @@ package entity
 
 // AIResponse は麻雀AIからのレスポンスを表すエンティティ
 type AIResponse struct {
-	Response     string
-	TokensUsed   int32
+	Response   string
+	TokensUsed int32
+	// Confidence は回答の検証結果から求めた信頼度（0 は検証していない）
 	Confidence   float32
 	ProcessingMs int64
+	// ToolCalls は回答の根拠としてAIが呼び出したツール
+	ToolCalls []ToolCall
+	// Verification は回答に含まれる主張を麻雀エンジンで検証した結果（検証していない場合は nil）
+	Verification *AnswerVerification
+	// Consistency は複数の回答を比べた自己一貫性の結果（複数の回答を生成していない場合は nil）
+	Consistency *SelfConsistency
+	// Structured は形式を指定した場合に回答のJSONを解析した値（形式を指定していない場合は nil）
+	Structured map[string]any
+	// Citations は回答の根拠としてAIに渡したルールの資料の抜粋
+	Citations []Citation
+	// Cached はAIに問い合わせず、キャッシュした回答を返したか
+	Cached bool
+	// Transcription は添付した画像の手牌を書き起こした結果（書き起こしていない場合は nil）
+	Transcription *HandTranscription
+}
+
+// Citation は回答の根拠としてAIに渡したルールの資料の抜粋
+type Citation struct {
+	// Document は文書のパス
+	Document string
+	// Title は文書の題名
+	Title string
+	// Section は節の見出し（文書の冒頭は空）
+	Section string
+	// Snippet は抜粋の本文の冒頭
+	Snippet string
+	// Score は質問との関連度（検索の得点）
+	Score float32
+	// Cited は回答が抜粋の番号（[1] など）を示して引用したか
+	Cited bool
+}
+
+// VerificationMode は回答の検証で誤りを見つけた場合の扱い
+type VerificationMode string
+
+const (
+	// VerificationOff は回答を検証しない
+	VerificationOff VerificationMode = "off"
+	// VerificationAnnotate は誤りの訂正を回答の末尾に追記する
+	VerificationAnnotate VerificationMode = "annotate"
+	// VerificationRegenerate は誤りと正しい値を伝えて回答を1度だけ生成し直し、それでも誤りが残れば訂正を追記する
+	// ストリーミングでは生成し直せないため、訂正の追記として扱う
+	VerificationRegenerate VerificationMode = "regenerate"
+)
+
+// VerificationModes は回答の検証の扱いの一覧を返す
+func VerificationModes() []VerificationMode {
+	return []VerificationMode{VerificationOff, VerificationAnnotate, VerificationRegenerate}
+}
+
+// ClaimCheck は回答に含まれる主張を麻雀エンジンで検証した結果
+type ClaimCheck struct {
+	// Kind は主張の種類（shanten / waits / score）
+	Kind string
+	// Claim は回答中の主張の表現
+	Claim   string
+	Correct bool
+	// Correction は誤っていた場合の訂正文
+	Correction string
+}
+
+// AnswerVerification は回答の検証結果
+type AnswerVerification struct {
+	Checks []ClaimCheck
+	// Regenerated は誤りを伝えて回答を生成し直したか
+	Regenerated bool
+	// Annotated は訂正を回答の末尾に追記したか
+	Annotated bool
+	// Confidence は検証結果から求めた信頼度
+	Confidence float32
+}
+
+// ConclusionVote は同じ結論になった回答の数
+type ConclusionVote struct {
+	// Conclusion は回答の結論（「打5s」「7700点」など、取り出せなかった場合は空）
+	Conclusion string
+	Count      int32
+}
+
+// SelfConsistency は同じ質問に対する複数の回答の結論を比べた結果
+type SelfConsistency struct {
+	// Samples は比べた回答の数（生成に失敗した回答は含まない）
+	Samples int32
+	// Conclusion は多数派の結論（結論を取り出せた回答がない場合は空）
+	Conclusion string
+	// Votes は結論ごとの回答の数（多い順）
+	Votes []ConclusionVote
+	// Agreement は多数派の結論になった回答の割合
+	Agreement float32
 }
 
 // NewAIResponse は新しいAIResponseを作成する
@@ -0,0 +1,39 @@
+package entity
+
+import "slices"
+
+// Attachment は質問に添付するファイル（雀魂のスクリーンショットや牌の写真など）
+type Attachment struct {
+	Data     []byte
+	MIMEType string
+}
+
+// attachmentMIMETypes は添付できるファイルのMIMEタイプ（Geminiが画像として扱える形式）
+var attachmentMIMETypes = []string{"image/png", "image/jpeg", "image/webp", "image/heic", "image/heif"}
+
+// AttachmentMIMETypes は添付できるファイルのMIMEタイプの一覧を返す
+func AttachmentMIMETypes() []string {
+	return slices.Clone(attachmentMIMETypes)
+}
+
+// HandTranscription は添付した画像の手牌をMPSZ表記に書き起こした結果
+type HandTranscription struct {
+	// Hand は門前の牌（MPSZ表記）
+	Hand string
+	// Melds は副露
+	Melds []MeldNotation
+	// Valid は牌の表記と枚数の検証に通ったか（通らなかった場合は質問に添えない）
+	Valid bool
+	// Error は検証に通らなかった理由
+	Error string
+	// Attempts は書き起こしを問い合わせた回数
+	Attempts int32
+}
+
+// MeldNotation は表記で表した副露
+type MeldNotation struct {
+	// Type は副露の種類（chi / pon / minkan / ankan / kakan）
+	Type string
+	// Tiles は副露した牌（MPSZ表記）
+	Tiles string
+}
@@ -0,0 +1,18 @@
+package entity
+
+import "time"
+
+// Conversation は複数のリクエストにまたがって引き継ぐ会話単位の設定を表すエンティティ
+type Conversation struct {
+	ID        string
+	RuleSet   string // ルールセット名
+	UpdatedAt time.Time
+}
+
+// NewConversation は新しいConversationを作成する
+func NewConversation(id string) *Conversation {
+	return &Conversation{
+		ID:        id,
+		UpdatedAt: time.Now(),
+	}
+}
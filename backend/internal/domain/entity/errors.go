@@ -5,16 +5,55 @@ import "errors"
 var (
 	// ErrEmptyPrompt はプロンプトが空の場合のエラー
 	ErrEmptyPrompt = errors.New("prompt cannot be empty")
-	
+
 	// ErrAIServiceUnavailable はAIサービスが利用できない場合のエラー
 	ErrAIServiceUnavailable = errors.New("AI service is unavailable")
-	
+
 	// ErrInvalidRequest は無効なリクエストの場合のエラー
 	ErrInvalidRequest = errors.New("invalid request")
-	
+
 	// ErrInvalidTemperature は無効な温度パラメータの場合のエラー
 	ErrInvalidTemperature = errors.New("temperature must be between 0.0 and 2.0")
-	
+
 	// ErrInvalidMaxTokens は無効な最大トークン数の場合のエラー
 	ErrInvalidMaxTokens = errors.New("max tokens must be greater than 0")
+
+	// ErrUnknownPersona は存在しないペルソナが指定された場合のエラー
+	ErrUnknownPersona = errors.New("unknown persona")
+
+	// ErrUnknownRuleSet は存在しないルールセットが指定された場合のエラー
+	ErrUnknownRuleSet = errors.New("unknown rule set")
+
+	// ErrInvalidTileNotation は牌の表記が解析できない場合のエラー
+	ErrInvalidTileNotation = errors.New("invalid tile notation")
+
+	// ErrInvalidHand は手牌の枚数や副露が不正な場合のエラー
+	ErrInvalidHand = errors.New("invalid hand")
+
+	// ErrNotWinningHand は和了形になっていない場合のエラー
+	ErrNotWinningHand = errors.New("not a winning hand")
+
+	// ErrInvalidGameState は局面の内容が成り立たない場合のエラー
+	ErrInvalidGameState = errors.New("invalid game state")
+
+	// ErrInvalidGameLog は牌譜が解析できない場合のエラー
+	ErrInvalidGameLog = errors.New("invalid game log")
+
+	// ErrGameLogNotFound は指定した牌譜が存在しない場合のエラー
+	ErrGameLogNotFound = errors.New("game log not found")
+
+	// ErrGameReviewNotFound は指定した牌譜の検討が存在しない場合のエラー
+	ErrGameReviewNotFound = errors.New("game review not found")
+
+	// ErrQuizNotFound は指定した何切る問題が存在しない場合のエラー
+	ErrQuizNotFound = errors.New("quiz not found")
+
+	// ErrUnknownResponseSchema は存在しない構造化出力の形式が指定された場合のエラー
+	ErrUnknownResponseSchema = errors.New("unknown response schema")
+
+	// ErrStructuredOutputMismatch は構造化出力が形式の定義に合わない場合のエラー
+	ErrStructuredOutputMismatch = errors.New("structured output does not match schema")
+
+	// ErrInvalidAttachment は添付したファイルの形式・大きさ・数が制限を超える場合のエラー
+	ErrInvalidAttachment = errors.New("invalid attachment")
 )
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// DefaultPersonaName はペルソナ未指定時に使用するペルソナ名
+const DefaultPersonaName = "default"
+
+// PersonaVariables はペルソナのテンプレートに埋め込む変数
+type PersonaVariables struct {
+	RuleSet   string // ルールセット名
+	UserLevel string // ユーザーのレベル
+	Language  string // 回答言語
+}
+
+// UserLevels は指定できるユーザーのレベル
+var UserLevels = []string{"beginner", "intermediate", "advanced", "expert"}
+
+// Languages は指定できる回答言語
+var Languages = []string{"ja", "en", "zh", "ko"}
+
+// Validate は変数が指定できる値かを検証する（空の場合は既定値を使うため妥当とする）
+// システムプロンプトに埋め込むため、自由な文字列は受け付けない
+func (v PersonaVariables) Validate() error {
+	if v.UserLevel != "" && !slices.Contains(UserLevels, v.UserLevel) {
+		return fmt.Errorf("%w: user level %q (use one of %s)", ErrInvalidRequest, v.UserLevel, strings.Join(UserLevels, ", "))
+	}
+	if v.Language != "" && !slices.Contains(Languages, v.Language) {
+		return fmt.Errorf("%w: language %q (use one of %s)", ErrInvalidRequest, v.Language, strings.Join(Languages, ", "))
+	}
+	return nil
+}
+
+// DefaultPersonaVariables は未指定の変数を補ったPersonaVariablesを返す
+func DefaultPersonaVariables(vars PersonaVariables) PersonaVariables {
+	if vars.UserLevel == "" {
+		vars.UserLevel = "intermediate"
+	}
+	if vars.Language == "" {
+		vars.Language = "ja"
+	}
+	return vars
+}
+
+// Persona はシステムプロンプトのテンプレートを持つAIの人格を表すエンティティ
+type Persona struct {
+	Name        string
+	Description string
+	tmpl        *template.Template
+}
+
+// NewPersona はテンプレートを解析して新しいPersonaを作成する
+func NewPersona(name, description, text string) (*Persona, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template of persona %q: %w", name, err)
+	}
+	return &Persona{
+		Name:        name,
+		Description: description,
+		tmpl:        tmpl,
+	}, nil
+}
+
+// Render は変数を埋め込んだシステムプロンプトを返す
+func (p *Persona) Render(vars PersonaVariables) (string, error) {
+	var b strings.Builder
+	if err := p.tmpl.Execute(&b, DefaultPersonaVariables(vars)); err != nil {
+		return "", fmt.Errorf("failed to render persona %q: %w", p.Name, err)
+	}
+	return strings.TrimSpace(b.String()), nil
+}
@@ -0,0 +1,235 @@
+package entity
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"sort"
+)
+
+// SchemaType は構造化出力の値の型（JSON Schema の型名）
+type SchemaType string
+
+// 構造化出力の値の型
+const (
+	SchemaObject  SchemaType = "object"
+	SchemaArray   SchemaType = "array"
+	SchemaString  SchemaType = "string"
+	SchemaInteger SchemaType = "integer"
+	SchemaNumber  SchemaType = "number"
+	SchemaBoolean SchemaType = "boolean"
+)
+
+// Schema は構造化出力の値の定義
+type Schema struct {
+	Type        SchemaType
+	Description string
+	// Properties はオブジェクトのプロパティ
+	Properties map[string]*Schema
+	// Required はオブジェクトの必須のプロパティ
+	Required []string
+	// Items は配列の要素の定義
+	Items *Schema
+	// Enum は文字列がとりうる値（空の場合は制限なし）
+	Enum []string
+}
+
+// ResponseSchema は名前を付けた構造化出力の形式
+type ResponseSchema struct {
+	Name        string
+	Description string
+	// Root は出力全体の定義（常にオブジェクト）
+	Root *Schema
+}
+
+// Validate は JSON を解析した値が定義に合うかを検証する
+// 合わない場合は最初に見つかった食い違いを ErrStructuredOutputMismatch として返す
+func (s *Schema) Validate(value any) error {
+	return s.validate("$", value)
+}
+
+func (s *Schema) validate(path string, value any) error {
+	mismatch := func(format string, args ...any) error {
+		return fmt.Errorf("%w: %s: %s", ErrStructuredOutputMismatch, path, fmt.Sprintf(format, args...))
+	}
+	switch s.Type {
+	case SchemaObject:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return mismatch("expected object")
+		}
+		for _, name := range s.Required {
+			if _, ok := object[name]; !ok {
+				return mismatch("missing required property %q", name)
+			}
+		}
+		names := make([]string, 0, len(object))
+		for name := range object {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := s.Properties[name]
+			if !ok {
+				return mismatch("unknown property %q", name)
+			}
+			if err := property.validate(path+"."+name, object[name]); err != nil {
+				return err
+			}
+		}
+	case SchemaArray:
+		array, ok := value.([]any)
+		if !ok {
+			return mismatch("expected array")
+		}
+		for i, item := range array {
+			if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	case SchemaString:
+		str, ok := value.(string)
+		if !ok {
+			return mismatch("expected string")
+		}
+		if len(s.Enum) > 0 && !slices.Contains(s.Enum, str) {
+			return mismatch("%q is not one of the allowed values", str)
+		}
+	case SchemaInteger:
+		number, ok := value.(float64)
+		if !ok || number != math.Trunc(number) {
+			return mismatch("expected integer")
+		}
+	case SchemaNumber:
+		if _, ok := value.(float64); !ok {
+			return mismatch("expected number")
+		}
+	case SchemaBoolean:
+		if _, ok := value.(bool); !ok {
+			return mismatch("expected boolean")
+		}
+	}
+	return nil
+}
+
+// tileNotations はMPSZ表記の牌の一覧（赤ドラの0m・0p・0sを含む）
+func tileNotations() []string {
+	var tiles []string
+	for _, suit := range "mps" {
+		for n := 0; n <= 9; n++ {
+			tiles = append(tiles, fmt.Sprintf("%d%c", n, suit))
+		}
+	}
+	for n := 1; n <= 7; n++ {
+		tiles = append(tiles, fmt.Sprintf("%dz", n))
+	}
+	return tiles
+}
+
+// 組み込みの構造化出力の形式
+var (
+	// ResponseSchemaDiscard は打牌の推奨
+	ResponseSchemaDiscard = ResponseSchema{
+		Name:        "discard",
+		Description: "推奨する打牌とその理由、ほかの候補",
+		Root: &Schema{
+			Type: SchemaObject,
+			Properties: map[string]*Schema{
+				"discard":   {Type: SchemaString, Description: "推奨する打牌（MPSZ表記）", Enum: tileNotations()},
+				"reasoning": {Type: SchemaString, Description: "推奨する理由"},
+				"alternatives": {
+					Type:        SchemaArray,
+					Description: "ほかの打牌の候補",
+					Items: &Schema{
+						Type: SchemaObject,
+						Properties: map[string]*Schema{
+							"discard": {Type: SchemaString, Description: "候補の打牌（MPSZ表記）", Enum: tileNotations()},
+							"reason":  {Type: SchemaString, Description: "推奨する打牌に劣る理由"},
+						},
+						Required: []string{"discard", "reason"},
+					},
+				},
+			},
+			Required: []string{"discard", "reasoning", "alternatives"},
+		},
+	}
+
+	// ResponseSchemaScore は和了の点数
+	ResponseSchemaScore = ResponseSchema{
+		Name:        "score",
+		Description: "和了の役・翻・符と点数",
+		Root: &Schema{
+			Type: SchemaObject,
+			Properties: map[string]*Schema{
+				"yaku":        {Type: SchemaArray, Description: "成立する役", Items: &Schema{Type: SchemaString}},
+				"han":         {Type: SchemaInteger, Description: "翻数（ドラを含む）"},
+				"fu":          {Type: SchemaInteger, Description: "符"},
+				"points":      {Type: SchemaInteger, Description: "和了点"},
+				"explanation": {Type: SchemaString, Description: "点数の求め方"},
+			},
+			Required: []string{"yaku", "han", "fu", "points", "explanation"},
+		},
+	}
+
+	// ResponseSchemaPushFold は押し引きの判断
+	ResponseSchemaPushFold = ResponseSchema{
+		Name:        "push_fold",
+		Description: "押し・回し・降りの判断と打牌",
+		Root: &Schema{
+			Type: SchemaObject,
+			Properties: map[string]*Schema{
+				"decision":  {Type: SchemaString, Description: "押し（push）・回し（mawashi）・降り（fold）", Enum: []string{"push", "mawashi", "fold"}},
+				"discard":   {Type: SchemaString, Description: "判断に沿った打牌（MPSZ表記）", Enum: tileNotations()},
+				"reasoning": {Type: SchemaString, Description: "判断の理由"},
+			},
+			Required: []string{"decision", "discard", "reasoning"},
+		},
+	}
+)
+
+// ResponseSchemaHandTranscription は画像の手牌の書き起こし（書き起こしの手順でのみ使い、リクエストでは指定できない）
+var ResponseSchemaHandTranscription = ResponseSchema{
+	Name:        "hand_transcription",
+	Description: "画像に写った質問者の手牌のMPSZ表記",
+	Root: &Schema{
+		Type: SchemaObject,
+		Properties: map[string]*Schema{
+			"hand": {Type: SchemaString, Description: "門前の牌（MPSZ表記、赤5は0）"},
+			"melds": {
+				Type:        SchemaArray,
+				Description: "ポン・チー・カンした牌",
+				Items: &Schema{
+					Type: SchemaObject,
+					Properties: map[string]*Schema{
+						"type":  {Type: SchemaString, Description: "副露の種類", Enum: []string{"chi", "pon", "minkan", "ankan", "kakan"}},
+						"tiles": {Type: SchemaString, Description: "副露した牌（MPSZ表記）"},
+					},
+					Required: []string{"type", "tiles"},
+				},
+			},
+		},
+		Required: []string{"hand", "melds"},
+	},
+}
+
+// responseSchemas は組み込みの構造化出力の形式の一覧
+var responseSchemas = []ResponseSchema{ResponseSchemaDiscard, ResponseSchemaScore, ResponseSchemaPushFold}
+
+// LookupResponseSchema は名前から構造化出力の形式を返す
+func LookupResponseSchema(name string) (ResponseSchema, bool) {
+	for _, s := range responseSchemas {
+		if s.Name == name {
+			return s, true
+		}
+	}
+	return ResponseSchema{}, false
+}
+
+// ResponseSchemaNames は構造化出力の形式の名前の一覧を返す
+func ResponseSchemaNames() []string {
+	names := make([]string, 0, len(responseSchemas))
+	for _, s := range responseSchemas {
+		names = append(names, s.Name)
+	}
+	return names
+}
@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   ResponseSchema
+		json     string
+		wantPath string // 食い違いの場所（空の場合は形式に合う）
+	}{
+		{name: "打牌", schema: ResponseSchemaDiscard, json: `{"discard":"5s","reasoning":"受け入れが最大","alternatives":[{"discard":"0p","reason":"赤ドラ"}]}`},
+		{name: "候補なしの打牌", schema: ResponseSchemaDiscard, json: `{"discard":"1z","reasoning":"安全","alternatives":[]}`},
+		{name: "必須のプロパティがない", schema: ResponseSchemaDiscard, json: `{"discard":"5s","alternatives":[]}`, wantPath: `$: missing required property "reasoning"`},
+		{name: "未知のプロパティ", schema: ResponseSchemaDiscard, json: `{"discard":"5s","reasoning":"","alternatives":[],"score":1}`, wantPath: `$: unknown property "score"`},
+		{name: "牌の表記でない", schema: ResponseSchemaDiscard, json: `{"discard":"5索","reasoning":"","alternatives":[]}`, wantPath: "$.discard"},
+		{name: "配列の要素の食い違い", schema: ResponseSchemaDiscard, json: `{"discard":"5s","reasoning":"","alternatives":[{"discard":"1m","reason":"x"},{"discard":"8z","reason":"x"}]}`, wantPath: "$.alternatives[1].discard"},
+		{name: "配列でない", schema: ResponseSchemaDiscard, json: `{"discard":"5s","reasoning":"","alternatives":"1m"}`, wantPath: "$.alternatives: expected array"},
+		{name: "オブジェクトでない", schema: ResponseSchemaDiscard, json: `["5s"]`, wantPath: "$: expected object"},
+		{name: "点数", schema: ResponseSchemaScore, json: `{"yaku":["立直","平和"],"han":2,"fu":30,"points":2000,"explanation":"30符2翻"}`},
+		{name: "小数の翻", schema: ResponseSchemaScore, json: `{"yaku":[],"han":2.5,"fu":30,"points":2000,"explanation":""}`, wantPath: "$.han: expected integer"},
+		{name: "文字列の点数", schema: ResponseSchemaScore, json: `{"yaku":[],"han":2,"fu":30,"points":"2000","explanation":""}`, wantPath: "$.points: expected integer"},
+		{name: "役が文字列でない", schema: ResponseSchemaScore, json: `{"yaku":[1],"han":2,"fu":30,"points":2000,"explanation":""}`, wantPath: "$.yaku[0]: expected string"},
+		{name: "押し引き", schema: ResponseSchemaPushFold, json: `{"decision":"fold","discard":"4m","reasoning":"現物"}`},
+		{name: "押し引きの判断が不正", schema: ResponseSchemaPushFold, json: `{"decision":"attack","discard":"4m","reasoning":""}`, wantPath: "$.decision"},
+		{name: "手牌の書き起こし", schema: ResponseSchemaHandTranscription, json: `{"hand":"123m456p789s11z","melds":[{"type":"pon","tiles":"777z"}]}`},
+		{name: "副露の種類が不正", schema: ResponseSchemaHandTranscription, json: `{"hand":"123m","melds":[{"type":"kan","tiles":"7777z"}]}`, wantPath: "$.melds[0].type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var value any
+			if err := json.Unmarshal([]byte(tt.json), &value); err != nil {
+				t.Fatal(err)
+			}
+			err := tt.schema.Root.Validate(value)
+			if tt.wantPath == "" {
+				if err != nil {
+					t.Errorf("Validate() = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrStructuredOutputMismatch) || !strings.Contains(err.Error(), tt.wantPath) {
+				t.Errorf("Validate() = %v, want a mismatch at %s", err, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestLookupResponseSchema(t *testing.T) {
+	for _, name := range ResponseSchemaNames() {
+		if s, ok := LookupResponseSchema(name); !ok || s.Name != name || s.Root.Type != SchemaObject {
+			t.Errorf("LookupResponseSchema(%q) = %+v, %v", name, s, ok)
+		}
+	}
+	// 書き起こしの形式はリクエストでは指定できない
+	for _, name := range []string{ResponseSchemaHandTranscription.Name, "", "unknown"} {
+		if _, ok := LookupResponseSchema(name); ok {
+			t.Errorf("LookupResponseSchema(%q) found a schema", name)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package entity
+
+import "context"
+
+// ToolParameterType はツールの引数の型
+type ToolParameterType string
+
+// ツールの引数の型（JSON Schema の型名）
+const (
+	ToolParameterString  ToolParameterType = "string"
+	ToolParameterInteger ToolParameterType = "integer"
+	ToolParameterNumber  ToolParameterType = "number"
+	ToolParameterBoolean ToolParameterType = "boolean"
+)
+
+// ToolParameter はツールの引数の定義
+type ToolParameter struct {
+	Name        string
+	Type        ToolParameterType
+	Description string
+	Required    bool
+	// Enum は引数がとりうる値（空の場合は制限なし）
+	Enum []string
+}
+
+// Tool はAIが回答の途中で呼び出せる関数
+type Tool struct {
+	Name        string
+	Description string
+	Parameters  []ToolParameter
+	// Call はツールを実行し、JSONに変換できる結果を返す
+	Call func(ctx context.Context, args map[string]any) (map[string]any, error)
+}
+
+// ToolCall はAIによるツールの呼び出しの記録
+type ToolCall struct {
+	Name      string
+	Arguments map[string]any
+	Result    map[string]any
+	// Error はツールの実行に失敗した場合のエラーメッセージ
+	Error string
+}
@@ -0,0 +1,420 @@
+// Package gamelog は牌譜を解析し、各打牌の判断の直前の局面を再構成する
+package gamelog
+
+import (
+	"fmt"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// Game は牌譜から再構成した対局
+type Game struct {
+	ID    string
+	Title string
+	// Players は起家からの席順のプレイヤー名
+	Players []string
+	Sanma   bool
+	Rounds  []Round
+}
+
+// Round は1局の経過
+type Round struct {
+	RoundWind    mahjong.Tile
+	Kyoku        int // 局（1始まり）
+	Honba        int
+	RiichiSticks int
+	// Scores は局の開始時の持ち点（起家からの席順）
+	Scores []int
+	// Steps は打牌の判断の一覧（打牌順）
+	Steps []Step
+	// Result は局の結果の説明（例: 和了・流局）
+	Result string
+}
+
+// Step は1回の打牌の判断
+type Step struct {
+	// Player は打牌者（起家からの席順）
+	Player int
+	// Turn は打牌者の何巡目の打牌か（1始まり）
+	Turn int
+	// State は打牌の直前の局面（Self は打牌者で、手牌は打牌者の分のみ）
+	State *mahjong.GameState
+
+	// 実際の打牌
+	Discard   mahjong.Tile
+	Tsumogiri bool
+	Riichi    bool
+}
+
+// RuleSet は対局のルールに最も近いルールセットを返す
+func (g *Game) RuleSet() mahjong.RuleSet {
+	if g.Sanma {
+		return mahjong.RuleSetSanma
+	}
+	return mahjong.RuleSetTenhou
+}
+
+// Step は局と打牌の番号（いずれも0始まり）から打牌の判断を返す
+func (g *Game) Step(round, step int) (*Step, error) {
+	if round < 0 || round >= len(g.Rounds) {
+		return nil, fmt.Errorf("%w: round %d is out of range (0-%d)", entity.ErrInvalidRequest, round, len(g.Rounds)-1)
+	}
+	steps := g.Rounds[round].Steps
+	if step < 0 || step >= len(steps) {
+		return nil, fmt.Errorf("%w: step %d of round %d is out of range (0-%d)", entity.ErrInvalidRequest, step, round, len(steps)-1)
+	}
+	return &steps[step], nil
+}
+
+// Validate は再構成したすべての打牌の判断の局面を検証する
+func (g *Game) Validate() error {
+	rules := g.RuleSet()
+	for _, r := range g.Rounds {
+		for i, step := range r.Steps {
+			if err := step.State.Validate(rules); err != nil {
+				return fmt.Errorf("%w: %s step %d: %v", entity.ErrInvalidGameLog, r.Name(), i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+// Name は局の名前（例: 東1局 0本場）を返す
+func (r *Round) Name() string {
+	return fmt.Sprintf("%s%d局 %d本場", windNames[r.RoundWind-mahjong.East], r.Kyoku, r.Honba)
+}
+
+// windNames は風の名前（東から順）
+var windNames = [4]string{"東", "南", "西", "北"}
+
+// initialWall は配牌後の山の残り枚数（四麻・三麻）
+const (
+	initialWall      = 136 - 14 - 13*4
+	initialWallSanma = 108 - 14 - 13*3
+)
+
+// tile は赤ドラの区別を含む牌
+type tile struct {
+	kind mahjong.Tile
+	red  bool
+}
+
+// player は再生中のプレイヤーの状況
+type player struct {
+	hand     []tile
+	melds    []mahjong.Meld
+	meldRed  int
+	discards []mahjong.DiscardedTile
+	// riichi は次の打牌で立直を宣言するか
+	riichi bool
+	// hidden は手牌が分からず、手牌を追跡しないか（mjai の他家）
+	hidden bool
+}
+
+// replayer は牌譜の出来事を順に適用し、打牌の判断ごとに局面を記録する
+// 牌譜の形式ごとの解析器が共通で使う
+type replayer struct {
+	game *Game
+
+	round      *Round
+	dealer     int
+	players    []*player
+	scores     []int
+	sticks     int
+	doras      []mahjong.Tile
+	wall       int
+	lastPlayer int // 最後に打牌したプレイヤー（-1 は打牌前）
+}
+
+// newReplayer は対局の再生を始める
+func newReplayer(game *Game) *replayer {
+	return &replayer{game: game}
+}
+
+// numPlayers は参加人数を返す
+func (r *replayer) numPlayers() int {
+	if r.game.Sanma {
+		return 3
+	}
+	return 4
+}
+
+// startRound は局を始める
+// index は東1局を0とする局の通し番号（三麻でも1場を4局として数える）
+// 手牌が分からないプレイヤーの配牌は nil にする
+func (r *replayer) startRound(index, honba, sticks int, scores []int, dora tile, hands [][]tile) error {
+	n := r.numPlayers()
+	if len(scores) < n || len(hands) < n {
+		return fmt.Errorf("%w: round %d has %d scores and %d hands for %d players", entity.ErrInvalidGameLog, index, len(scores), len(hands), n)
+	}
+	if index < 0 || index/4 > 3 {
+		return fmt.Errorf("%w: round index %d is out of range", entity.ErrInvalidGameLog, index)
+	}
+	r.dealer = index % 4
+	if r.dealer >= n {
+		return fmt.Errorf("%w: round index %d has no dealer for %d players", entity.ErrInvalidGameLog, index, n)
+	}
+	r.round = &Round{
+		RoundWind:    mahjong.East + mahjong.Tile(index/4),
+		Kyoku:        r.dealer + 1,
+		Honba:        honba,
+		RiichiSticks: sticks,
+		Scores:       append([]int{}, scores[:n]...),
+	}
+	r.scores = append([]int{}, scores[:n]...)
+	r.sticks = sticks
+	r.doras = []mahjong.Tile{dora.kind}
+	r.wall = initialWall
+	if r.game.Sanma {
+		r.wall = initialWallSanma
+	}
+	r.lastPlayer = -1
+	r.players = make([]*player, n)
+	for i := range r.players {
+		if hands[i] == nil {
+			r.players[i] = &player{hidden: true}
+			continue
+		}
+		if len(hands[i]) != 13 {
+			return fmt.Errorf("%w: player %d starts with %d tiles", entity.ErrInvalidGameLog, i, len(hands[i]))
+		}
+		r.players[i] = &player{hand: append([]tile{}, hands[i]...)}
+	}
+	return nil
+}
+
+// checkPlayer はプレイヤーの番号を検証する
+func (r *replayer) checkPlayer(p int) error {
+	if r.round == nil {
+		return fmt.Errorf("%w: action before the first round", entity.ErrInvalidGameLog)
+	}
+	if p < 0 || p >= len(r.players) {
+		return fmt.Errorf("%w: player %d is out of range", entity.ErrInvalidGameLog, p)
+	}
+	return nil
+}
+
+// seat はプレイヤーの自風を返す
+func (r *replayer) seat(p int) mahjong.Tile {
+	n := len(r.players)
+	return mahjong.East + mahjong.Tile((p-r.dealer+n)%n)
+}
+
+// draw はツモ（嶺上牌を含む）を適用する
+func (r *replayer) draw(p int, t tile) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	if pl := r.players[p]; !pl.hidden {
+		pl.hand = append(pl.hand, t)
+	}
+	r.wall--
+	return nil
+}
+
+// declareRiichi は次の打牌で立直を宣言することを記録する
+func (r *replayer) declareRiichi(p int) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	r.players[p].riichi = true
+	return nil
+}
+
+// acceptRiichi は立直が成立し、立直棒を供託したことを適用する
+func (r *replayer) acceptRiichi(p int) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	r.scores[p] -= 1000
+	r.sticks++
+	return nil
+}
+
+// discard は打牌を適用する。適用の前の局面を打牌の判断として記録する
+func (r *replayer) discard(p int, t tile, tsumogiri bool) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	pl := r.players[p]
+	r.round.Steps = append(r.round.Steps, Step{
+		Player:    p,
+		Turn:      len(pl.discards) + 1,
+		State:     r.snapshot(p),
+		Discard:   t.kind,
+		Tsumogiri: tsumogiri,
+		Riichi:    pl.riichi,
+	})
+	if err := pl.remove(t); err != nil {
+		return err
+	}
+	pl.discards = append(pl.discards, mahjong.DiscardedTile{Tile: t.kind, Tsumogiri: tsumogiri, Riichi: pl.riichi})
+	pl.riichi = false
+	r.lastPlayer = p
+	return nil
+}
+
+// call はチー・ポン・大明槓を適用する。tiles は鳴いた牌を含む副露の牌
+func (r *replayer) call(p int, meldType mahjong.MeldType, tiles []tile) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	if r.lastPlayer < 0 || r.lastPlayer == p {
+		return fmt.Errorf("%w: player %d calls without a discard from another player", entity.ErrInvalidGameLog, p)
+	}
+	from := r.players[r.lastPlayer]
+	called := &from.discards[len(from.discards)-1]
+	called.Called = true
+
+	pl := r.players[p]
+	skipped := false
+	for _, t := range tiles {
+		if !skipped && t.kind == called.Tile {
+			skipped = true
+			continue
+		}
+		if err := pl.remove(t); err != nil {
+			return err
+		}
+	}
+	if !skipped {
+		return fmt.Errorf("%w: player %d calls %s without the discarded tile", entity.ErrInvalidGameLog, p, called.Tile)
+	}
+	return pl.addMeld(meldType, tiles)
+}
+
+// ankan は暗槓を適用する
+func (r *replayer) ankan(p int, tiles []tile) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	pl := r.players[p]
+	for _, t := range tiles {
+		if err := pl.remove(t); err != nil {
+			return err
+		}
+	}
+	return pl.addMeld(mahjong.MeldAnkan, tiles)
+}
+
+// kakan は加槓を適用する
+func (r *replayer) kakan(p int, added tile) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	pl := r.players[p]
+	for i, m := range pl.melds {
+		if m.Type != mahjong.MeldPon || m.First() != added.kind {
+			continue
+		}
+		if err := pl.remove(added); err != nil {
+			return err
+		}
+		pl.melds[i] = mahjong.Meld{Type: mahjong.MeldKakan, Tiles: append(append([]mahjong.Tile{}, m.Tiles...), added.kind)}
+		if added.red {
+			pl.meldRed++
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: player %d adds %s without a pon", entity.ErrInvalidGameLog, p, added.kind)
+}
+
+// nuki は三麻の北抜きを適用する
+func (r *replayer) nuki(p int, t tile) error {
+	if err := r.checkPlayer(p); err != nil {
+		return err
+	}
+	return r.players[p].remove(t)
+}
+
+// revealDora は槓ドラの表示牌を加える
+func (r *replayer) revealDora(t mahjong.Tile) {
+	r.doras = append(r.doras, t)
+}
+
+// endRound は局を終え、結果とともに対局に加える
+func (r *replayer) endRound(result string) {
+	if r.round == nil {
+		return
+	}
+	r.round.Result = result
+	r.game.Rounds = append(r.game.Rounds, *r.round)
+	r.round = nil
+}
+
+// snapshot は打牌者から見た現在の局面を返す
+func (r *replayer) snapshot(actor int) *mahjong.GameState {
+	state := &mahjong.GameState{
+		RoundWind:      r.round.RoundWind,
+		Kyoku:          r.round.Kyoku,
+		Honba:          r.round.Honba,
+		RiichiSticks:   r.sticks,
+		DoraIndicators: append([]mahjong.Tile{}, r.doras...),
+		Self:           r.seat(actor),
+		WallRemaining:  max(0, r.wall),
+	}
+	for i, pl := range r.players {
+		ps := mahjong.PlayerState{
+			Seat:     r.seat(i),
+			Score:    r.scores[i],
+			AkaDora:  pl.meldRed,
+			Melds:    append([]mahjong.Meld{}, pl.melds...),
+			Discards: append([]mahjong.DiscardedTile{}, pl.discards...),
+		}
+		if i < len(r.game.Players) {
+			ps.Name = r.game.Players[i]
+		}
+		if i == actor {
+			for _, t := range pl.hand {
+				ps.Hand = append(ps.Hand, t.kind)
+				if t.red {
+					ps.AkaDora++
+				}
+			}
+		}
+		state.Players = append(state.Players, ps)
+	}
+	return state
+}
+
+// remove は手牌から牌を1枚除く（赤ドラの区別が一致する牌を優先する）
+// 手牌を追跡しないプレイヤーでは何もしない
+func (pl *player) remove(t tile) error {
+	if pl.hidden {
+		return nil
+	}
+	fallback := -1
+	for i, h := range pl.hand {
+		if h.kind != t.kind {
+			continue
+		}
+		if h.red == t.red {
+			pl.hand = append(pl.hand[:i], pl.hand[i+1:]...)
+			return nil
+		}
+		fallback = i
+	}
+	if fallback < 0 {
+		return fmt.Errorf("%w: %s is not in hand", entity.ErrInvalidGameLog, t.kind)
+	}
+	pl.hand = append(pl.hand[:fallback], pl.hand[fallback+1:]...)
+	return nil
+}
+
+// addMeld は副露を加える
+func (pl *player) addMeld(meldType mahjong.MeldType, tiles []tile) error {
+	kinds := make([]mahjong.Tile, 0, len(tiles))
+	for _, t := range tiles {
+		kinds = append(kinds, t.kind)
+		if t.red {
+			pl.meldRed++
+		}
+	}
+	meld, err := mahjong.NewMeld(meldType, kinds)
+	if err != nil {
+		return fmt.Errorf("%w: %v", entity.ErrInvalidGameLog, err)
+	}
+	pl.melds = append(pl.melds, meld)
+	return nil
+}
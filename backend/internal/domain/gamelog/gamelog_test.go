@@ -0,0 +1,101 @@
+package gamelog
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// readFixture は testdata の牌譜を読み込む
+func readFixture(t *testing.T, name string) string {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	return string(data)
+}
+
+// checkSampleGame は testdata の同じ対局を解析した結果を検証する
+// 東1局で、親が2巡目にツモ切り立直し、西家が北家の3枚目の中をポンして流局する
+func checkSampleGame(t *testing.T, game *Game) {
+	t.Helper()
+	if got := strings.Join(game.Players, ","); got != "Alice,Bob,Carol,Dave" {
+		t.Errorf("players = %s", got)
+	}
+	if game.Sanma || len(game.Rounds) != 1 {
+		t.Fatalf("sanma = %v, rounds = %d", game.Sanma, len(game.Rounds))
+	}
+	round := game.Rounds[0]
+	if round.Name() != "東1局 0本場" || !strings.Contains(round.Result, "流局") {
+		t.Errorf("round = %s, result = %q", round.Name(), round.Result)
+	}
+
+	want := []struct {
+		player    int
+		discard   string
+		tsumogiri bool
+		riichi    bool
+	}{
+		{0, "9p", true, false}, {1, "1m", false, false}, {2, "9m", false, false}, {3, "5z", false, false},
+		{0, "8m", true, true}, {1, "7z", true, false}, {2, "1m", false, false}, {3, "3p", true, false},
+		{0, "4z", true, false}, {1, "3z", false, false}, {2, "2s", true, false}, {3, "9s", true, false},
+	}
+	if len(round.Steps) != len(want) {
+		t.Fatalf("steps = %d, want %d", len(round.Steps), len(want))
+	}
+	for i, w := range want {
+		s := round.Steps[i]
+		if s.Player != w.player || s.Discard.String() != w.discard || s.Tsumogiri != w.tsumogiri || s.Riichi != w.riichi {
+			t.Errorf("step %d = player %d %s tsumogiri %v riichi %v, want player %d %s tsumogiri %v riichi %v",
+				i, s.Player, s.Discard, s.Tsumogiri, s.Riichi, w.player, w.discard, w.tsumogiri, w.riichi)
+		}
+	}
+
+	dealer := round.Steps[0].State.Player(mahjong.East)
+	if dealer.AkaDora != 1 || len(dealer.Hand) != 14 {
+		t.Errorf("dealer hand = %d tiles with %d red fives, want 14 with 1", len(dealer.Hand), dealer.AkaDora)
+	}
+	west := round.Steps[6].State.Player(mahjong.West)
+	if len(west.Melds) != 1 || west.Melds[0].Type != mahjong.MeldPon || west.Melds[0].First() != mahjong.Red {
+		t.Errorf("west melds = %v, want a pon of 7z", west.Melds)
+	}
+	if err := game.Validate(); err != nil {
+		t.Errorf("Validate: %v", err)
+	}
+}
+
+func TestGameValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse func([]byte) (*Game, error)
+		data  string
+	}{
+		{
+			name:  "天鳳JSONで同じ牌が5枚",
+			parse: ParseTenhouJSON,
+			data:  strings.Replace(readFixture(t, "tenhou.json"), "26, 27, 33", "46, 46, 46", 1),
+		},
+		{
+			name:  "mjlogで同じ牌の番号が重複",
+			parse: ParseMjlog,
+			data:  strings.Replace(readFixture(t, "sample.mjlog"), `hai0="4,8,12`, `hai0="128,129,130`, 1),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game, err := tt.parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("parse: %v", err)
+			}
+			if err := game.Validate(); !errors.Is(err, entity.ErrInvalidGameLog) {
+				t.Errorf("Validate error = %v, want %v", err, entity.ErrInvalidGameLog)
+			}
+		})
+	}
+}
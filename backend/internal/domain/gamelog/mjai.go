@@ -0,0 +1,439 @@
+package gamelog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// MjaiMessage は mjai プロトコルの1つのメッセージ
+// 受け取るイベントと返す行動で共通の形式を使い、使わない項目は省略する
+type MjaiMessage struct {
+	Type      string   `json:"type"`
+	Actor     *int     `json:"actor,omitempty"`
+	Target    *int     `json:"target,omitempty"`
+	Pai       string   `json:"pai,omitempty"`
+	Consumed  []string `json:"consumed,omitempty"`
+	Tsumogiri *bool    `json:"tsumogiri,omitempty"`
+
+	// hello への応答（join）
+	Name string `json:"name,omitempty"`
+	Room string `json:"room,omitempty"`
+
+	// start_game: ID は自分の席（起家からの席順）
+	ID    *int     `json:"id,omitempty"`
+	Names []string `json:"names,omitempty"`
+
+	// start_kyoku・dora
+	Bakaze     string     `json:"bakaze,omitempty"`
+	DoraMarker string     `json:"dora_marker,omitempty"`
+	Kyoku      int        `json:"kyoku,omitempty"`
+	Honba      int        `json:"honba,omitempty"`
+	Kyotaku    int        `json:"kyotaku,omitempty"`
+	Oya        int        `json:"oya,omitempty"`
+	Scores     []int      `json:"scores,omitempty"`
+	Tehais     [][]string `json:"tehais,omitempty"`
+
+	// 以下は mjai にない拡張
+	// Explain・Persona は start_game でAIによる解説を有効にする場合に指定する
+	Explain bool   `json:"explain,omitempty"`
+	Persona string `json:"persona,omitempty"`
+	// Text は解説（explanation）、Message はエラーの内容（error）
+	Text    string `json:"text,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// mjai のメッセージの種類（explanation・error は拡張）
+const (
+	MjaiHello       = "hello"
+	MjaiJoin        = "join"
+	MjaiStartGame   = "start_game"
+	MjaiStartKyoku  = "start_kyoku"
+	MjaiTsumo       = "tsumo"
+	MjaiDahai       = "dahai"
+	MjaiChi         = "chi"
+	MjaiPon         = "pon"
+	MjaiDaiminkan   = "daiminkan"
+	MjaiAnkan       = "ankan"
+	MjaiKakan       = "kakan"
+	MjaiDora        = "dora"
+	MjaiReach       = "reach"
+	MjaiReachAccept = "reach_accepted"
+	MjaiHora        = "hora"
+	MjaiRyukyoku    = "ryukyoku"
+	MjaiEndKyoku    = "end_kyoku"
+	MjaiEndGame     = "end_game"
+	MjaiNone        = "none"
+	MjaiError       = "error"
+	MjaiExplanation = "explanation"
+)
+
+// mjai の牌の表記
+const (
+	mjaiUnknownTile  = "?"
+	mjaiRedSuffix    = "r"
+	mjaiHonorLetters = "ESWNPFC"
+)
+
+// NewMjaiAction は行動のメッセージを作成する
+func NewMjaiAction(actionType string, actor int) *MjaiMessage {
+	return &MjaiMessage{Type: actionType, Actor: &actor}
+}
+
+// NewMjaiNone は何もしないことを表すメッセージを作成する
+func NewMjaiNone() *MjaiMessage {
+	return &MjaiMessage{Type: MjaiNone}
+}
+
+// MjaiSession は mjai のイベントを順に適用し、自分から見た局面を追跡する
+// 他家の手牌は分からないため追跡せず、河・副露・点数だけを再生する
+type MjaiSession struct {
+	game *Game
+	r    *replayer
+	// self は自分の席（起家からの席順）
+	self int
+	// drawn は自分の直前のツモ牌（ツモの直後でない場合は nil）
+	drawn *tile
+	// passed・passedAfterRiichi は自分の最後の打牌以降・立直後に見逃した他家の捨て牌
+	passed            []mahjong.Tile
+	passedAfterRiichi []mahjong.Tile
+	// offered は直前の他家の捨て牌（次のイベントで見逃したものとして数える）
+	offered *mahjong.Tile
+	results []string
+}
+
+// NewMjaiSession は新しいセッションを作成する（start_game の前は自分を席0とする）
+func NewMjaiSession() *MjaiSession {
+	game := &Game{}
+	return &MjaiSession{game: game, r: newReplayer(game)}
+}
+
+// Apply はイベントを適用する
+// 知らない種類のイベントは無視する
+func (s *MjaiSession) Apply(msg *MjaiMessage) error {
+	if s.offered != nil {
+		s.passed = append(s.passed, *s.offered)
+		if s.InRound() && s.r.players[s.self].riichiDeclared() {
+			s.passedAfterRiichi = append(s.passedAfterRiichi, *s.offered)
+		}
+		s.offered = nil
+	}
+
+	switch msg.Type {
+	case MjaiStartGame:
+		s.game = &Game{Players: msg.Names}
+		s.r = newReplayer(s.game)
+		s.self = 0
+		if msg.ID != nil {
+			if *msg.ID < 0 || *msg.ID >= 4 {
+				return fmt.Errorf("%w: start_game id %d is out of range", entity.ErrInvalidGameLog, *msg.ID)
+			}
+			s.self = *msg.ID
+		}
+		return nil
+	case MjaiStartKyoku:
+		return s.startKyoku(msg)
+	case MjaiEndKyoku:
+		s.r.endRound(strings.Join(s.results, " / "))
+		s.results = nil
+		return nil
+	case MjaiHora:
+		actor, err := msg.actor()
+		if err != nil {
+			return err
+		}
+		win := "和了: " + playerName(s.game, actor)
+		if msg.Target == nil || *msg.Target == actor {
+			win += " ツモ"
+		} else {
+			win += " ロン（放銃: " + playerName(s.game, *msg.Target) + "）"
+		}
+		s.results = append(s.results, win)
+		return nil
+	case MjaiRyukyoku:
+		s.results = append(s.results, "流局")
+		return nil
+	case MjaiDora:
+		t, err := parseMjaiTile(msg.DoraMarker)
+		if err != nil {
+			return err
+		}
+		s.r.revealDora(t.kind)
+		return nil
+	case MjaiTsumo, MjaiDahai, MjaiReach, MjaiReachAccept, MjaiChi, MjaiPon, MjaiDaiminkan, MjaiAnkan, MjaiKakan:
+		return s.applyAction(msg)
+	default:
+		return nil
+	}
+}
+
+// startKyoku は局を始める
+func (s *MjaiSession) startKyoku(msg *MjaiMessage) error {
+	// end_kyoku を受け取っていない局は結果なしで終える
+	s.r.endRound(strings.Join(s.results, " / "))
+	s.results = nil
+	s.drawn = nil
+	s.passed = nil
+	s.passedAfterRiichi = nil
+
+	wind := strings.Index(mjaiHonorLetters[:4], msg.Bakaze)
+	if wind < 0 || len(msg.Bakaze) != 1 {
+		return fmt.Errorf("%w: bakaze %q", entity.ErrInvalidGameLog, msg.Bakaze)
+	}
+	if msg.Kyoku < 1 || msg.Kyoku > 4 {
+		return fmt.Errorf("%w: kyoku %d is out of range", entity.ErrInvalidGameLog, msg.Kyoku)
+	}
+	dora, err := parseMjaiTile(msg.DoraMarker)
+	if err != nil {
+		return err
+	}
+	hands := make([][]tile, len(msg.Tehais))
+	for i, tehai := range msg.Tehais {
+		// 他家の配牌は "?" で届く
+		if len(tehai) > 0 && tehai[0] == mjaiUnknownTile {
+			continue
+		}
+		for _, pai := range tehai {
+			t, err := parseMjaiTile(pai)
+			if err != nil {
+				return err
+			}
+			hands[i] = append(hands[i], t)
+		}
+	}
+	if s.self < len(hands) && hands[s.self] == nil {
+		return fmt.Errorf("%w: own tehai is unknown", entity.ErrInvalidGameLog)
+	}
+	return s.r.startRound(wind*4+msg.Kyoku-1, msg.Honba, msg.Kyotaku, msg.Scores, dora, hands)
+}
+
+// applyAction は局の中のプレイヤーの行動を適用する
+func (s *MjaiSession) applyAction(msg *MjaiMessage) error {
+	actor, err := msg.actor()
+	if err != nil {
+		return err
+	}
+	// 立直の宣言ではツモ牌をそのまま持ち越す
+	if actor == s.self && msg.Type != MjaiReach {
+		s.drawn = nil
+	}
+
+	switch msg.Type {
+	case MjaiTsumo:
+		t := tile{}
+		if actor == s.self {
+			if t, err = parseMjaiTile(msg.Pai); err != nil {
+				return err
+			}
+			s.drawn = &t
+		}
+		return s.r.draw(actor, t)
+	case MjaiDahai:
+		t, err := parseMjaiTile(msg.Pai)
+		if err != nil {
+			return err
+		}
+		if err := s.r.discard(actor, t, msg.Tsumogiri != nil && *msg.Tsumogiri); err != nil {
+			return err
+		}
+		if actor == s.self {
+			s.passed = nil
+		} else {
+			s.offered = &t.kind
+		}
+		return nil
+	case MjaiReach:
+		return s.r.declareRiichi(actor)
+	case MjaiReachAccept:
+		return s.r.acceptRiichi(actor)
+	case MjaiKakan:
+		t, err := parseMjaiTile(msg.Pai)
+		if err != nil {
+			return err
+		}
+		return s.r.kakan(actor, t)
+	}
+
+	tiles := make([]tile, 0, len(msg.Consumed)+1)
+	for _, pai := range msg.Consumed {
+		t, err := parseMjaiTile(pai)
+		if err != nil {
+			return err
+		}
+		tiles = append(tiles, t)
+	}
+	if msg.Type == MjaiAnkan {
+		return s.r.ankan(actor, tiles)
+	}
+	called, err := parseMjaiTile(msg.Pai)
+	if err != nil {
+		return err
+	}
+	tiles = append(tiles, called)
+	meldType := map[string]mahjong.MeldType{
+		MjaiChi:       mahjong.MeldChi,
+		MjaiPon:       mahjong.MeldPon,
+		MjaiDaiminkan: mahjong.MeldMinkan,
+	}[msg.Type]
+	return s.r.call(actor, meldType, tiles)
+}
+
+// Self は自分の席（起家からの席順）を返す
+func (s *MjaiSession) Self() int {
+	return s.self
+}
+
+// InRound は局の途中かを返す
+func (s *MjaiSession) InRound() bool {
+	return s.r.round != nil
+}
+
+// RuleSet は対局のルールセットを返す
+func (s *MjaiSession) RuleSet() mahjong.RuleSet {
+	return s.game.RuleSet()
+}
+
+// State は自分から見た現在の局面を返す（局の途中でない場合は nil）
+func (s *MjaiSession) State() *mahjong.GameState {
+	if !s.InRound() {
+		return nil
+	}
+	return s.r.snapshot(s.self)
+}
+
+// Drawn は自分の直前のツモ牌を返す（ツモの直後でない場合は false）
+func (s *MjaiSession) Drawn() (mahjong.Tile, bool) {
+	if s.drawn == nil {
+		return 0, false
+	}
+	return s.drawn.kind, true
+}
+
+// LastDiscard は直前の打牌とそのプレイヤーを返す（打牌の前は false）
+func (s *MjaiSession) LastDiscard() (int, mahjong.Tile, bool) {
+	if !s.InRound() || s.r.lastPlayer < 0 {
+		return 0, 0, false
+	}
+	discards := s.r.players[s.r.lastPlayer].discards
+	return s.r.lastPlayer, discards[len(discards)-1].Tile, true
+}
+
+// RiichiDeclared は自分が立直を宣言し、宣言牌をまだ切っていないかを返す
+func (s *MjaiSession) RiichiDeclared() bool {
+	return s.InRound() && s.r.players[s.self].riichi
+}
+
+// Furiten は自分のフリテンの判定に使う情報を返す
+func (s *MjaiSession) Furiten() mahjong.FuritenInput {
+	in := mahjong.FuritenInput{
+		PassedSinceLastDiscard: append([]mahjong.Tile{}, s.passed...),
+		PassedAfterRiichi:      append([]mahjong.Tile{}, s.passedAfterRiichi...),
+	}
+	if !s.InRound() {
+		return in
+	}
+	pl := s.r.players[s.self]
+	for _, d := range pl.discards {
+		in.Discards = append(in.Discards, d.Tile)
+	}
+	in.Riichi = pl.riichiDeclared()
+	return in
+}
+
+// Pai は自分の手牌から kind の牌を切るときの mjai の表記と、ツモ切りかを返す
+// ツモ牌と同じ種類ならツモ牌を、それ以外は赤ドラでない牌を優先する
+func (s *MjaiSession) Pai(kind mahjong.Tile) (string, bool) {
+	if s.drawn != nil && s.drawn.kind == kind {
+		return formatMjaiTile(*s.drawn), true
+	}
+	found := tile{kind: kind}
+	if s.InRound() {
+		for _, t := range s.r.players[s.self].hand {
+			if t.kind == kind {
+				found = t
+				if !t.red {
+					break
+				}
+			}
+		}
+	}
+	return formatMjaiTile(found), false
+}
+
+// riichiDeclared は立直の宣言牌を切ったかを返す
+func (pl *player) riichiDeclared() bool {
+	for _, d := range pl.discards {
+		if d.Riichi {
+			return true
+		}
+	}
+	return false
+}
+
+// actor は行動したプレイヤーを返す
+func (m *MjaiMessage) actor() (int, error) {
+	if m.Actor == nil {
+		return 0, fmt.Errorf("%w: %s has no actor", entity.ErrInvalidGameLog, m.Type)
+	}
+	return *m.Actor, nil
+}
+
+// parseMjaiTile は mjai の牌の表記（例: 5m・5mr・E・P）を解析する
+// 字牌は東南西北白發中の順に E S W N P F C と表記する
+func parseMjaiTile(pai string) (tile, error) {
+	if len(pai) == 1 {
+		if i := strings.Index(mjaiHonorLetters, pai); i >= 0 {
+			return tile{kind: mahjong.East + mahjong.Tile(i)}, nil
+		}
+	}
+	red := strings.HasSuffix(pai, mjaiRedSuffix)
+	t, err := mahjong.ParseTile(strings.TrimSuffix(pai, mjaiRedSuffix))
+	if err != nil || t.IsHonor() || (red && t.Number() != 5) {
+		return tile{}, fmt.Errorf("%w: mjai tile %q", entity.ErrInvalidGameLog, pai)
+	}
+	return tile{kind: t, red: red}, nil
+}
+
+// formatMjaiTile は牌を mjai の表記にする
+func formatMjaiTile(t tile) string {
+	if t.kind.IsHonor() {
+		return string(mjaiHonorLetters[t.kind-mahjong.East])
+	}
+	if t.red {
+		return t.kind.String() + mjaiRedSuffix
+	}
+	return t.kind.String()
+}
+
+// MjaiTiles はMPSZ表記（0 は赤5）の牌を1枚ずつ mjai の表記にする
+func MjaiTiles(s string) ([]string, error) {
+	if _, _, err := mahjong.ParseTiles(s); err != nil {
+		return nil, err
+	}
+	var pais []string
+	var numbers []int
+	for _, r := range strings.ReplaceAll(s, " ", "") {
+		if r >= '0' && r <= '9' {
+			numbers = append(numbers, int(r-'0'))
+			continue
+		}
+		suit := mahjong.Suit(strings.IndexRune("mpsz", r))
+		for _, n := range numbers {
+			t := tile{red: n == 0}
+			if t.red {
+				n = 5
+			}
+			t.kind = mahjong.NewTile(suit, n)
+			pais = append(pais, formatMjaiTile(t))
+		}
+		numbers = numbers[:0]
+	}
+	return pais, nil
+}
+
+// MjaiWind は風牌を mjai の表記にする
+func MjaiWind(wind mahjong.Tile) string {
+	return formatMjaiTile(tile{kind: wind})
+}
@@ -0,0 +1,339 @@
+package gamelog
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// mjlog（天鳳のXML形式の牌譜）の GO 要素の type のビット
+const (
+	mjlogNoAka = 0x02 // 赤ドラなし
+	mjlogSanma = 0x10 // 三人麻雀
+)
+
+// mjlogRedIDs は赤5の牌の番号（萬子・筒子・索子）
+var mjlogRedIDs = map[int]bool{16: true, 52: true, 88: true}
+
+// mjlogRyuukyoku は RYUUKYOKU 要素の type と流局の種類の対応
+var mjlogRyuukyoku = map[string]string{
+	"":       "流局",
+	"yao9":   "九種九牌",
+	"reach4": "四家立直",
+	"ron3":   "三家和了",
+	"kan4":   "四槓散了",
+	"kaze4":  "四風連打",
+	"nm":     "流し満貫",
+}
+
+// mjlogParser は mjlog の要素を順に再生する
+type mjlogParser struct {
+	r   *replayer
+	aka bool
+	// lastDraw はプレイヤーごとの直前のツモ牌の番号（-1 はツモの直後でない）
+	lastDraw []int
+	results  []string
+}
+
+// ParseMjlog は天鳳のXML形式の牌譜（mjlog）を解析する
+func ParseMjlog(data []byte) (*Game, error) {
+	game := &Game{}
+	p := &mjlogParser{r: newReplayer(game), aka: true, lastDraw: []int{-1, -1, -1, -1}}
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", entity.ErrInvalidGameLog, err)
+		}
+		element, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if err := p.handle(element); err != nil {
+			return nil, fmt.Errorf("round %d: %w", len(game.Rounds)+1, err)
+		}
+	}
+	p.finishRound()
+	if len(game.Rounds) == 0 {
+		return nil, fmt.Errorf("%w: log has no rounds", entity.ErrInvalidGameLog)
+	}
+	return game, nil
+}
+
+// handle は1つの要素を適用する
+func (p *mjlogParser) handle(e xml.StartElement) error {
+	attrs := map[string]string{}
+	for _, a := range e.Attr {
+		attrs[a.Name.Local] = a.Value
+	}
+	name := e.Name.Local
+
+	switch name {
+	case "GO":
+		flags, _ := strconv.Atoi(attrs["type"])
+		p.r.game.Sanma = flags&mjlogSanma != 0
+		p.aka = flags&mjlogNoAka == 0
+		return nil
+	case "UN":
+		// 再接続時の UN には名前が含まれない
+		if _, ok := attrs["n0"]; !ok || len(p.r.game.Players) > 0 {
+			return nil
+		}
+		for i := 0; i < 4; i++ {
+			if n, err := url.PathUnescape(attrs[fmt.Sprintf("n%d", i)]); err == nil && n != "" {
+				p.r.game.Players = append(p.r.game.Players, n)
+			}
+		}
+		return nil
+	case "INIT":
+		return p.startRound(attrs)
+	case "N":
+		return p.meld(attrs)
+	case "REACH":
+		who, err := mjlogInt(attrs, "who")
+		if err != nil {
+			return err
+		}
+		if attrs["step"] == "1" {
+			return p.r.declareRiichi(who)
+		}
+		return p.r.acceptRiichi(who)
+	case "DORA":
+		id, err := mjlogInt(attrs, "hai")
+		if err != nil {
+			return err
+		}
+		dora, err := p.tile(id)
+		if err != nil {
+			return err
+		}
+		p.r.revealDora(dora.kind)
+		return nil
+	case "AGARI":
+		return p.agari(attrs)
+	case "RYUUKYOKU":
+		p.results = append(p.results, mjlogRyuukyoku[attrs["type"]])
+		return nil
+	}
+
+	// T/U/V/W + 牌の番号はツモ、D/E/F/G + 牌の番号は打牌
+	if len(name) < 2 {
+		return nil
+	}
+	id, err := strconv.Atoi(name[1:])
+	if err != nil {
+		return nil
+	}
+	if strings.IndexByte("TUVWDEFG", name[0]) < 0 {
+		return nil
+	}
+	t, err := p.tile(id)
+	if err != nil {
+		return err
+	}
+	if who := strings.IndexByte("TUVW", name[0]); who >= 0 {
+		if err := p.r.draw(who, t); err != nil {
+			return err
+		}
+		p.lastDraw[who] = id
+		return nil
+	}
+	if who := strings.IndexByte("DEFG", name[0]); who >= 0 {
+		tsumogiri := p.lastDraw[who] == id
+		p.lastDraw[who] = -1
+		return p.r.discard(who, t, tsumogiri)
+	}
+	return nil
+}
+
+// startRound は INIT 要素から局を始める
+func (p *mjlogParser) startRound(attrs map[string]string) error {
+	p.finishRound()
+	seed, err := mjlogInts(attrs["seed"])
+	if err != nil || len(seed) < 6 {
+		return fmt.Errorf("%w: INIT seed %q", entity.ErrInvalidGameLog, attrs["seed"])
+	}
+	ten, err := mjlogInts(attrs["ten"])
+	if err != nil {
+		return fmt.Errorf("%w: INIT ten %q", entity.ErrInvalidGameLog, attrs["ten"])
+	}
+	scores := make([]int, 0, len(ten))
+	for _, t := range ten {
+		scores = append(scores, t*100)
+	}
+	hands := make([][]tile, 4)
+	for i := range hands {
+		ids, err := mjlogInts(attrs[fmt.Sprintf("hai%d", i)])
+		if err != nil {
+			return fmt.Errorf("%w: INIT hai%d", entity.ErrInvalidGameLog, i)
+		}
+		if hands[i], err = p.tiles(ids); err != nil {
+			return err
+		}
+	}
+	dora, err := p.tile(seed[5])
+	if err != nil {
+		return err
+	}
+	p.lastDraw = []int{-1, -1, -1, -1}
+	return p.r.startRound(seed[0], seed[1], seed[2], scores, dora, hands)
+}
+
+// finishRound は進行中の局を結果とともに終える
+func (p *mjlogParser) finishRound() {
+	p.r.endRound(strings.Join(p.results, " / "))
+	p.results = nil
+}
+
+// agari は AGARI 要素の結果を記録する（ダブロンでは複数回現れる）
+func (p *mjlogParser) agari(attrs map[string]string) error {
+	who, err := mjlogInt(attrs, "who")
+	if err != nil {
+		return err
+	}
+	from, err := mjlogInt(attrs, "fromWho")
+	if err != nil {
+		return err
+	}
+	ten, _ := mjlogInts(attrs["ten"])
+	win := "和了: " + playerName(p.r.game, who)
+	if who == from {
+		win += " ツモ"
+	} else {
+		win += " ロン（放銃: " + playerName(p.r.game, from) + "）"
+	}
+	if len(ten) >= 2 {
+		win += fmt.Sprintf(" %d符%d点", ten[0], ten[1])
+	}
+	p.results = append(p.results, win)
+	return nil
+}
+
+// meld は N 要素の副露を適用する
+// m 属性のビット列は鳴きの種類ごとに、副露の牌と鳴いた相手を表す
+func (p *mjlogParser) meld(attrs map[string]string) error {
+	who, err := mjlogInt(attrs, "who")
+	if err != nil {
+		return err
+	}
+	m, err := mjlogInt(attrs, "m")
+	if err != nil {
+		return err
+	}
+	if who >= 0 && who < len(p.lastDraw) {
+		p.lastDraw[who] = -1
+	}
+	from := m & 3
+
+	switch {
+	case m&0x4 != 0:
+		// チー: 3枚の順子の先頭の牌と、鳴いた牌の位置
+		t := (m & 0xFC00) >> 10
+		t /= 3
+		base := t/7*9 + t%7
+		ids := make([]int, 0, 3)
+		for i := 0; i < 3; i++ {
+			ids = append(ids, (base+i)*4+(m>>(3+2*i))&3)
+		}
+		tiles, err := p.tiles(ids)
+		if err != nil {
+			return err
+		}
+		return p.r.call(who, mahjong.MeldChi, tiles)
+	case m&0x18 != 0:
+		// ポン・加槓: 刻子の牌と、ポンで使わなかった1枚
+		t := (m & 0xFE00) >> 9
+		kind := t / 3
+		unused := (m & 0x60) >> 5
+		if m&0x8 != 0 {
+			ids := make([]int, 0, 3)
+			for i := 0; i < 4; i++ {
+				if i != unused {
+					ids = append(ids, kind*4+i)
+				}
+			}
+			tiles, err := p.tiles(ids)
+			if err != nil {
+				return err
+			}
+			return p.r.call(who, mahjong.MeldPon, tiles)
+		}
+		added, err := p.tile(kind*4 + unused)
+		if err != nil {
+			return err
+		}
+		return p.r.kakan(who, added)
+	case m&0x20 != 0:
+		// 三麻の北抜き
+		return p.r.nuki(who, tile{kind: mahjong.North})
+	default:
+		// 大明槓・暗槓
+		kind := ((m & 0xFF00) >> 8) / 4
+		tiles, err := p.tiles([]int{kind * 4, kind*4 + 1, kind*4 + 2, kind*4 + 3})
+		if err != nil {
+			return err
+		}
+		if from == 0 {
+			return p.r.ankan(who, tiles)
+		}
+		return p.r.call(who, mahjong.MeldMinkan, tiles)
+	}
+}
+
+// tile は牌の番号（0-135）を変換する
+func (p *mjlogParser) tile(id int) (tile, error) {
+	if id < 0 || id >= 4*int(mahjong.NumTileKinds) {
+		return tile{}, fmt.Errorf("%w: tile id %d is out of range (0-%d)", entity.ErrInvalidGameLog, id, 4*int(mahjong.NumTileKinds)-1)
+	}
+	return tile{kind: mahjong.Tile(id / 4), red: p.aka && mjlogRedIDs[id]}, nil
+}
+
+// tiles は牌の番号の一覧を変換する
+func (p *mjlogParser) tiles(ids []int) ([]tile, error) {
+	tiles := make([]tile, 0, len(ids))
+	for _, id := range ids {
+		t, err := p.tile(id)
+		if err != nil {
+			return nil, err
+		}
+		tiles = append(tiles, t)
+	}
+	return tiles, nil
+}
+
+// mjlogInt は整数の属性を返す
+func mjlogInt(attrs map[string]string, name string) (int, error) {
+	v, err := strconv.Atoi(attrs[name])
+	if err != nil {
+		return 0, fmt.Errorf("%w: attribute %s=%q", entity.ErrInvalidGameLog, name, attrs[name])
+	}
+	return v, nil
+}
+
+// mjlogInts はカンマ区切りの整数の属性を返す（空の場合は空）
+func mjlogInts(s string) ([]int, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ",")
+	result := make([]int, 0, len(parts))
+	for _, part := range parts {
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
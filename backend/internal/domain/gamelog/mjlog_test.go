@@ -0,0 +1,41 @@
+package gamelog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+func TestParseMjlog(t *testing.T) {
+	game, err := ParseMjlog([]byte(readFixture(t, "sample.mjlog")))
+	if err != nil {
+		t.Fatalf("ParseMjlog: %v", err)
+	}
+	checkSampleGame(t, game)
+}
+
+func TestParseMjlogInvalid(t *testing.T) {
+	fixture := readFixture(t, "sample.mjlog")
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "局がない", data: `<mjloggm ver="2.3"><GO type="169"/></mjloggm>`},
+		{name: "seedのドラ表示牌の番号が範囲外", data: strings.Replace(fixture, `seed="0,0,0,2,4,90"`, `seed="0,0,0,2,4,136"`, 1)},
+		{name: "配牌の番号が負", data: strings.Replace(fixture, `hai0="4,`, `hai0="-1,`, 1)},
+		{name: "配牌の番号が範囲外", data: strings.Replace(fixture, `hai3="5,`, `hai3="200,`, 1)},
+		{name: "ツモの番号が範囲外", data: strings.Replace(fixture, "<T68/><D68/>", "<T136/><D136/>", 1)},
+		{name: "打牌の番号が範囲外", data: strings.Replace(fixture, "<E0/>", "<E999/>", 1)},
+		{name: "DORAの番号が範囲外", data: strings.Replace(fixture, "<T120/>", `<DORA hai="136"/><T120/>`, 1)},
+		{name: "属性が整数でない", data: strings.Replace(fixture, `<N who="2"`, `<N who="x"`, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseMjlog([]byte(tt.data)); !errors.Is(err, entity.ErrInvalidGameLog) {
+				t.Errorf("ParseMjlog error = %v, want %v", err, entity.ErrInvalidGameLog)
+			}
+		})
+	}
+}
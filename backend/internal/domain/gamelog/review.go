@@ -0,0 +1,284 @@
+package gamelog
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// DecisionKind は打牌の判断を評価した観点
+type DecisionKind int
+
+const (
+	DecisionEfficiency DecisionKind = iota // 牌効率（立直者がいない場合）
+	DecisionPushFold                       // 押し引き・安全度（立直者がいる場合）
+)
+
+// String は観点の名前を返す
+func (k DecisionKind) String() string {
+	switch k {
+	case DecisionEfficiency:
+		return "牌効率"
+	case DecisionPushFold:
+		return "押し引き"
+	default:
+		return "?"
+	}
+}
+
+// Severity は悪手の重大度
+type Severity int
+
+const (
+	SeverityNone     Severity = iota // 問題なし
+	SeverityMinor                    // 軽微
+	SeverityModerate                 // 中程度
+	SeveritySevere                   // 重大
+)
+
+// String は重大度の名前を返す
+func (s Severity) String() string {
+	switch s {
+	case SeverityNone:
+		return "問題なし"
+	case SeverityMinor:
+		return "軽微"
+	case SeverityModerate:
+		return "中程度"
+	case SeveritySevere:
+		return "重大"
+	default:
+		return "?"
+	}
+}
+
+// 損失の目安（点）から重大度を決める閾値
+const (
+	minorLoss    = 300
+	moderateLoss = 800
+	severeLoss   = 2000
+)
+
+// 聴牌時の牌効率の損失を点に換算する係数
+// 向聴数を戻す打牌は1向聴ごとに shantenLoss、同じ向聴数では受け入れの減った割合に ukeireLoss を掛け、
+// 聴牌から遠いほど和了への影響が小さいため最善の打牌の向聴数に1を足した数で割る
+const (
+	shantenLoss = 2000
+	ukeireLoss  = 1500
+)
+
+// severityOf は損失の目安から重大度を返す
+func severityOf(loss float64) Severity {
+	switch {
+	case loss >= severeLoss:
+		return SeveritySevere
+	case loss >= moderateLoss:
+		return SeverityModerate
+	case loss >= minorLoss:
+		return SeverityMinor
+	default:
+		return SeverityNone
+	}
+}
+
+// Decision は1回の打牌の判断をエンジンで評価した結果
+type Decision struct {
+	// Round と Step は牌譜の中の位置（いずれも0始まり）
+	Round     int
+	Step      int
+	RoundName string
+	Turn      int
+
+	Kind        DecisionKind
+	Actual      mahjong.Tile
+	Recommended mahjong.Tile
+	// Loss は推奨する打牌と比べた損失の目安（点）
+	Loss     float64
+	Severity Severity
+	// Evaluation はエンジンによる評価の要約
+	Evaluation string
+}
+
+// IsMistake は悪手として指摘するかを返す
+func (d *Decision) IsMistake() bool {
+	return d.Severity > SeverityNone
+}
+
+// EvaluateStep は打牌の判断をエンジンで評価する
+// 立直者がいない場合は牌効率（向聴数・受け入れ枚数）、いる場合は押し引きの推奨と放銃率で実際の打牌と比べる
+// 立直後の打牌や手牌が14枚でない判断は評価しない（nil を返す）
+func (g *Game) EvaluateStep(round, step int) (*Decision, error) {
+	s, err := g.Step(round, step)
+	if err != nil {
+		return nil, err
+	}
+	state := s.State
+	if self := state.Player(state.Self); self == nil || self.RiichiTurn() > 0 {
+		return nil, nil
+	}
+	hand, err := state.SelfHand()
+	if err != nil || hand == nil || len(hand.Concealed)%3 != 2 {
+		return nil, err
+	}
+
+	rules := g.RuleSet()
+	winCtx := state.WinContext()
+	discards := state.Player(state.Self).DiscardTiles()
+	analysis, err := mahjong.RecommendDiscard(hand, state.Seen(), discards, winCtx, mahjong.SimulationOptions{}, rules)
+	if err != nil {
+		return nil, err
+	}
+	candidates := make(map[mahjong.Tile]mahjong.DiscardCandidate, len(analysis.Candidates))
+	for _, c := range analysis.Candidates {
+		candidates[c.Tile] = c
+	}
+	actual, ok := candidates[s.Discard]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is not in the hand at round %d step %d", entity.ErrInvalidGameLog, s.Discard, round, step)
+	}
+	best := analysis.Candidates[0]
+
+	d := &Decision{
+		Round:       round,
+		Step:        step,
+		RoundName:   g.Rounds[round].Name(),
+		Turn:        s.Turn,
+		Kind:        DecisionEfficiency,
+		Actual:      s.Discard,
+		Recommended: best.Tile,
+		Loss:        efficiencyLoss(best, actual),
+		Evaluation: fmt.Sprintf("打%s: %s 受け入れ%d枚 / 最善 打%s: %s 受け入れ%d枚",
+			actual.Tile, shantenText(actual.Shanten), actual.Ukeire.Total, best.Tile, shantenText(best.Shanten), best.Ukeire.Total),
+	}
+
+	opponents := state.Opponents()
+	riichi := false
+	for _, o := range opponents {
+		riichi = riichi || o.IsRiichi()
+	}
+	if riichi {
+		if err := evaluatePushFold(d, state, hand, opponents, discards, candidates, rules); err != nil {
+			return nil, err
+		}
+	}
+	if d.Actual == d.Recommended {
+		d.Loss = 0
+	}
+	d.Severity = severityOf(d.Loss)
+	return d, nil
+}
+
+// evaluatePushFold は立直者がいる局面の判断を押し引きの推奨と比べる
+// 推奨する打牌より危険な牌を切った場合は、放銃率の差に立直者の平均的な打点を掛けて損失に加える
+func evaluatePushFold(d *Decision, state *mahjong.GameState, hand *mahjong.Hand, opponents []mahjong.Opponent, discards []mahjong.Tile, candidates map[mahjong.Tile]mahjong.DiscardCandidate, rules mahjong.RuleSet) error {
+	analysis, err := mahjong.EvaluatePushFold(mahjong.PushFoldInput{
+		Hand:      hand,
+		Opponents: opponents,
+		Visible:   state.Visible(),
+		Discards:  discards,
+		Context:   state.WinContext(),
+		Score:     state.ScoreSituation(),
+	}, rules)
+	if err != nil {
+		return err
+	}
+	safety, err := mahjong.AssessSafety(hand, opponents, state.Visible())
+	if err != nil {
+		return err
+	}
+	risks := make(map[mahjong.Tile]float64, len(safety))
+	for _, s := range safety {
+		risks[s.Tile] = s.Risk
+	}
+	var recommended mahjong.PushFoldOption
+	for _, o := range analysis.Options {
+		if o.Action == analysis.Recommendation {
+			recommended = o
+		}
+	}
+	threat := 0.0
+	for _, t := range analysis.Threats {
+		threat += float64(t.ExpectedLoss)
+	}
+	threat /= float64(max(1, len(analysis.Threats)))
+
+	d.Kind = DecisionPushFold
+	d.Recommended = recommended.Discard
+	d.Loss = max(0, risks[d.Actual]-recommended.DiscardRisk) * threat
+	if recommended.Action != mahjong.ActionFold {
+		d.Loss += efficiencyLoss(candidates[recommended.Discard], candidates[d.Actual])
+	}
+	d.Evaluation = fmt.Sprintf("%sを推奨（打%s %s 放銃率%.0f%%）/ 実際 打%s %s 放銃率%.0f%%",
+		recommended.Action, recommended.Discard, shantenText(recommended.Shanten), recommended.DiscardRisk*100,
+		d.Actual, shantenText(candidates[d.Actual].Shanten), risks[d.Actual]*100)
+	return nil
+}
+
+// efficiencyLoss は牌効率の損失の目安を返す
+func efficiencyLoss(best, actual mahjong.DiscardCandidate) float64 {
+	distance := float64(max(0, best.Shanten) + 1)
+	if actual.Shanten > best.Shanten {
+		return float64(shantenLoss*(actual.Shanten-best.Shanten)) / distance
+	}
+	if best.Ukeire.Total == 0 || actual.Ukeire.Total >= best.Ukeire.Total {
+		return 0
+	}
+	return ukeireLoss * float64(best.Ukeire.Total-actual.Ukeire.Total) / float64(best.Ukeire.Total) / distance
+}
+
+// shantenText は向聴数を表記する
+func shantenText(shanten int) string {
+	switch {
+	case shanten < 0:
+		return "和了"
+	case shanten == 0:
+		return "聴牌"
+	default:
+		return fmt.Sprintf("%d向聴", shanten)
+	}
+}
+
+// ReviewStatus は検討の進み具合
+type ReviewStatus int
+
+const (
+	ReviewPending   ReviewStatus = iota // 開始前
+	ReviewRunning                       // エンジンによる評価・AIによる解説の作成中
+	ReviewCompleted                     // 完了
+	ReviewFailed                        // 失敗
+)
+
+// Mistake は検討で指摘した悪手
+type Mistake struct {
+	Decision
+	// Commentary はAIによる解説（作成前や作成に失敗した場合は空）
+	Commentary string
+}
+
+// Review は1人のプレイヤーの対局全体の打牌を検討した結果
+type Review struct {
+	ID     string
+	GameID string
+	// Player は検討するプレイヤー（起家からの席順）
+	Player     int
+	PlayerName string
+
+	Status ReviewStatus
+	// Error は失敗した理由
+	Error string
+	// Evaluated と Total はエンジンで評価した判断の数と全体の数
+	Evaluated int
+	Total     int
+	// Mistakes は損失の大きい順の悪手
+	Mistakes []Mistake
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Done は検討が終わったかを返す
+func (r *Review) Done() bool {
+	return r.Status == ReviewCompleted || r.Status == ReviewFailed
+}
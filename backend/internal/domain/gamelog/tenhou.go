@@ -0,0 +1,380 @@
+package gamelog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// 天鳳のJSON形式の牌譜（tenhou.net/6 の形式）
+// 牌は 11-19 萬子、21-29 筒子、31-39 索子、41-47 字牌、51-53 赤5 の数値で表される
+type tenhouLog struct {
+	Title []string            `json:"title"`
+	Name  []string            `json:"name"`
+	Log   [][]json.RawMessage `json:"log"`
+}
+
+// 局の配列の添字
+const (
+	tenhouRoundInfo   = 0 // [局の通し番号, 本場, 供託]
+	tenhouScores      = 1
+	tenhouDoras       = 2
+	tenhouFirstPlayer = 4 // 以降プレイヤーごとに [配牌, 取得, 打牌] の3要素
+	tenhouResult      = 16
+)
+
+// tenhouTsumogiri は打牌の配列でツモ切りを表す値
+const tenhouTsumogiri = 60
+
+// ParseTenhouJSON は天鳳のJSON形式の牌譜を解析する
+func ParseTenhouJSON(data []byte) (*Game, error) {
+	var log tenhouLog
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrInvalidGameLog, err)
+	}
+	if len(log.Log) == 0 {
+		return nil, fmt.Errorf("%w: log has no rounds", entity.ErrInvalidGameLog)
+	}
+
+	game := &Game{Title: strings.TrimSpace(strings.Join(log.Title, " "))}
+	for _, name := range log.Name {
+		if name != "" {
+			game.Players = append(game.Players, name)
+		}
+	}
+	game.Sanma = len(game.Players) == 3
+
+	r := newReplayer(game)
+	for i, round := range log.Log {
+		if err := replayTenhouRound(r, round); err != nil {
+			return nil, fmt.Errorf("round %d: %w", i+1, err)
+		}
+	}
+	return game, nil
+}
+
+// tenhouPlayer はプレイヤーごとの取得（ツモ・鳴き）と打牌の列
+type tenhouPlayer struct {
+	takes    []any
+	discards []any
+	ti, di   int
+}
+
+// nextTake は次の取得を返す（残りがない場合は nil）
+func (p *tenhouPlayer) nextTake() any {
+	if p.ti >= len(p.takes) {
+		return nil
+	}
+	return p.takes[p.ti]
+}
+
+// replayTenhouRound は1局分の配列を再生する
+func replayTenhouRound(r *replayer, round []json.RawMessage) error {
+	if len(round) <= tenhouResult {
+		return fmt.Errorf("%w: round has %d elements", entity.ErrInvalidGameLog, len(round))
+	}
+	var info, scores, doras []int
+	for _, v := range []struct {
+		index int
+		dst   *[]int
+	}{{tenhouRoundInfo, &info}, {tenhouScores, &scores}, {tenhouDoras, &doras}} {
+		if err := json.Unmarshal(round[v.index], v.dst); err != nil {
+			return fmt.Errorf("%w: %v", entity.ErrInvalidGameLog, err)
+		}
+	}
+	if len(info) < 3 || len(doras) == 0 {
+		return fmt.Errorf("%w: round info or dora indicators are missing", entity.ErrInvalidGameLog)
+	}
+	dora, err := tenhouTile(doras[0])
+	if err != nil {
+		return err
+	}
+
+	n := r.numPlayers()
+	hands := make([][]tile, n)
+	players := make([]*tenhouPlayer, n)
+	for i := 0; i < n; i++ {
+		base := tenhouFirstPlayer + 3*i
+		var haipai []int
+		p := &tenhouPlayer{}
+		for _, v := range []struct {
+			index int
+			dst   any
+		}{{base, &haipai}, {base + 1, &p.takes}, {base + 2, &p.discards}} {
+			if err := json.Unmarshal(round[v.index], v.dst); err != nil {
+				return fmt.Errorf("%w: %v", entity.ErrInvalidGameLog, err)
+			}
+		}
+		for _, code := range haipai {
+			t, err := tenhouTile(code)
+			if err != nil {
+				return err
+			}
+			hands[i] = append(hands[i], t)
+		}
+		players[i] = p
+	}
+	if err := r.startRound(info[0], info[1], info[2], scores, dora, hands); err != nil {
+		return err
+	}
+
+	kans := 0
+	revealKanDora := func() error {
+		kans++
+		if kans < len(doras) {
+			t, err := tenhouTile(doras[kans])
+			if err != nil {
+				return err
+			}
+			r.revealDora(t.kind)
+		}
+		return nil
+	}
+
+	cur, needDraw := r.dealer, true
+	var lastDraw tile
+	riichi := -1
+	for {
+		p := players[cur]
+		if needDraw {
+			take := p.nextTake()
+			if take == nil {
+				break
+			}
+			p.ti++
+			code, ok := take.(float64)
+			if !ok {
+				return fmt.Errorf("%w: player %d calls %v on own turn", entity.ErrInvalidGameLog, cur, take)
+			}
+			t, err := tenhouTile(int(code))
+			if err != nil {
+				return err
+			}
+			if riichi >= 0 {
+				if err := r.acceptRiichi(riichi); err != nil {
+					return err
+				}
+				riichi = -1
+			}
+			if err := r.draw(cur, t); err != nil {
+				return err
+			}
+			lastDraw = t
+		}
+
+		if p.di >= len(p.discards) {
+			break
+		}
+		action := p.discards[p.di]
+		p.di++
+
+		var discarded tile
+		tsumogiri := false
+		switch v := action.(type) {
+		case float64:
+			if int(v) == tenhouTsumogiri {
+				discarded, tsumogiri = lastDraw, true
+			} else if discarded, err = tenhouTile(int(v)); err != nil {
+				return err
+			}
+		case string:
+			switch {
+			case strings.HasPrefix(v, "r"):
+				if err := r.declareRiichi(cur); err != nil {
+					return err
+				}
+				code, err := strconv.Atoi(v[1:])
+				if err != nil {
+					return fmt.Errorf("%w: riichi %q", entity.ErrInvalidGameLog, v)
+				}
+				if code == tenhouTsumogiri {
+					discarded, tsumogiri = lastDraw, true
+				} else if discarded, err = tenhouTile(code); err != nil {
+					return err
+				}
+				riichi = cur
+			case strings.Contains(v, "a"):
+				tiles, _, err := tenhouMeldTiles(v, "a")
+				if err != nil {
+					return err
+				}
+				if err := r.ankan(cur, tiles); err != nil {
+					return err
+				}
+				if err := revealKanDora(); err != nil {
+					return err
+				}
+				needDraw = true
+				continue
+			case strings.Contains(v, "k"):
+				_, added, err := tenhouMeldTiles(v, "k")
+				if err != nil {
+					return err
+				}
+				if err := r.kakan(cur, added); err != nil {
+					return err
+				}
+				if err := revealKanDora(); err != nil {
+					return err
+				}
+				needDraw = true
+				continue
+			case strings.HasPrefix(v, "f"):
+				_, nukiTile, err := tenhouMeldTiles(v, "f")
+				if err != nil {
+					return err
+				}
+				if err := r.nuki(cur, nukiTile); err != nil {
+					return err
+				}
+				needDraw = true
+				continue
+			default:
+				return fmt.Errorf("%w: unknown action %q", entity.ErrInvalidGameLog, v)
+			}
+		default:
+			return fmt.Errorf("%w: unknown action %v", entity.ErrInvalidGameLog, action)
+		}
+		if err := r.discard(cur, discarded, tsumogiri); err != nil {
+			return err
+		}
+
+		caller, meldType, tiles, err := findTenhouCall(players, cur, discarded.kind)
+		if err != nil {
+			return err
+		}
+		if caller < 0 {
+			cur, needDraw = (cur+1)%n, true
+			continue
+		}
+		if riichi >= 0 {
+			if err := r.acceptRiichi(riichi); err != nil {
+				return err
+			}
+			riichi = -1
+		}
+		players[caller].ti++
+		if err := r.call(caller, meldType, tiles); err != nil {
+			return err
+		}
+		cur, needDraw = caller, false
+		if meldType == mahjong.MeldMinkan {
+			// 大明槓の後は打牌の列に 0 が入り、嶺上牌をツモる
+			players[caller].di++
+			if err := revealKanDora(); err != nil {
+				return err
+			}
+			needDraw = true
+		}
+	}
+
+	r.endRound(tenhouResultText(r.game, round[tenhouResult]))
+	return nil
+}
+
+// findTenhouCall は打牌を鳴いたプレイヤーを探す（鳴きがない場合は -1）
+// ポン・大明槓をチーより優先し、鳴いた牌が打牌と一致するものを選ぶ
+func findTenhouCall(players []*tenhouPlayer, discarder int, discarded mahjong.Tile) (int, mahjong.MeldType, []tile, error) {
+	n := len(players)
+	for _, marker := range []string{"p", "m", "c"} {
+		for k := 1; k < n; k++ {
+			q := (discarder + k) % n
+			if marker == "c" && k != 1 {
+				continue
+			}
+			take, ok := players[q].nextTake().(string)
+			if !ok || !strings.Contains(take, marker) {
+				continue
+			}
+			tiles, called, err := tenhouMeldTiles(take, marker)
+			if err != nil {
+				return -1, 0, nil, err
+			}
+			if called.kind != discarded {
+				continue
+			}
+			meldType := map[string]mahjong.MeldType{"p": mahjong.MeldPon, "m": mahjong.MeldMinkan, "c": mahjong.MeldChi}[marker]
+			return q, meldType, tiles, nil
+		}
+	}
+	return -1, 0, nil, nil
+}
+
+// tenhouMeldTiles は鳴きの文字列（例: "c275226"、"37p3737"）の牌と、種類の文字の直後の牌を返す
+func tenhouMeldTiles(s, marker string) ([]tile, tile, error) {
+	i := strings.Index(s, marker)
+	digits := s[:i] + s[i+1:]
+	if len(digits)%2 != 0 || i%2 != 0 || i+2 > len(digits) {
+		return nil, tile{}, fmt.Errorf("%w: meld %q", entity.ErrInvalidGameLog, s)
+	}
+	var tiles []tile
+	for j := 0; j < len(digits); j += 2 {
+		code, err := strconv.Atoi(digits[j : j+2])
+		if err != nil {
+			return nil, tile{}, fmt.Errorf("%w: meld %q", entity.ErrInvalidGameLog, s)
+		}
+		t, err := tenhouTile(code)
+		if err != nil {
+			return nil, tile{}, err
+		}
+		tiles = append(tiles, t)
+	}
+	return tiles, tiles[i/2], nil
+}
+
+// tenhouTile は天鳳の牌の数値を変換する
+func tenhouTile(code int) (tile, error) {
+	switch {
+	case code >= 51 && code <= 53:
+		return tile{kind: mahjong.NewTile(mahjong.Suit(code-51), 5), red: true}, nil
+	case code >= 11 && code <= 39 && code%10 != 0:
+		return tile{kind: mahjong.NewTile(mahjong.Suit(code/10-1), code%10)}, nil
+	case code >= 41 && code <= 47:
+		return tile{kind: mahjong.NewTile(mahjong.SuitHonor, code%10)}, nil
+	default:
+		return tile{}, fmt.Errorf("%w: unknown tile code %d", entity.ErrInvalidGameLog, code)
+	}
+}
+
+// tenhouResultText は局の結果の配列を説明にする
+// 和了は ["和了", [収支], [和了者, 放銃者, 責任者, "30符1飜1000点", 役...], ...]、それ以外は ["流局", ...] など
+func tenhouResultText(game *Game, raw json.RawMessage) string {
+	var result []any
+	if err := json.Unmarshal(raw, &result); err != nil || len(result) == 0 {
+		return ""
+	}
+	kind, _ := result[0].(string)
+	if kind != "和了" {
+		return kind
+	}
+	var wins []string
+	for i := 2; i < len(result); i += 2 {
+		detail, ok := result[i].([]any)
+		if !ok || len(detail) < 4 {
+			continue
+		}
+		who, _ := detail[0].(float64)
+		from, _ := detail[1].(float64)
+		text, _ := detail[3].(string)
+		win := playerName(game, int(who))
+		if who == from {
+			win += " ツモ"
+		} else {
+			win += " ロン（放銃: " + playerName(game, int(from)) + "）"
+		}
+		wins = append(wins, win+" "+text)
+	}
+	return "和了: " + strings.Join(wins, " / ")
+}
+
+// playerName はプレイヤー名を返す（名前がない場合は席順）
+func playerName(game *Game, p int) string {
+	if p >= 0 && p < len(game.Players) {
+		return game.Players[p]
+	}
+	return fmt.Sprintf("player%d", p)
+}
@@ -0,0 +1,43 @@
+package gamelog
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+func TestParseTenhouJSON(t *testing.T) {
+	game, err := ParseTenhouJSON([]byte(readFixture(t, "tenhou.json")))
+	if err != nil {
+		t.Fatalf("ParseTenhouJSON: %v", err)
+	}
+	if game.Title != "テスト" {
+		t.Errorf("title = %q", game.Title)
+	}
+	checkSampleGame(t, game)
+}
+
+func TestParseTenhouJSONInvalid(t *testing.T) {
+	fixture := readFixture(t, "tenhou.json")
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "JSONでない", data: "{"},
+		{name: "局がない", data: `{"name":["A","B","C","D"],"log":[]}`},
+		{name: "ドラ表示牌の番号が不正", data: strings.Replace(fixture, "[35]", "[48]", 1)},
+		{name: "配牌の番号が不正", data: strings.Replace(fixture, "[12, 13, 14,", "[10, 13, 14,", 1)},
+		{name: "ツモの番号が不正", data: strings.Replace(fixture, "[29, 18, 44]", "[29, 54, 44]", 1)},
+		{name: "打牌の番号が不正", data: strings.Replace(fixture, "[11, 60, 43]", "[11, 60, 40]", 1)},
+		{name: "鳴きの番号が不正", data: strings.Replace(fixture, `"p474747"`, `"p474748"`, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseTenhouJSON([]byte(tt.data)); !errors.Is(err, entity.ErrInvalidGameLog) {
+				t.Errorf("ParseTenhouJSON error = %v, want %v", err, entity.ErrInvalidGameLog)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package knowledge
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BM25 のパラメータ
+const (
+	// bm25K1 は語の出現回数による得点の飽和の速さ
+	bm25K1 = 1.2
+	// bm25B は抜粋の長さによる正規化の強さ
+	bm25B = 0.75
+)
+
+// SearchResult は検索でヒットした抜粋と得点
+type SearchResult struct {
+	Passage Passage
+	Score   float64
+}
+
+// Index は抜粋の BM25 による全文検索の索引
+type Index struct {
+	passages []Passage
+	// terms は抜粋ごとの語の出現回数
+	terms   []map[string]int
+	lengths []int
+	avgLen  float64
+	// docFreq は語が現れる抜粋の数
+	docFreq map[string]int
+}
+
+// NewIndex は抜粋の索引を作成する
+func NewIndex(passages []Passage) *Index {
+	idx := &Index{
+		passages: passages,
+		terms:    make([]map[string]int, len(passages)),
+		lengths:  make([]int, len(passages)),
+		docFreq:  map[string]int{},
+	}
+	total := 0
+	for i, p := range passages {
+		// 見出しも本文と同じように検索の対象にする
+		tokens := Tokenize(p.Title + "\n" + p.Section + "\n" + p.Text)
+		tf := make(map[string]int, len(tokens))
+		for _, t := range tokens {
+			tf[t]++
+		}
+		for t := range tf {
+			idx.docFreq[t]++
+		}
+		idx.terms[i] = tf
+		idx.lengths[i] = len(tokens)
+		total += len(tokens)
+	}
+	if len(passages) > 0 {
+		idx.avgLen = float64(total) / float64(len(passages))
+	}
+	return idx
+}
+
+// Len は索引に含まれる抜粋の数を返す
+func (idx *Index) Len() int {
+	return len(idx.passages)
+}
+
+// Passages は索引に含まれる抜粋を返す
+func (idx *Index) Passages() []Passage {
+	return idx.passages
+}
+
+// Search は質問に関係する抜粋を得点の高い順に最大 k 件返す（得点が0の抜粋は返さない）
+func (idx *Index) Search(query string, k int) []SearchResult {
+	if k <= 0 || len(idx.passages) == 0 {
+		return nil
+	}
+	queryTerms := map[string]bool{}
+	for _, t := range Tokenize(query) {
+		queryTerms[t] = true
+	}
+
+	n := float64(len(idx.passages))
+	var results []SearchResult
+	for i, tf := range idx.terms {
+		score := 0.0
+		for t := range queryTerms {
+			f := float64(tf[t])
+			if f == 0 {
+				continue
+			}
+			df := float64(idx.docFreq[t])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			norm := 1 - bm25B + bm25B*float64(idx.lengths[i])/idx.avgLen
+			score += idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+		}
+		if score > 0 {
+			results = append(results, SearchResult{Passage: idx.passages[i], Score: score})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > k {
+		results = results[:k]
+	}
+	return results
+}
+
+// Tokenize は文章を検索の語に分ける
+//
+// 英数字の並び（牌の表記 123m など）は1語とし、日本語は分かち書きをせず2文字ずつの語（bi-gram）にする。
+// 全角の英数字は半角に、英字は小文字にそろえる。
+func Tokenize(text string) []string {
+	var (
+		tokens []string
+		word   []rune
+		run    []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, string(word))
+			word = word[:0]
+		}
+	}
+	flushRun := func() {
+		switch {
+		case len(run) == 1:
+			tokens = append(tokens, string(run))
+		case len(run) > 1:
+			for i := 0; i+1 < len(run); i++ {
+				tokens = append(tokens, string(run[i:i+2]))
+			}
+		}
+		run = run[:0]
+	}
+
+	for _, r := range text {
+		// 全角の英数字・記号を半角にする
+		if r >= '！' && r <= '～' {
+			r -= '！' - '!'
+		}
+		r = unicode.ToLower(r)
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			flushRun()
+			word = append(word, r)
+		case isJapanese(r):
+			flushWord()
+			run = append(run, r)
+		default:
+			flushWord()
+			flushRun()
+		}
+	}
+	flushWord()
+	flushRun()
+	return tokens
+}
+
+// isJapanese は漢字・ひらがな・カタカナ（長音記号を含む）かを返す
+func isJapanese(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana) || r == 'ー'
+}
+
+// Snippet は抜粋の本文を改行を詰めて最大 n 文字に縮めて返す
+func Snippet(text string, n int) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	if len(runes) <= n {
+		return string(runes)
+	}
+	return string(runes[:n]) + "…"
+}
@@ -0,0 +1,93 @@
+package knowledge
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want []string
+	}{
+		{name: "日本語はbi-gram", text: "立直一発", want: []string{"立直", "直一", "一発"}},
+		{name: "1文字の日本語", text: "東 南", want: []string{"東", "南"}},
+		{name: "牌の表記は1語", text: "123mと5sを切る", want: []string{"123m", "と", "5s", "を切", "切る"}},
+		{name: "全角英数字と大文字", text: "ＤＯＲＡ３", want: []string{"dora3"}},
+		{name: "長音記号", text: "ロン・ツーモ", want: []string{"ロン", "ツー", "ーモ"}},
+		{name: "記号と空白だけ", text: "、。 !?", want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Tokenize(tt.text); !slices.Equal(got, tt.want) {
+				t.Errorf("Tokenize(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIndexSearch(t *testing.T) {
+	idx := NewIndex([]Passage{
+		{ID: "riichi", Title: "役", Section: "立直", Text: "門前で聴牌したときに立直を宣言できる。立直後は手牌を変えられない。"},
+		{ID: "ippatsu", Title: "役", Section: "一発", Text: "立直から1巡以内に和了すると一発が付く。"},
+		{ID: "furiten", Title: "ルール", Section: "振聴", Text: "自分の捨て牌に和了牌がある場合はロンできない。振聴でもツモ和了はできる。"},
+		{ID: "dora", Title: "ルール", Section: "ドラ", Text: "ドラ表示牌の次の牌がドラになる。赤5もドラとして数える。"},
+		{ID: "long", Title: "ルール", Section: "流局", Text: "山がなくなると流局する。聴牌していれば聴牌料を受け取る。" +
+			"途中流局には九種九牌、四風連打、四家立直、四開槓、三家和がある。四家立直は4人が立直した場合の流局。"},
+	})
+	if idx.Len() != 5 {
+		t.Fatalf("Len() = %d, want 5", idx.Len())
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		k     int
+		want  []string
+	}{
+		{name: "見出しに一致する抜粋が上位", query: "振聴", k: 3, want: []string{"furiten"}},
+		{name: "珍しい語を重視", query: "一発の条件", k: 1, want: []string{"ippatsu"}},
+		{name: "表記の揺れ", query: "ＤＯＲＡ ドラ表示牌", k: 2, want: []string{"dora"}},
+		{name: "件数の上限", query: "立直", k: 2, want: []string{"riichi", "long"}},
+		{name: "一致しない", query: "chombo", k: 3, want: nil},
+		{name: "件数が0", query: "立直", k: 0, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := idx.Search(tt.query, tt.k)
+			if got := resultIDs(results); !slices.Equal(got, tt.want) {
+				t.Errorf("Search(%q, %d) = %v, want %v", tt.query, tt.k, got, tt.want)
+			}
+			for i := 1; i < len(results); i++ {
+				if results[i].Score > results[i-1].Score {
+					t.Errorf("results are not sorted by score: %v", results)
+				}
+			}
+		})
+	}
+}
+
+func TestIndexSearchLengthNormalization(t *testing.T) {
+	// 同じ回数だけ語が現れる場合は短い抜粋を上位にする
+	idx := NewIndex([]Passage{
+		{ID: "long", Text: "和了の形には一般形と七対子と国士無双があり、点数の計算では符と翻を数えて基本点を求め、親と子で支払いが変わる。"},
+		{ID: "short", Text: "七対子は2符ではなく25符。"},
+		{ID: "other", Text: "鳴いた手でも役があれば和了できる。"},
+	})
+	if got := resultIDs(idx.Search("七対子", 3)); !slices.Equal(got, []string{"short", "long"}) {
+		t.Errorf("Search(七対子) = %v, want [short long]", got)
+	}
+	if got := NewIndex(nil).Search("七対子", 3); got != nil {
+		t.Errorf("Search on an empty index = %v, want nil", got)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	if got := Snippet("立直は\n  門前でのみ\tできる", 100); got != "立直は 門前でのみ できる" {
+		t.Errorf("Snippet() = %q", got)
+	}
+	if got := Snippet("一二三四五六", 4); got != "一二三四…" {
+		t.Errorf("Snippet() = %q, want 一二三四…", got)
+	}
+}
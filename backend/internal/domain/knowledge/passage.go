@@ -0,0 +1,100 @@
+// Package knowledge はルールの資料を節ごとの抜粋に分け、質問に関係する抜粋を検索する
+package knowledge
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Passage は資料の1つの節の抜粋
+type Passage struct {
+	// ID は抜粋の識別子（文書のパスと節の番号）
+	ID string
+	// Document は文書のパス（例: yaku.md）
+	Document string
+	// Title は文書の題名（最初の見出し、なければパス）
+	Title string
+	// Section は節の見出し（「一翻役 > 立直」のように上位の見出しから連ねる、文書の冒頭は空）
+	Section string
+	// Text は節の本文
+	Text string
+}
+
+// Label は抜粋の出典を「題名 > 節」の形式で返す
+func (p Passage) Label() string {
+	if p.Section == "" {
+		return p.Title
+	}
+	return p.Title + " > " + p.Section
+}
+
+// ParseMarkdown はMarkdownの文書を見出しごとの抜粋に分ける
+//
+// 最初の `#` 見出しを文書の題名とし、`##` 以下の見出しごとに節を分ける。
+// 本文のない節は抜粋にしない。
+func ParseMarkdown(document, content string) []Passage {
+	title := strings.TrimSuffix(path.Base(document), path.Ext(document))
+	var (
+		passages []Passage
+		headings []string
+		body     strings.Builder
+		inCode   bool
+	)
+	flush := func() {
+		text := strings.TrimSpace(body.String())
+		body.Reset()
+		if text == "" {
+			return
+		}
+		passages = append(passages, Passage{
+			ID:       fmt.Sprintf("%s#%d", document, len(passages)),
+			Document: document,
+			Section:  strings.Join(headings, " > "),
+			Text:     text,
+		})
+	}
+
+	titled := false
+	for _, line := range strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n") {
+		if strings.HasPrefix(line, "```") {
+			inCode = !inCode
+		}
+		level := headingLevel(line)
+		if inCode || level == 0 {
+			body.WriteString(line + "\n")
+			continue
+		}
+		heading := strings.TrimSpace(line[level:])
+		if level == 1 && !titled {
+			flush()
+			title, titled = heading, true
+			continue
+		}
+		flush()
+		// 見出しの深さに合わせて上位の見出しを残す（# は ## と同じ深さとして扱う）
+		depth := max(level-2, 0)
+		if depth > len(headings) {
+			depth = len(headings)
+		}
+		headings = append(headings[:depth], heading)
+	}
+	flush()
+
+	for i := range passages {
+		passages[i].Title = title
+	}
+	return passages
+}
+
+// headingLevel は行がMarkdownの見出しであれば深さを、そうでなければ0を返す
+func headingLevel(line string) int {
+	level := 0
+	for level < len(line) && line[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 || level >= len(line) || line[level] != ' ' {
+		return 0
+	}
+	return level
+}
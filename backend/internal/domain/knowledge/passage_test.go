@@ -0,0 +1,70 @@
+package knowledge
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseMarkdown(t *testing.T) {
+	content := "冒頭の説明。\r\n" +
+		"# 役の一覧\n" +
+		"役の概要。\n" +
+		"## 一翻役\n" +
+		"### 立直\n" +
+		"門前で聴牌したときに宣言する。\n" +
+		"```\n" +
+		"# コードブロックの中は見出しにしない\n" +
+		"```\n" +
+		"### 平和\n" +
+		"\n" +
+		"## 役満\n" +
+		"#### 国士無双\n" +
+		"么九牌13種。\n" +
+		"#見出しでない行\n" +
+		"## 二翻役\n" +
+		"七対子など。\n"
+
+	want := []Passage{
+		{ID: "yaku.md#0", Document: "yaku.md", Title: "役の一覧", Text: "冒頭の説明。"},
+		{ID: "yaku.md#1", Document: "yaku.md", Title: "役の一覧", Text: "役の概要。"},
+		{ID: "yaku.md#2", Document: "yaku.md", Title: "役の一覧", Section: "一翻役 > 立直", Text: "門前で聴牌したときに宣言する。\n```\n# コードブロックの中は見出しにしない\n```"},
+		// 本文のない「平和」は抜粋にせず、深い見出しは上位の見出しの直下に連ねる
+		{ID: "yaku.md#3", Document: "yaku.md", Title: "役の一覧", Section: "役満 > 国士無双", Text: "么九牌13種。\n#見出しでない行"},
+		{ID: "yaku.md#4", Document: "yaku.md", Title: "役の一覧", Section: "二翻役", Text: "七対子など。"},
+	}
+	if got := ParseMarkdown("yaku.md", content); !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseMarkdown() =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestParseMarkdownTitle(t *testing.T) {
+	// 題名のない文書はパスから題名を付ける
+	got := ParseMarkdown("docs/scoring.md", "## 符\n副底は20符。\n")
+	want := []Passage{{ID: "docs/scoring.md#0", Document: "docs/scoring.md", Title: "scoring", Section: "符", Text: "副底は20符。"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseMarkdown() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	// 2つ目の # 見出しは ## と同じ深さの節にする
+	got = ParseMarkdown("scoring.md", "# 点数\n## 符\n### 副底\n20符。\n# 翻\n役とドラ。\n")
+	want = []Passage{
+		{ID: "scoring.md#0", Document: "scoring.md", Title: "点数", Section: "符 > 副底", Text: "20符。"},
+		{ID: "scoring.md#1", Document: "scoring.md", Title: "点数", Section: "翻", Text: "役とドラ。"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseMarkdown() =\n%+v\nwant\n%+v", got, want)
+	}
+
+	if got := ParseMarkdown("empty.md", "# 題名だけ\n"); len(got) != 0 {
+		t.Errorf("ParseMarkdown() = %+v, want no passages", got)
+	}
+}
+
+func TestPassageLabel(t *testing.T) {
+	if got := (Passage{Title: "役", Section: "一翻役 > 立直"}).Label(); got != "役 > 一翻役 > 立直" {
+		t.Errorf("Label() = %q", got)
+	}
+	if got := (Passage{Title: "役"}).Label(); got != "役" {
+		t.Errorf("Label() = %q", got)
+	}
+}
@@ -0,0 +1,114 @@
+package knowledge
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"sort"
+)
+
+// rrfK は Reciprocal Rank Fusion で順位に足す定数（上位の順位の差を緩める）
+const rrfK = 60
+
+// Hash は抜粋の出典と本文から求めた値を返す（本文が変わったかの判定に使う）
+func (p Passage) Hash() string {
+	sum := sha256.Sum256([]byte(p.Label() + "\n" + p.Text))
+	return hex.EncodeToString(sum[:8])
+}
+
+// VectorEntry は抜粋の埋め込みベクトル
+type VectorEntry struct {
+	PassageID string    `json:"passage_id"`
+	Hash      string    `json:"hash"`
+	Vector    []float32 `json:"vector"`
+}
+
+// VectorIndex は抜粋の埋め込みベクトルの索引
+type VectorIndex struct {
+	// Model はベクトルを求めた埋め込みモデルの名前（異なるモデルのベクトルとは比べられない）
+	Model   string        `json:"model"`
+	Entries []VectorEntry `json:"entries"`
+}
+
+// Lookup は抜粋のベクトルを返す（本文が変わっている場合は見つからないものとする）
+func (v *VectorIndex) Lookup(p Passage) ([]float32, bool) {
+	if v == nil {
+		return nil, false
+	}
+	for _, e := range v.Entries {
+		if e.PassageID == p.ID && e.Hash == p.Hash() {
+			return e.Vector, true
+		}
+	}
+	return nil, false
+}
+
+// Search は質問のベクトルとのコサイン類似度が高い抜粋を最大 k 件返す
+// ベクトルのない抜粋と、類似度が0以下の抜粋は返さない
+func (v *VectorIndex) Search(passages []Passage, query []float32, k int) []SearchResult {
+	if v == nil || k <= 0 {
+		return nil
+	}
+	vectors := make(map[string]VectorEntry, len(v.Entries))
+	for _, e := range v.Entries {
+		vectors[e.PassageID] = e
+	}
+	var results []SearchResult
+	for _, p := range passages {
+		e, ok := vectors[p.ID]
+		if !ok || e.Hash != p.Hash() {
+			continue
+		}
+		if score := Cosine(query, e.Vector); score > 0 {
+			results = append(results, SearchResult{Passage: p, Score: score})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > k {
+		results = results[:k]
+	}
+	return results
+}
+
+// Cosine は2つのベクトルのコサイン類似度を返す（次元が異なるか、いずれかが零ベクトルの場合は0）
+func Cosine(a, b []float32) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+// FuseRankings は複数の検索結果の順位を Reciprocal Rank Fusion でまとめ、最大 k 件返す
+// 得点は各検索結果での順位 r について 1/(rrfK+r) を合計した値とする
+func FuseRankings(k int, rankings ...[]SearchResult) []SearchResult {
+	scores := map[string]float64{}
+	passages := map[string]Passage{}
+	var order []string
+	for _, ranking := range rankings {
+		for rank, r := range ranking {
+			if _, ok := passages[r.Passage.ID]; !ok {
+				passages[r.Passage.ID] = r.Passage
+				order = append(order, r.Passage.ID)
+			}
+			scores[r.Passage.ID] += 1 / float64(rrfK+rank+1)
+		}
+	}
+	results := make([]SearchResult, 0, len(order))
+	for _, id := range order {
+		results = append(results, SearchResult{Passage: passages[id], Score: scores[id]})
+	}
+	sort.SliceStable(results, func(i, j int) bool { return results[i].Score > results[j].Score })
+	if len(results) > k {
+		results = results[:k]
+	}
+	return results
+}
@@ -0,0 +1,52 @@
+package knowledge
+
+import (
+	"slices"
+	"testing"
+)
+
+// resultIDs は検索結果の抜粋のIDを順に返す
+func resultIDs(results []SearchResult) []string {
+	ids := make([]string, len(results))
+	for i, r := range results {
+		ids[i] = r.Passage.ID
+	}
+	return ids
+}
+
+// ranking は抜粋のIDの順の検索結果を作る
+func ranking(ids ...string) []SearchResult {
+	results := make([]SearchResult, len(ids))
+	for i, id := range ids {
+		results[i] = SearchResult{Passage: Passage{ID: id}, Score: float64(len(ids) - i)}
+	}
+	return results
+}
+
+func TestFuseRankings(t *testing.T) {
+	tests := []struct {
+		name     string
+		k        int
+		rankings [][]SearchResult
+		want     []string
+	}{
+		{name: "両方の上位が先", k: 10, rankings: [][]SearchResult{ranking("a", "b", "c"), ranking("b", "a", "d")}, want: []string{"a", "b", "c", "d"}},
+		{name: "両方に現れる抜粋は片方の1位より上", k: 10, rankings: [][]SearchResult{ranking("x", "a", "b"), ranking("y", "b", "a")}, want: []string{"a", "b", "x", "y"}},
+		{name: "同点は最初に現れた順", k: 10, rankings: [][]SearchResult{ranking("a"), ranking("b")}, want: []string{"a", "b"}},
+		{name: "k件まで", k: 2, rankings: [][]SearchResult{ranking("a", "b", "c"), ranking("c", "b", "a")}, want: []string{"a", "c"}},
+		{name: "検索結果が空", k: 3, rankings: [][]SearchResult{nil, ranking("a")}, want: []string{"a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FuseRankings(tt.k, tt.rankings...)
+			if ids := resultIDs(got); !slices.Equal(ids, tt.want) {
+				t.Errorf("FuseRankings = %v, want %v", ids, tt.want)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].Score > got[i-1].Score {
+					t.Errorf("scores are not descending: %v", got)
+				}
+			}
+		})
+	}
+}
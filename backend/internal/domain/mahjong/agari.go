@@ -0,0 +1,169 @@
+package mahjong
+
+import (
+	"fmt"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// Agari は和了の評価結果（最も高い点数になる解釈）
+type Agari struct {
+	Yaku    []Yaku
+	Han     int // ドラを含む翻数
+	Fu      int
+	Yakuman int // 役満の倍数
+
+	Dora    int
+	AkaDora int
+	UraDora int
+
+	Wait          WaitShape
+	Decomposition Decomposition
+	Score         Score
+}
+
+// HasYaku は役があるか（ドラのみでは和了できない）を返す
+func (a *Agari) HasYaku() bool {
+	return len(a.Yaku) > 0
+}
+
+// EvaluateWin は和了牌を含む手牌（門前の牌は3n+2枚）の役・符・点数を計算する
+// 複数の解釈ができる場合は最も点数が高いものを返す
+// 役がない場合も HasYaku が false の結果を返し、和了形でない場合のみエラーを返す
+func EvaluateWin(hand *Hand, ctx WinContext, rules RuleSet) (*Agari, error) {
+	concealed := hand.ConcealedCounts()
+	if concealed[ctx.WinTile] == 0 {
+		return nil, fmt.Errorf("%w: winning tile %s is not in the hand", entity.ErrInvalidHand, ctx.WinTile)
+	}
+	decompositions := decompose(concealed)
+	if len(decompositions) == 0 {
+		return nil, fmt.Errorf("%w: %s", entity.ErrNotWinningHand, hand)
+	}
+
+	before := concealed
+	before[ctx.WinTile]--
+	all := hand.AllCounts()
+	closed := hand.IsClosed()
+	dealer := ctx.SeatWind == East
+
+	var best *Agari
+	for _, d := range decompositions {
+		for _, shape := range winShapes(d, hand.Melds, ctx, closed) {
+			e := &yakuEvaluator{shape: shape, ctx: ctx, rules: rules, all: all, before: before}
+			agari := &Agari{Yaku: e.evaluateYaku(), Wait: shape.wait, Decomposition: d}
+			for _, y := range agari.Yaku {
+				agari.Han += y.Han
+				agari.Yakuman += y.Yakuman
+			}
+			if agari.Yakuman == 0 {
+				agari.Fu = e.fu(agari.Yaku)
+				if agari.HasYaku() {
+					agari.Dora, agari.AkaDora, agari.UraDora = countDora(all, hand.AkaDora, ctx, rules)
+					agari.Han += agari.Dora + agari.AkaDora + agari.UraDora
+				}
+			}
+			agari.Score = CalculateScore(agari.Han, agari.Fu, agari.Yakuman, dealer, rules)
+			if best == nil || agari.betterThan(best) {
+				best = agari
+			}
+		}
+	}
+	return best, nil
+}
+
+// betterThan は点数の高い解釈かを返す（役のある解釈を優先する）
+func (a *Agari) betterThan(other *Agari) bool {
+	if a.HasYaku() != other.HasYaku() {
+		return a.HasYaku()
+	}
+	if a.Score.Base != other.Score.Base {
+		return a.Score.Base > other.Score.Base
+	}
+	if a.Han != other.Han {
+		return a.Han > other.Han
+	}
+	return a.Fu > other.Fu
+}
+
+// winShapes は分解に対して和了牌が完成させうる面子・雀頭ごとの和了の形を返す
+func winShapes(d Decomposition, melds []Meld, ctx WinContext, closed bool) []winShape {
+	base := winShape{decomposition: d, melds: melds, closed: closed}
+	switch d.Form {
+	case FormChiitoitsu:
+		base.wait = WaitTanki
+		return []winShape{base}
+	case FormKokushi:
+		// 雀頭が和了牌であれば和了前に13種そろっていた十三面待ち
+		base.wait = WaitTanki
+		if d.Pair == ctx.WinTile {
+			base.wait = WaitMultiSided
+		}
+		return []winShape{base}
+	}
+
+	meldSets := make([]Set, 0, len(melds))
+	for _, m := range melds {
+		meldSets = append(meldSets, setFromMeld(m))
+	}
+	withSets := func(wait WaitShape, sets []Set) winShape {
+		s := base
+		s.wait = wait
+		s.sets = append(append([]Set{}, sets...), meldSets...)
+		return s
+	}
+
+	var shapes []winShape
+	if d.Pair == ctx.WinTile {
+		shapes = append(shapes, withSets(WaitTanki, d.Sets))
+	}
+	seen := map[Set]bool{}
+	for i, set := range d.Sets {
+		if !set.Contains(ctx.WinTile) || seen[set] {
+			continue
+		}
+		seen[set] = true
+
+		sets := append([]Set{}, d.Sets...)
+		var wait WaitShape
+		if set.Kind == SetTriplet {
+			wait = WaitShanpon
+			// ロンで完成した刻子は明刻として扱う
+			sets[i].Open = !ctx.Tsumo
+		} else {
+			wait = sequenceWait(set.First, ctx.WinTile)
+		}
+		shapes = append(shapes, withSets(wait, sets))
+	}
+	return shapes
+}
+
+// sequenceWait は順子のどの位置で和了したかから待ちの形を返す
+func sequenceWait(first, win Tile) WaitShape {
+	switch {
+	case win == first+1:
+		return WaitKanchan
+	case win == first && first.Number() == 7:
+		return WaitPenchan
+	case win == first+2 && first.Number() == 1:
+		return WaitPenchan
+	default:
+		return WaitRyanmen
+	}
+}
+
+// countDora はドラ（抜きドラを含む）・赤ドラ・裏ドラの枚数を数える（裏ドラは立直時のみ）
+// 赤ドラはルールセットの枚数までとし、赤ドラのないルールでは数えない
+func countDora(all Counts, akaDora int, ctx WinContext, rules RuleSet) (dora, aka, ura int) {
+	for _, indicator := range ctx.DoraIndicators {
+		dora += all[rules.DoraOf(indicator)]
+	}
+	if rules.NukiDora {
+		dora += ctx.NukiDora
+	}
+	if ctx.Riichi || ctx.DoubleRiichi {
+		for _, indicator := range ctx.UraDoraIndicators {
+			ura += all[rules.DoraOf(indicator)]
+		}
+	}
+	return dora, min(akaDora, rules.AkaDora), ura
+}
@@ -0,0 +1,100 @@
+package mahjong
+
+import "testing"
+
+func TestEvaluateWin(t *testing.T) {
+	tests := []struct {
+		name    string
+		hand    string
+		ctx     WinContext
+		rules   RuleSet
+		han     int
+		fu      int
+		yakuman int
+		aka     int
+	}{
+		{
+			name:  "平和ツモは20符",
+			hand:  "123456m789p234s55p",
+			ctx:   WinContext{WinTile: NewTile(SuitMan, 1), Tsumo: true, SeatWind: South, RoundWind: East},
+			rules: RuleSetTenhou,
+			han:   2, fu: 20,
+		},
+		{
+			name:  "平和ロンは30符",
+			hand:  "123456m789p234s55p",
+			ctx:   WinContext{WinTile: NewTile(SuitMan, 1), Riichi: true, SeatWind: South, RoundWind: East},
+			rules: RuleSetTenhou,
+			han:   2, fu: 30,
+		},
+		{
+			name:  "七対子は25符",
+			hand:  "1133m2266p4488s77z",
+			ctx:   WinContext{WinTile: Red, Tsumo: true, SeatWind: South, RoundWind: East},
+			rules: RuleSetTenhou,
+			han:   3, fu: 25,
+		},
+		{
+			name:    "国士無双",
+			hand:    "19m19p19s12345677z",
+			ctx:     WinContext{WinTile: Red, SeatWind: South, RoundWind: East},
+			rules:   RuleSetTenhou,
+			yakuman: 1,
+		},
+		{
+			name:  "連風牌の雀頭は4符",
+			hand:  "222m333p456789s11z",
+			ctx:   WinContext{WinTile: NewTile(SuitSou, 9), Riichi: true, SeatWind: East, RoundWind: East},
+			rules: RuleSetTenhou,
+			han:   1, fu: 50,
+		},
+		{
+			name:  "連風牌の雀頭は2符",
+			hand:  "222m333p456789s11z",
+			ctx:   WinContext{WinTile: NewTile(SuitSou, 9), Riichi: true, SeatWind: East, RoundWind: East},
+			rules: RuleSetMLeague,
+			han:   1, fu: 40,
+		},
+		{
+			name:  "赤ドラを数える",
+			hand:  "123406m789p234s55p",
+			ctx:   WinContext{WinTile: NewTile(SuitMan, 1), Tsumo: true, SeatWind: South, RoundWind: East},
+			rules: RuleSetTenhou,
+			han:   3, fu: 20, aka: 1,
+		},
+		{
+			name:  "赤ドラのないルールでは数えない",
+			hand:  "123406m789p234s55p",
+			ctx:   WinContext{WinTile: NewTile(SuitMan, 1), Tsumo: true, SeatWind: South, RoundWind: East},
+			rules: RuleSetWRC,
+			han:   2, fu: 20,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agari, err := EvaluateWin(mustHand(t, tt.hand), tt.ctx, tt.rules)
+			if err != nil {
+				t.Fatalf("EvaluateWin: %v", err)
+			}
+			if !agari.HasYaku() {
+				t.Fatalf("EvaluateWin(%s) has no yaku", tt.hand)
+			}
+			if tt.yakuman > 0 {
+				if agari.Yakuman != tt.yakuman {
+					t.Errorf("yakuman = %d, want %d", agari.Yakuman, tt.yakuman)
+				}
+				return
+			}
+			if agari.Han != tt.han || agari.Fu != tt.fu || agari.AkaDora != tt.aka {
+				t.Errorf("EvaluateWin(%s) = %d翻%d符 (aka %d) %v, want %d翻%d符 (aka %d)",
+					tt.hand, agari.Han, agari.Fu, agari.AkaDora, agari.Yaku, tt.han, tt.fu, tt.aka)
+			}
+		})
+	}
+}
+
+func TestEvaluateWinNotWinning(t *testing.T) {
+	if _, err := EvaluateWin(mustHand(t, "123456m789p234s56p"), WinContext{WinTile: NewTile(SuitMan, 1)}, RuleSetTenhou); err == nil {
+		t.Error("EvaluateWin of an incomplete hand succeeded")
+	}
+}
@@ -0,0 +1,93 @@
+package mahjong
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Conclusion は回答の結論（推奨する打牌、打牌がなければ点数）
+type Conclusion struct {
+	// Discard は推奨する打牌（書かれていない場合は -1）
+	Discard Tile
+	// Points は打牌が書かれていない場合に結論として述べている点数（書かれていない場合は0）
+	Points int
+}
+
+var (
+	// discardPatterns は「打5s」「5sを切る」「5s切り」の表現
+	discardPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`打\s*([0-9][mpsz])`),
+		regexp.MustCompile(`([0-9][mpsz])\s*(?:を)?\s*(?:切|捨)`),
+	}
+	// conclusionPointsPattern は「7700点」の表現
+	conclusionPointsPattern = regexp.MustCompile(`([0-9][0-9,]*)\s*点`)
+)
+
+// conclusionMarkers は結論を述べている文の目安
+var conclusionMarkers = []string{"結論", "推奨", "おすすめ", "オススメ", "最善", "ベスト", "正解", "答え"}
+
+// ExtractConclusion は回答の文章から推奨する打牌を取り出し、打牌が書かれていなければ点数を取り出す
+// 結論を述べている文（「結論」「推奨」などを含む文）を優先し、なければ文章全体の最初の表現を使う
+func ExtractConclusion(text string) Conclusion {
+	sentences := strings.FieldsFunc(text, func(r rune) bool {
+		return r == '。' || r == '\n' || r == '！' || r == '？' || r == '!' || r == '?'
+	})
+	var marked []string
+	for _, s := range sentences {
+		if containsAny(s, conclusionMarkers) {
+			marked = append(marked, s)
+		}
+	}
+
+	c := Conclusion{Discard: -1}
+	for _, scope := range [][]string{marked, sentences} {
+		for _, s := range scope {
+			if t := firstDiscard(s); t >= 0 {
+				c.Discard = t
+				return c
+			}
+		}
+	}
+	for _, scope := range [][]string{marked, sentences} {
+		for _, s := range scope {
+			if m := conclusionPointsPattern.FindStringSubmatch(s); m != nil {
+				c.Points = atoi(m[1])
+				return c
+			}
+		}
+	}
+	return c
+}
+
+// firstDiscard は文中で最初に述べている打牌を返す（ない場合は -1）
+func firstDiscard(s string) Tile {
+	best, at := Tile(-1), len(s)
+	for _, p := range discardPatterns {
+		if m := p.FindStringSubmatchIndex(s); m != nil && m[0] < at {
+			t, err := ParseTile(s[m[2]:m[3]])
+			if err != nil {
+				continue
+			}
+			best, at = t, m[0]
+		}
+	}
+	return best
+}
+
+// IsEmpty は結論を取り出せなかったかを返す
+func (c Conclusion) IsEmpty() bool {
+	return c.Discard < 0 && c.Points == 0
+}
+
+// String は結論を「打5s」「7700点」のような形式で返す（取り出せなかった場合は空）
+func (c Conclusion) String() string {
+	switch {
+	case c.Discard >= 0:
+		return "打" + c.Discard.String()
+	case c.Points > 0:
+		return fmt.Sprintf("%d点", c.Points)
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,34 @@
+package mahjong
+
+import "testing"
+
+func TestExtractConclusion(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "打の表記", text: "ここは打5sです。", want: "打5s"},
+		{name: "切るの表記", text: "1mを切りましょう。", want: "打1m"},
+		{name: "切りの表記", text: "7z切りが安全です。", want: "打7z"},
+		{name: "結論の文を優先", text: "打1mも考えられます。しかし結論としては打9pです。", want: "打9p"},
+		{name: "文中で最初の打牌", text: "2pを切るか打5sで迷います。", want: "打2p"},
+		{name: "打牌を点数より優先", text: "推奨は8000点の手を目指す打3mです。", want: "打3m"},
+		{name: "点数", text: "この手は満貫で8000点になります。", want: "8000点"},
+		{name: "桁区切りの点数", text: "答えは12,000点です。", want: "12000点"},
+		{name: "結論の文の点数を優先", text: "子なら7700点。正解は親の11600点です。", want: "11600点"},
+		{name: "結論なし", text: "状況によります。", want: ""},
+		{name: "空", text: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ExtractConclusion(tt.text)
+			if got := c.String(); got != tt.want {
+				t.Errorf("ExtractConclusion(%q) = %q, want %q", tt.text, got, tt.want)
+			}
+			if c.IsEmpty() != (tt.want == "") {
+				t.Errorf("IsEmpty() = %v for %q", c.IsEmpty(), tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package mahjong
+
+// SetKind は面子の種類
+type SetKind int
+
+const (
+	SetSequence SetKind = iota // 順子
+	SetTriplet                 // 刻子（槓子を含む）
+)
+
+// Set は面子
+type Set struct {
+	Kind  SetKind
+	First Tile // 順子の場合は先頭の牌
+	Open  bool // 副露した面子か（ロンで完成した刻子も明刻として扱う）
+	Kan   bool // 槓子か
+}
+
+// Contains は面子が牌を含むかを返す
+func (s Set) Contains(t Tile) bool {
+	if s.Kind == SetTriplet {
+		return s.First == t
+	}
+	return t >= s.First && t <= s.First+2 && t.Suit() == s.First.Suit()
+}
+
+// HasYaochu は面子が么九牌を含むかを返す
+func (s Set) HasYaochu() bool {
+	if s.Kind == SetTriplet {
+		return s.First.IsYaochu()
+	}
+	return s.First.Number() == 1 || s.First.Number() == 7
+}
+
+// setFromMeld は副露を面子に変換する
+func setFromMeld(m Meld) Set {
+	kind := SetTriplet
+	if m.Type == MeldChi {
+		kind = SetSequence
+	}
+	return Set{Kind: kind, First: m.First(), Open: m.IsOpen(), Kan: m.IsKan()}
+}
+
+// HandForm は和了形の種類
+type HandForm int
+
+const (
+	FormStandard   HandForm = iota // 4面子1雀頭
+	FormChiitoitsu                 // 七対子
+	FormKokushi                    // 国士無双
+)
+
+// Decomposition は門前の牌を雀頭と面子に分解した結果
+type Decomposition struct {
+	Form HandForm
+	Pair Tile
+	// Sets は門前の牌から作られる面子（副露は含まない）
+	Sets []Set
+}
+
+// decompose は門前の牌（3n+2枚）の和了形としての分解をすべて返す
+func decompose(c Counts) []Decomposition {
+	var result []Decomposition
+	if c.Total()%3 != 2 {
+		return nil
+	}
+
+	// 4面子1雀頭
+	for pair := Tile(0); pair < NumTileKinds; pair++ {
+		if c[pair] < 2 {
+			continue
+		}
+		c[pair] -= 2
+		for _, sets := range decomposeSets(&c, 0) {
+			result = append(result, Decomposition{Form: FormStandard, Pair: pair, Sets: sets})
+		}
+		c[pair] += 2
+	}
+
+	if c.Total() == 14 {
+		if isChiitoitsu(c) {
+			result = append(result, Decomposition{Form: FormChiitoitsu})
+		}
+		if pair, ok := kokushiPair(c); ok {
+			result = append(result, Decomposition{Form: FormKokushi, Pair: pair})
+		}
+	}
+	return result
+}
+
+// decomposeSets は牌をすべて面子に分解する方法を列挙する
+func decomposeSets(c *Counts, from Tile) [][]Set {
+	t := from
+	for t < NumTileKinds && c[t] == 0 {
+		t++
+	}
+	if t == NumTileKinds {
+		return [][]Set{nil}
+	}
+
+	var result [][]Set
+	if c[t] >= 3 {
+		c[t] -= 3
+		for _, rest := range decomposeSets(c, t) {
+			result = append(result, append([]Set{{Kind: SetTriplet, First: t}}, rest...))
+		}
+		c[t] += 3
+	}
+	if !t.IsHonor() && t.Number() <= 7 && c[t+1] > 0 && c[t+2] > 0 {
+		c[t]--
+		c[t+1]--
+		c[t+2]--
+		for _, rest := range decomposeSets(c, t) {
+			result = append(result, append([]Set{{Kind: SetSequence, First: t}}, rest...))
+		}
+		c[t]++
+		c[t+1]++
+		c[t+2]++
+	}
+	return result
+}
+
+// isChiitoitsu は七対子の形かを返す（同じ牌4枚は2対子として扱わない）
+func isChiitoitsu(c Counts) bool {
+	pairs := 0
+	for _, n := range c {
+		switch n {
+		case 0:
+		case 2:
+			pairs++
+		default:
+			return false
+		}
+	}
+	return pairs == 7
+}
+
+// yaochuTiles は么九牌の一覧
+var yaochuTiles = []Tile{0, 8, 9, 17, 18, 26, 27, 28, 29, 30, 31, 32, 33}
+
+// kokushiPair は国士無双の形であれば雀頭の牌を返す
+func kokushiPair(c Counts) (Tile, bool) {
+	var pair Tile
+	found := false
+	for _, t := range yaochuTiles {
+		switch c[t] {
+		case 1:
+		case 2:
+			if found {
+				return 0, false
+			}
+			pair, found = t, true
+		default:
+			return 0, false
+		}
+	}
+	return pair, found && c.Total() == 14
+}
+
+// IsComplete は門前の牌（3n+2枚）が和了形かを返す
+func IsComplete(c Counts) bool {
+	return len(decompose(c)) > 0
+}
@@ -0,0 +1,163 @@
+package mahjong
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// maxSimulatedCandidates はモンテカルロ法で評価する打牌候補の最大数
+const maxSimulatedCandidates = 6
+
+// DiscardCandidate は打牌候補の評価
+type DiscardCandidate struct {
+	Tile    Tile
+	Shanten int // 打牌後の向聴数
+	Ukeire  Ukeire
+
+	// ImprovementCount は向聴数は進まないが、最良の打牌で受け入れが増える牌（改良牌）の残り枚数
+	ImprovementCount int
+	// GoodShapeRate は1向聴以内のとき、受け入れの牌で聴牌した場合に2種以上の待ちになる割合
+	GoodShapeRate float64
+
+	// Simulated はモンテカルロ法で評価したか
+	Simulated  bool
+	Simulation SimulationResult
+}
+
+// DiscardAnalysis は打牌候補の評価結果（推奨順）
+type DiscardAnalysis struct {
+	// Shanten は打牌前の向聴数（-1 の場合はツモ和了できる）
+	Shanten    int
+	Candidates []DiscardCandidate
+}
+
+// RecommendDiscard は14枚（副露を3枚として数える）の手牌の打牌候補を評価し、推奨順に並べる
+// visible は河・ドラ表示牌など手牌以外で見えている牌、discards は自分の捨て牌（フリテンの判定に使う）
+// 向聴数が最も小さい候補はモンテカルロ法で和了率と打点期待値を見積もる
+func RecommendDiscard(hand *Hand, visible, discards []Tile, winCtx WinContext, opts SimulationOptions, rules RuleSet) (*DiscardAnalysis, error) {
+	return RecommendDiscardContext(context.Background(), hand, visible, discards, winCtx, opts, rules)
+}
+
+// RecommendDiscardContext は RecommendDiscard をキャンセル可能にしたもの
+// ctx がキャンセルされるとモンテカルロ法の評価を打ち切り、ctx のエラーを返す
+func RecommendDiscardContext(ctx context.Context, hand *Hand, visible, discards []Tile, winCtx WinContext, opts SimulationOptions, rules RuleSet) (*DiscardAnalysis, error) {
+	if len(hand.Concealed)%3 != 2 {
+		return nil, fmt.Errorf("%w: discard recommendation requires 14 tiles counting each meld as 3, got %d", entity.ErrInvalidHand, len(hand.Concealed)+3*len(hand.Melds))
+	}
+
+	c := hand.ConcealedCounts()
+	melds := len(hand.Melds)
+	unseen := unseenCounts(hand.AllCounts(), visible, rules)
+	analysis := &DiscardAnalysis{Shanten: Shanten(c, melds)}
+
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if c[t] == 0 {
+			continue
+		}
+		c[t]--
+		candidate := DiscardCandidate{Tile: t, Shanten: Shanten(c, melds)}
+		candidate.Ukeire = CalculateUkeire(c, melds, unseen)
+		candidate.ImprovementCount = improvementCount(c, melds, candidate.Shanten, candidate.Ukeire.Total, unseen)
+		candidate.GoodShapeRate = goodShapeRate(c, melds, candidate.Shanten, candidate.Ukeire, unseen)
+		c[t]++
+		analysis.Candidates = append(analysis.Candidates, candidate)
+	}
+	sortCandidates(analysis.Candidates)
+
+	// 向聴数が最も小さい上位の候補をモンテカルロ法で評価する
+	if opts.Simulations > 0 {
+		best := analysis.Candidates[0].Shanten
+		for i := range analysis.Candidates {
+			candidate := &analysis.Candidates[i]
+			if i >= maxSimulatedCandidates || candidate.Shanten > best {
+				break
+			}
+			c[candidate.Tile]--
+			simulation, err := SimulateHandContext(ctx, SimulationHand{
+				Concealed: c,
+				Melds:     hand.Melds,
+				AkaDora:   hand.AkaDora,
+				Discards:  append(append([]Tile{}, discards...), candidate.Tile),
+			}, unseen, winCtx, opts, rules)
+			c[candidate.Tile]++
+			if err != nil {
+				return nil, err
+			}
+			candidate.Simulation = simulation
+			candidate.Simulated = true
+		}
+		sortCandidates(analysis.Candidates)
+	}
+	return analysis, nil
+}
+
+// sortCandidates は向聴数・打点期待値・受け入れ枚数・改良枚数の順に候補を並べる
+func sortCandidates(candidates []DiscardCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.Shanten != b.Shanten {
+			return a.Shanten < b.Shanten
+		}
+		if a.Simulated && b.Simulated && a.Simulation.ExpectedValue != b.Simulation.ExpectedValue {
+			return a.Simulation.ExpectedValue > b.Simulation.ExpectedValue
+		}
+		if a.Ukeire.Total != b.Ukeire.Total {
+			return a.Ukeire.Total > b.Ukeire.Total
+		}
+		return a.ImprovementCount > b.ImprovementCount
+	})
+}
+
+// improvementCount は向聴数を進めないツモのうち、最良の打牌で受け入れが増える牌の残り枚数を返す
+func improvementCount(c Counts, melds, shanten, ukeire int, unseen Counts) int {
+	if shanten <= 0 {
+		return 0
+	}
+	count := 0
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if unseen[t] == 0 {
+			continue
+		}
+		c[t]++
+		if Shanten(c, melds) == shanten {
+			rest := unseen
+			rest[t]--
+			if bestDiscardUkeire(c, melds, shanten, rest) > ukeire {
+				count += unseen[t]
+			}
+		}
+		c[t]--
+	}
+	return count
+}
+
+// goodShapeRate は1向聴以内のとき、聴牌した場合に2種以上の待ちになる割合を返す
+func goodShapeRate(c Counts, melds, shanten int, ukeire Ukeire, unseen Counts) float64 {
+	switch shanten {
+	case 0:
+		if len(ukeire.Tiles) >= 2 {
+			return 1
+		}
+		return 0
+	case 1:
+		if ukeire.Total == 0 {
+			return 0
+		}
+		good := 0
+		for _, u := range ukeire.Tiles {
+			c[u.Tile]++
+			rest := unseen
+			rest[u.Tile]--
+			if kinds, _ := bestTenpaiWaits(c, melds, rest); kinds >= 2 {
+				good += u.Remaining
+			}
+			c[u.Tile]--
+		}
+		return float64(good) / float64(ukeire.Total)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,67 @@
+package mahjong
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRecommendDiscard(t *testing.T) {
+	tests := []struct {
+		name    string
+		hand    string
+		shanten int
+		best    Tile
+		ukeire  int
+	}{
+		{name: "浮いた牌を切って聴牌", hand: "1239m456p789s23s11z", shanten: 0, best: NewTile(SuitMan, 9), ukeire: 8},
+		{name: "和了形からも打牌を選ぶ", hand: "123456m789p234s55p", shanten: -1, best: NewTile(SuitMan, 1), ukeire: 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := RecommendDiscard(mustHand(t, tt.hand), nil, nil, WinContext{SeatWind: South, RoundWind: East}, SimulationOptions{}, RuleSetTenhou)
+			if err != nil {
+				t.Fatalf("RecommendDiscard: %v", err)
+			}
+			best := analysis.Candidates[0]
+			if analysis.Shanten != tt.shanten || best.Tile != tt.best {
+				t.Errorf("RecommendDiscard(%s) = shanten %d best %s, want shanten %d best %s", tt.hand, analysis.Shanten, best.Tile, tt.shanten, tt.best)
+			}
+			if tt.ukeire > 0 && best.Ukeire.Total != tt.ukeire {
+				t.Errorf("best ukeire = %d, want %d", best.Ukeire.Total, tt.ukeire)
+			}
+			for i := 1; i < len(analysis.Candidates); i++ {
+				if analysis.Candidates[i].Shanten < analysis.Candidates[i-1].Shanten {
+					t.Errorf("candidates are not sorted by shanten: %v", analysis.Candidates)
+				}
+			}
+		})
+	}
+}
+
+func TestRecommendDiscardRequires14Tiles(t *testing.T) {
+	if _, err := RecommendDiscard(mustHand(t, "123m456p789s23s11z"), nil, nil, WinContext{}, SimulationOptions{}, RuleSetTenhou); err == nil {
+		t.Error("RecommendDiscard of 13 tiles succeeded")
+	}
+}
+
+func TestRecommendDiscardContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	hand := mustHand(t, "1239m456p789s23s11z")
+	winCtx := WinContext{SeatWind: South, RoundWind: East}
+
+	// シミュレーションしない場合はキャンセルの影響を受けない
+	analysis, err := RecommendDiscardContext(ctx, hand, nil, nil, winCtx, SimulationOptions{}, RuleSetTenhou)
+	if err != nil {
+		t.Fatalf("RecommendDiscardContext without simulations: %v", err)
+	}
+	if analysis.Candidates[0].Simulated {
+		t.Error("candidate was simulated without simulations")
+	}
+
+	opts := SimulationOptions{Simulations: 100}.Normalize()
+	if _, err := RecommendDiscardContext(ctx, hand, nil, nil, winCtx, opts, RuleSetTenhou); !errors.Is(err, context.Canceled) {
+		t.Errorf("RecommendDiscardContext after cancel = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,64 @@
+package mahjong
+
+// FuritenInput はフリテンの判定に使う情報
+type FuritenInput struct {
+	// Discards は自分の捨て牌（鳴かれた牌を含む）
+	Discards []Tile
+	// PassedSinceLastDiscard は自分の最後の打牌以降に見逃した他家の捨て牌
+	PassedSinceLastDiscard []Tile
+	// Riichi は立直しているか
+	Riichi bool
+	// PassedAfterRiichi は立直後に見逃した他家の捨て牌
+	PassedAfterRiichi []Tile
+}
+
+// Furiten はフリテンの判定結果
+type Furiten struct {
+	// Permanent は捨て牌フリテン（自分の捨て牌に和了牌があり、待ちを変えない限り解消しない）
+	Permanent bool
+	// Temporary は同巡内フリテン（最後の打牌以降に和了牌を見逃した。次の打牌で解消する）
+	Temporary bool
+	// Riichi は立直後フリテン（立直後に和了牌を見逃した。その局の間解消しない）
+	Riichi bool
+	// Tiles はフリテンの原因になった和了牌
+	Tiles []Tile
+}
+
+// IsFuriten はいずれかのフリテンでロン和了できないかを返す
+func (f Furiten) IsFuriten() bool {
+	return f.Permanent || f.Temporary || f.Riichi
+}
+
+// CheckFuriten は和了牌と捨て牌・見逃した牌からフリテンを判定する
+// フリテンは待ちのすべての牌に対して適用され、役の有無には関係しない
+func CheckFuriten(waits []Tile, in FuritenInput) Furiten {
+	waiting := map[Tile]bool{}
+	for _, t := range waits {
+		waiting[t] = true
+	}
+
+	var f Furiten
+	cause := map[Tile]bool{}
+	check := func(tiles []Tile) bool {
+		hit := false
+		for _, t := range tiles {
+			if waiting[t] {
+				hit = true
+				cause[t] = true
+			}
+		}
+		return hit
+	}
+	f.Permanent = check(in.Discards)
+	f.Temporary = check(in.PassedSinceLastDiscard)
+	if in.Riichi {
+		f.Riichi = check(in.PassedAfterRiichi)
+	}
+
+	for _, t := range waits {
+		if cause[t] {
+			f.Tiles = append(f.Tiles, t)
+		}
+	}
+	return f
+}
@@ -0,0 +1,47 @@
+package mahjong
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheckFuriten(t *testing.T) {
+	waits := []Tile{NewTile(SuitSou, 1), NewTile(SuitSou, 4)}
+	tests := []struct {
+		name      string
+		in        FuritenInput
+		permanent bool
+		temporary bool
+		riichi    bool
+		tiles     string
+	}{
+		{name: "フリテンなし", in: FuritenInput{Discards: []Tile{East, NewTile(SuitSou, 2)}}},
+		{name: "捨て牌フリテン", in: FuritenInput{Discards: []Tile{NewTile(SuitSou, 4)}}, permanent: true, tiles: "4s"},
+		{name: "同巡内フリテン", in: FuritenInput{PassedSinceLastDiscard: []Tile{NewTile(SuitSou, 1)}}, temporary: true, tiles: "1s"},
+		{name: "立直後フリテン", in: FuritenInput{Riichi: true, PassedAfterRiichi: []Tile{NewTile(SuitSou, 1)}}, riichi: true, tiles: "1s"},
+		{name: "立直していなければ立直後の見逃しは数えない", in: FuritenInput{PassedAfterRiichi: []Tile{NewTile(SuitSou, 1)}}},
+		{
+			name:      "複合",
+			in:        FuritenInput{Discards: []Tile{NewTile(SuitSou, 4)}, PassedSinceLastDiscard: []Tile{NewTile(SuitSou, 1)}},
+			permanent: true, temporary: true, tiles: "14s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := CheckFuriten(waits, tt.in)
+			if f.Permanent != tt.permanent || f.Temporary != tt.temporary || f.Riichi != tt.riichi {
+				t.Errorf("CheckFuriten = %+v, want permanent %v temporary %v riichi %v", f, tt.permanent, tt.temporary, tt.riichi)
+			}
+			var want []Tile
+			if tt.tiles != "" {
+				want = mustTiles(t, tt.tiles)
+			}
+			if !slices.Equal(f.Tiles, want) {
+				t.Errorf("tiles = %s, want %s", FormatTiles(f.Tiles), tt.tiles)
+			}
+			if f.IsFuriten() != (tt.permanent || tt.temporary || tt.riichi) {
+				t.Errorf("IsFuriten = %v", f.IsFuriten())
+			}
+		})
+	}
+}
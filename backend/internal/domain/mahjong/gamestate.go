@@ -0,0 +1,359 @@
+package mahjong
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// DiscardedTile は河の1枚
+type DiscardedTile struct {
+	Tile      Tile
+	Tsumogiri bool // ツモ切り
+	Called    bool // 他家に鳴かれた
+	Riichi    bool // 立直宣言牌
+}
+
+// PlayerState はプレイヤーの状況
+type PlayerState struct {
+	// Seat は自風
+	Seat  Tile
+	Name  string
+	Score int
+	// Hand は門前の牌（分かる場合のみ。通常は自分のみ）
+	Hand []Tile
+	// AkaDora は Hand と Melds に含まれる赤ドラの枚数
+	AkaDora  int
+	Melds    []Meld
+	Discards []DiscardedTile
+}
+
+// RiichiTurn は立直宣言牌が何枚目の捨て牌かを返す（1始まり、0 は立直していない）
+func (p *PlayerState) RiichiTurn() int {
+	for i, d := range p.Discards {
+		if d.Riichi {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// 他家の聴牌を推測する目安（捨て牌の枚数）
+const (
+	tenpaiTwoMeldTurn = 9
+	tenpaiLateTurn    = 12
+)
+
+// TenpaiSignal は河と副露から聴牌している可能性が高いと推測した根拠を返す（推測できない場合は空）
+// 立直、3副露以上、2副露で中盤以降、終盤の中張牌の手出しを聴牌の目安とする
+func (p *PlayerState) TenpaiSignal() string {
+	open := 0
+	for _, m := range p.Melds {
+		if m.IsOpen() {
+			open++
+		}
+	}
+	turn := len(p.Discards)
+	switch {
+	case p.RiichiTurn() > 0:
+		return "立直"
+	case open >= 3:
+		return fmt.Sprintf("%d副露", open)
+	case open == 2 && turn >= tenpaiTwoMeldTurn:
+		return fmt.Sprintf("2副露で%d巡目", turn)
+	case turn >= tenpaiLateTurn && !p.Discards[turn-1].Tsumogiri && !p.Discards[turn-1].Tile.IsYaochu():
+		return fmt.Sprintf("%d巡目に中張牌（%s）を手出し", turn, p.Discards[turn-1].Tile)
+	}
+	return ""
+}
+
+// DiscardTiles は河の牌を捨てた順に返す
+func (p *PlayerState) DiscardTiles() []Tile {
+	tiles := make([]Tile, 0, len(p.Discards))
+	for _, d := range p.Discards {
+		tiles = append(tiles, d.Tile)
+	}
+	return tiles
+}
+
+// GameState は局の状況
+type GameState struct {
+	RoundWind    Tile
+	Kyoku        int // 局（1始まり）
+	Honba        int
+	RiichiSticks int
+
+	DoraIndicators []Tile
+	Players        []PlayerState
+	// Self は質問者の自風（-1 の場合は観戦者）
+	Self Tile
+	// WallRemaining は山の残り枚数
+	WallRemaining int
+}
+
+// Validate は局の状況が成り立つかを検証する
+func (g *GameState) Validate(rules RuleSet) error {
+	if !g.RoundWind.IsWind() {
+		return fmt.Errorf("%w: round wind %s is not a wind", entity.ErrInvalidGameState, g.RoundWind)
+	}
+	if g.Kyoku < 1 || g.Kyoku > rules.Players {
+		return fmt.Errorf("%w: kyoku %d is out of range (1-%d)", entity.ErrInvalidGameState, g.Kyoku, rules.Players)
+	}
+	if g.Honba < 0 || g.RiichiSticks < 0 || g.WallRemaining < 0 {
+		return fmt.Errorf("%w: honba, riichi sticks and wall remaining must not be negative", entity.ErrInvalidGameState)
+	}
+	if len(g.Players) > rules.Players {
+		return fmt.Errorf("%w: %d players exceed %d for rule set %s", entity.ErrInvalidGameState, len(g.Players), rules.Players, rules.Name)
+	}
+
+	seats := map[Tile]bool{}
+	var all Counts
+	red := 0
+	for _, t := range g.DoraIndicators {
+		all[t]++
+	}
+	for _, p := range g.Players {
+		if !p.Seat.IsWind() || p.Seat-East >= Tile(rules.Players) {
+			return fmt.Errorf("%w: seat %s is not valid for %d players", entity.ErrInvalidGameState, p.Seat, rules.Players)
+		}
+		if seats[p.Seat] {
+			return fmt.Errorf("%w: seat %s appears more than once", entity.ErrInvalidGameState, p.Seat)
+		}
+		seats[p.Seat] = true
+
+		riichi := 0
+		for _, d := range p.Discards {
+			if d.Riichi {
+				riichi++
+			}
+			// 鳴かれた牌は鳴いたプレイヤーの副露として数える
+			if !d.Called {
+				all[d.Tile]++
+			}
+		}
+		if riichi > 1 {
+			return fmt.Errorf("%w: seat %s declared riichi %d times", entity.ErrInvalidGameState, p.Seat, riichi)
+		}
+		if riichi > 0 && hasOpenMeld(p.Melds) {
+			return fmt.Errorf("%w: seat %s declared riichi with an open meld", entity.ErrInvalidGameState, p.Seat)
+		}
+		red += p.AkaDora
+		if len(p.Hand) > 0 {
+			if _, err := NewHand(p.Hand, p.Melds, p.AkaDora); err != nil {
+				return fmt.Errorf("%w: hand of seat %s: %v", entity.ErrInvalidGameState, p.Seat, err)
+			}
+		}
+		for _, t := range p.Hand {
+			all[t]++
+		}
+		for _, m := range p.Melds {
+			for _, t := range m.Tiles {
+				all[t]++
+			}
+		}
+	}
+	for t, n := range all {
+		if n > 4 {
+			return fmt.Errorf("%w: %s appears %d times", entity.ErrInvalidGameState, Tile(t), n)
+		}
+	}
+	if red > rules.AkaDora {
+		return fmt.Errorf("%w: %d red fives exceed %d for rule set %s", entity.ErrInvalidGameState, red, rules.AkaDora, rules.Name)
+	}
+	if g.Self >= 0 && !seats[g.Self] {
+		return fmt.Errorf("%w: self seat %s is not in players", entity.ErrInvalidGameState, g.Self)
+	}
+	return nil
+}
+
+// hasOpenMeld は門前を崩す副露があるかを返す
+func hasOpenMeld(melds []Meld) bool {
+	for _, m := range melds {
+		if m.IsOpen() {
+			return true
+		}
+	}
+	return false
+}
+
+// Player は自風のプレイヤーを返す（いない場合は nil）
+func (g *GameState) Player(seat Tile) *PlayerState {
+	for i := range g.Players {
+		if g.Players[i].Seat == seat {
+			return &g.Players[i]
+		}
+	}
+	return nil
+}
+
+// SelfHand は質問者の手牌を返す（観戦者の場合や手牌が分からない場合は nil）
+func (g *GameState) SelfHand() (*Hand, error) {
+	self := g.Player(g.Self)
+	if self == nil || len(self.Hand) == 0 {
+		return nil, nil
+	}
+	return NewHand(self.Hand, self.Melds, self.AkaDora)
+}
+
+// Opponents は質問者以外のプレイヤーを安全度・押し引きの評価に使う他家として返す
+func (g *GameState) Opponents() []Opponent {
+	var opponents []Opponent
+	for i := range g.Players {
+		p := &g.Players[i]
+		if p.Seat == g.Self {
+			continue
+		}
+		opponents = append(opponents, Opponent{
+			Seat:       p.Seat,
+			Discards:   p.DiscardTiles(),
+			RiichiTurn: p.RiichiTurn(),
+			Score:      p.Score,
+		})
+	}
+	return opponents
+}
+
+// Visible は質問者の手牌と他家の捨て牌以外で見えている牌（自分の捨て牌・他家の副露・ドラ表示牌）を返す
+// AssessSafety・EvaluatePushFold の visible に対応し、他家の捨て牌は Opponents の側で数える
+func (g *GameState) Visible() []Tile {
+	var c Counts
+	for _, t := range g.DoraIndicators {
+		c[t]++
+	}
+	for i := range g.Players {
+		p := &g.Players[i]
+		if p.Seat == g.Self {
+			for _, d := range p.Discards {
+				if !d.Called {
+					c[d.Tile]++
+				}
+			}
+			continue
+		}
+		for _, m := range p.Melds {
+			for _, t := range m.Tiles {
+				c[t]++
+			}
+		}
+	}
+	// 他家の捨て牌から鳴かれた牌は Opponents の捨て牌と副露の両方に含まれるため、副露の側から除く
+	for _, o := range g.Opponents() {
+		p := g.Player(o.Seat)
+		for _, d := range p.Discards {
+			if d.Called && c[d.Tile] > 0 {
+				c[d.Tile]--
+			}
+		}
+	}
+	return c.Tiles()
+}
+
+// Seen は質問者の手牌以外で見えている牌をすべて返す（AnalyzeWaits・RecommendDiscard の visible に対応）
+func (g *GameState) Seen() []Tile {
+	seen := g.Visible()
+	for _, o := range g.Opponents() {
+		seen = append(seen, o.Discards...)
+	}
+	return seen
+}
+
+// WinContext は質問者の和了の評価に使う状況を返す
+func (g *GameState) WinContext() WinContext {
+	ctx := WinContext{
+		SeatWind:       g.Self,
+		RoundWind:      g.RoundWind,
+		DoraIndicators: g.DoraIndicators,
+	}
+	if self := g.Player(g.Self); self != nil {
+		ctx.Riichi = self.RiichiTurn() > 0
+	}
+	return ctx
+}
+
+// ScoreSituation は質問者の持ち点と供託・積み棒を返す
+func (g *GameState) ScoreSituation() ScoreSituation {
+	s := ScoreSituation{RiichiSticks: g.RiichiSticks, Honba: g.Honba}
+	if self := g.Player(g.Self); self != nil {
+		s.OwnScore = self.Score
+	}
+	return s
+}
+
+// discardLegend は Render の河の表記の説明
+const discardLegend = "河の表記: ' はツモ切り、^ は他家に鳴かれた牌、! は立直宣言牌"
+
+// Render は局の状況をプロンプトに含める決まった書式の文字列にする
+// 同じ局面は常に同じ文字列になるよう、プレイヤーは東家から自風の順に並べる
+func (g *GameState) Render() string {
+	var b strings.Builder
+	b.WriteString("【局面】\n")
+	fmt.Fprintf(&b, "%s%d局 %d本場 供託%d本", windName(g.RoundWind), g.Kyoku, g.Honba, g.RiichiSticks)
+	if g.WallRemaining > 0 {
+		fmt.Fprintf(&b, " 残り山%d枚", g.WallRemaining)
+	}
+	b.WriteString("\n")
+	if len(g.DoraIndicators) > 0 {
+		fmt.Fprintf(&b, "ドラ表示牌: %s\n", strings.Join(tileStrings(g.DoraIndicators), " "))
+	}
+
+	for seat := East; seat <= North; seat++ {
+		p := g.Player(seat)
+		if p == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%s家", windName(seat))
+		if p.Name != "" {
+			fmt.Fprintf(&b, " %s", p.Name)
+		}
+		if seat == g.Self {
+			b.WriteString("（自分）")
+		}
+		fmt.Fprintf(&b, " %d点\n", p.Score)
+		if len(p.Hand) > 0 {
+			fmt.Fprintf(&b, "  手牌: %s", FormatTiles(p.Hand))
+			if p.AkaDora > 0 {
+				fmt.Fprintf(&b, "（赤ドラ%d枚）", p.AkaDora)
+			}
+			b.WriteString("\n")
+		}
+		if len(p.Melds) > 0 {
+			melds := make([]string, 0, len(p.Melds))
+			for _, m := range p.Melds {
+				melds = append(melds, m.String())
+			}
+			fmt.Fprintf(&b, "  副露: %s\n", strings.Join(melds, " "))
+		}
+		if len(p.Discards) > 0 {
+			discards := make([]string, 0, len(p.Discards))
+			for _, d := range p.Discards {
+				s := d.Tile.String()
+				if d.Tsumogiri {
+					s += "'"
+				}
+				if d.Called {
+					s += "^"
+				}
+				if d.Riichi {
+					s += "!"
+				}
+				discards = append(discards, s)
+			}
+			fmt.Fprintf(&b, "  河: %s\n", strings.Join(discards, " "))
+		}
+		if turn := p.RiichiTurn(); turn > 0 {
+			fmt.Fprintf(&b, "  立直: %d巡目\n", turn)
+		}
+	}
+	b.WriteString(discardLegend)
+	return b.String()
+}
+
+// tileStrings は牌を1枚ずつのMPSZ表記にする
+func tileStrings(tiles []Tile) []string {
+	result := make([]string, 0, len(tiles))
+	for _, t := range tiles {
+		result = append(result, t.String())
+	}
+	return result
+}
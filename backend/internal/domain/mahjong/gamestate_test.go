@@ -0,0 +1,103 @@
+package mahjong
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// testGameState は東1局で北家が立直している局面を返す
+func testGameState(t *testing.T) *GameState {
+	t.Helper()
+	pon, err := NewMeld(MeldPon, mustTiles(t, "777z"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &GameState{
+		RoundWind:      East,
+		Kyoku:          1,
+		DoraIndicators: mustTiles(t, "3p"),
+		Players: []PlayerState{
+			{Seat: East, Score: 25000, Hand: mustTiles(t, "123m456p789s1122z"), AkaDora: 1},
+			{Seat: South, Score: 25000, Melds: []Meld{pon}, Discards: []DiscardedTile{{Tile: NewTile(SuitMan, 9)}}},
+			{Seat: West, Score: 25000, Discards: []DiscardedTile{{Tile: NewTile(SuitPin, 1), Tsumogiri: true, Called: true}}},
+			{Seat: North, Score: 24000, Discards: []DiscardedTile{{Tile: NewTile(SuitSou, 9), Riichi: true}}},
+		},
+		Self:          East,
+		WallRemaining: 60,
+	}
+}
+
+func TestGameStateValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(g *GameState)
+		rules  RuleSet
+		valid  bool
+	}{
+		{name: "妥当な局面", modify: func(g *GameState) {}, rules: RuleSetTenhou, valid: true},
+		{name: "観戦者", modify: func(g *GameState) { g.Self = -1 }, rules: RuleSetTenhou, valid: true},
+		{name: "場風が風牌でない", modify: func(g *GameState) { g.RoundWind = NewTile(SuitMan, 1) }, rules: RuleSetTenhou},
+		{name: "局が範囲外", modify: func(g *GameState) { g.Kyoku = 5 }, rules: RuleSetTenhou},
+		{name: "負の本場", modify: func(g *GameState) { g.Honba = -1 }, rules: RuleSetTenhou},
+		{name: "同じ席が2人", modify: func(g *GameState) { g.Players[1].Seat = East }, rules: RuleSetTenhou},
+		{name: "三人麻雀", modify: func(g *GameState) { g.Players = g.Players[:3] }, rules: RuleSetSanma, valid: true},
+		{name: "三人麻雀に北家", modify: func(g *GameState) { g.Players = []PlayerState{g.Players[0], g.Players[1], g.Players[3]} }, rules: RuleSetSanma},
+		{name: "三人麻雀に4人", modify: func(g *GameState) {}, rules: RuleSetSanma},
+		{name: "副露して立直", modify: func(g *GameState) { g.Players[1].Discards[0].Riichi = true }, rules: RuleSetTenhou},
+		{name: "2回の立直", modify: func(g *GameState) {
+			g.Players[3].Discards = append(g.Players[3].Discards, DiscardedTile{Tile: NewTile(SuitSou, 8), Riichi: true})
+		}, rules: RuleSetTenhou},
+		{name: "5枚目の牌", modify: func(g *GameState) { g.DoraIndicators = mustTiles(t, "1111z") }, rules: RuleSetTenhou},
+		{name: "赤ドラのないルールの赤5", modify: func(g *GameState) {}, rules: RuleSetWRC},
+		{name: "自分がいない", modify: func(g *GameState) { g.Players = g.Players[1:] }, rules: RuleSetTenhou},
+		{name: "手牌の枚数が不正", modify: func(g *GameState) { g.Players[0].Hand = g.Players[0].Hand[1:] }, rules: RuleSetTenhou},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := testGameState(t)
+			tt.modify(g)
+			err := g.Validate(tt.rules)
+			if tt.valid {
+				if err != nil {
+					t.Fatalf("Validate() = %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, entity.ErrInvalidGameState) {
+				t.Errorf("Validate() = %v, want ErrInvalidGameState", err)
+			}
+		})
+	}
+}
+
+func TestGameStateRender(t *testing.T) {
+	g := testGameState(t)
+	// プレイヤーの並び順によらず同じ文字列になる
+	shuffled := testGameState(t)
+	shuffled.Players[0], shuffled.Players[3] = shuffled.Players[3], shuffled.Players[0]
+	got := g.Render()
+	if other := shuffled.Render(); got != other {
+		t.Errorf("Render() depends on the order of players:\n%s\n---\n%s", got, other)
+	}
+
+	for _, want := range []string{
+		"東1局 0本場 供託0本 残り山60枚",
+		"ドラ表示牌: 3p",
+		"東家（自分） 25000点",
+		"手牌: 123m456p789s1122z（赤ドラ1枚）",
+		"河: 1p'^",
+		"河: 9s!",
+		"立直: 1巡目",
+		discardLegend,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Render() does not contain %q:\n%s", want, got)
+		}
+	}
+	if strings.Index(got, "東家") > strings.Index(got, "北家") {
+		t.Errorf("Render() does not list players from East:\n%s", got)
+	}
+}
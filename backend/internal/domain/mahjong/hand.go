@@ -0,0 +1,165 @@
+package mahjong
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// MeldType は副露の種類
+type MeldType int
+
+const (
+	MeldChi    MeldType = iota // チー
+	MeldPon                    // ポン
+	MeldMinkan                 // 大明槓
+	MeldAnkan                  // 暗槓
+	MeldKakan                  // 加槓
+)
+
+// String は副露の種類の名前を返す
+func (m MeldType) String() string {
+	switch m {
+	case MeldChi:
+		return "チー"
+	case MeldPon:
+		return "ポン"
+	case MeldMinkan:
+		return "明槓"
+	case MeldAnkan:
+		return "暗槓"
+	case MeldKakan:
+		return "加槓"
+	default:
+		return "?"
+	}
+}
+
+// meldTypeNames は副露の種類の英語名（MeldType の順）
+var meldTypeNames = []string{"chi", "pon", "minkan", "ankan", "kakan"}
+
+// ParseMeldType は英語名（chi / pon / minkan / ankan / kakan）から副露の種類を返す
+func ParseMeldType(name string) (MeldType, bool) {
+	for i, n := range meldTypeNames {
+		if n == name {
+			return MeldType(i), true
+		}
+	}
+	return -1, false
+}
+
+// Meld は副露（暗槓を含む）
+type Meld struct {
+	Type  MeldType
+	Tiles []Tile
+}
+
+// NewMeld は副露を作成し、牌の組み合わせが種類と一致するかを検証する
+func NewMeld(meldType MeldType, tiles []Tile) (Meld, error) {
+	sorted := CountTiles(tiles).Tiles()
+	m := Meld{Type: meldType, Tiles: sorted}
+	valid := false
+	switch meldType {
+	case MeldChi:
+		valid = len(sorted) == 3 && !sorted[0].IsHonor() &&
+			sorted[0].Suit() == sorted[2].Suit() && sorted[1] == sorted[0]+1 && sorted[2] == sorted[0]+2
+	case MeldPon:
+		valid = len(sorted) == 3 && sorted[0] == sorted[2]
+	case MeldMinkan, MeldAnkan, MeldKakan:
+		valid = len(sorted) == 4 && sorted[0] == sorted[3]
+	}
+	if !valid {
+		return Meld{}, fmt.Errorf("%w: %s is not a valid %s", entity.ErrInvalidHand, FormatTiles(tiles), meldType)
+	}
+	return m, nil
+}
+
+// IsOpen は門前を崩す副露かを返す（暗槓は門前扱い）
+func (m Meld) IsOpen() bool {
+	return m.Type != MeldAnkan
+}
+
+// IsKan は槓子かを返す
+func (m Meld) IsKan() bool {
+	return m.Type == MeldMinkan || m.Type == MeldAnkan || m.Type == MeldKakan
+}
+
+// First は副露の先頭（最小）の牌を返す
+func (m Meld) First() Tile {
+	return m.Tiles[0]
+}
+
+// String は副露を表記（例: ポン555z）で返す
+func (m Meld) String() string {
+	return m.Type.String() + FormatTiles(m.Tiles)
+}
+
+// Hand は手牌（門前の牌と副露）
+type Hand struct {
+	Concealed []Tile
+	Melds     []Meld
+	// AkaDora は手牌・副露に含まれる赤ドラの枚数
+	AkaDora int
+}
+
+// NewHand は手牌を作成し、枚数を検証する
+// 門前の牌は副露1つにつき3枚少ない13枚（ツモ後は14枚）でなければならない
+func NewHand(concealed []Tile, melds []Meld, akaDora int) (*Hand, error) {
+	h := &Hand{Concealed: concealed, Melds: melds, AkaDora: akaDora}
+	size := len(concealed) + 3*len(melds)
+	if size != 13 && size != 14 {
+		return nil, fmt.Errorf("%w: hand has %d tiles (expected 13 or 14 counting each meld as 3)", entity.ErrInvalidHand, size)
+	}
+	counts := h.AllCounts()
+	for t, n := range counts {
+		if n > 4 {
+			return nil, fmt.Errorf("%w: %s appears %d times", entity.ErrInvalidHand, Tile(t), n)
+		}
+	}
+	return h, nil
+}
+
+// ParseHand はMPSZ表記の門前の牌と副露から手牌を作成する
+func ParseHand(concealed string, melds []Meld) (*Hand, error) {
+	tiles, red, err := ParseTiles(concealed)
+	if err != nil {
+		return nil, err
+	}
+	return NewHand(tiles, melds, red)
+}
+
+// IsClosed は門前（暗槓のみを含む）かを返す
+func (h *Hand) IsClosed() bool {
+	for _, m := range h.Melds {
+		if m.IsOpen() {
+			return false
+		}
+	}
+	return true
+}
+
+// ConcealedCounts は門前の牌の枚数を返す
+func (h *Hand) ConcealedCounts() Counts {
+	return CountTiles(h.Concealed)
+}
+
+// AllCounts は副露を含むすべての牌の枚数を返す
+func (h *Hand) AllCounts() Counts {
+	c := CountTiles(h.Concealed)
+	for _, m := range h.Melds {
+		for _, t := range m.Tiles {
+			c[t]++
+		}
+	}
+	return c
+}
+
+// String は手牌を表記（例: 123m456p11z ポン555z）で返す
+func (h *Hand) String() string {
+	parts := []string{FormatTiles(h.Concealed)}
+	for _, m := range h.Melds {
+		parts = append(parts, m.String())
+	}
+	return strings.Join(parts, " ")
+}
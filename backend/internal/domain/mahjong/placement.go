@@ -0,0 +1,203 @@
+package mahjong
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// placementFu は必要な和了を探す符の候補（実戦で現れやすい70符まで、同じ点数では先の符で表す）
+var placementFu = []int{30, 40, 50, 60, 70, 25, 20}
+
+// placementLimitHan は満貫以上の区分ごとの翻数
+var placementLimitHan = []int{5, 6, 8, 11}
+
+// PlacementInput はオーラスの点数状況
+type PlacementInput struct {
+	// Scores は持ち点（起家からの席順）
+	Scores []int
+	// Dealer は親の席（起家からの席順）
+	Dealer       int
+	Honba        int
+	RiichiSticks int
+}
+
+// PlacementStanding はプレイヤーの現在の順位
+type PlacementStanding struct {
+	Player int
+	Score  int
+	// Rank は順位（1始まり、同点の場合は起家に近い方が上位）
+	Rank int
+	// FinalPoints はこのまま終局した場合のウマ・オカを含む最終ポイント（千点単位）
+	FinalPoints float64
+}
+
+// PlacementRequirement は目標の順位に届く最小の和了
+type PlacementRequirement struct {
+	Player int
+	// Target は目標の順位（1始まり）
+	Target int
+	// From はロンの放銃者（ツモの場合は -1）
+	From int
+	// Possible は役満以下の和了で届くか
+	Possible bool
+	// Score は必要な最小の和了（Possible が false の場合はゼロ値）
+	Score Score
+	// Gain は和了で受け取る点数（積み棒・供託を含む）
+	Gain int
+}
+
+// Tsumo はツモ和了の条件かを返す
+func (r PlacementRequirement) Tsumo() bool {
+	return r.From < 0
+}
+
+// Describe は必要な和了を「30符2翻 ロン2000点」「満貫 ツモ2000-4000」のような形式で返す
+func (r PlacementRequirement) Describe() string {
+	if !r.Possible {
+		return "役満でも届かない"
+	}
+	s := r.Score
+	switch {
+	case !r.Tsumo():
+		return fmt.Sprintf("%s ロン%d点", s.Name(), s.Ron)
+	case s.Dealer:
+		return fmt.Sprintf("%s ツモ%dオール", s.Name(), s.TsumoFromNonDealer)
+	default:
+		return fmt.Sprintf("%s ツモ%d-%d", s.Name(), s.TsumoFromNonDealer, s.TsumoFromDealer)
+	}
+}
+
+// PlacementAnalysis はオーラスの点数状況の計算結果
+type PlacementAnalysis struct {
+	// Standings は起家からの席順の現在の順位
+	Standings []PlacementStanding
+	// Requirements はプレイヤーごとに、現在より上の順位に届く和了を目標の順位・放銃者の順に並べたもの
+	Requirements []PlacementRequirement
+}
+
+// AnalyzePlacement はオーラスで各プレイヤーが各順位に届くために必要な和了を、
+// 他家それぞれからのロンとツモについて計算する
+// 積み棒・供託は和了者が受け取り、流局や複数人の和了は考慮しない
+func AnalyzePlacement(input PlacementInput, rules RuleSet) (*PlacementAnalysis, error) {
+	players := rules.Players
+	if len(input.Scores) != players {
+		return nil, fmt.Errorf("%w: %d scores for %d players", entity.ErrInvalidGameState, len(input.Scores), players)
+	}
+	if input.Dealer < 0 || input.Dealer >= players {
+		return nil, fmt.Errorf("%w: dealer %d is out of range (0-%d)", entity.ErrInvalidGameState, input.Dealer, players-1)
+	}
+	if input.Honba < 0 || input.RiichiSticks < 0 {
+		return nil, fmt.Errorf("%w: honba and riichi sticks must not be negative", entity.ErrInvalidGameState)
+	}
+
+	analysis := &PlacementAnalysis{}
+	oka := float64((rules.ReturnPoints-rules.StartingPoints)*players) / 1000
+	for p, score := range input.Scores {
+		rank := placementRank(input.Scores, p)
+		points := float64(score-rules.ReturnPoints)/1000 + float64(rules.Uma[rank-1])
+		if rank == 1 {
+			points += oka
+		}
+		analysis.Standings = append(analysis.Standings, PlacementStanding{Player: p, Score: score, Rank: rank, FinalPoints: points})
+	}
+
+	for p := range input.Scores {
+		dealer := p == input.Dealer
+		ron := placementCandidates(dealer, false, rules)
+		tsumo := placementCandidates(dealer, true, rules)
+		for target := 1; target < analysis.Standings[p].Rank; target++ {
+			for from := 0; from < players; from++ {
+				if from != p {
+					analysis.Requirements = append(analysis.Requirements, requirePlacement(input, p, from, target, ron, rules))
+				}
+			}
+			analysis.Requirements = append(analysis.Requirements, requirePlacement(input, p, -1, target, tsumo, rules))
+		}
+	}
+	return analysis, nil
+}
+
+// requirePlacement は点数の低い順に和了を試し、目標の順位に届く最初の和了を返す
+func requirePlacement(input PlacementInput, player, from, target int, candidates []Score, rules RuleSet) PlacementRequirement {
+	req := PlacementRequirement{Player: player, Target: target, From: from}
+	for _, s := range candidates {
+		after := settleWin(input, player, from, s, rules)
+		if placementRank(after, player) <= target {
+			req.Possible = true
+			req.Score = s
+			req.Gain = after[player] - input.Scores[player]
+			return req
+		}
+	}
+	return req
+}
+
+// settleWin は和了の点数の移動を反映した持ち点を返す（from が -1 の場合はツモ）
+func settleWin(input PlacementInput, winner, from int, s Score, rules RuleSet) []int {
+	after := append([]int{}, input.Scores...)
+	after[winner] += input.RiichiSticks * rules.RiichiDeposit
+	if from >= 0 {
+		pay := s.Ron + input.Honba*rules.HonbaPoints
+		after[from] -= pay
+		after[winner] += pay
+		return after
+	}
+	for q := range after {
+		if q == winner {
+			continue
+		}
+		pay := s.TsumoFromNonDealer
+		if q == input.Dealer {
+			pay = s.TsumoFromDealer
+		}
+		pay += input.Honba * rules.HonbaPoints / (rules.Players - 1)
+		after[q] -= pay
+		after[winner] += pay
+	}
+	return after
+}
+
+// placementRank は順位を返す（1始まり、同点の場合は起家に近い方が上位）
+func placementRank(scores []int, player int) int {
+	rank := 1
+	for q, s := range scores {
+		if s > scores[player] || (s == scores[player] && q < player) {
+			rank++
+		}
+	}
+	return rank
+}
+
+// placementCandidates は和了として成り立つ翻・符の点数を基本点の低い順に返す
+// 同じ基本点では placementFu の先の符の組み合わせだけを残す（20符はツモの平和、25符は七対子として2翻以上とする）
+func placementCandidates(dealer, tsumo bool, rules RuleSet) []Score {
+	var scores []Score
+	for _, fu := range placementFu {
+		for han := 1; han <= 4; han++ {
+			if (fu == 20 && (!tsumo || han < 2)) || (fu == 25 && han < 2) {
+				continue
+			}
+			// 満貫以上は区分ごとの翻数で表す
+			if score := CalculateScore(han, fu, 0, dealer, rules); score.Limit == LimitNone {
+				scores = append(scores, score)
+			}
+		}
+	}
+	for _, han := range placementLimitHan {
+		scores = append(scores, CalculateScore(han, 30, 0, dealer, rules))
+	}
+	scores = append(scores, CalculateScore(0, 0, 1, dealer, rules))
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Base < scores[j].Base
+	})
+	unique := scores[:0]
+	for _, s := range scores {
+		if len(unique) == 0 || unique[len(unique)-1].Base != s.Base {
+			unique = append(unique, s)
+		}
+	}
+	return unique
+}
@@ -0,0 +1,190 @@
+package mahjong
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+func TestAnalyzePlacementStandings(t *testing.T) {
+	// 天鳳: 25000点持ち30000点返し、ウマ 20-10、オカ 20
+	analysis, err := AnalyzePlacement(PlacementInput{Scores: []int{32000, 25000, 25000, 18000}, Dealer: 3}, RuleSetTenhou)
+	if err != nil {
+		t.Fatalf("AnalyzePlacement: %v", err)
+	}
+	want := []PlacementStanding{
+		{Player: 0, Score: 32000, Rank: 1, FinalPoints: 2 + 20 + 20},
+		{Player: 1, Score: 25000, Rank: 2, FinalPoints: -5 + 10},
+		{Player: 2, Score: 25000, Rank: 3, FinalPoints: -5 - 10}, // 同点は起家に近い方が上位
+		{Player: 3, Score: 18000, Rank: 4, FinalPoints: -12 - 20},
+	}
+	for i, got := range analysis.Standings {
+		if got.Player != want[i].Player || got.Score != want[i].Score || got.Rank != want[i].Rank || math.Abs(got.FinalPoints-want[i].FinalPoints) > 1e-9 {
+			t.Errorf("Standings[%d] = %+v, want %+v", i, got, want[i])
+		}
+	}
+}
+
+func TestAnalyzePlacementFinalPointsSumToZero(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []int
+		rules  RuleSet
+	}{
+		{name: "天鳳", scores: []int{41000, 30000, 19000, 10000}, rules: RuleSetTenhou},
+		{name: "Mリーグ", scores: []int{25000, 25000, 25000, 25000}, rules: RuleSetMLeague},
+		{name: "WRC（オカなし）", scores: []int{52000, 38000, 20000, 10000}, rules: RuleSetWRC},
+		{name: "三人麻雀", scores: []int{50000, 35000, 20000}, rules: RuleSetSanma},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := AnalyzePlacement(PlacementInput{Scores: tt.scores}, tt.rules)
+			if err != nil {
+				t.Fatalf("AnalyzePlacement: %v", err)
+			}
+			// 供託がなければウマ・オカを含めた最終ポイントの合計は0になる
+			sum := 0.0
+			for _, s := range analysis.Standings {
+				sum += s.FinalPoints
+			}
+			if math.Abs(sum) > 1e-9 {
+				t.Errorf("sum of final points = %g, want 0 (%+v)", sum, analysis.Standings)
+			}
+		})
+	}
+}
+
+func TestAnalyzePlacementRequirements(t *testing.T) {
+	tests := []struct {
+		name  string
+		input PlacementInput
+		rules RuleSet
+	}{
+		{name: "平場", input: PlacementInput{Scores: []int{32000, 25000, 25000, 18000}, Dealer: 3}, rules: RuleSetTenhou},
+		{name: "積み棒と供託", input: PlacementInput{Scores: []int{30000, 27000, 23000, 18000}, Dealer: 0, Honba: 2, RiichiSticks: 2}, rules: RuleSetTenhou},
+		{name: "切り上げ満貫", input: PlacementInput{Scores: []int{35000, 31000, 29000, 25000}, Dealer: 1}, rules: RuleSetWRC},
+		{name: "三人麻雀", input: PlacementInput{Scores: []int{45000, 35000, 24000}, Dealer: 2, Honba: 1}, rules: RuleSetSanma},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := AnalyzePlacement(tt.input, tt.rules)
+			if err != nil {
+				t.Fatalf("AnalyzePlacement: %v", err)
+			}
+			for _, req := range analysis.Requirements {
+				candidates := placementCandidates(req.Player == tt.input.Dealer, req.Tsumo(), tt.rules)
+				if !req.Possible {
+					t.Errorf("player %d cannot reach rank %d from %d", req.Player, req.Target, req.From)
+					continue
+				}
+				after := settleWin(tt.input, req.Player, req.From, req.Score, tt.rules)
+				if rank := placementRank(after, req.Player); rank > req.Target {
+					t.Errorf("%+v reaches rank %d, want %d", req, rank, req.Target)
+				}
+				if gain := after[req.Player] - tt.input.Scores[req.Player]; gain != req.Gain {
+					t.Errorf("Gain = %d, want %d", req.Gain, gain)
+				}
+				// 必要な和了より安い和了では届かない
+				for _, s := range candidates {
+					if s.Base >= req.Score.Base {
+						break
+					}
+					if rank := placementRank(settleWin(tt.input, req.Player, req.From, s, tt.rules), req.Player); rank <= req.Target {
+						t.Errorf("player %d reaches rank %d from %d with %s, cheaper than %s", req.Player, req.Target, req.From, s.Name(), req.Score.Name())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzePlacementExample(t *testing.T) {
+	input := PlacementInput{Scores: []int{32000, 25000, 25000, 18000}, Dealer: 0, Honba: 1, RiichiSticks: 1}
+	analysis, err := AnalyzePlacement(input, RuleSetTenhou)
+	if err != nil {
+		t.Fatalf("AnalyzePlacement: %v", err)
+	}
+	find := func(player, target, from int) PlacementRequirement {
+		t.Helper()
+		for _, req := range analysis.Requirements {
+			if req.Player == player && req.Target == target && req.From == from {
+				return req
+			}
+		}
+		t.Fatalf("no requirement for player %d to rank %d from %d", player, target, from)
+		return PlacementRequirement{}
+	}
+
+	// 北家が西家から直撃で3着: 18000+1000+300+x > 25000-300-x で x > 2700 となり、3200点（50符2翻）
+	if got := find(3, 3, 2); got.Score.Ron != 3200 || got.Gain != 4500 || got.Describe() != "50符2翻 ロン3200点" {
+		t.Errorf("ron from player 2 = %s (gain %d), want 50符2翻 ロン3200点 (gain 4500)", got.Describe(), got.Gain)
+	}
+	// 4着からでもツモでトップに届く
+	if got := find(3, 1, -1); !got.Possible || !got.Tsumo() {
+		t.Errorf("tsumo to first = %+v, want a possible tsumo", got)
+	}
+	if n := len(analysis.Requirements); n != (0+1+2+3)*4 {
+		t.Errorf("%d requirements, want one per higher rank and winning source", n)
+	}
+
+	// 役満でも届かない順位
+	far, err := AnalyzePlacement(PlacementInput{Scores: []int{90000, 5000, 3000, 2000}}, RuleSetTenhou)
+	if err != nil {
+		t.Fatalf("AnalyzePlacement: %v", err)
+	}
+	for _, req := range far.Requirements {
+		if req.Player == 3 && req.Target == 1 && req.Possible {
+			t.Errorf("player 3 reaches first with %s, want impossible", req.Describe())
+		}
+		if req.Player == 3 && req.Target == 1 && req.Describe() != "役満でも届かない" {
+			t.Errorf("Describe() = %q", req.Describe())
+		}
+	}
+}
+
+func TestSettleWinConservesPoints(t *testing.T) {
+	input := PlacementInput{Scores: []int{30000, 27000, 23000, 18000}, Dealer: 1, Honba: 3, RiichiSticks: 2}
+	total := func(scores []int) int {
+		sum := 0
+		for _, s := range scores {
+			sum += s
+		}
+		return sum
+	}
+	for _, tsumo := range []bool{false, true} {
+		for _, s := range placementCandidates(false, tsumo, RuleSetTenhou) {
+			from := 0
+			if tsumo {
+				from = -1
+			}
+			after := settleWin(input, 3, from, s, RuleSetTenhou)
+			// 場の供託だけが増える
+			if got, want := total(after), total(input.Scores)+input.RiichiSticks*RuleSetTenhou.RiichiDeposit; got != want {
+				t.Errorf("total after %s (tsumo %v) = %d, want %d", s.Name(), tsumo, got, want)
+			}
+		}
+	}
+}
+
+func TestAnalyzePlacementInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input PlacementInput
+		rules RuleSet
+	}{
+		{name: "持ち点の数が人数と違う", input: PlacementInput{Scores: []int{25000, 25000, 25000}}, rules: RuleSetTenhou},
+		{name: "三人麻雀に4人分の持ち点", input: PlacementInput{Scores: []int{25000, 25000, 25000, 25000}}, rules: RuleSetSanma},
+		{name: "親が範囲外", input: PlacementInput{Scores: []int{25000, 25000, 25000, 25000}, Dealer: 4}, rules: RuleSetTenhou},
+		{name: "負の本場", input: PlacementInput{Scores: []int{25000, 25000, 25000, 25000}, Honba: -1}, rules: RuleSetTenhou},
+		{name: "負の供託", input: PlacementInput{Scores: []int{25000, 25000, 25000, 25000}, RiichiSticks: -1}, rules: RuleSetTenhou},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AnalyzePlacement(tt.input, tt.rules); !errors.Is(err, entity.ErrInvalidGameState) {
+				t.Errorf("AnalyzePlacement() error = %v, want ErrInvalidGameState", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,449 @@
+package mahjong
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// PushFoldAction は押し引きの選択肢
+type PushFoldAction int
+
+const (
+	ActionPush    PushFoldAction = iota // 押し（牌効率を優先して打牌し、以降も危険牌を押す）
+	ActionMawashi                       // 回し（向聴数を大きく落とさない範囲で安全寄りの牌を切る）
+	ActionFold                          // 降り（安全な牌から順に切り、和了を目指さない）
+)
+
+// String は選択肢の名前を返す
+func (a PushFoldAction) String() string {
+	switch a {
+	case ActionPush:
+		return "押し"
+	case ActionMawashi:
+		return "回し"
+	default:
+		return "降り"
+	}
+}
+
+// 押し引きのモデルの定数（牌譜統計に基づく目安）
+const (
+	// lastTurn は流局までの自分の打牌の最大回数
+	lastTurn = 18
+	// riichiWinPerTurn は立直者が自分以外から1巡あたりに和了する確率
+	riichiWinPerTurn = 0.06
+	// ronMultiplier は聴牌時の1巡あたりの和了率をツモのみの場合の何倍とするか（他家からのロンを含める）
+	ronMultiplier = 2.0
+	// defaultWaits は聴牌前の手牌が聴牌したときに想定する待ちの残り枚数
+	defaultWaits = 6
+	// riichiLoss・dealerRiichiLoss は子・親の立直に放銃したときの平均失点
+	riichiLoss       = 5200
+	dealerRiichiLoss = 7700
+	// fallbackWinPoints はシミュレーションで打点を見積もれない場合の子の平均打点
+	fallbackWinPoints = 4000
+	// genbutsuGrowth は1巡ごとに立直者の捨て牌と他家の打牌の見逃しで牌が現物になる割合（3種 / 34種）
+	genbutsuGrowth = 3.0 / NumTileKinds
+	// notenPenalty は流局時に不聴者から聴牌者へ支払われる点数の合計
+	notenPenalty = 3000
+)
+
+// ScoreSituation は点数状況
+type ScoreSituation struct {
+	OwnScore int
+	// AllLast はオーラスか（順位点を含めて評価する。他家の持ち点は Opponent.Score を使う）
+	AllLast bool
+	// RiichiSticks は供託の立直棒の本数（今局の立直者の分を含む。少ない場合は立直者の数とみなす）
+	RiichiSticks int
+	Honba        int
+}
+
+// PushFoldInput は押し引きの評価の入力
+type PushFoldInput struct {
+	// Hand は14枚（副露を3枚として数える）の手牌
+	Hand      *Hand
+	Opponents []Opponent
+	// Visible は自分の捨て牌・他家の副露・ドラ表示牌など、手牌と他家の捨て牌以外で見えている牌
+	Visible  []Tile
+	Discards []Tile
+	Context  WinContext
+	// Turn は現在の巡目（0 の場合は自分の捨て牌の枚数から求める）
+	Turn       int
+	Score      ScoreSituation
+	Simulation SimulationOptions
+}
+
+// PushFoldOption は押し引きの選択肢ごとの評価
+type PushFoldOption struct {
+	Action  PushFoldAction
+	Discard Tile
+	Shanten int // 打牌後の向聴数
+	Ukeire  int // 打牌後の受け入れ枚数
+	// DiscardRisk はこの打牌で立直者に放銃する確率の目安
+	DiscardRisk float64
+	// WinRate・DealInRate は局が終わるまでに和了する確率・立直者に放銃する確率
+	WinRate    float64
+	DealInRate float64
+	// AverageWinPoints は和了したときの平均打点（供託・積み棒を含む）
+	AverageWinPoints float64
+	// ExpectedValue は局の収支の期待値（オーラスでは順位点の増減を含む）
+	ExpectedValue float64
+}
+
+// Threat は立直者の脅威の見積もり
+type Threat struct {
+	Seat Tile
+	// ExpectedLoss は放銃したときの平均失点
+	ExpectedLoss int
+	// WinRatePerTurn は自分以外から1巡あたりに和了する確率
+	WinRatePerTurn float64
+}
+
+// PushFoldAnalysis は押し引きの評価結果
+type PushFoldAnalysis struct {
+	Recommendation PushFoldAction
+	// Options は押し・回し・降りの順の評価（回しが押しより安全にならない場合は含めない）
+	Options        []PushFoldOption
+	Threats        []Threat
+	RemainingTurns int
+	// DrawRisk は押した場合に以降の巡目でツモ切りする牌の放銃率の平均
+	DrawRisk float64
+}
+
+// EvaluatePushFold は自分の手牌の価値・立直者の脅威・点数状況・巡目から押し引きを評価する
+//
+// 1巡ごとに自分の和了・放銃・立直者の他家からの和了が起こる確率を積み上げる簡易的な競争モデルで、
+// 和了時の打点はモンテカルロ法、放銃率は AssessSafety の目安を使う。
+func EvaluatePushFold(input PushFoldInput, rules RuleSet) (*PushFoldAnalysis, error) {
+	hand := input.Hand
+	if len(hand.Concealed)%3 != 2 {
+		return nil, fmt.Errorf("%w: push/fold evaluation requires 14 tiles counting each meld as 3, got %d", entity.ErrInvalidHand, len(hand.Concealed)+3*len(hand.Melds))
+	}
+	if len(input.Opponents) > rules.Players-1 {
+		return nil, fmt.Errorf("%w: %d opponents exceed %d for rule set %s", entity.ErrInvalidRequest, len(input.Opponents), rules.Players-1, rules.Name)
+	}
+	board, err := newSafetyBoard(hand, input.Opponents, input.Visible)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := append([]Tile{}, input.Visible...)
+	for _, o := range input.Opponents {
+		seen = append(seen, o.Discards...)
+	}
+	discardAnalysis, err := RecommendDiscard(hand, seen, input.Discards, input.Context, SimulationOptions{}, rules)
+	if err != nil {
+		return nil, err
+	}
+	unseen := unseenCounts(hand.AllCounts(), seen, rules)
+
+	turn := input.Turn
+	if turn <= 0 {
+		turn = len(input.Discards) + 1
+	}
+	analysis := &PushFoldAnalysis{
+		RemainingTurns: max(1, lastTurn-turn+1),
+		DrawRisk:       board.drawRisk(unseen),
+	}
+	for _, o := range board.riichi {
+		loss := riichiLoss
+		if o.Seat == East {
+			loss = dealerRiichiLoss
+		}
+		analysis.Threats = append(analysis.Threats, Threat{
+			Seat:           o.Seat,
+			ExpectedLoss:   loss + input.Score.Honba*rules.HonbaPoints,
+			WinRatePerTurn: riichiWinPerTurn,
+		})
+	}
+
+	// 押しは牌効率が最良の打牌、降りは最も安全な打牌、回しは向聴数を1つまで落とす範囲で最も安全な打牌
+	candidates := discardAnalysis.Candidates
+	risks := make(map[Tile]float64, len(candidates))
+	for _, c := range candidates {
+		risks[c.Tile] = board.assess(c.Tile).Risk
+	}
+	push := candidates[0]
+	fold, mawashi := push, DiscardCandidate{Tile: -1}
+	for _, c := range candidates {
+		if risks[c.Tile] < risks[fold.Tile] {
+			fold = c
+		}
+		if c.Tile != push.Tile && c.Shanten <= push.Shanten+1 && risks[c.Tile] < risks[push.Tile] &&
+			(mawashi.Tile < 0 || risks[c.Tile] < risks[mawashi.Tile]) {
+			mawashi = c
+		}
+	}
+
+	// 降りでは手牌の安全な牌から順に、ツモった牌の方が安全ならその牌を切る
+	var foldRisks []float64
+	c := hand.ConcealedCounts()
+	for len(foldRisks) < analysis.RemainingTurns {
+		best := Tile(-1)
+		for t := Tile(0); t < NumTileKinds; t++ {
+			if c[t] > 0 && (best < 0 || risks[t] < risks[best]) {
+				best = t
+			}
+		}
+		if best < 0 {
+			break
+		}
+		c[best]--
+		risk := risks[best]
+		if len(foldRisks) > 0 {
+			risk = board.safestOfDraw(unseen, risk)
+		}
+		foldRisks = append(foldRisks, risk)
+	}
+	riskAt := func(action PushFoldAction, first float64) func(int) float64 {
+		return func(t int) float64 {
+			switch {
+			case t == 0:
+				return first
+			case action == ActionPush:
+				return analysis.DrawRisk
+			case t < len(foldRisks) && action == ActionFold:
+				return foldRisks[t]
+			case t < len(foldRisks):
+				return (analysis.DrawRisk + foldRisks[t]) / 2
+			default:
+				return analysis.DrawRisk
+			}
+		}
+	}
+
+	utility := newScoreUtility(input, analysis.Threats, rules)
+	evaluate := func(action PushFoldAction, candidate DiscardCandidate) PushFoldOption {
+		option := PushFoldOption{
+			Action:      action,
+			Discard:     candidate.Tile,
+			Shanten:     candidate.Shanten,
+			Ukeire:      candidate.Ukeire.Total,
+			DiscardRisk: risks[candidate.Tile],
+		}
+		if action != ActionFold {
+			option.AverageWinPoints = estimateWinPoints(hand, candidate.Tile, unseen, input, analysis.RemainingTurns, rules)
+			option.AverageWinPoints += float64(input.Score.Honba*rules.HonbaPoints + max(input.Score.RiichiSticks, len(board.riichi))*rules.RiichiDeposit)
+		}
+		race := raceModel{
+			turns:    analysis.RemainingTurns,
+			shanten:  candidate.Shanten,
+			ukeire:   candidate.Ukeire.Total,
+			unseen:   unseen.Total(),
+			fold:     action == ActionFold,
+			riskAt:   riskAt(action, risks[candidate.Tile]),
+			oppWin:   1 - math.Pow(1-riichiWinPerTurn, float64(len(board.riichi))),
+			winValue: option.AverageWinPoints,
+		}
+		option.WinRate, option.DealInRate, option.ExpectedValue = race.run(utility)
+		return option
+	}
+
+	analysis.Options = append(analysis.Options, evaluate(ActionPush, push))
+	if mawashi.Tile >= 0 {
+		analysis.Options = append(analysis.Options, evaluate(ActionMawashi, mawashi))
+	}
+	analysis.Options = append(analysis.Options, evaluate(ActionFold, fold))
+
+	best := analysis.Options[0]
+	for _, o := range analysis.Options[1:] {
+		if o.ExpectedValue > best.ExpectedValue {
+			best = o
+		}
+	}
+	analysis.Recommendation = best.Action
+	return analysis, nil
+}
+
+// estimateWinPoints は打牌後の手牌の和了時の平均打点をモンテカルロ法で見積もる
+func estimateWinPoints(hand *Hand, discard Tile, unseen Counts, input PushFoldInput, turns int, rules RuleSet) float64 {
+	fallback := float64(fallbackWinPoints)
+	if input.Context.SeatWind == East {
+		fallback *= 1.5
+	}
+	if input.Simulation.Simulations <= 0 {
+		return fallback
+	}
+	c := hand.ConcealedCounts()
+	c[discard]--
+	opts := input.Simulation
+	opts.Turns = turns
+	result := SimulateHand(SimulationHand{
+		Concealed: c,
+		Melds:     hand.Melds,
+		AkaDora:   hand.AkaDora,
+		Discards:  append(append([]Tile{}, input.Discards...), discard),
+	}, unseen, input.Context, opts, rules)
+	if result.AverageWinPoints == 0 {
+		return fallback
+	}
+	return result.AverageWinPoints
+}
+
+// raceModel は自分・立直者の和了と放銃を1巡ごとに積み上げる競争モデル
+type raceModel struct {
+	turns   int
+	shanten int
+	ukeire  int
+	unseen  int
+	// fold は和了を目指さないか
+	fold bool
+	// riskAt は t 巡後（0 は今回）の打牌の放銃率
+	riskAt func(t int) float64
+	// oppWin は立直者が自分以外から1巡あたりに和了する確率
+	oppWin   float64
+	winValue float64
+}
+
+// run は和了率・放銃率・収支の期待値を返す
+func (m raceModel) run(u scoreUtility) (winRate, dealInRate, ev float64) {
+	if m.unseen == 0 {
+		m.unseen = 1
+	}
+	advance := min(0.9, float64(m.ukeire)/float64(m.unseen))
+	waits := float64(defaultWaits)
+	if m.shanten == 0 {
+		waits = float64(m.ukeire)
+	}
+	win := min(0.5, ronMultiplier*waits/float64(m.unseen))
+
+	// state[s] は向聴数 s で局が続いている確率
+	state := make([]float64, max(m.shanten, 0)+1)
+	state[len(state)-1] = 1
+	oppWinRate := 0.0
+	for t := 0; t < m.turns; t++ {
+		// 今回の打牌は決まっているため、ツモによる前進・和了は次の巡目から
+		if t > 0 && !m.fold {
+			for s := range state {
+				if s == 0 {
+					w := state[0] * win
+					winRate += w
+					state[0] -= w
+				} else {
+					a := state[s] * advance
+					state[s] -= a
+					state[s-1] += a
+				}
+			}
+		}
+		// 巡目が進むと現物が増え、以降の打牌は安全になっていく
+		d := m.riskAt(t) * math.Pow(1-genbutsuGrowth, float64(t))
+		for s := range state {
+			dealInRate += state[s] * d
+			state[s] *= 1 - d
+		}
+		for s := range state {
+			oppWinRate += state[s] * m.oppWin
+			state[s] *= 1 - m.oppWin
+		}
+	}
+
+	tenpai := 0.0
+	if !m.fold {
+		tenpai = state[0]
+	}
+	draw := 0.0
+	for _, p := range state {
+		draw += p
+	}
+	ev = winRate*u.win(m.winValue) + dealInRate*u.dealIn + oppWinRate*u.oppWin +
+		tenpai*u.tenpai + (draw-tenpai)*u.noten
+	return winRate, dealInRate, ev
+}
+
+// scoreUtility は局の結果ごとの効用（点数の増減、オーラスでは順位点の増減を含む）
+type scoreUtility struct {
+	win    func(points float64) float64
+	dealIn float64
+	oppWin float64
+	tenpai float64
+	noten  float64
+}
+
+// newScoreUtility は点数状況から局の結果ごとの効用を求める
+// threats は立直している他家の順に並んでいるものとし、立直者の和了は半分をツモ、半分を他家からのロンとみなす
+func newScoreUtility(input PushFoldInput, threats []Threat, rules RuleSet) scoreUtility {
+	opponents := input.Opponents
+	// value は自分と他家の点数の増減に対する効用を返す
+	value := func(own float64, others []float64) float64 {
+		if !input.Score.AllLast {
+			return own
+		}
+		before, after := 0, 0
+		for i, o := range opponents {
+			if o.Score > input.Score.OwnScore {
+				before++
+			}
+			if float64(o.Score)+others[i] > float64(input.Score.OwnScore)+own {
+				after++
+			}
+		}
+		// 順位は順位点の範囲に収める
+		last := len(rules.Uma) - 1
+		before, after = min(before, last), min(after, last)
+		return own + float64(rules.Uma[after]-rules.Uma[before])*1000
+	}
+
+	// 流局時は立直者を聴牌、立直していない他家を不聴とみなす
+	drawn := func(selfTenpai bool) float64 {
+		tenpai := []bool{selfTenpai}
+		for _, o := range opponents {
+			tenpai = append(tenpai, o.IsRiichi())
+		}
+		count := 0
+		for _, t := range tenpai {
+			if t {
+				count++
+			}
+		}
+		deltas := make([]float64, len(tenpai))
+		if count > 0 && count < len(tenpai) {
+			for i, t := range tenpai {
+				if t {
+					deltas[i] = notenPenalty / float64(count)
+				} else {
+					deltas[i] = -notenPenalty / float64(len(tenpai)-count)
+				}
+			}
+		}
+		return value(deltas[0], deltas[1:])
+	}
+	u := scoreUtility{tenpai: drawn(true), noten: drawn(false)}
+	u.win = func(points float64) float64 {
+		others := make([]float64, len(opponents))
+		for i := range others {
+			others[i] = -points / float64(len(opponents))
+		}
+		return value(points, others)
+	}
+	// ツモ和了は和了者以外の全員で支払う（表示されていない他家も含める）
+	payers := float64(rules.Players - 1)
+	k := 0
+	for i, o := range opponents {
+		if !o.IsRiichi() {
+			continue
+		}
+		loss := float64(threats[k].ExpectedLoss)
+		k++
+
+		others := make([]float64, len(opponents))
+		others[i] = loss
+		u.dealIn += value(-loss, others) / float64(len(threats))
+
+		// ツモ和了
+		tsumo := make([]float64, len(opponents))
+		for j := range tsumo {
+			tsumo[j] = -loss / payers
+		}
+		tsumo[i] = loss
+		// 他家からのロン和了（自分の点数は変わらない）
+		ron := make([]float64, len(opponents))
+		ron[i] = loss
+		if j := (i + 1) % len(opponents); j != i {
+			ron[j] = -loss
+		}
+		u.oppWin += (value(-loss/payers, tsumo)/2 + value(0, ron)/2) / float64(len(threats))
+	}
+	return u
+}
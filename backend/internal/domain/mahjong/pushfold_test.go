@@ -0,0 +1,76 @@
+package mahjong
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+func TestEvaluatePushFoldOpponents(t *testing.T) {
+	riichi := Opponent{Seat: South, Discards: mustTiles(t, "19m1z"), RiichiTurn: 3, Score: 30000}
+	quiet := Opponent{Seat: West, Discards: mustTiles(t, "9p2z"), Score: 20000}
+	tests := []struct {
+		name      string
+		opponents []Opponent
+		rules     RuleSet
+		wantErr   error
+	}{
+		{name: "四人麻雀で他家3人", opponents: []Opponent{riichi, quiet, quiet}, rules: RuleSetTenhou},
+		{name: "四人麻雀で他家4人", opponents: []Opponent{riichi, quiet, quiet, quiet}, rules: RuleSetTenhou, wantErr: entity.ErrInvalidRequest},
+		{name: "三人麻雀で他家2人", opponents: []Opponent{riichi, quiet}, rules: RuleSetSanma},
+		{name: "三人麻雀で他家3人", opponents: []Opponent{riichi, quiet, quiet}, rules: RuleSetSanma, wantErr: entity.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := EvaluatePushFold(PushFoldInput{
+				Hand:      mustHand(t, "123p456p789s23s11z5p"),
+				Opponents: tt.opponents,
+				Context:   WinContext{SeatWind: East, RoundWind: East},
+				Turn:      10,
+				Score:     ScoreSituation{OwnScore: 25000, AllLast: true},
+			}, tt.rules)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("EvaluatePushFold error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewScoreUtilityClampsRank(t *testing.T) {
+	opponents := make([]Opponent, 5)
+	for i := range opponents {
+		opponents[i] = Opponent{Score: 40000}
+	}
+	opponents[0].RiichiTurn = 1
+	input := PushFoldInput{Opponents: opponents, Score: ScoreSituation{OwnScore: 10000, AllLast: true}}
+	u := newScoreUtility(input, []Threat{{ExpectedLoss: 8000, WinRatePerTurn: 0.1}}, RuleSetTenhou)
+	if got := u.win(100000); got <= 0 {
+		t.Errorf("win utility = %v, want positive", got)
+	}
+}
+
+func TestNewScoreUtilityTsumoPayment(t *testing.T) {
+	riichi := Opponent{Seat: South, RiichiTurn: 1}
+	threats := []Threat{{ExpectedLoss: 12000, WinRatePerTurn: 0.1}}
+	tests := []struct {
+		name      string
+		opponents []Opponent
+		rules     RuleSet
+		want      float64
+	}{
+		// 立直者の和了はツモとロンが半々で、ツモは和了者以外の全員で等分する
+		{name: "四人麻雀", opponents: []Opponent{riichi, {Seat: West}, {Seat: North}}, rules: RuleSetTenhou, want: -12000.0 / 3 / 2},
+		{name: "三人麻雀", opponents: []Opponent{riichi, {Seat: West}}, rules: RuleSetSanma, want: -12000.0 / 2 / 2},
+		{name: "他家の一部のみ指定", opponents: []Opponent{riichi}, rules: RuleSetTenhou, want: -12000.0 / 3 / 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newScoreUtility(PushFoldInput{Opponents: tt.opponents}, threats, tt.rules)
+			if math.Abs(u.oppWin-tt.want) > 1e-9 {
+				t.Errorf("oppWin = %g, want %g", u.oppWin, tt.want)
+			}
+		})
+	}
+}
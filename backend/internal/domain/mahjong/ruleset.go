@@ -0,0 +1,178 @@
+package mahjong
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// RuleSet はリーチ麻雀のルール差（ハウスルール）を表す
+// 点数計算・解析エンジンとLLMへのシステムプロンプトの両方で参照される
+type RuleSet struct {
+	Name        string // 識別子（リクエストで指定する値）
+	DisplayName string // 表示名
+
+	Players int // 参加人数（4 または 3）
+
+	Kuitan        bool // 喰いタンあり
+	AkaDora       int  // 赤ドラの枚数
+	KiriageMangan bool // 切り上げ満貫あり（4翻30符・3翻60符を満貫とする）
+	KazoeYakuman  bool // 数え役満あり（なしの場合13翻以上は三倍満）
+	// YakumanStacking は複数の役満の複合を認めるか
+	YakumanStacking bool
+	// DoubleYakuman は四暗刻単騎・国士無双十三面待ちなどをダブル役満とするか
+	DoubleYakuman bool
+	Atozuke       bool // 後付けあり
+	Tobi          bool // 持ち点が0未満になった時点で終局
+	// DoubleWindPairFu は連風牌の雀頭の符（2 または 4）
+	DoubleWindPairFu int
+
+	// 三人麻雀
+	NukiDora  bool // 北抜きドラあり
+	TsumoLoss bool // ツモ損あり
+
+	// 点数状況
+	StartingPoints int    // 配給原点
+	ReturnPoints   int    // 返し点（オカの基準）
+	Uma            [4]int // 順位ウマ（千点単位、1位から順に）
+	HonbaPoints    int    // 1本場あたりの加算点（和了1回あたりの合計）
+	RiichiDeposit  int    // リーチ棒の点数
+}
+
+// 定義済みのルールセット
+var (
+	// RuleSetTenhou は天鳳（四麻・段位戦）のルール
+	RuleSetTenhou = RuleSet{
+		Name: "tenhou", DisplayName: "天鳳",
+		Players: 4, Kuitan: true, AkaDora: 3,
+		KiriageMangan: false, KazoeYakuman: true, YakumanStacking: true, DoubleYakuman: false,
+		Atozuke: true, Tobi: true, DoubleWindPairFu: 4,
+		StartingPoints: 25000, ReturnPoints: 30000, Uma: [4]int{20, 10, -10, -20},
+		HonbaPoints: 300, RiichiDeposit: 1000,
+	}
+
+	// RuleSetMLeague はMリーグのルール
+	RuleSetMLeague = RuleSet{
+		Name: "mleague", DisplayName: "Mリーグ",
+		Players: 4, Kuitan: true, AkaDora: 3,
+		KiriageMangan: false, KazoeYakuman: true, YakumanStacking: false, DoubleYakuman: false,
+		Atozuke: true, Tobi: false, DoubleWindPairFu: 2,
+		StartingPoints: 25000, ReturnPoints: 30000, Uma: [4]int{30, 10, -10, -30},
+		HonbaPoints: 300, RiichiDeposit: 1000,
+	}
+
+	// RuleSetWRC はWorld Riichi Championshipのルール
+	RuleSetWRC = RuleSet{
+		Name: "wrc", DisplayName: "WRC",
+		Players: 4, Kuitan: true, AkaDora: 0,
+		KiriageMangan: true, KazoeYakuman: false, YakumanStacking: true, DoubleYakuman: false,
+		Atozuke: true, Tobi: false, DoubleWindPairFu: 2,
+		StartingPoints: 30000, ReturnPoints: 30000, Uma: [4]int{15, 5, -5, -15},
+		HonbaPoints: 300, RiichiDeposit: 1000,
+	}
+
+	// RuleSetEMA はEuropean Mahjong Associationのリーチルール
+	RuleSetEMA = RuleSet{
+		Name: "ema", DisplayName: "EMA",
+		Players: 4, Kuitan: true, AkaDora: 0,
+		KiriageMangan: false, KazoeYakuman: false, YakumanStacking: true, DoubleYakuman: false,
+		Atozuke: true, Tobi: false, DoubleWindPairFu: 4,
+		StartingPoints: 30000, ReturnPoints: 30000, Uma: [4]int{15, 5, -5, -15},
+		HonbaPoints: 300, RiichiDeposit: 1000,
+	}
+
+	// RuleSetMahjongSoul は雀魂（四麻・段位戦）のルール
+	RuleSetMahjongSoul = RuleSet{
+		Name: "mahjongsoul", DisplayName: "雀魂",
+		Players: 4, Kuitan: true, AkaDora: 3,
+		KiriageMangan: false, KazoeYakuman: true, YakumanStacking: true, DoubleYakuman: true,
+		Atozuke: true, Tobi: true, DoubleWindPairFu: 4,
+		StartingPoints: 25000, ReturnPoints: 30000, Uma: [4]int{15, 5, -5, -15},
+		HonbaPoints: 300, RiichiDeposit: 1000,
+	}
+
+	// RuleSetSanma は三人麻雀（雀魂・段位戦準拠）のルール
+	RuleSetSanma = RuleSet{
+		Name: "sanma", DisplayName: "三人麻雀",
+		Players: 3, Kuitan: true, AkaDora: 2,
+		KiriageMangan: false, KazoeYakuman: true, YakumanStacking: true, DoubleYakuman: true,
+		Atozuke: true, Tobi: true, DoubleWindPairFu: 4,
+		NukiDora: true, TsumoLoss: true,
+		StartingPoints: 35000, ReturnPoints: 40000, Uma: [4]int{15, 0, -15, 0},
+		HonbaPoints: 200, RiichiDeposit: 1000,
+	}
+)
+
+// presets は名前からルールセットを引くための表
+var presets = map[string]RuleSet{
+	RuleSetTenhou.Name:      RuleSetTenhou,
+	RuleSetMLeague.Name:     RuleSetMLeague,
+	RuleSetWRC.Name:         RuleSetWRC,
+	RuleSetEMA.Name:         RuleSetEMA,
+	RuleSetMahjongSoul.Name: RuleSetMahjongSoul,
+	RuleSetSanma.Name:       RuleSetSanma,
+}
+
+// LookupRuleSet は名前（大文字小文字・ハイフン・アンダースコアは区別しない）から定義済みのルールセットを返す
+func LookupRuleSet(name string) (RuleSet, bool) {
+	key := strings.NewReplacer("-", "", "_", "", " ", "").Replace(strings.ToLower(name))
+	rs, ok := presets[key]
+	return rs, ok
+}
+
+// RuleSetNames は定義済みのルールセット名を返す
+func RuleSetNames() []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsSanma は三人麻雀かを返す
+func (r RuleSet) IsSanma() bool {
+	return r.Players == 3
+}
+
+// DoraOf はドラ表示牌からドラを返す（三人麻雀では1萬の次を9萬とする）
+func (r RuleSet) DoraOf(indicator Tile) Tile {
+	if r.IsSanma() && indicator == NewTile(SuitMan, 1) {
+		return NewTile(SuitMan, 9)
+	}
+	return indicator.DoraIndicated()
+}
+
+// Describe はLLMへのシステムプロンプトに埋め込むルールの説明を返す
+func (r RuleSet) Describe() string {
+	yesNo := func(b bool) string {
+		if b {
+			return "あり"
+		}
+		return "なし"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "適用ルール: %s\n", r.DisplayName)
+	if r.IsSanma() {
+		fmt.Fprintf(&b, "- 三人麻雀（萬子は1・9のみ、北抜きドラ%s、ツモ損%s）\n", yesNo(r.NukiDora), yesNo(r.TsumoLoss))
+	} else {
+		b.WriteString("- 四人麻雀\n")
+	}
+	fmt.Fprintf(&b, "- 喰いタン%s\n", yesNo(r.Kuitan))
+	fmt.Fprintf(&b, "- 赤ドラ%d枚\n", r.AkaDora)
+	fmt.Fprintf(&b, "- 後付け%s\n", yesNo(r.Atozuke))
+	fmt.Fprintf(&b, "- 切り上げ満貫%s\n", yesNo(r.KiriageMangan))
+	fmt.Fprintf(&b, "- 数え役満%s（なしの場合13翻以上は三倍満）\n", yesNo(r.KazoeYakuman))
+	fmt.Fprintf(&b, "- 役満の複合%s、ダブル役満%s\n", yesNo(r.YakumanStacking), yesNo(r.DoubleYakuman))
+	fmt.Fprintf(&b, "- 連風牌の雀頭は%d符\n", r.DoubleWindPairFu)
+	fmt.Fprintf(&b, "- 飛び%s\n", yesNo(r.Tobi))
+	uma := make([]string, 0, r.Players)
+	for _, u := range r.Uma[:r.Players] {
+		uma = append(uma, fmt.Sprintf("%+d", u))
+	}
+	fmt.Fprintf(&b, "- %d点持ち%d点返し、ウマ %s\n", r.StartingPoints, r.ReturnPoints, strings.Join(uma, "/"))
+	fmt.Fprintf(&b, "- 積み棒1本%d点、リーチ棒%d点\n", r.HonbaPoints, r.RiichiDeposit)
+	b.WriteString("回答はこのルールを前提とし、ルールによって結論が変わる場合はその旨を明記してください。")
+	return b.String()
+}
@@ -0,0 +1,307 @@
+package mahjong
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// SafetyClass は立直者に対する牌の安全度の分類
+type SafetyClass int
+
+const (
+	SafetyGenbutsu  SafetyClass = iota // 現物
+	SafetyNoChance                     // 壁・字牌の残りなしにより両面（字牌は単騎・双碰）に当たらない
+	SafetySuji                         // 筋
+	SafetyHonor                        // 字牌（残りあり）
+	SafetyOneChance                    // ワンチャンス
+	SafetyHalfSuji                     // 片筋（4〜6の片側のみ両面に当たらない）
+	SafetyMusuji                       // 無筋
+)
+
+// String は分類の名前を返す
+func (s SafetyClass) String() string {
+	switch s {
+	case SafetyGenbutsu:
+		return "現物"
+	case SafetyNoChance:
+		return "ノーチャンス"
+	case SafetySuji:
+		return "筋"
+	case SafetyHonor:
+		return "字牌"
+	case SafetyOneChance:
+		return "ワンチャンス"
+	case SafetyHalfSuji:
+		return "片筋"
+	default:
+		return "無筋"
+	}
+}
+
+// 放銃率の目安（立直者1人に対する割合）
+// 天鳳の牌譜統計でよく知られた傾向（無筋の4〜6が最も危険、筋の1・9や残りの少ない字牌は安全）に合わせた概算値
+var (
+	// sideRisk は数牌の数字ごとの、片側の両面待ちに当たる放銃率
+	sideRisk = [10]float64{0, 0.040, 0.045, 0.055, 0.045, 0.045, 0.045, 0.055, 0.045, 0.040}
+	// baseRisk は数牌の数字ごとの、両面以外（嵌張・辺張・単騎・双碰）の待ちに当たる放銃率
+	baseRisk = [10]float64{0, 0.020, 0.035, 0.045, 0.030, 0.030, 0.030, 0.045, 0.035, 0.020}
+	// honorRisk は字牌の見えていない残り枚数ごとの放銃率
+	honorRisk = [5]float64{0, 0.010, 0.030, 0.060, 0.070}
+)
+
+// 放銃率の補正
+const (
+	// oneChanceFactor はワンチャンスの側の両面待ちの放銃率にかける係数
+	oneChanceFactor = 0.5
+	// deadTileFactor は残りがなく単騎・双碰に当たらない数牌の、両面以外の待ちの放銃率にかける係数
+	deadTileFactor = 0.5
+	// matagiFactor は立直宣言牌のまたぎ筋の放銃率にかける係数
+	matagiFactor = 1.3
+)
+
+// Opponent は他家の捨て牌と立直の状況
+type Opponent struct {
+	// Seat は他家の自風（結果の表示用）
+	Seat     Tile
+	Discards []Tile
+	// RiichiTurn は立直宣言牌が何枚目の捨て牌か（1始まり、0 は立直していない）
+	RiichiTurn int
+	// PassedAfterRiichi は立直後に他家が捨て、この他家が和了しなかった牌（見えている牌には数えない）
+	PassedAfterRiichi []Tile
+	// Score は持ち点（押し引きの判断でオーラスの順位を考慮する場合に使う）
+	Score int
+}
+
+// IsRiichi は立直しているかを返す
+func (o Opponent) IsRiichi() bool {
+	return o.RiichiTurn > 0
+}
+
+// OpponentSafety は1人の立直者に対する牌の安全度
+type OpponentSafety struct {
+	Seat    Tile
+	Class   SafetyClass
+	Risk    float64 // 放銃率の目安
+	Reasons []string
+}
+
+// TileSafety は手牌の1種類の牌の安全度
+type TileSafety struct {
+	Tile  Tile
+	Count int // 手牌にある枚数
+	// Risk はいずれかの立直者に放銃する確率の目安
+	Risk    float64
+	Against []OpponentSafety
+}
+
+// AssessSafety は手牌の牌ごとに立直者への安全度を評価し、安全な順に並べる
+// visible は他家の副露やドラ表示牌など、手牌と他家の捨て牌以外で見えている牌
+// 立直していない他家は評価の対象にせず、捨て牌を見えている牌として数える
+func AssessSafety(hand *Hand, opponents []Opponent, visible []Tile) ([]TileSafety, error) {
+	board, err := newSafetyBoard(hand, opponents, visible)
+	if err != nil {
+		return nil, err
+	}
+
+	c := hand.ConcealedCounts()
+	var result []TileSafety
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if c[t] == 0 {
+			continue
+		}
+		safety := board.assess(t)
+		safety.Count = c[t]
+		result = append(result, safety)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Risk < result[j].Risk
+	})
+	return result, nil
+}
+
+// safetyBoard は安全度の評価に使う見えている牌と立直者
+type safetyBoard struct {
+	seen   Counts
+	riichi []Opponent
+}
+
+// newSafetyBoard は手牌・他家の捨て牌・見えている牌から安全度の評価の準備をする
+func newSafetyBoard(hand *Hand, opponents []Opponent, visible []Tile) (*safetyBoard, error) {
+	board := &safetyBoard{seen: hand.AllCounts()}
+	for _, t := range visible {
+		board.seen[t]++
+	}
+	for i, o := range opponents {
+		if o.RiichiTurn < 0 || o.RiichiTurn > len(o.Discards) {
+			return nil, fmt.Errorf("%w: riichi turn %d of opponent %d is out of range (discards: %d)", entity.ErrInvalidRequest, o.RiichiTurn, i+1, len(o.Discards))
+		}
+		if o.IsRiichi() {
+			board.riichi = append(board.riichi, o)
+		}
+		for _, t := range o.Discards {
+			board.seen[t]++
+		}
+	}
+	if len(board.riichi) == 0 {
+		return nil, fmt.Errorf("%w: no opponent has declared riichi", entity.ErrInvalidRequest)
+	}
+	for t := range board.seen {
+		board.seen[t] = min(board.seen[t], 4)
+	}
+	return board, nil
+}
+
+// assess は牌の立直者ごとの安全度と、いずれかに放銃する確率を返す
+func (b *safetyBoard) assess(t Tile) TileSafety {
+	safety := TileSafety{Tile: t}
+	safe := 1.0
+	for _, o := range b.riichi {
+		s := assessAgainst(t, o, b.seen)
+		safe *= 1 - s.Risk
+		safety.Against = append(safety.Against, s)
+	}
+	safety.Risk = 1 - safe
+	return safety
+}
+
+// drawRisk は残りの牌を1枚ツモってそのまま切った場合の放銃率の平均を返す
+func (b *safetyBoard) drawRisk(unseen Counts) float64 {
+	return b.safestOfDraw(unseen, 1)
+}
+
+// safestOfDraw は手牌の放銃率 held の牌と残りからツモった牌のうち、安全な方を切った場合の放銃率の平均を返す
+func (b *safetyBoard) safestOfDraw(unseen Counts, held float64) float64 {
+	total, risk := 0, 0.0
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if n := unseen[t]; n > 0 {
+			total += n
+			risk += float64(n) * min(held, b.assess(t).Risk)
+		}
+	}
+	if total == 0 {
+		return held
+	}
+	return risk / float64(total)
+}
+
+// assessAgainst は1人の立直者に対する牌の安全度を評価する
+func assessAgainst(t Tile, o Opponent, seen Counts) OpponentSafety {
+	s := OpponentSafety{Seat: o.Seat}
+
+	genbutsu := CountTiles(o.Discards)
+	for _, p := range o.PassedAfterRiichi {
+		genbutsu[p]++
+	}
+	if genbutsu[t] > 0 {
+		s.Class = SafetyGenbutsu
+		s.Reasons = append(s.Reasons, "現物")
+		return s
+	}
+
+	if t.IsHonor() {
+		remaining := 4 - seen[t]
+		s.Risk = honorRisk[remaining]
+		s.Class = SafetyHonor
+		switch remaining {
+		case 0:
+			s.Class = SafetyNoChance
+			s.Reasons = append(s.Reasons, "字牌・残りなし（国士無双以外には当たらない）")
+		case 1:
+			s.Reasons = append(s.Reasons, "字牌・残り1枚（単騎のみ）")
+		default:
+			s.Reasons = append(s.Reasons, fmt.Sprintf("字牌・残り%d枚", remaining))
+		}
+		return s
+	}
+
+	// 両面待ちは t を下端とする形（t+1, t+2）と上端とする形（t-2, t-1）の2通り
+	n := t.Number()
+	type side struct {
+		exists   bool
+		suji     Tile // もう一方の和了牌
+		shape    [2]Tile
+		excluded bool
+		oneshot  bool
+	}
+	sides := [2]side{
+		{exists: n <= 6, suji: t + 3, shape: [2]Tile{t + 1, t + 2}},
+		{exists: n >= 4, suji: t - 3, shape: [2]Tile{t - 2, t - 1}},
+	}
+	existing, bySuji, byKabe, oneChance, open := 0, 0, 0, 0, 0
+	for i := range sides {
+		sd := &sides[i]
+		if !sd.exists {
+			continue
+		}
+		existing++
+		switch {
+		case genbutsu[sd.suji] > 0:
+			sd.excluded = true
+			bySuji++
+			s.Reasons = append(s.Reasons, fmt.Sprintf("筋（%s が現物）", sd.suji))
+		case seen[sd.shape[0]] == 4 || seen[sd.shape[1]] == 4:
+			sd.excluded = true
+			byKabe++
+			s.Reasons = append(s.Reasons, fmt.Sprintf("壁（%s が4枚見え）", kabeTile(sd.shape, seen, 4)))
+		case seen[sd.shape[0]] == 3 || seen[sd.shape[1]] == 3:
+			sd.oneshot = true
+			oneChance++
+			s.Reasons = append(s.Reasons, fmt.Sprintf("ワンチャンス（%s が3枚見え）", kabeTile(sd.shape, seen, 3)))
+		default:
+			open++
+		}
+	}
+
+	for _, sd := range sides {
+		switch {
+		case !sd.exists || sd.excluded:
+		case sd.oneshot:
+			s.Risk += sideRisk[n] * oneChanceFactor
+		default:
+			s.Risk += sideRisk[n]
+		}
+	}
+	base := baseRisk[n]
+	if seen[t] == 4 {
+		base *= deadTileFactor
+		s.Reasons = append(s.Reasons, "残りなし（単騎・双碰に当たらない）")
+	}
+	s.Risk += base
+
+	switch {
+	case bySuji == existing:
+		s.Class = SafetySuji
+	case bySuji+byKabe == existing:
+		s.Class = SafetyNoChance
+	case open == 0:
+		s.Class = SafetyOneChance
+	case existing == 2 && open == 1 && oneChance == 0:
+		s.Class = SafetyHalfSuji
+	default:
+		s.Class = SafetyMusuji
+		s.Reasons = append(s.Reasons, "無筋")
+	}
+
+	// 立直宣言牌のまたぎ筋は、宣言牌の周辺で両面を残した可能性があり危険
+	if open > 0 {
+		declared := o.Discards[o.RiichiTurn-1]
+		if !declared.IsHonor() && declared.Suit() == t.Suit() {
+			if d := n - declared.Number(); d == -2 || d == -1 || d == 1 || d == 2 {
+				s.Risk *= matagiFactor
+				s.Reasons = append(s.Reasons, fmt.Sprintf("立直宣言牌 %s のまたぎ筋", declared))
+			}
+		}
+	}
+	return s
+}
+
+// kabeTile は両面の形の牌のうち、見えている枚数が count のものを返す
+func kabeTile(shape [2]Tile, seen Counts, count int) Tile {
+	if seen[shape[0]] == count {
+		return shape[0]
+	}
+	return shape[1]
+}
@@ -0,0 +1,105 @@
+package mahjong
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+func TestAssessAgainst(t *testing.T) {
+	// 4m を捨てたあと 9p で立直
+	riichi := Opponent{Discards: mustTiles(t, "4m9p"), RiichiTurn: 2}
+
+	tests := []struct {
+		name    string
+		tile    string
+		visible string // 見えている牌
+		want    SafetyClass
+	}{
+		{name: "現物", tile: "4m", want: SafetyGenbutsu},
+		{name: "筋の1", tile: "1m", want: SafetySuji},
+		{name: "筋の7", tile: "7m", want: SafetySuji},
+		{name: "無筋の5", tile: "5m", want: SafetyMusuji},
+		{name: "片筋", tile: "6p", want: SafetyHalfSuji},
+		{name: "壁", tile: "2s", visible: "3333s", want: SafetyNoChance},
+		{name: "ワンチャンス", tile: "2s", visible: "333s", want: SafetyOneChance},
+		{name: "字牌の残りあり", tile: "1z", visible: "1z", want: SafetyHonor},
+		{name: "字牌の残りなし", tile: "1z", visible: "1111z", want: SafetyNoChance},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seen := CountTiles(mustTiles(t, tt.visible+"4m9p"))
+			got := assessAgainst(mustTiles(t, tt.tile)[0], riichi, seen)
+			if got.Class != tt.want {
+				t.Errorf("assessAgainst(%s) = %s %v, want %s", tt.tile, got.Class, got.Reasons, tt.want)
+			}
+			if got.Class == SafetyGenbutsu && got.Risk != 0 {
+				t.Errorf("risk of genbutsu = %g, want 0", got.Risk)
+			}
+		})
+	}
+}
+
+func TestAssessAgainstMatagi(t *testing.T) {
+	riichi := Opponent{Discards: mustTiles(t, "4m9p"), RiichiTurn: 2}
+	seen := CountTiles(mustTiles(t, "4m9p"))
+
+	// 8p は立直宣言牌 9p のまたぎ筋、8s は同じ形のまたぎ筋でない牌
+	matagi := assessAgainst(NewTile(SuitPin, 8), riichi, seen)
+	plain := assessAgainst(NewTile(SuitSou, 8), riichi, seen)
+	if want := plain.Risk * matagiFactor; math.Abs(matagi.Risk-want) > 1e-9 {
+		t.Errorf("risk of 8p = %g, want %g (8s risk × %g)", matagi.Risk, want, matagiFactor)
+	}
+}
+
+func TestAssessSafetyRanking(t *testing.T) {
+	hand := mustHand(t, "1145m123p789s122z")
+	opponents := []Opponent{
+		{Discards: mustTiles(t, "4m9p1z"), RiichiTurn: 2},
+		{Discards: mustTiles(t, "5s6s")}, // 立直していない他家は評価しない
+	}
+	result, err := AssessSafety(hand, opponents, nil)
+	if err != nil {
+		t.Fatalf("AssessSafety: %v", err)
+	}
+
+	order := make([]Tile, 0, len(result))
+	for i, s := range result {
+		if len(s.Against) != 1 {
+			t.Fatalf("%s is assessed against %d opponents, want 1", s.Tile, len(s.Against))
+		}
+		if i > 0 && s.Risk < result[i-1].Risk {
+			t.Errorf("result is not sorted by risk: %s (%g) after %s (%g)", s.Tile, s.Risk, result[i-1].Tile, result[i-1].Risk)
+		}
+		order = append(order, s.Tile)
+	}
+	// 現物の 4m・1z が最も安全で、無筋の 5m が最も危険
+	if got := order[len(order)-1]; got != NewTile(SuitMan, 5) {
+		t.Errorf("most dangerous tile = %s, want 5m (order %v)", got, order)
+	}
+	for _, s := range result[:2] {
+		if s.Against[0].Class != SafetyGenbutsu {
+			t.Errorf("safest tiles = %v, want genbutsu 4m and 1z first", order)
+		}
+	}
+}
+
+func TestAssessSafetyInvalid(t *testing.T) {
+	hand := mustHand(t, "123m456p789s1122z")
+	tests := []struct {
+		name      string
+		opponents []Opponent
+	}{
+		{name: "立直者なし", opponents: []Opponent{{Discards: mustTiles(t, "1m")}}},
+		{name: "立直の巡目が範囲外", opponents: []Opponent{{Discards: mustTiles(t, "1m"), RiichiTurn: 2}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AssessSafety(hand, tt.opponents, nil); !errors.Is(err, entity.ErrInvalidRequest) {
+				t.Errorf("AssessSafety() error = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package mahjong
+
+import "fmt"
+
+// Limit は満貫以上の点数区分
+type Limit int
+
+const (
+	LimitNone Limit = iota
+	LimitMangan
+	LimitHaneman
+	LimitBaiman
+	LimitSanbaiman
+	LimitYakuman
+)
+
+// String は点数区分の名前を返す
+func (l Limit) String() string {
+	switch l {
+	case LimitMangan:
+		return "満貫"
+	case LimitHaneman:
+		return "跳満"
+	case LimitBaiman:
+		return "倍満"
+	case LimitSanbaiman:
+		return "三倍満"
+	case LimitYakuman:
+		return "役満"
+	default:
+		return ""
+	}
+}
+
+// Score は和了の点数（積み棒・供託を含まない）
+type Score struct {
+	Han     int
+	Fu      int
+	Yakuman int // 役満の倍数（0の場合は通常役）
+	Dealer  bool
+	Limit   Limit
+	// Base は基本点（符 × 2^(翻+2)、満貫以上は区分ごとの固定値）
+	Base int
+
+	// Ron はロン和了時に放銃者が支払う点数
+	Ron int
+	// TsumoFromDealer は子のツモ和了時に親が支払う点数
+	TsumoFromDealer int
+	// TsumoFromNonDealer はツモ和了時に子1人が支払う点数
+	TsumoFromNonDealer int
+	// TsumoTotal はツモ和了時の受け取り合計
+	TsumoTotal int
+}
+
+// String は点数を「30符4翻 7700点」のような形式で返す
+func (s Score) String() string {
+	head := s.Name()
+	if s.Dealer {
+		return fmt.Sprintf("%s ロン%d点 / ツモ%dオール", head, s.Ron, s.TsumoFromNonDealer)
+	}
+	return fmt.Sprintf("%s ロン%d点 / ツモ%d-%d", head, s.Ron, s.TsumoFromNonDealer, s.TsumoFromDealer)
+}
+
+// Name は翻・符または点数区分を「30符4翻」「6翻 跳満」「役満」のような形式で返す
+func (s Score) Name() string {
+	switch {
+	case s.Yakuman > 1:
+		return fmt.Sprintf("%d倍役満", s.Yakuman)
+	case s.Yakuman == 1:
+		return "役満"
+	case s.Limit != LimitNone:
+		return fmt.Sprintf("%d翻 %s", s.Han, s.Limit)
+	default:
+		return fmt.Sprintf("%d符%d翻", s.Fu, s.Han)
+	}
+}
+
+// CalculateScore は翻・符から点数を計算する
+// yakuman が1以上の場合は役満として扱い、han・fu は無視する
+func CalculateScore(han, fu, yakuman int, dealer bool, rules RuleSet) Score {
+	s := Score{Han: han, Fu: fu, Dealer: dealer}
+
+	switch {
+	case yakuman > 0:
+		if !rules.YakumanStacking && !rules.DoubleYakuman {
+			yakuman = 1
+		}
+		s.Yakuman = yakuman
+		s.Limit = LimitYakuman
+		s.Base = 8000 * yakuman
+	case han >= 13 && rules.KazoeYakuman:
+		s.Yakuman = 1
+		s.Limit = LimitYakuman
+		s.Base = 8000
+	case han >= 11:
+		s.Limit, s.Base = LimitSanbaiman, 6000
+	case han >= 8:
+		s.Limit, s.Base = LimitBaiman, 4000
+	case han >= 6:
+		s.Limit, s.Base = LimitHaneman, 3000
+	default:
+		s.Fu = RoundFu(fu)
+		base := s.Fu << (han + 2)
+		if han >= 5 || base >= 2000 || (rules.KiriageMangan && base == 1920) {
+			s.Limit, s.Base = LimitMangan, 2000
+		} else {
+			s.Base = base
+		}
+	}
+
+	if dealer {
+		s.Ron = roundUp100(s.Base * 6)
+		s.TsumoFromNonDealer = roundUp100(s.Base * 2)
+		s.TsumoTotal = s.TsumoFromNonDealer * (rules.Players - 1)
+	} else {
+		s.Ron = roundUp100(s.Base * 4)
+		s.TsumoFromDealer = roundUp100(s.Base * 2)
+		s.TsumoFromNonDealer = roundUp100(s.Base)
+		s.TsumoTotal = s.TsumoFromDealer + s.TsumoFromNonDealer*(rules.Players-2)
+	}
+
+	// ツモ損なしの三人麻雀では不在の1人分を残りの2人で折半する
+	if rules.IsSanma() && !rules.TsumoLoss {
+		missing := s.TsumoFromNonDealer
+		half := roundUp100(missing / 2)
+		s.TsumoFromNonDealer += half
+		if !dealer {
+			s.TsumoFromDealer += half
+		}
+		s.TsumoTotal += half * 2
+	}
+	return s
+}
+
+// RoundFu は符を10符単位に切り上げる（七対子の25符はそのまま）
+func RoundFu(fu int) int {
+	if fu == 25 {
+		return fu
+	}
+	return (fu + 9) / 10 * 10
+}
+
+// roundUp100 は100点単位に切り上げる
+func roundUp100(points int) int {
+	return (points + 99) / 100 * 100
+}
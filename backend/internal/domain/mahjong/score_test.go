@@ -0,0 +1,44 @@
+package mahjong
+
+import "testing"
+
+func TestCalculateScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		han     int
+		fu      int
+		yakuman int
+		dealer  bool
+		rules   RuleSet
+		ron     int
+		tsumo   int
+		limit   Limit
+	}{
+		{name: "子20符2翻ツモ", han: 2, fu: 20, rules: RuleSetTenhou, ron: 1300, tsumo: 1500},
+		{name: "子30符4翻（切り上げなし）", han: 4, fu: 30, rules: RuleSetTenhou, ron: 7700, tsumo: 7900},
+		{name: "子30符4翻（切り上げ満貫）", han: 4, fu: 30, rules: RuleSetWRC, ron: 8000, tsumo: 8000, limit: LimitMangan},
+		{name: "親60符3翻（切り上げ満貫）", han: 3, fu: 60, dealer: true, rules: RuleSetWRC, ron: 12000, tsumo: 12000, limit: LimitMangan},
+		{name: "親30符4翻", han: 4, fu: 30, dealer: true, rules: RuleSetTenhou, ron: 11600, tsumo: 11700},
+		{name: "子7翻 跳満", han: 7, fu: 30, rules: RuleSetTenhou, ron: 12000, tsumo: 12000, limit: LimitHaneman},
+		{name: "数え役満", han: 13, fu: 30, rules: RuleSetTenhou, ron: 32000, tsumo: 32000, limit: LimitYakuman},
+		{name: "数え役満なし", han: 13, fu: 30, rules: RuleSetWRC, ron: 24000, tsumo: 24000, limit: LimitSanbaiman},
+		{name: "ダブル役満（複合なし）", yakuman: 2, rules: RuleSetMLeague, ron: 32000, tsumo: 32000, limit: LimitYakuman},
+		{name: "ダブル役満（複合あり）", yakuman: 2, rules: RuleSetTenhou, ron: 64000, tsumo: 64000, limit: LimitYakuman},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := CalculateScore(tt.han, tt.fu, tt.yakuman, tt.dealer, tt.rules)
+			if s.Ron != tt.ron || s.TsumoTotal != tt.tsumo || s.Limit != tt.limit {
+				t.Errorf("CalculateScore = ron %d tsumo %d %v, want ron %d tsumo %d %v", s.Ron, s.TsumoTotal, s.Limit, tt.ron, tt.tsumo, tt.limit)
+			}
+		})
+	}
+}
+
+func TestRoundFu(t *testing.T) {
+	for in, want := range map[int]int{22: 30, 25: 25, 30: 30, 32: 40, 102: 110} {
+		if got := RoundFu(in); got != want {
+			t.Errorf("RoundFu(%d) = %d, want %d", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+package mahjong
+
+import "sync"
+
+// blockCount は1つの牌種（萬子・筒子・索子・字牌）から取り出せるブロックの数
+type blockCount struct {
+	mentsu int // 面子
+	taatsu int // 搭子（対子を含む）
+	pair   int // 雀頭（0 または 1）
+}
+
+// blockCache は牌種ごとの枚数の並びに対するブロックの取り出し方の候補
+var blockCache sync.Map // map[uint64][]blockCount
+
+// Shanten は向聴数を返す（和了形は-1、聴牌は0）
+// 門前の牌は3n+1枚または3n+2枚で、副露は完成した面子として数える
+func Shanten(c Counts, melds int) int {
+	s := standardShanten(c, melds)
+	if melds == 0 {
+		s = min(s, chiitoitsuShanten(c), kokushiShanten(c))
+	}
+	return s
+}
+
+// standardShanten は4面子1雀頭の形の向聴数を返す
+func standardShanten(c Counts, melds int) int {
+	// 面子数と雀頭の有無ごとに搭子数の最大値を求める（-1 は到達不能）
+	var dp [5][2]int
+	for m := range dp {
+		dp[m] = [2]int{-1, -1}
+	}
+	dp[min(melds, 4)][0] = 0
+	for suit := SuitMan; suit <= SuitHonor; suit++ {
+		options := suitBlocks(c, suit)
+		var next [5][2]int
+		for m := range next {
+			next[m] = [2]int{-1, -1}
+		}
+		for m := range dp {
+			for p := range dp[m] {
+				if dp[m][p] < 0 {
+					continue
+				}
+				for _, b := range options {
+					nm, np := m+b.mentsu, p+b.pair
+					if nm > 4 || np > 1 {
+						continue
+					}
+					next[nm][np] = max(next[nm][np], dp[m][p]+b.taatsu)
+				}
+			}
+		}
+		dp = next
+	}
+
+	best := 8
+	for m := range dp {
+		for p := range dp[m] {
+			if dp[m][p] < 0 {
+				continue
+			}
+			taatsu := min(dp[m][p], 4-m)
+			best = min(best, 8-2*m-taatsu-p)
+		}
+	}
+	return best
+}
+
+// suitBlocks は1つの牌種から取り出せるブロックの数の候補を返す
+func suitBlocks(c Counts, suit Suit) []blockCount {
+	start := int(suit) * 9
+	size := 9
+	if suit == SuitHonor {
+		size = 7
+	}
+	var key uint64 = uint64(suit) + 1
+	for i := 0; i < size; i++ {
+		key = key*5 + uint64(c[start+i])
+	}
+	if cached, ok := blockCache.Load(key); ok {
+		return cached.([]blockCount)
+	}
+
+	counts := make([]int, size)
+	copy(counts, c[start:start+size])
+	var seen blockSet
+	searchBlocks(counts, 0, suit == SuitHonor, blockCount{}, &seen)
+	var found []blockCount
+	for m := range seen {
+		for t := range seen[m] {
+			for p := range seen[m][t] {
+				if seen[m][t][p] {
+					found = append(found, blockCount{m, t, p})
+				}
+			}
+		}
+	}
+	result := pareto(found)
+	blockCache.Store(key, result)
+	return result
+}
+
+// blockSet は見つかったブロックの数の組（面子は最大4、搭子は最大7）
+type blockSet [5][8][2]bool
+
+// searchBlocks はブロックの取り出し方を再帰的に列挙する
+func searchBlocks(c []int, i int, honor bool, cur blockCount, found *blockSet) {
+	for i < len(c) && c[i] == 0 {
+		i++
+	}
+	if i == len(c) {
+		found[min(cur.mentsu, 4)][min(cur.taatsu, 7)][cur.pair] = true
+		return
+	}
+
+	// 刻子
+	if c[i] >= 3 {
+		c[i] -= 3
+		searchBlocks(c, i, honor, blockCount{cur.mentsu + 1, cur.taatsu, cur.pair}, found)
+		c[i] += 3
+	}
+	// 順子
+	if !honor && i+2 < len(c) && c[i+1] > 0 && c[i+2] > 0 {
+		c[i]--
+		c[i+1]--
+		c[i+2]--
+		searchBlocks(c, i, honor, blockCount{cur.mentsu + 1, cur.taatsu, cur.pair}, found)
+		c[i]++
+		c[i+1]++
+		c[i+2]++
+	}
+	// 対子（雀頭または搭子）
+	if c[i] >= 2 {
+		c[i] -= 2
+		if cur.pair == 0 {
+			searchBlocks(c, i, honor, blockCount{cur.mentsu, cur.taatsu, 1}, found)
+		}
+		searchBlocks(c, i, honor, blockCount{cur.mentsu, cur.taatsu + 1, cur.pair}, found)
+		c[i] += 2
+	}
+	// 両面・辺張・嵌張
+	if !honor {
+		for _, d := range []int{1, 2} {
+			if i+d < len(c) && c[i+d] > 0 {
+				c[i]--
+				c[i+d]--
+				searchBlocks(c, i, honor, blockCount{cur.mentsu, cur.taatsu + 1, cur.pair}, found)
+				c[i]++
+				c[i+d]++
+			}
+		}
+	}
+	// 孤立牌として使わない
+	c[i]--
+	searchBlocks(c, i, honor, cur, found)
+	c[i]++
+}
+
+// pareto は他の候補に劣る（面子・搭子・雀頭のすべてが以下の）候補を取り除く
+// 候補に重複はないものとする
+func pareto(candidates []blockCount) []blockCount {
+	var result []blockCount
+	for i, a := range candidates {
+		dominated := false
+		for j, b := range candidates {
+			if i == j {
+				continue
+			}
+			if b.mentsu >= a.mentsu && b.taatsu >= a.taatsu && b.pair >= a.pair {
+				dominated = true
+				break
+			}
+		}
+		if !dominated {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// chiitoitsuShanten は七対子の向聴数を返す
+func chiitoitsuShanten(c Counts) int {
+	pairs, kinds := 0, 0
+	for _, n := range c {
+		if n > 0 {
+			kinds++
+		}
+		if n >= 2 {
+			pairs++
+		}
+	}
+	return 6 - pairs + max(0, 7-kinds)
+}
+
+// kokushiShanten は国士無双の向聴数を返す
+func kokushiShanten(c Counts) int {
+	kinds, pair := 0, 0
+	for _, t := range yaochuTiles {
+		if c[t] > 0 {
+			kinds++
+		}
+		if c[t] >= 2 {
+			pair = 1
+		}
+	}
+	return 13 - kinds - pair
+}
@@ -0,0 +1,33 @@
+package mahjong
+
+import "testing"
+
+func TestShanten(t *testing.T) {
+	tests := []struct {
+		name  string
+		hand  string
+		melds int
+		want  int
+	}{
+		{name: "和了形", hand: "123456m789p234s55p", want: -1},
+		{name: "聴牌", hand: "123m456p789s23s11z", want: 0},
+		{name: "1向聴", hand: "123m456p78s23s11z9m", want: 1},
+		{name: "七対子の聴牌", hand: "1133m2266p4488s1z", want: 0},
+		{name: "七対子の1向聴", hand: "1133m2266p448s12z", want: 1},
+		{name: "七対子の和了形", hand: "1133m2266p4488s11z", want: -1},
+		{name: "国士無双の聴牌", hand: "19m19p19s1234566z", want: 0},
+		{name: "国士無双十三面", hand: "19m19p19s1234567z", want: 0},
+		{name: "国士無双の1向聴", hand: "159m19p19s123456z", want: 1},
+		{name: "バラバラの手牌は七対子の6向聴", hand: "147m258p369s1234z", want: 6},
+		{name: "副露3つで単騎の聴牌", hand: "1z", melds: 4, want: 0},
+		{name: "副露3つで両面の聴牌", hand: "23s11z", melds: 3, want: 0},
+		{name: "副露があると七対子を数えない", hand: "1133m2266p48s", melds: 1, want: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Shanten(CountTiles(mustTiles(t, tt.hand)), tt.melds); got != tt.want {
+				t.Errorf("Shanten(%s, %d) = %d, want %d", tt.hand, tt.melds, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,422 @@
+package mahjong
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// シミュレーションの既定値と上限
+const (
+	DefaultSimulations = 500
+	MaxSimulations     = 5000
+	DefaultTurns       = 12
+)
+
+// SimulationOptions はモンテカルロ法の設定
+type SimulationOptions struct {
+	// Simulations は試行回数（0の場合はシミュレーションしない）
+	Simulations int
+	// Turns は残りの自分のツモ回数
+	Turns int
+	// Seed は乱数の種（同じ種と設定では同じ結果になる）
+	Seed uint64
+	// Policy は自分の打牌の方針（nil の場合は牌効率）
+	Policy DiscardPolicy
+	// Workers は並列に試行するゴルーチンの数（0 の場合は GOMAXPROCS）
+	// 試行ごとに乱数を作るため、並列数によらず結果は同じになる
+	Workers int
+}
+
+// Normalize は未指定・範囲外の値を既定値に補った設定を返す
+func (o SimulationOptions) Normalize() SimulationOptions {
+	if o.Simulations <= 0 {
+		o.Simulations = DefaultSimulations
+	}
+	o.Simulations = min(o.Simulations, MaxSimulations)
+	if o.Turns <= 0 {
+		o.Turns = DefaultTurns
+	}
+	if o.Policy == nil {
+		o.Policy = EfficiencyPolicy
+	}
+	if o.Workers <= 0 {
+		o.Workers = runtime.GOMAXPROCS(0)
+	}
+	return o
+}
+
+// DiscardPolicy はシミュレーションで自分の打牌を選ぶ方針
+type DiscardPolicy interface {
+	// Name は方針の名前
+	Name() string
+	// Discard は3n+2枚の門前の牌から打牌を選ぶ（drawn はツモった牌、配牌から打つ場合は -1）
+	// c は呼び出し元に戻す前提で一時的に書き換えてよい
+	Discard(c Counts, melds int, drawn Tile) Tile
+}
+
+// 組み込みの打牌の方針
+var (
+	// EfficiencyPolicy は向聴数が最小になる打牌のうち、他の牌とのつながりが最も弱い牌を選ぶ
+	EfficiencyPolicy DiscardPolicy = efficiencyPolicy{}
+	// TsumogiriPolicy はツモった牌をそのまま捨てる（配牌からは牌効率で打つ）
+	// 手牌を変えない場合の和了率・聴牌率の基準として使う
+	TsumogiriPolicy DiscardPolicy = tsumogiriPolicy{}
+)
+
+// discardPolicies は名前から引ける打牌の方針
+var discardPolicies = map[string]DiscardPolicy{
+	EfficiencyPolicy.Name(): EfficiencyPolicy,
+	TsumogiriPolicy.Name():  TsumogiriPolicy,
+}
+
+// LookupDiscardPolicy は名前から打牌の方針を返す
+func LookupDiscardPolicy(name string) (DiscardPolicy, bool) {
+	p, ok := discardPolicies[name]
+	return p, ok
+}
+
+// DiscardPolicyNames は打牌の方針の名前を返す
+func DiscardPolicyNames() []string {
+	names := make([]string, 0, len(discardPolicies))
+	for name := range discardPolicies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type efficiencyPolicy struct{}
+
+func (efficiencyPolicy) Name() string { return "efficiency" }
+
+func (efficiencyPolicy) Discard(c Counts, melds int, _ Tile) Tile {
+	return chooseDiscard(c, melds)
+}
+
+type tsumogiriPolicy struct{}
+
+func (tsumogiriPolicy) Name() string { return "tsumogiri" }
+
+func (tsumogiriPolicy) Discard(c Counts, melds int, drawn Tile) Tile {
+	if drawn < 0 {
+		return chooseDiscard(c, melds)
+	}
+	return drawn
+}
+
+// SimulationHand はシミュレーション開始時の自分の手牌
+// 打牌後の3n+1枚のほか、3n+2枚の場合は方針に従って打牌してから始める
+type SimulationHand struct {
+	Concealed Counts
+	Melds     []Meld
+	AkaDora   int
+	Discards  []Tile
+}
+
+// SimulationResult はモンテカルロ法の集計結果
+type SimulationResult struct {
+	Simulations int
+	// WinRate は和了した試行の割合
+	WinRate float64
+	// TsumoRate はツモ和了した試行の割合
+	TsumoRate float64
+	// TenpaiRate は流局時点を含め、いずれかの時点で聴牌した試行の割合
+	TenpaiRate float64
+	// AverageWinPoints は和了した試行の平均獲得点数
+	AverageWinPoints float64
+	// ExpectedValue はすべての試行の平均獲得点数（和了率 × 平均打点）
+	ExpectedValue float64
+	// ByTurn は自分のツモ1回目から Turns 回目までの各時点での累積の聴牌率・和了率・打点期待値
+	ByTurn []SimulationTurn
+}
+
+// SimulationTurn は自分の N 回目のツモ（と他家の捨て牌）を終えた時点までの累積の集計
+type SimulationTurn struct {
+	Turn          int
+	TenpaiRate    float64
+	WinRate       float64
+	ExpectedValue float64
+}
+
+// simulationOutcome は1回の試行の結果
+type simulationOutcome struct {
+	won    bool
+	tsumo  bool
+	tenpai bool
+	points int
+	// tenpaiTurn は初めて聴牌した自分のツモの回数（開始時点で聴牌の場合は0）
+	tenpaiTurn int
+	// winTurn は和了した自分のツモの回数（ロン和了はその巡の他家の捨て牌）
+	winTurn int
+}
+
+// SimulateHand は山からのツモと他家の捨て牌を無作為に再現し、和了率と打点を見積もる
+//
+// 各巡目で自分は1枚ツモって方針に従って打牌し、門前で聴牌したら立直する。
+// 他家の3枚の捨て牌も残りの牌から無作為に選び、待ちの牌が出ればロン和了とする。
+// 他家の和了や鳴き、押し引きは考慮しない簡易的なモデルである。
+func SimulateHand(hand SimulationHand, unseen Counts, ctx WinContext, opts SimulationOptions, rules RuleSet) SimulationResult {
+	// キャンセルされないため、エラーになることはない
+	result, _ := SimulateHandContext(context.Background(), hand, unseen, ctx, opts, rules)
+	return result
+}
+
+// SimulateHandContext は SimulateHand をキャンセル可能にしたもの
+// ctx がキャンセルされると残りの試行を打ち切り、ctx のエラーを返す
+func SimulateHandContext(ctx context.Context, hand SimulationHand, unseen Counts, winCtx WinContext, opts SimulationOptions, rules RuleSet) (SimulationResult, error) {
+	opts = opts.Normalize()
+	if size := hand.Concealed.Total() + 3*len(hand.Melds); size%3 == 0 {
+		return SimulationResult{}, fmt.Errorf("%w: simulation requires 13 or 14 tiles counting each meld as 3, got %d", entity.ErrInvalidHand, size)
+	}
+
+	wall := unseen.Tiles()
+	outcomes := make([]simulationOutcome, opts.Simulations)
+
+	workers := min(opts.Workers, opts.Simulations)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			shuffled := make([]Tile, len(wall))
+			for i := w; i < opts.Simulations; i += workers {
+				if ctx.Err() != nil {
+					return
+				}
+				// 試行ごとに乱数を作り、並列数によらず同じ結果にする
+				rng := rand.New(rand.NewPCG(opts.Seed, uint64(i)))
+				copy(shuffled, wall)
+				rng.Shuffle(len(shuffled), func(a, b int) { shuffled[a], shuffled[b] = shuffled[b], shuffled[a] })
+				outcomes[i] = simulateOnce(hand, shuffled, winCtx, opts, rules)
+			}
+		}(w)
+	}
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return SimulationResult{}, err
+	}
+	return summarizeOutcomes(outcomes, opts.Turns), nil
+}
+
+// Simulate は手牌（13枚または14枚）と見えている牌から SimulateHandContext を行う
+// visible は河・ドラ表示牌など手牌以外で見えている牌、discards は自分の捨て牌（フリテンの判定に使う）
+func Simulate(ctx context.Context, hand *Hand, visible, discards []Tile, winCtx WinContext, opts SimulationOptions, rules RuleSet) (SimulationResult, error) {
+	unseen := unseenCounts(hand.AllCounts(), visible, rules)
+	return SimulateHandContext(ctx, SimulationHand{
+		Concealed: hand.ConcealedCounts(),
+		Melds:     hand.Melds,
+		AkaDora:   hand.AkaDora,
+		Discards:  discards,
+	}, unseen, winCtx, opts, rules)
+}
+
+// summarizeOutcomes は試行の結果を全体と巡目ごとに集計する
+func summarizeOutcomes(outcomes []simulationOutcome, turns int) SimulationResult {
+	result := SimulationResult{Simulations: len(outcomes)}
+	if len(outcomes) == 0 {
+		return result
+	}
+	// 巡目ごとの件数を数え、累積して割合にする（添字0は開始時点）
+	tenpaiAt := make([]int, turns+1)
+	winAt := make([]int, turns+1)
+	pointsAt := make([]int, turns+1)
+	wins, tsumo, tenpai, points := 0, 0, 0, 0
+	for _, o := range outcomes {
+		if o.won {
+			wins++
+			points += o.points
+			winAt[o.winTurn]++
+			pointsAt[o.winTurn] += o.points
+		}
+		if o.tsumo {
+			tsumo++
+		}
+		if o.tenpai {
+			tenpai++
+			tenpaiAt[o.tenpaiTurn]++
+		}
+	}
+	n := float64(len(outcomes))
+	result.WinRate = float64(wins) / n
+	result.TsumoRate = float64(tsumo) / n
+	result.TenpaiRate = float64(tenpai) / n
+	result.ExpectedValue = float64(points) / n
+	if wins > 0 {
+		result.AverageWinPoints = float64(points) / float64(wins)
+	}
+
+	result.ByTurn = make([]SimulationTurn, 0, turns)
+	cumTenpai, cumWins, cumPoints := tenpaiAt[0], winAt[0], pointsAt[0]
+	for turn := 1; turn <= turns; turn++ {
+		cumTenpai += tenpaiAt[turn]
+		cumWins += winAt[turn]
+		cumPoints += pointsAt[turn]
+		result.ByTurn = append(result.ByTurn, SimulationTurn{
+			Turn:          turn,
+			TenpaiRate:    float64(cumTenpai) / n,
+			WinRate:       float64(cumWins) / n,
+			ExpectedValue: float64(cumPoints) / n,
+		})
+	}
+	return result
+}
+
+// simulateOnce は1回の試行を行う
+func simulateOnce(start SimulationHand, wall []Tile, ctx WinContext, opts SimulationOptions, rules RuleSet) simulationOutcome {
+	c := start.Concealed
+	melds := len(start.Melds)
+	closed := (&Hand{Melds: start.Melds}).IsClosed()
+	discarded := CountTiles(start.Discards)
+	riichi := ctx.Riichi || ctx.DoubleRiichi
+
+	var outcome simulationOutcome
+	var waits []Tile
+	turn := 0
+	updateWaits := func() {
+		waits = waits[:0]
+		if Shanten(c, melds) != 0 {
+			return
+		}
+		if !outcome.tenpai {
+			outcome.tenpai, outcome.tenpaiTurn = true, turn
+		}
+		for t := Tile(0); t < NumTileKinds; t++ {
+			if c[t] < 4 {
+				c[t]++
+				if Shanten(c, melds) == -1 {
+					waits = append(waits, t)
+				}
+				c[t]--
+			}
+		}
+	}
+	furiten := func() bool {
+		for _, t := range waits {
+			if discarded[t] > 0 {
+				return true
+			}
+		}
+		return false
+	}
+	win := func(t Tile, tsumo bool) bool {
+		winCtx := ctx
+		winCtx.WinTile, winCtx.Tsumo, winCtx.Riichi = t, tsumo, riichi
+		hand := &Hand{Concealed: c.Tiles(), Melds: start.Melds, AkaDora: start.AkaDora}
+		agari, err := EvaluateWin(hand, winCtx, rules)
+		if err != nil || !agari.HasYaku() {
+			return false
+		}
+		outcome.won, outcome.tsumo, outcome.winTurn = true, tsumo, turn
+		outcome.points = agari.Score.Ron
+		if tsumo {
+			outcome.points = agari.Score.TsumoTotal
+		}
+		return true
+	}
+
+	// 3n+2枚から始める場合は先に打牌する
+	if c.Total()%3 == 2 {
+		discard := opts.Policy.Discard(c, melds, -1)
+		c[discard]--
+		discarded[discard]++
+		updateWaits()
+		if closed && !riichi && len(waits) > 0 {
+			riichi = true
+		}
+	} else {
+		updateWaits()
+	}
+	pos := 0
+	for turn = 1; turn <= opts.Turns && pos < len(wall); turn++ {
+		// 自分のツモ
+		draw := wall[pos]
+		pos++
+		c[draw]++
+		if Shanten(c, melds) == -1 && win(draw, true) {
+			return outcome
+		}
+
+		discard := draw
+		if !riichi {
+			discard = opts.Policy.Discard(c, melds, draw)
+		}
+		c[discard]--
+		discarded[discard]++
+		updateWaits()
+		if closed && !riichi && len(waits) > 0 {
+			riichi = true
+		}
+
+		// 他家の捨て牌
+		for k := 0; k < rules.Players-1 && pos < len(wall); k++ {
+			t := wall[pos]
+			pos++
+			if len(waits) == 0 || furiten() {
+				continue
+			}
+			for _, w := range waits {
+				if w != t {
+					continue
+				}
+				c[t]++
+				won := win(t, false)
+				c[t]--
+				if won {
+					return outcome
+				}
+			}
+		}
+	}
+	return outcome
+}
+
+// chooseDiscard は向聴数が最小になる打牌のうち、他の牌とのつながりが最も弱い牌を選ぶ
+func chooseDiscard(c Counts, melds int) Tile {
+	best, bestShanten, bestValue := Tile(-1), 99, 0
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if c[t] == 0 {
+			continue
+		}
+		c[t]--
+		s := Shanten(c, melds)
+		c[t]++
+		value := connectivity(c, t)
+		if s < bestShanten || (s == bestShanten && value < bestValue) {
+			best, bestShanten, bestValue = t, s, value
+		}
+	}
+	return best
+}
+
+// connectivity は牌が手牌の他の牌とどれだけつながっているかの目安を返す
+func connectivity(c Counts, t Tile) int {
+	value := (c[t] - 1) * 4
+	if t.IsHonor() {
+		return value
+	}
+	for d := -2; d <= 2; d++ {
+		if d == 0 {
+			continue
+		}
+		n := t.Number() + d
+		if n < 1 || n > 9 {
+			continue
+		}
+		weight := 1
+		if d == -1 || d == 1 {
+			weight = 2
+		}
+		value += c[t+Tile(d)] * weight
+	}
+	// 端の牌は順子になりにくい
+	if t.IsTerminal() {
+		value--
+	}
+	return value
+}
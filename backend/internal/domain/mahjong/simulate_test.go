@@ -0,0 +1,63 @@
+package mahjong
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// simulationInput は1向聴の13枚の手牌と山に残る牌を返す
+func simulationInput(t testing.TB) (SimulationHand, Counts) {
+	c := CountTiles(mustTiles(t, "23m456p78s234s11z9m"))
+	return SimulationHand{Concealed: c}, unseenCounts(c, nil, RuleSetTenhou)
+}
+
+func TestSimulateHandDeterministic(t *testing.T) {
+	hand, unseen := simulationInput(t)
+	ctx := WinContext{SeatWind: South, RoundWind: East}
+	base := SimulationOptions{Simulations: 200, Seed: 42}
+
+	var want SimulationResult
+	for i, workers := range []int{1, 2, 3, 8} {
+		opts := base
+		opts.Workers = workers
+		got := SimulateHand(hand, unseen, ctx, opts, RuleSetTenhou)
+		if got.Simulations != base.Simulations || got.WinRate <= 0 || len(got.ByTurn) != DefaultTurns {
+			t.Fatalf("workers %d: unexpected result %+v", workers, got)
+		}
+		if i == 0 {
+			want = got
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("workers %d: result %+v differs from workers 1: %+v", workers, got, want)
+		}
+	}
+
+	other := base
+	other.Seed = 43
+	if got := SimulateHand(hand, unseen, ctx, other, RuleSetTenhou); reflect.DeepEqual(got, want) {
+		t.Error("a different seed gave the same result")
+	}
+}
+
+func TestSimulateHandContextCanceled(t *testing.T) {
+	hand, unseen := simulationInput(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := SimulateHandContext(ctx, hand, unseen, WinContext{SeatWind: South, RoundWind: East}, SimulationOptions{Simulations: 100}, RuleSetTenhou)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("SimulateHandContext error = %v, want %v", err, context.Canceled)
+	}
+}
+
+func BenchmarkSimulateHand(b *testing.B) {
+	hand, unseen := simulationInput(b)
+	ctx := WinContext{SeatWind: South, RoundWind: East}
+	opts := SimulationOptions{Simulations: DefaultSimulations, Seed: 1}
+	b.ResetTimer()
+	for range b.N {
+		SimulateHand(hand, unseen, ctx, opts, RuleSetTenhou)
+	}
+}
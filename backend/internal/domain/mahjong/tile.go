@@ -0,0 +1,213 @@
+package mahjong
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// Tile は牌の種類を表す（0-8: 萬子, 9-17: 筒子, 18-26: 索子, 27-33: 東南西北白發中）
+type Tile int
+
+// NumTileKinds は牌の種類の数
+const NumTileKinds = 34
+
+// 字牌
+const (
+	East  Tile = 27
+	South Tile = 28
+	West  Tile = 29
+	North Tile = 30
+	White Tile = 31 // 白
+	Green Tile = 32 // 發
+	Red   Tile = 33 // 中
+)
+
+// Suit は牌の種類（萬子・筒子・索子・字牌）
+type Suit int
+
+const (
+	SuitMan Suit = iota
+	SuitPin
+	SuitSou
+	SuitHonor
+)
+
+// suitLetters はMPSZ表記の種類の文字
+const suitLetters = "mpsz"
+
+// NewTile は種類と数字（1-9、字牌は1-7）から牌を作成する
+func NewTile(suit Suit, number int) Tile {
+	return Tile(int(suit)*9 + number - 1)
+}
+
+// Suit は牌の種類を返す
+func (t Tile) Suit() Suit {
+	return Suit(t / 9)
+}
+
+// Number は牌の数字（1-9、字牌は1-7）を返す
+func (t Tile) Number() int {
+	return int(t)%9 + 1
+}
+
+// IsHonor は字牌かを返す
+func (t Tile) IsHonor() bool {
+	return t >= East
+}
+
+// IsWind は風牌かを返す
+func (t Tile) IsWind() bool {
+	return t >= East && t <= North
+}
+
+// IsDragon は三元牌かを返す
+func (t Tile) IsDragon() bool {
+	return t >= White
+}
+
+// IsTerminal は老頭牌（数牌の1・9）かを返す
+func (t Tile) IsTerminal() bool {
+	return !t.IsHonor() && (t.Number() == 1 || t.Number() == 9)
+}
+
+// IsYaochu は么九牌（老頭牌・字牌）かを返す
+func (t Tile) IsYaochu() bool {
+	return t.IsHonor() || t.IsTerminal()
+}
+
+// IsValid は有効な牌かを返す
+func (t Tile) IsValid() bool {
+	return t >= 0 && t < NumTileKinds
+}
+
+// DoraIndicated はこの牌がドラ表示牌のときのドラを返す
+func (t Tile) DoraIndicated() Tile {
+	switch {
+	case t.IsWind():
+		return East + (t-East+1)%4
+	case t.IsDragon():
+		return White + (t-White+1)%3
+	default:
+		return t - Tile(t.Number()-1) + Tile(t.Number()%9)
+	}
+}
+
+// String は牌をMPSZ表記（例: 5m, 7z）で返す
+func (t Tile) String() string {
+	if !t.IsValid() {
+		return "?"
+	}
+	return fmt.Sprintf("%d%c", t.Number(), suitLetters[t.Suit()])
+}
+
+// Counts は牌の種類ごとの枚数
+type Counts [NumTileKinds]int
+
+// CountTiles は牌の枚数を数える
+func CountTiles(tiles []Tile) Counts {
+	var c Counts
+	for _, t := range tiles {
+		c[t]++
+	}
+	return c
+}
+
+// Total は牌の合計枚数を返す
+func (c Counts) Total() int {
+	n := 0
+	for _, v := range c {
+		n += v
+	}
+	return n
+}
+
+// Tiles は枚数を牌の並びに展開する
+func (c Counts) Tiles() []Tile {
+	tiles := make([]Tile, 0, 14)
+	for t, n := range c {
+		for i := 0; i < n; i++ {
+			tiles = append(tiles, Tile(t))
+		}
+	}
+	return tiles
+}
+
+// maxRedFivesPerSuit は各色の赤5の最大枚数（定義済みのルールセットはいずれも各色1枚まで）
+const maxRedFivesPerSuit = 1
+
+// ParseTiles はMPSZ表記（例: 123m406p789s11z）の牌を解析する
+// 0 は赤5として扱い、赤ドラの枚数を返す
+func ParseTiles(s string) ([]Tile, int, error) {
+	var tiles []Tile
+	var numbers []int
+	red := 0
+	var redBySuit [SuitHonor]int
+	for _, r := range strings.ReplaceAll(s, " ", "") {
+		switch {
+		case r >= '0' && r <= '9':
+			numbers = append(numbers, int(r-'0'))
+		case strings.ContainsRune(suitLetters, r):
+			if len(numbers) == 0 {
+				return nil, 0, fmt.Errorf("%w: %q has suit %q without numbers", entity.ErrInvalidTileNotation, s, r)
+			}
+			suit := Suit(strings.IndexRune(suitLetters, r))
+			for _, n := range numbers {
+				if n == 0 {
+					if suit == SuitHonor {
+						return nil, 0, fmt.Errorf("%w: %q has red honor tile", entity.ErrInvalidTileNotation, s)
+					}
+					redBySuit[suit]++
+					if redBySuit[suit] > maxRedFivesPerSuit {
+						return nil, 0, fmt.Errorf("%w: %q has more than %d red 5%c", entity.ErrInvalidHand, s, maxRedFivesPerSuit, r)
+					}
+					red++
+					n = 5
+				}
+				if suit == SuitHonor && n > 7 {
+					return nil, 0, fmt.Errorf("%w: %q has honor tile %dz", entity.ErrInvalidTileNotation, s, n)
+				}
+				tiles = append(tiles, NewTile(suit, n))
+			}
+			numbers = numbers[:0]
+		default:
+			return nil, 0, fmt.Errorf("%w: %q has unexpected character %q", entity.ErrInvalidTileNotation, s, r)
+		}
+	}
+	if len(numbers) > 0 {
+		return nil, 0, fmt.Errorf("%w: %q ends without suit", entity.ErrInvalidTileNotation, s)
+	}
+	return tiles, red, nil
+}
+
+// ParseTile は1枚の牌を解析する
+func ParseTile(s string) (Tile, error) {
+	tiles, _, err := ParseTiles(s)
+	if err != nil {
+		return 0, err
+	}
+	if len(tiles) != 1 {
+		return 0, fmt.Errorf("%w: %q is not a single tile", entity.ErrInvalidTileNotation, s)
+	}
+	return tiles[0], nil
+}
+
+// FormatTiles は牌を種類ごとにまとめたMPSZ表記（例: 123m456p11z）で返す
+func FormatTiles(tiles []Tile) string {
+	c := CountTiles(tiles)
+	var b strings.Builder
+	for suit := SuitMan; suit <= SuitHonor; suit++ {
+		wrote := false
+		for t := Tile(suit * 9); t < Tile(suit*9+9) && t < NumTileKinds; t++ {
+			for i := 0; i < c[t]; i++ {
+				b.WriteByte(byte('0' + t.Number()))
+				wrote = true
+			}
+		}
+		if wrote {
+			b.WriteByte(suitLetters[suit])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,68 @@
+package mahjong
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// mustTiles はテスト用にMPSZ表記の牌を解析する
+func mustTiles(t testing.TB, s string) []Tile {
+	t.Helper()
+	tiles, _, err := ParseTiles(s)
+	if err != nil {
+		t.Fatalf("ParseTiles(%q): %v", s, err)
+	}
+	return tiles
+}
+
+// mustHand はテスト用にMPSZ表記の門前の牌から手牌を作る
+func mustHand(t *testing.T, s string, melds ...Meld) *Hand {
+	t.Helper()
+	hand, err := ParseHand(s, melds)
+	if err != nil {
+		t.Fatalf("ParseHand(%q): %v", s, err)
+	}
+	return hand
+}
+
+func TestParseTiles(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+		red  int
+	}{
+		{in: "123m456p789s1234567z", want: "123m456p789s1234567z"},
+		{in: "0m05p", want: "5m55p", red: 2},
+		{in: "0m0p0s", want: "5m5p5s", red: 3},
+		{in: "321m", want: "123m"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			tiles, red, err := ParseTiles(tt.in)
+			if err != nil {
+				t.Fatalf("ParseTiles: %v", err)
+			}
+			if got := FormatTiles(tiles); got != tt.want || red != tt.red {
+				t.Errorf("ParseTiles(%q) = %s (red %d), want %s (red %d)", tt.in, got, red, tt.want, tt.red)
+			}
+		})
+	}
+}
+
+func TestParseTilesInvalid(t *testing.T) {
+	for _, in := range []string{"123", "8z", "12x", "m"} {
+		if _, _, err := ParseTiles(in); !errors.Is(err, entity.ErrInvalidTileNotation) {
+			t.Errorf("ParseTiles(%q) error = %v, want ErrInvalidTileNotation", in, err)
+		}
+	}
+}
+
+func TestParseTilesTooManyRedFives(t *testing.T) {
+	for _, in := range []string{"00m", "0p123p0p", "406s0s"} {
+		if _, _, err := ParseTiles(in); !errors.Is(err, entity.ErrInvalidHand) {
+			t.Errorf("ParseTiles(%q) error = %v, want ErrInvalidHand", in, err)
+		}
+	}
+}
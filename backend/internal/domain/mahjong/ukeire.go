@@ -0,0 +1,81 @@
+package mahjong
+
+// UkeireTile は向聴数を進める牌とその残り枚数
+type UkeireTile struct {
+	Tile      Tile
+	Remaining int
+}
+
+// Ukeire は受け入れ（向聴数を進める牌）の一覧
+type Ukeire struct {
+	Tiles []UkeireTile
+	// Total は受け入れの残り枚数の合計
+	Total int
+}
+
+// unseenCounts は自分の手牌と見えている牌を除いた残り枚数を返す
+// 三人麻雀では2萬から8萬を除く
+func unseenCounts(hand Counts, visible []Tile, rules RuleSet) Counts {
+	var unseen Counts
+	seen := CountTiles(visible)
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if rules.IsSanma() && t.Suit() == SuitMan && t.Number() > 1 && t.Number() < 9 {
+			continue
+		}
+		unseen[t] = max(0, 4-hand[t]-seen[t])
+	}
+	return unseen
+}
+
+// CalculateUkeire は3n+1枚の門前の牌の受け入れを返す
+// unseen は牌ごとの残り枚数で、残りのない牌は受け入れに含めない
+func CalculateUkeire(c Counts, melds int, unseen Counts) Ukeire {
+	current := Shanten(c, melds)
+	var u Ukeire
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if unseen[t] == 0 {
+			continue
+		}
+		c[t]++
+		if Shanten(c, melds) < current {
+			u.Tiles = append(u.Tiles, UkeireTile{Tile: t, Remaining: unseen[t]})
+			u.Total += unseen[t]
+		}
+		c[t]--
+	}
+	return u
+}
+
+// bestDiscardUkeire は3n+2枚の門前の牌から向聴数を保つ打牌のうち、受け入れが最大になるものの受け入れ枚数を返す
+func bestDiscardUkeire(c Counts, melds int, shanten int, unseen Counts) int {
+	best := -1
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if c[t] == 0 {
+			continue
+		}
+		c[t]--
+		if Shanten(c, melds) == shanten {
+			best = max(best, CalculateUkeire(c, melds, unseen).Total)
+		}
+		c[t]++
+	}
+	return best
+}
+
+// bestTenpaiWaits は3n+2枚の門前の牌から聴牌を取る打牌のうち、待ちの種類が最も多いものの種類数と残り枚数を返す
+func bestTenpaiWaits(c Counts, melds int, unseen Counts) (kinds int, remaining int) {
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if c[t] == 0 {
+			continue
+		}
+		c[t]--
+		if Shanten(c, melds) == 0 {
+			u := CalculateUkeire(c, melds, unseen)
+			if len(u.Tiles) > kinds || (len(u.Tiles) == kinds && u.Total > remaining) {
+				kinds, remaining = len(u.Tiles), u.Total
+			}
+		}
+		c[t]++
+	}
+	return kinds, remaining
+}
@@ -0,0 +1,43 @@
+package mahjong
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCalculateUkeire(t *testing.T) {
+	tests := []struct {
+		name    string
+		hand    string
+		visible string
+		rules   RuleSet
+		tiles   string
+		total   int
+	}{
+		{name: "両面", hand: "123m456p789s23s11z", rules: RuleSetTenhou, tiles: "14s", total: 8},
+		{name: "見えている牌を除く", hand: "123m456p789s23s11z", visible: "1s1s4s", rules: RuleSetTenhou, tiles: "14s", total: 5},
+		{name: "延べ単は手牌の枚数を除く", hand: "123m456p789s2345s", rules: RuleSetTenhou, tiles: "25s", total: 6},
+		{name: "三人麻雀では4萬を数えない", hand: "23m456p789s123s11z", rules: RuleSetSanma, tiles: "1m", total: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := mustHand(t, tt.hand)
+			var visible []Tile
+			if tt.visible != "" {
+				visible = mustTiles(t, tt.visible)
+			}
+			u := CalculateUkeire(hand.ConcealedCounts(), 0, unseenCounts(hand.AllCounts(), visible, tt.rules))
+			got := make([]Tile, 0, len(u.Tiles))
+			for _, ut := range u.Tiles {
+				got = append(got, ut.Tile)
+			}
+			var want []Tile
+			if tt.tiles != "" {
+				want = mustTiles(t, tt.tiles)
+			}
+			if !slices.Equal(got, want) || u.Total != tt.total {
+				t.Errorf("CalculateUkeire(%s) = %s (%d), want %s (%d)", tt.hand, FormatTiles(got), u.Total, tt.tiles, tt.total)
+			}
+		})
+	}
+}
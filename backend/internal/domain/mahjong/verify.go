@@ -0,0 +1,312 @@
+package mahjong
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ClaimKind は回答に含まれる主張の種類
+type ClaimKind int
+
+const (
+	// ClaimShanten は手牌の向聴数の主張
+	ClaimShanten ClaimKind = iota + 1
+	// ClaimWaits は聴牌形の待ちの主張
+	ClaimWaits
+	// ClaimScore は翻・符と点数の対応の主張
+	ClaimScore
+)
+
+// String は主張の種類の名前を返す
+func (k ClaimKind) String() string {
+	switch k {
+	case ClaimShanten:
+		return "shanten"
+	case ClaimWaits:
+		return "waits"
+	case ClaimScore:
+		return "score"
+	default:
+		return ""
+	}
+}
+
+// Claim は回答から取り出した主張をエンジンで計算し直した結果
+type Claim struct {
+	Kind ClaimKind
+	// Hand は主張の対象の門前の牌（点数の主張では nil）
+	Hand []Tile
+	// Text は回答中の主張の表現
+	Text string
+	// Actual はエンジンで計算した正しい値
+	Actual  string
+	Correct bool
+}
+
+// Correction は誤った主張を訂正する文を返す
+func (c Claim) Correction() string {
+	switch c.Kind {
+	case ClaimShanten, ClaimWaits:
+		return fmt.Sprintf("手牌 %s は「%s」ではなく、%s", FormatTiles(c.Hand), c.Text, c.Actual)
+	default:
+		return fmt.Sprintf("「%s」は誤りで、%s", c.Text, c.Actual)
+	}
+}
+
+var (
+	// handPattern は文章中のMPSZ表記の牌（手牌かどうかは枚数で判定する）
+	handPattern = regexp.MustCompile(`(?:[0-9]+[mpsz])+`)
+	// shantenPattern は「1向聴」「イーシャンテン」などの向聴数の表現
+	shantenPattern = regexp.MustCompile(`([0-9０-９一二三四五六]|イー|リャン|サン|スー)\s*(?:向聴|シャンテン)`)
+	// tenpaiPattern は聴牌していることを述べる表現
+	tenpaiPattern = regexp.MustCompile(`(?:聴牌|テンパイ)(?:です|しています|している|しており|の状態)`)
+	// waitsPattern は「3-6pの両面待ち」「1zと2zのシャンポン待ち」などの待ちの表現
+	waitsPattern = regexp.MustCompile(`((?:[0-9](?:[-・][0-9])*[mpsz]\s*(?:と|・|、|,|/|や)?\s*)+)\s*の?\s*(?:両面|リャンメン|嵌張|カンチャン|辺張|ペンチャン|単騎|タンキ|シャンポン|双碰|多面|ノベタン|変則)?\s*待ち`)
+	// waitTilePattern は待ちの表現の中の牌（3-6p は 36p として扱う）
+	waitTilePattern = regexp.MustCompile(`[0-9](?:[-・][0-9])*[mpsz]`)
+	// hanFuPattern は「30符4翻」「4翻30符」の表現
+	hanFuPattern = regexp.MustCompile(`([0-9]+)\s*(?:符|フ)\s*([0-9]+)\s*(?:翻|飜|ハン)|([0-9]+)\s*(?:翻|飜|ハン)\s*([0-9]+)\s*(?:符|フ)`)
+	// pointsPattern は翻・符に続く「3900オール」「2000-3900」「7700点」の表現
+	pointsPattern = regexp.MustCompile(`([0-9][0-9,]*)\s*(?:点\s*)?オール|([0-9][0-9,]*)\s*[-－]\s*([0-9][0-9,]*)|([0-9][0-9,]*)\s*点`)
+)
+
+// conditionalWords は打牌・ツモ・鳴きの後の手牌について述べている文の目安
+// 手牌そのものの主張ではないため、向聴数と待ちを検証しない
+var conditionalWords = []string{"切", "打", "捨", "引", "ツモ", "鳴", "ポン", "チー", "カン", "入れ", "来", "なら", "れば", "たら"}
+
+// waitShapeWords は conditionalWords を含む待ちの形の名前（「シャンポン」の「ポン」など）
+var waitShapeWords = strings.NewReplacer("シャンポン", "", "カンチャン", "", "ペンチャン", "", "リャンメン", "")
+
+// negationSuffixes は主張を打ち消す続きの表現（「2向聴ではなく」など）
+var negationSuffixes = []string{"ではなく", "じゃなく", "ではありません", "ではない", "でなく"}
+
+// kanjiNumbers は向聴数の表現の数字
+var kanjiNumbers = map[string]int{
+	"一": 1, "二": 2, "三": 3, "四": 4, "五": 5, "六": 6,
+	"イー": 1, "リャン": 2, "サン": 3, "スー": 4,
+}
+
+// VerifyAnswer は回答の文章から手牌と向聴数・待ち・点数の主張を取り出し、エンジンで計算し直して検証する
+//
+// 向聴数と待ちは、同じ文か前の文に書かれた13枚・14枚の手牌についての主張として扱う。
+// hand は手牌が書かれる前の主張に使う手牌（nil の場合は文章中の手牌のみ）。
+// 打牌やツモの後の手牌について述べている文は検証しない。点数は翻・符と点数の対応のみを検証する。
+func VerifyAnswer(text string, hand *Hand, rules RuleSet) []Claim {
+	var claims []Claim
+	seen := map[string]bool{}
+	add := func(c Claim) {
+		key := fmt.Sprintf("%d/%s/%s", c.Kind, FormatTiles(c.Hand), c.Text)
+		if !seen[key] {
+			seen[key] = true
+			claims = append(claims, c)
+		}
+	}
+
+	current := hand
+	sentences := strings.FieldsFunc(text, func(r rune) bool {
+		return r == '。' || r == '\n' || r == '！' || r == '？' || r == '!' || r == '?'
+	})
+	for _, sentence := range sentences {
+		// 手牌の表記を取り除き、残りの文から主張を探す
+		rest := handPattern.ReplaceAllStringFunc(sentence, func(s string) string {
+			if h := parseClaimHand(s); h != nil {
+				current = h
+				return "＿"
+			}
+			return s
+		})
+
+		for _, c := range scoreClaims(rest, rules) {
+			add(c)
+		}
+		if current == nil || containsAny(waitShapeWords.Replace(rest), conditionalWords) {
+			continue
+		}
+		if c, ok := shantenClaim(rest, current); ok {
+			add(c)
+		}
+		if c, ok := waitsClaim(rest, current, rules); ok {
+			add(c)
+		}
+	}
+	return claims
+}
+
+// parseClaimHand は13枚・14枚の副露のない手牌として解析できる場合に手牌を返す
+func parseClaimHand(s string) *Hand {
+	tiles, red, err := ParseTiles(s)
+	if err != nil || (len(tiles) != 13 && len(tiles) != 14) {
+		return nil
+	}
+	hand, err := NewHand(tiles, nil, red)
+	if err != nil {
+		return nil
+	}
+	return hand
+}
+
+// shantenClaim は文中の向聴数の主張を検証する
+func shantenClaim(s string, hand *Hand) (Claim, bool) {
+	claimed, text := -1, ""
+	if m := shantenPattern.FindStringSubmatchIndex(s); m != nil && !negated(s, m[1]) {
+		claimed, text = claimNumber(s[m[2]:m[3]]), s[m[0]:m[1]]
+	} else if m := tenpaiPattern.FindStringIndex(s); m != nil && !negated(s, m[1]) {
+		claimed, text = 0, s[m[0]:m[1]]
+	}
+	if claimed < 0 {
+		return Claim{}, false
+	}
+	actual := Shanten(hand.ConcealedCounts(), len(hand.Melds))
+	return Claim{
+		Kind:    ClaimShanten,
+		Hand:    hand.Concealed,
+		Text:    text,
+		Actual:  shantenName(actual),
+		Correct: claimed == actual,
+	}, true
+}
+
+// waitsClaim は文中の待ちの主張を検証する（13枚の手牌のみ）
+// 待ちは AnalyzeWaits と同じく、自分で4枚使っている牌を和了牌に含めない
+func waitsClaim(s string, hand *Hand, rules RuleSet) (Claim, bool) {
+	if len(hand.Concealed)%3 != 1 {
+		return Claim{}, false
+	}
+	m := waitsPattern.FindStringSubmatchIndex(s)
+	if m == nil || negated(s, m[1]) {
+		return Claim{}, false
+	}
+	var claimed Counts
+	for _, token := range waitTilePattern.FindAllString(s[m[2]:m[3]], -1) {
+		tiles, _, err := ParseTiles(strings.NewReplacer("-", "", "・", "").Replace(token))
+		if err != nil {
+			return Claim{}, false
+		}
+		for _, t := range tiles {
+			claimed[t] = 1
+		}
+	}
+
+	c := hand.ConcealedCounts()
+	melds := len(hand.Melds)
+	claim := Claim{Kind: ClaimWaits, Hand: hand.Concealed, Text: strings.TrimSpace(s[m[0]:m[1]])}
+	if shanten := Shanten(c, melds); shanten > 0 {
+		claim.Actual = shantenName(shanten) + "で待ちはありません"
+		return claim, true
+	}
+	// 役の有無は待ちに関係しないため、場況は子の東場とする
+	analysis, err := AnalyzeWaits(hand, WinContext{SeatWind: South, RoundWind: East}, nil, rules)
+	if err != nil {
+		return Claim{}, false
+	}
+	var actual Counts
+	waits := analysis.WaitTiles()
+	for _, t := range waits {
+		actual[t] = 1
+	}
+	claim.Actual = fmt.Sprintf("待ちは %s です", strings.Join(tileStrings(waits), " "))
+	if len(waits) == 0 {
+		// 待ちの牌をすべて自分で使っている形式聴牌
+		claim.Actual = "待ちの牌をすべて手牌で使っており、和了牌はありません"
+	}
+	claim.Correct = claimed == actual
+	return claim, true
+}
+
+// scoreClaims は文中の翻・符とそれに続く点数の対応を検証する
+// 親子が書かれていないことが多いため、親・子のいずれかの点数と一致すれば正しいとする
+func scoreClaims(s string, rules RuleSet) []Claim {
+	var claims []Claim
+	for _, m := range hanFuPattern.FindAllStringSubmatchIndex(s, -1) {
+		var fu, han int
+		if m[2] >= 0 {
+			fu, han = atoi(s[m[2]:m[3]]), atoi(s[m[4]:m[5]])
+		} else {
+			han, fu = atoi(s[m[6]:m[7]]), atoi(s[m[8]:m[9]])
+		}
+		if han < 1 || han > 13 || fu < 20 || fu > 110 {
+			continue
+		}
+		// 翻・符の直後の点数のみを対応とみなす
+		after := s[m[1]:]
+		p := pointsPattern.FindStringSubmatchIndex(after)
+		if p == nil || len(strings.TrimSpace(after[:p[0]])) > 24 || negated(after, p[1]) {
+			continue
+		}
+		text := strings.TrimSpace(s[m[0] : m[1]+p[1]])
+
+		nonDealer := CalculateScore(han, fu, 0, false, rules)
+		dealer := CalculateScore(han, fu, 0, true, rules)
+		var correct bool
+		switch {
+		case p[2] >= 0:
+			correct = atoi(after[p[2]:p[3]]) == dealer.TsumoFromNonDealer
+		case p[4] >= 0:
+			a, b := atoi(after[p[4]:p[5]]), atoi(after[p[6]:p[7]])
+			correct = a == nonDealer.TsumoFromNonDealer && b == nonDealer.TsumoFromDealer
+		default:
+			points := atoi(after[p[8]:p[9]])
+			correct = points == nonDealer.Ron || points == dealer.Ron || points == nonDealer.TsumoTotal || points == dealer.TsumoTotal
+		}
+		claims = append(claims, Claim{
+			Kind:    ClaimScore,
+			Text:    text,
+			Actual:  fmt.Sprintf("子は%s、親は%sです", nonDealer, dealer),
+			Correct: correct,
+		})
+	}
+	return claims
+}
+
+// shantenName は向聴数を「聴牌」「1向聴」のような表現にする
+func shantenName(shanten int) string {
+	switch shanten {
+	case -1:
+		return "和了形です"
+	case 0:
+		return "聴牌です"
+	default:
+		return fmt.Sprintf("%d向聴です", shanten)
+	}
+}
+
+// claimNumber は向聴数の表現の数字を返す
+func claimNumber(s string) int {
+	if n, ok := kanjiNumbers[s]; ok {
+		return n
+	}
+	r := []rune(s)[0]
+	if r >= '０' && r <= '９' {
+		return int(r - '０')
+	}
+	return int(r - '0')
+}
+
+// negated は位置 end の直後に主張を打ち消す表現が続くかを返す
+func negated(s string, end int) bool {
+	rest := strings.TrimLeft(s[end:], " 　")
+	for _, suffix := range negationSuffixes {
+		if strings.HasPrefix(rest, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsAny は s がいずれかの語を含むかを返す
+func containsAny(s string, words []string) bool {
+	for _, w := range words {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// atoi は桁区切りのカンマを含む数字を整数にする（解析できない場合は0）
+func atoi(s string) int {
+	n, _ := strconv.Atoi(strings.ReplaceAll(s, ",", ""))
+	return n
+}
@@ -0,0 +1,58 @@
+package mahjong
+
+import "testing"
+
+func TestVerifyAnswer(t *testing.T) {
+	type claim struct {
+		kind    ClaimKind
+		correct bool
+	}
+	tests := []struct {
+		name  string
+		text  string
+		rules RuleSet
+		want  []claim
+	}{
+		{name: "聴牌の主張", text: "手牌 123m456p789s23s11z は聴牌しています。", want: []claim{{ClaimShanten, true}}},
+		{name: "誤った向聴数", text: "123m456p789s23s11z は1向聴です。", want: []claim{{ClaimShanten, false}}},
+		{name: "カタカナの向聴数", text: "123m456p78s23s11z9m はイーシャンテンです。", want: []claim{{ClaimShanten, true}}},
+		{name: "打ち消した向聴数", text: "123m456p789s23s11z は1向聴ではなく聴牌です。", want: []claim{{ClaimShanten, true}}},
+		{name: "正しい両面待ち", text: "123m456p789s23s11z は1-4sの両面待ちです。", want: []claim{{ClaimWaits, true}}},
+		{name: "誤った両面待ち", text: "123m456p789s23s11z は2-5sの両面待ちです。", want: []claim{{ClaimWaits, false}}},
+		{name: "前の文の手牌への主張", text: "手牌は 123m456p789s23s11z。1sと4sの待ちです。", want: []claim{{ClaimWaits, true}}},
+		{name: "4枚使っている牌は待ちに含めない", text: "1111m234m456p789s は1-4mの待ちです。", want: []claim{{ClaimWaits, false}}},
+		{name: "4枚使っている牌を除いた単騎待ち", text: "1111m234m456p789s は4mの単騎待ちです。", want: []claim{{ClaimWaits, true}}},
+		{name: "打牌後の手牌は検証しない", text: "123m456p789s23s11z から1zを切れば1向聴です。"},
+		{name: "子のロンの点数", text: "30符4翻は7700点です。", want: []claim{{ClaimScore, true}}},
+		{name: "翻と符の順が逆", text: "4翻30符で2000-3900です。", want: []claim{{ClaimScore, true}}},
+		{name: "親のツモの点数", text: "30符4翻は3900オールです。", want: []claim{{ClaimScore, true}}},
+		{name: "誤った点数", text: "30符3翻は5000点です。", want: []claim{{ClaimScore, false}}},
+		{name: "切り上げ満貫のないルールの8000点", text: "30符4翻は8000点です。", want: []claim{{ClaimScore, false}}},
+		{name: "切り上げ満貫のあるルールの8000点", text: "30符4翻は8000点です。", rules: RuleSetWRC, want: []claim{{ClaimScore, true}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rules := tt.rules
+			if rules.Name == "" {
+				rules = RuleSetTenhou
+			}
+			got := VerifyAnswer(tt.text, nil, rules)
+			if len(got) != len(tt.want) {
+				t.Fatalf("VerifyAnswer(%q) = %+v, want %d claims", tt.text, got, len(tt.want))
+			}
+			for i, w := range tt.want {
+				if got[i].Kind != w.kind || got[i].Correct != w.correct {
+					t.Errorf("claim %d = %s correct %v (%q, %s), want %s correct %v", i, got[i].Kind, got[i].Correct, got[i].Text, got[i].Actual, w.kind, w.correct)
+				}
+			}
+		})
+	}
+}
+
+func TestVerifyAnswerUsesGivenHand(t *testing.T) {
+	// 文章に手牌がない場合は与えた手牌への主張として扱う
+	claims := VerifyAnswer("この手は1向聴です。", mustHand(t, "123m456p789s23s11z"), RuleSetTenhou)
+	if len(claims) != 1 || claims[0].Correct || claims[0].Actual != "聴牌です" {
+		t.Errorf("VerifyAnswer = %+v, want an incorrect shanten claim", claims)
+	}
+}
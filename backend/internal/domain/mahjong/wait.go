@@ -0,0 +1,183 @@
+package mahjong
+
+import (
+	"fmt"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// WaitShape は待ちの形
+type WaitShape int
+
+const (
+	WaitUnknown    WaitShape = iota
+	WaitRyanmen              // 両面
+	WaitKanchan              // 嵌張
+	WaitPenchan              // 辺張
+	WaitShanpon              // 双碰
+	WaitTanki                // 単騎
+	WaitNobetan              // 延べ単
+	WaitMultiSided           // 多面張（3種以上、または複数の形の複合）
+)
+
+// String は待ちの形の名前を返す
+func (w WaitShape) String() string {
+	switch w {
+	case WaitRyanmen:
+		return "両面"
+	case WaitKanchan:
+		return "嵌張"
+	case WaitPenchan:
+		return "辺張"
+	case WaitShanpon:
+		return "双碰"
+	case WaitTanki:
+		return "単騎"
+	case WaitNobetan:
+		return "延べ単"
+	case WaitMultiSided:
+		return "多面張"
+	default:
+		return "不明"
+	}
+}
+
+// Wait は和了牌ごとの待ちの情報
+type Wait struct {
+	Tile Tile
+	// Shapes はこの牌で和了したときにとりうる待ちの形
+	Shapes []WaitShape
+	// Remaining は見えていない残り枚数（4枚から自分の手牌と見えている牌を引いたもの）
+	Remaining int
+
+	// Ron・Tsumo はそれぞれロン・ツモで和了したときの評価
+	Ron   *Agari
+	Tsumo *Agari
+}
+
+// NoYakuRon はロンでは役がなく和了できないかを返す
+func (w Wait) NoYakuRon() bool {
+	return !w.Ron.HasYaku()
+}
+
+// NoYakuTsumo はツモでも役がなく和了できないかを返す
+func (w Wait) NoYakuTsumo() bool {
+	return !w.Tsumo.HasYaku()
+}
+
+// WaitAnalysis は聴牌形の待ちの解析結果
+type WaitAnalysis struct {
+	Tenpai bool
+	Waits  []Wait
+	// Shape は手牌全体としての待ちの形
+	Shape WaitShape
+}
+
+// WaitTiles は和了牌の一覧を返す
+func (a *WaitAnalysis) WaitTiles() []Tile {
+	tiles := make([]Tile, 0, len(a.Waits))
+	for _, w := range a.Waits {
+		tiles = append(tiles, w.Tile)
+	}
+	return tiles
+}
+
+// AnalyzeWaits は13枚（副露を3枚として数える）の手牌の和了牌と待ちの形を列挙する
+// ctx の WinTile・Tsumo は無視され、和了牌ごとにロン・ツモの両方で役の有無を評価する
+// visible は河・ドラ表示牌など手牌以外で見えている牌で、残り枚数の計算に使う
+func AnalyzeWaits(hand *Hand, ctx WinContext, visible []Tile, rules RuleSet) (*WaitAnalysis, error) {
+	if len(hand.Concealed)%3 != 1 {
+		return nil, fmt.Errorf("%w: waits require 13 tiles counting each meld as 3, got %d", entity.ErrInvalidHand, len(hand.Concealed)+3*len(hand.Melds))
+	}
+
+	all := hand.AllCounts()
+	seen := CountTiles(visible)
+	analysis := &WaitAnalysis{}
+	for t := Tile(0); t < NumTileKinds; t++ {
+		if rules.IsSanma() && t.Suit() == SuitMan && t.Number() > 1 && t.Number() < 9 {
+			continue
+		}
+		// 自分で4枚使っている牌は和了牌にならない
+		if all[t] >= 4 {
+			continue
+		}
+		concealed := append(append([]Tile{}, hand.Concealed...), t)
+		if !IsComplete(CountTiles(concealed)) {
+			continue
+		}
+
+		completed := &Hand{Concealed: concealed, Melds: hand.Melds, AkaDora: hand.AkaDora}
+		wait := Wait{Tile: t, Remaining: max(0, 4-all[t]-seen[t])}
+
+		ronCtx := ctx
+		ronCtx.WinTile, ronCtx.Tsumo = t, false
+		ron, err := EvaluateWin(completed, ronCtx, rules)
+		if err != nil {
+			return nil, err
+		}
+		tsumoCtx := ctx
+		tsumoCtx.WinTile, tsumoCtx.Tsumo = t, true
+		tsumo, err := EvaluateWin(completed, tsumoCtx, rules)
+		if err != nil {
+			return nil, err
+		}
+		wait.Ron, wait.Tsumo = ron, tsumo
+		wait.Shapes = waitShapes(completed, t)
+		analysis.Waits = append(analysis.Waits, wait)
+	}
+
+	analysis.Tenpai = len(analysis.Waits) > 0
+	analysis.Shape = overallShape(analysis.Waits)
+	return analysis, nil
+}
+
+// waitShapes は和了牌で完成させうる待ちの形をすべて返す
+func waitShapes(completed *Hand, t Tile) []WaitShape {
+	found := map[WaitShape]bool{}
+	ctx := WinContext{WinTile: t}
+	for _, d := range decompose(completed.ConcealedCounts()) {
+		for _, shape := range winShapes(d, nil, ctx, true) {
+			found[shape.wait] = true
+		}
+	}
+	shapes := make([]WaitShape, 0, len(found))
+	for w := WaitRyanmen; w <= WaitMultiSided; w++ {
+		if found[w] {
+			shapes = append(shapes, w)
+		}
+	}
+	return shapes
+}
+
+// overallShape は和了牌ごとの待ちの形から手牌全体の待ちの形を判定する
+func overallShape(waits []Wait) WaitShape {
+	switch len(waits) {
+	case 0:
+		return WaitUnknown
+	case 1:
+		if len(waits[0].Shapes) == 1 {
+			return waits[0].Shapes[0]
+		}
+		return WaitMultiSided
+	case 2:
+		a, b := waits[0], waits[1]
+		sameSuit := !a.Tile.IsHonor() && !b.Tile.IsHonor() && a.Tile.Suit() == b.Tile.Suit()
+		if len(a.Shapes) == 1 && len(b.Shapes) == 1 && a.Shapes[0] == b.Shapes[0] {
+			switch a.Shapes[0] {
+			case WaitShanpon:
+				return WaitShanpon
+			case WaitRyanmen:
+				if sameSuit && b.Tile-a.Tile == 3 {
+					return WaitRyanmen
+				}
+			case WaitTanki:
+				if sameSuit && b.Tile-a.Tile == 3 {
+					return WaitNobetan
+				}
+			}
+		}
+		return WaitMultiSided
+	default:
+		return WaitMultiSided
+	}
+}
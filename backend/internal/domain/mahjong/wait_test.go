@@ -0,0 +1,72 @@
+package mahjong
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestAnalyzeWaits(t *testing.T) {
+	tests := []struct {
+		name  string
+		hand  string
+		waits string
+		shape WaitShape
+	}{
+		{name: "両面", hand: "123m456p789s23s11z", waits: "14s", shape: WaitRyanmen},
+		{name: "嵌張", hand: "123m456p789s13s11z", waits: "2s", shape: WaitKanchan},
+		{name: "辺張", hand: "123m456p789s12s11z", waits: "3s", shape: WaitPenchan},
+		{name: "双碰", hand: "123m456p789s22s11z", waits: "2s1z", shape: WaitShanpon},
+		{name: "単騎", hand: "123m456p789s123s1z", waits: "1z", shape: WaitTanki},
+		{name: "延べ単", hand: "123m456p789s2345s", waits: "25s", shape: WaitNobetan},
+		{name: "九蓮宝燈", hand: "1112345678999m", waits: "123456789m", shape: WaitMultiSided},
+		{name: "国士無双十三面", hand: "19m19p19s1234567z", waits: "19m19p19s1234567z", shape: WaitMultiSided},
+		{name: "七対子", hand: "1133m2266p4488s7z", waits: "7z", shape: WaitTanki},
+		{name: "4枚使っている牌は待ちにならない", hand: "1111m234m456p789s", waits: "4m", shape: WaitTanki},
+		{name: "不聴", hand: "1357m2468p1357s1z", shape: WaitUnknown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analysis, err := AnalyzeWaits(mustHand(t, tt.hand), WinContext{SeatWind: South, RoundWind: East}, nil, RuleSetTenhou)
+			if err != nil {
+				t.Fatalf("AnalyzeWaits: %v", err)
+			}
+			var want []Tile
+			if tt.waits != "" {
+				want = mustTiles(t, tt.waits)
+			}
+			if got := analysis.WaitTiles(); !slices.Equal(got, want) || analysis.Tenpai != (len(want) > 0) {
+				t.Errorf("AnalyzeWaits(%s) waits = %s (tenpai %v), want %s", tt.hand, FormatTiles(got), analysis.Tenpai, tt.waits)
+			}
+			if analysis.Shape != tt.shape {
+				t.Errorf("AnalyzeWaits(%s) shape = %s, want %s", tt.hand, analysis.Shape, tt.shape)
+			}
+		})
+	}
+}
+
+func TestAnalyzeWaitsRemaining(t *testing.T) {
+	analysis, err := AnalyzeWaits(mustHand(t, "123m456p789s23s11z"), WinContext{SeatWind: South, RoundWind: East}, mustTiles(t, "1s1s4s"), RuleSetTenhou)
+	if err != nil {
+		t.Fatalf("AnalyzeWaits: %v", err)
+	}
+	remaining := map[Tile]int{}
+	for _, w := range analysis.Waits {
+		remaining[w.Tile] = w.Remaining
+	}
+	if remaining[NewTile(SuitSou, 1)] != 2 || remaining[NewTile(SuitSou, 4)] != 3 {
+		t.Errorf("remaining = %v, want 1s:2 4s:3", remaining)
+	}
+}
+
+func TestAnalyzeWaitsNoYaku(t *testing.T) {
+	// 門前で役のない両面待ちはロンでは和了できず、ツモなら門前清自摸和がつく
+	analysis, err := AnalyzeWaits(mustHand(t, "123m456p789s23s11z"), WinContext{SeatWind: South, RoundWind: East}, nil, RuleSetTenhou)
+	if err != nil {
+		t.Fatalf("AnalyzeWaits: %v", err)
+	}
+	for _, w := range analysis.Waits {
+		if !w.NoYakuRon() || w.NoYakuTsumo() {
+			t.Errorf("wait %s: NoYakuRon %v NoYakuTsumo %v, want true false", w.Tile, w.NoYakuRon(), w.NoYakuTsumo())
+		}
+	}
+}
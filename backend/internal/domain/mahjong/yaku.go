@@ -0,0 +1,617 @@
+package mahjong
+
+// Yaku は成立した役
+type Yaku struct {
+	Name string
+	Han  int
+	// Yakuman は役満の倍数（通常役の場合は0）
+	Yakuman int
+}
+
+// WinContext は和了時の状況
+type WinContext struct {
+	WinTile Tile
+	Tsumo   bool
+
+	Riichi       bool
+	DoubleRiichi bool
+	Ippatsu      bool
+	Haitei       bool // 海底（最後のツモ牌で和了）
+	Houtei       bool // 河底（最後の打牌で和了）
+	Rinshan      bool // 嶺上開花
+	Chankan      bool // 槍槓
+	Tenhou       bool // 天和
+	Chiihou      bool // 地和
+
+	SeatWind  Tile
+	RoundWind Tile
+
+	DoraIndicators    []Tile
+	UraDoraIndicators []Tile
+	// NukiDora は三人麻雀で抜いた北の枚数
+	NukiDora int
+}
+
+// winShape は和了牌がどの面子・雀頭を完成させたかを表す和了の形
+type winShape struct {
+	decomposition Decomposition
+	// sets は副露を含むすべての面子（ロンで完成した刻子は明刻として扱う）
+	sets  []Set
+	melds []Meld
+	wait  WaitShape
+	// closed は門前か
+	closed bool
+}
+
+// yakuEvaluator は1つの和了の形について役を判定する
+type yakuEvaluator struct {
+	shape winShape
+	ctx   WinContext
+	rules RuleSet
+	all   Counts // 副露を含むすべての牌
+	// before は和了牌を除いた門前の牌
+	before Counts
+}
+
+// evaluateYaku は成立した役を返す（役満が成立した場合は役満のみ）
+func (e *yakuEvaluator) evaluateYaku() []Yaku {
+	if yakuman := e.yakuman(); len(yakuman) > 0 {
+		return yakuman
+	}
+
+	var yaku []Yaku
+	add := func(name string, closedHan, openHan int) {
+		han := openHan
+		if e.shape.closed {
+			han = closedHan
+		}
+		if han > 0 {
+			yaku = append(yaku, Yaku{Name: name, Han: han})
+		}
+	}
+
+	// 状況役
+	switch {
+	case e.ctx.DoubleRiichi:
+		add("ダブル立直", 2, 0)
+	case e.ctx.Riichi:
+		add("立直", 1, 0)
+	}
+	if e.ctx.Ippatsu && (e.ctx.Riichi || e.ctx.DoubleRiichi) {
+		add("一発", 1, 0)
+	}
+	if e.ctx.Tsumo {
+		add("門前清自摸和", 1, 0)
+	}
+	if e.ctx.Haitei && e.ctx.Tsumo && !e.ctx.Rinshan {
+		add("海底摸月", 1, 1)
+	}
+	if e.ctx.Houtei && !e.ctx.Tsumo {
+		add("河底撈魚", 1, 1)
+	}
+	if e.ctx.Rinshan && e.ctx.Tsumo {
+		add("嶺上開花", 1, 1)
+	}
+	if e.ctx.Chankan && !e.ctx.Tsumo {
+		add("槍槓", 1, 1)
+	}
+
+	if e.isTanyao() {
+		if e.shape.closed || e.rules.Kuitan {
+			add("断么九", 1, 1)
+		}
+	}
+
+	if e.shape.decomposition.Form == FormChiitoitsu {
+		add("七対子", 2, 0)
+		e.addFlushYaku(add)
+		if e.isHonroutou() {
+			add("混老頭", 2, 2)
+		}
+		return yaku
+	}
+
+	if e.isPinfu() {
+		add("平和", 1, 0)
+	}
+	switch peikou := e.peikouCount(); {
+	case peikou == 2:
+		add("二盃口", 3, 0)
+	case peikou == 1:
+		add("一盃口", 1, 0)
+	}
+
+	// 役牌
+	for _, s := range e.shape.sets {
+		if s.Kind != SetTriplet {
+			continue
+		}
+		switch {
+		case s.First == White:
+			add("役牌 白", 1, 1)
+		case s.First == Green:
+			add("役牌 發", 1, 1)
+		case s.First == Red:
+			add("役牌 中", 1, 1)
+		}
+		if s.First == e.ctx.SeatWind {
+			add("自風 "+windName(s.First), 1, 1)
+		}
+		if s.First == e.ctx.RoundWind {
+			add("場風 "+windName(s.First), 1, 1)
+		}
+	}
+
+	if e.isSanshokuDoujun() {
+		add("三色同順", 2, 1)
+	}
+	if e.isIttsu() {
+		add("一気通貫", 2, 1)
+	}
+	switch e.outsideHand() {
+	case 2:
+		add("純全帯么九", 3, 2)
+	case 1:
+		add("混全帯么九", 2, 1)
+	}
+
+	triplets, concealedTriplets, kans := 0, 0, 0
+	for _, s := range e.shape.sets {
+		if s.Kind == SetTriplet {
+			triplets++
+			if !s.Open {
+				concealedTriplets++
+			}
+			if s.Kan {
+				kans++
+			}
+		}
+	}
+	if triplets == 4 {
+		add("対々和", 2, 2)
+	}
+	if concealedTriplets == 3 {
+		add("三暗刻", 2, 2)
+	}
+	if kans == 3 {
+		add("三槓子", 2, 2)
+	}
+	if e.isSanshokuDoukou() {
+		add("三色同刻", 2, 2)
+	}
+	if e.isHonroutou() {
+		add("混老頭", 2, 2)
+	}
+	if e.dragonTriplets() == 2 && e.shape.decomposition.Pair.IsDragon() {
+		add("小三元", 2, 2)
+	}
+	e.addFlushYaku(add)
+	return yaku
+}
+
+// addFlushYaku は混一色・清一色を判定して追加する
+func (e *yakuEvaluator) addFlushYaku(add func(string, int, int)) {
+	suits := map[Suit]bool{}
+	honors := false
+	for t, n := range e.all {
+		if n == 0 {
+			continue
+		}
+		if Tile(t).IsHonor() {
+			honors = true
+		} else {
+			suits[Tile(t).Suit()] = true
+		}
+	}
+	if len(suits) == 1 {
+		if honors {
+			add("混一色", 3, 2)
+		} else {
+			add("清一色", 6, 5)
+		}
+	}
+}
+
+// yakuman は成立した役満を返す
+func (e *yakuEvaluator) yakuman() []Yaku {
+	var yaku []Yaku
+	add := func(name string, multiplier int) {
+		if multiplier > 1 && !e.rules.DoubleYakuman {
+			multiplier = 1
+		}
+		yaku = append(yaku, Yaku{Name: name, Han: 13 * multiplier, Yakuman: multiplier})
+	}
+
+	if e.ctx.Tenhou && e.ctx.Tsumo && e.shape.closed {
+		add("天和", 1)
+	}
+	if e.ctx.Chiihou && e.ctx.Tsumo && e.shape.closed {
+		add("地和", 1)
+	}
+
+	d := e.shape.decomposition
+	if d.Form == FormKokushi {
+		// 和了牌を除いて13種がそろっていれば十三面待ち
+		if e.isKokushiThirteenWait() {
+			add("国士無双十三面", 2)
+		} else {
+			add("国士無双", 1)
+		}
+		return e.limitYakuman(yaku)
+	}
+
+	if d.Form == FormStandard {
+		concealedTriplets, kans := 0, 0
+		for _, s := range e.shape.sets {
+			if s.Kind == SetTriplet {
+				if !s.Open {
+					concealedTriplets++
+				}
+				if s.Kan {
+					kans++
+				}
+			}
+		}
+		if concealedTriplets == 4 {
+			if e.shape.wait == WaitTanki {
+				add("四暗刻単騎", 2)
+			} else {
+				add("四暗刻", 1)
+			}
+		}
+		if kans == 4 {
+			add("四槓子", 1)
+		}
+		if e.dragonTriplets() == 3 {
+			add("大三元", 1)
+		}
+		switch winds := e.windTriplets(); {
+		case winds == 4:
+			add("大四喜", 2)
+		case winds == 3 && d.Pair.IsWind():
+			add("小四喜", 1)
+		}
+		if e.shape.closed && len(e.shape.melds) == 0 {
+			if junsei, ok := e.chuuren(); ok {
+				if junsei {
+					add("純正九蓮宝燈", 2)
+				} else {
+					add("九蓮宝燈", 1)
+				}
+			}
+		}
+	}
+
+	allHonors, allTerminals, allGreen := true, true, true
+	for t, n := range e.all {
+		if n == 0 {
+			continue
+		}
+		tile := Tile(t)
+		if !tile.IsHonor() {
+			allHonors = false
+		}
+		if !tile.IsTerminal() {
+			allTerminals = false
+		}
+		if !isGreen(tile) {
+			allGreen = false
+		}
+	}
+	if allHonors {
+		add("字一色", 1)
+	}
+	if allTerminals {
+		add("清老頭", 1)
+	}
+	if allGreen {
+		add("緑一色", 1)
+	}
+	return e.limitYakuman(yaku)
+}
+
+// limitYakuman は役満の複合を認めないルールでは最も大きい役満1つに絞る
+func (e *yakuEvaluator) limitYakuman(yaku []Yaku) []Yaku {
+	if e.rules.YakumanStacking || len(yaku) <= 1 {
+		return yaku
+	}
+	best := yaku[0]
+	for _, y := range yaku[1:] {
+		if y.Yakuman > best.Yakuman {
+			best = y
+		}
+	}
+	return []Yaku{best}
+}
+
+// isKokushiThirteenWait は和了牌を除いた手牌が么九牌13種1枚ずつかを返す
+func (e *yakuEvaluator) isKokushiThirteenWait() bool {
+	for _, t := range yaochuTiles {
+		if e.before[t] != 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// chuuren は九蓮宝燈かと、純正（和了前が九面待ち）かを返す
+func (e *yakuEvaluator) chuuren() (junsei bool, ok bool) {
+	var suit Suit = -1
+	for t, n := range e.all {
+		if n == 0 {
+			continue
+		}
+		if Tile(t).IsHonor() || (suit >= 0 && Tile(t).Suit() != suit) {
+			return false, false
+		}
+		suit = Tile(t).Suit()
+	}
+	base := Tile(suit * 9)
+	required := [9]int{3, 1, 1, 1, 1, 1, 1, 1, 3}
+	for i, r := range required {
+		if e.all[base+Tile(i)] < r {
+			return false, false
+		}
+	}
+	for i, r := range required {
+		if e.before[base+Tile(i)] != r {
+			return false, true
+		}
+	}
+	return true, true
+}
+
+// isGreen は緑一色に使える牌かを返す（23468索・發）
+func isGreen(t Tile) bool {
+	if t == Green {
+		return true
+	}
+	if t.Suit() != SuitSou {
+		return false
+	}
+	switch t.Number() {
+	case 2, 3, 4, 6, 8:
+		return true
+	}
+	return false
+}
+
+// isTanyao はすべて中張牌かを返す
+func (e *yakuEvaluator) isTanyao() bool {
+	for t, n := range e.all {
+		if n > 0 && Tile(t).IsYaochu() {
+			return false
+		}
+	}
+	return true
+}
+
+// isHonroutou はすべて么九牌かを返す
+func (e *yakuEvaluator) isHonroutou() bool {
+	for t, n := range e.all {
+		if n > 0 && !Tile(t).IsYaochu() {
+			return false
+		}
+	}
+	return true
+}
+
+// isYakuhai は役牌（雀頭で符がつく牌）かを返す
+func (e *yakuEvaluator) isYakuhai(t Tile) bool {
+	return t.IsDragon() || t == e.ctx.SeatWind || t == e.ctx.RoundWind
+}
+
+// isPinfu は平和の形かを返す
+func (e *yakuEvaluator) isPinfu() bool {
+	if !e.shape.closed || e.shape.wait != WaitRyanmen || e.isYakuhai(e.shape.decomposition.Pair) {
+		return false
+	}
+	for _, s := range e.shape.sets {
+		if s.Kind != SetSequence {
+			return false
+		}
+	}
+	return true
+}
+
+// peikouCount は同じ順子の組の数（一盃口は1、二盃口は2）を返す
+func (e *yakuEvaluator) peikouCount() int {
+	if !e.shape.closed {
+		return 0
+	}
+	seen := map[Tile]int{}
+	for _, s := range e.shape.sets {
+		if s.Kind == SetSequence {
+			seen[s.First]++
+		}
+	}
+	count := 0
+	for _, n := range seen {
+		count += n / 2
+	}
+	return count
+}
+
+// isSanshokuDoujun は三色同順かを返す
+func (e *yakuEvaluator) isSanshokuDoujun() bool {
+	var found [9][3]bool
+	for _, s := range e.shape.sets {
+		if s.Kind == SetSequence {
+			found[s.First.Number()-1][s.First.Suit()] = true
+		}
+	}
+	for _, f := range found {
+		if f[0] && f[1] && f[2] {
+			return true
+		}
+	}
+	return false
+}
+
+// isSanshokuDoukou は三色同刻かを返す
+func (e *yakuEvaluator) isSanshokuDoukou() bool {
+	var found [9][3]bool
+	for _, s := range e.shape.sets {
+		if s.Kind == SetTriplet && !s.First.IsHonor() {
+			found[s.First.Number()-1][s.First.Suit()] = true
+		}
+	}
+	for _, f := range found {
+		if f[0] && f[1] && f[2] {
+			return true
+		}
+	}
+	return false
+}
+
+// isIttsu は一気通貫かを返す
+func (e *yakuEvaluator) isIttsu() bool {
+	var found [3][3]bool
+	for _, s := range e.shape.sets {
+		if s.Kind == SetSequence && (s.First.Number()-1)%3 == 0 {
+			found[s.First.Suit()][(s.First.Number()-1)/3] = true
+		}
+	}
+	for _, f := range found {
+		if f[0] && f[1] && f[2] {
+			return true
+		}
+	}
+	return false
+}
+
+// outsideHand はすべての面子と雀頭に么九牌を含む場合に、字牌を含めば1、含まなければ2を返す
+// 順子を含まない場合（混老頭）は0を返す
+func (e *yakuEvaluator) outsideHand() int {
+	if !e.shape.decomposition.Pair.IsYaochu() {
+		return 0
+	}
+	honors := e.shape.decomposition.Pair.IsHonor()
+	sequences := 0
+	for _, s := range e.shape.sets {
+		if !s.HasYaochu() {
+			return 0
+		}
+		if s.Kind == SetSequence {
+			sequences++
+		} else if s.First.IsHonor() {
+			honors = true
+		}
+	}
+	if sequences == 0 {
+		return 0
+	}
+	if honors {
+		return 1
+	}
+	return 2
+}
+
+// dragonTriplets は三元牌の刻子の数を返す
+func (e *yakuEvaluator) dragonTriplets() int {
+	n := 0
+	for _, s := range e.shape.sets {
+		if s.Kind == SetTriplet && s.First.IsDragon() {
+			n++
+		}
+	}
+	return n
+}
+
+// windTriplets は風牌の刻子の数を返す
+func (e *yakuEvaluator) windTriplets() int {
+	n := 0
+	for _, s := range e.shape.sets {
+		if s.Kind == SetTriplet && s.First.IsWind() {
+			n++
+		}
+	}
+	return n
+}
+
+// windName は風牌の名前を返す
+func windName(t Tile) string {
+	switch t {
+	case East:
+		return "東"
+	case South:
+		return "南"
+	case West:
+		return "西"
+	case North:
+		return "北"
+	default:
+		return ""
+	}
+}
+
+// fu は和了の形の符を返す
+func (e *yakuEvaluator) fu(yaku []Yaku) int {
+	d := e.shape.decomposition
+	switch d.Form {
+	case FormChiitoitsu:
+		return 25
+	case FormKokushi:
+		return 30
+	}
+
+	pinfu := false
+	for _, y := range yaku {
+		if y.Name == "平和" {
+			pinfu = true
+		}
+	}
+	if pinfu {
+		if e.ctx.Tsumo {
+			return 20
+		}
+		return 30
+	}
+
+	fu := 20
+	if e.shape.closed && !e.ctx.Tsumo {
+		fu += 10
+	}
+	if e.ctx.Tsumo {
+		fu += 2
+	}
+	for _, s := range e.shape.sets {
+		if s.Kind != SetTriplet {
+			continue
+		}
+		f := 2
+		if s.First.IsYaochu() {
+			f *= 2
+		}
+		if !s.Open {
+			f *= 2
+		}
+		if s.Kan {
+			f *= 4
+		}
+		fu += f
+	}
+
+	// 雀頭
+	pairFu := 0
+	if d.Pair.IsDragon() {
+		pairFu += 2
+	}
+	if d.Pair == e.ctx.SeatWind && d.Pair == e.ctx.RoundWind {
+		pairFu += e.rules.DoubleWindPairFu
+	} else if d.Pair == e.ctx.SeatWind || d.Pair == e.ctx.RoundWind {
+		pairFu += 2
+	}
+	fu += pairFu
+
+	switch e.shape.wait {
+	case WaitKanchan, WaitPenchan, WaitTanki:
+		fu += 2
+	}
+
+	// 喰い平和の形は30符とする
+	if fu == 20 {
+		return 30
+	}
+	return RoundFu(fu)
+}
@@ -0,0 +1,222 @@
+package quiz
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// 出題する局面の条件
+const (
+	// minQuizTurn と maxQuizTurn は出題する自分のツモの巡目の範囲
+	minQuizTurn = 4
+	maxQuizTurn = 12
+	// maxQuizShanten は出題する手牌の最大の向聴数（打牌後）
+	maxQuizShanten = 3
+	// minChoices は最善と向聴数が同じ打牌候補の最小数
+	minChoices = 3
+	// maxDeals は条件を満たす局面を探して配り直す最大の回数
+	maxDeals = 50
+	// quizSimulations は正解を決めるモンテカルロ法の試行回数
+	quizSimulations = 300
+	// deadWallTiles は王牌の枚数
+	deadWallTiles = 14
+)
+
+// wallTile は山の1枚（赤5を区別する）
+type wallTile struct {
+	tile mahjong.Tile
+	red  bool
+}
+
+// Generate は seed から配牌と序盤の打牌をシミュレーションし、何切る問題になる局面を作る
+// 全員が牌効率で打ち（鳴き・立直はしない）、自分の4-12巡目のツモ後に最善と向聴数が同じ候補が3つ以上あり、
+// エンジンが正解としない候補が残る局面を出題する。条件を満たさない配牌は配り直す
+// 同じ seed とルールセットでは同じ問題になる
+func Generate(seed uint64, rules mahjong.RuleSet) (*Quiz, error) {
+	for deal := range maxDeals {
+		rng := rand.New(rand.NewPCG(seed, uint64(deal)))
+		q, err := playDeal(rng, rules)
+		if err != nil {
+			return nil, err
+		}
+		if q == nil {
+			continue
+		}
+		hand, err := q.State.SelfHand()
+		if err != nil {
+			return nil, err
+		}
+		self := q.State.Player(q.State.Self)
+		analysis, err := mahjong.RecommendDiscard(hand, q.State.Seen(), self.DiscardTiles(), q.State.WinContext(),
+			mahjong.SimulationOptions{Simulations: quizSimulations, Seed: seed}, rules)
+		if err != nil {
+			return nil, err
+		}
+		q.Candidates = analysis.Candidates
+		q.Accepted = acceptedDiscards(analysis.Candidates)
+		if len(q.Accepted) >= choices(analysis.Candidates) {
+			// 向聴数を保つ打牌がどれも正解になる局面は出題しない
+			continue
+		}
+		q.RuleSet = rules.Name
+		q.CreatedAt = time.Now()
+		return q, nil
+	}
+	return nil, fmt.Errorf("no quiz position found in %d deals", maxDeals)
+}
+
+// playDeal は1局の配牌から打牌を進め、出題の候補になる局面を返す（見つからない場合は nil）
+func playDeal(rng *rand.Rand, rules mahjong.RuleSet) (*Quiz, error) {
+	wall := buildWall(rules)
+	rng.Shuffle(len(wall), func(i, j int) { wall[i], wall[j] = wall[j], wall[i] })
+	live := wall[:len(wall)-deadWallTiles]
+
+	state := &mahjong.GameState{
+		RoundWind:      mahjong.East + mahjong.Tile(rng.IntN(2)),
+		Kyoku:          1 + rng.IntN(rules.Players),
+		DoraIndicators: []mahjong.Tile{wall[len(wall)-deadWallTiles+4].tile},
+		Self:           mahjong.East + mahjong.Tile(rng.IntN(rules.Players)),
+	}
+	hands := make([][]wallTile, rules.Players)
+	for seat := range hands {
+		hands[seat] = append([]wallTile(nil), live[:13]...)
+		live = live[13:]
+		state.Players = append(state.Players, mahjong.PlayerState{Seat: mahjong.East + mahjong.Tile(seat), Score: rules.StartingPoints})
+	}
+	self := int(state.Self - mahjong.East)
+	target := minQuizTurn + rng.IntN(maxQuizTurn-minQuizTurn+1)
+
+	for turn := 1; turn <= maxQuizTurn; turn++ {
+		for seat := range hands {
+			if len(live) == 0 {
+				return nil, nil
+			}
+			drawn := live[0]
+			live = live[1:]
+			hands[seat] = append(hands[seat], drawn)
+			counts := countWallTiles(hands[seat])
+			shanten := mahjong.Shanten(counts, 0)
+			if shanten < 0 {
+				// 和了した配牌は出題に使わない
+				return nil, nil
+			}
+
+			if seat == self && turn >= target && shanten <= maxQuizShanten {
+				q := position(state, hands[seat], len(live), turn)
+				ok, err := suitable(q, rules)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					return q, nil
+				}
+			}
+
+			discard := mahjong.EfficiencyPolicy.Discard(counts, 0, drawn.tile)
+			var removed wallTile
+			hands[seat], removed = removeTile(hands[seat], discard)
+			p := &state.Players[seat]
+			p.Discards = append(p.Discards, mahjong.DiscardedTile{Tile: discard, Tsumogiri: discard == drawn.tile && removed.red == drawn.red})
+		}
+	}
+	return nil, nil
+}
+
+// position は自分のツモ後の局面を問題にする（他家の手牌は含めない）
+func position(state *mahjong.GameState, hand []wallTile, wallRemaining, turn int) *Quiz {
+	s := *state
+	s.DoraIndicators = append([]mahjong.Tile(nil), state.DoraIndicators...)
+	s.WallRemaining = wallRemaining
+	s.Players = make([]mahjong.PlayerState, len(state.Players))
+	for i, p := range state.Players {
+		p.Discards = append([]mahjong.DiscardedTile(nil), p.Discards...)
+		s.Players[i] = p
+	}
+	self := s.Player(s.Self)
+	for _, t := range hand {
+		self.Hand = append(self.Hand, t.tile)
+		if t.red {
+			self.AkaDora++
+		}
+	}
+	return &Quiz{State: &s, Hand: formatHand(hand), Turn: turn}
+}
+
+// suitable はシミュレーションせずに評価して、出題の候補になる局面かを返す
+func suitable(q *Quiz, rules mahjong.RuleSet) (bool, error) {
+	hand, err := q.State.SelfHand()
+	if err != nil {
+		return false, err
+	}
+	analysis, err := mahjong.RecommendDiscard(hand, q.State.Seen(), q.State.Player(q.State.Self).DiscardTiles(), q.State.WinContext(), mahjong.SimulationOptions{}, rules)
+	if err != nil {
+		return false, err
+	}
+	best := analysis.Candidates[0]
+	return best.Shanten >= 0 && best.Shanten <= maxQuizShanten && choices(analysis.Candidates) >= minChoices, nil
+}
+
+// choices は最善と向聴数が同じ打牌候補の数を返す
+func choices(candidates []mahjong.DiscardCandidate) int {
+	n := 0
+	for _, c := range candidates {
+		if c.Shanten == candidates[0].Shanten {
+			n++
+		}
+	}
+	return n
+}
+
+// buildWall はルールセットの牌をすべて並べた山を返す
+// 三人麻雀では2萬から8萬を除き、赤ドラは5筒・5索・5萬の順に1枚ずつ5の牌に割り当てる
+func buildWall(rules mahjong.RuleSet) []wallTile {
+	var wall []wallTile
+	for t := mahjong.Tile(0); t < mahjong.NumTileKinds; t++ {
+		if rules.IsSanma() && t.Suit() == mahjong.SuitMan && t.Number() > 1 && t.Number() < 9 {
+			continue
+		}
+		for range 4 {
+			wall = append(wall, wallTile{tile: t})
+		}
+	}
+
+	var fives []int
+	for _, suit := range []mahjong.Suit{mahjong.SuitPin, mahjong.SuitSou, mahjong.SuitMan} {
+		five := mahjong.NewTile(suit, 5)
+		for i, w := range wall {
+			if w.tile == five {
+				fives = append(fives, i)
+				break
+			}
+		}
+	}
+	for i := range min(rules.AkaDora, 4*len(fives)) {
+		// 同じ種類の赤5が2枚目以降の場合は隣の5を赤にする
+		wall[fives[i%len(fives)]+i/len(fives)].red = true
+	}
+	return wall
+}
+
+// countWallTiles は牌の種類ごとの枚数を返す
+func countWallTiles(tiles []wallTile) mahjong.Counts {
+	var c mahjong.Counts
+	for _, t := range tiles {
+		c[t.tile]++
+	}
+	return c
+}
+
+// removeTile は手牌から牌を1枚除く（赤5は残し、通常の5から除く）
+func removeTile(hand []wallTile, t mahjong.Tile) ([]wallTile, wallTile) {
+	index := -1
+	for i, w := range hand {
+		if w.tile == t && (index < 0 || hand[index].red) {
+			index = i
+		}
+	}
+	removed := hand[index]
+	return append(hand[:index], hand[index+1:]...), removed
+}
@@ -0,0 +1,204 @@
+package quiz
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// 採点の基準
+const (
+	// acceptedEVRatio は最善の打牌の打点期待値に対して、同等として正解にする割合
+	acceptedEVRatio = 0.95
+	// sameShantenBase と sameShantenRange は向聴数の同じ不正解の得点（受け入れ・打点期待値の割合に応じて base から base+range まで）
+	sameShantenBase  = 30
+	sameShantenRange = 59
+	// worseShantenScore は向聴数を戻す不正解の得点（戻した向聴数で割る）
+	worseShantenScore = 20
+)
+
+// Quiz は何切る問題
+type Quiz struct {
+	ID      string
+	RuleSet string
+	// Date は日替わりの問題の日付（YYYY-MM-DD、日替わりでない場合は空）
+	Date string
+
+	// State は出題する局面（自分の手牌はツモ後の14枚、他家の手牌は含まない）
+	State *mahjong.GameState
+	// Hand は自分の手牌のMPSZ表記（0 は赤5）
+	Hand string
+	// Turn は何巡目のツモか（1始まり）
+	Turn int
+
+	// Candidates はエンジンによる推奨順の打牌候補
+	Candidates []mahjong.DiscardCandidate
+	// Accepted は正解とする打牌（最善の打牌と、向聴数が同じで評価が同等の打牌）
+	Accepted []mahjong.Tile
+
+	CreatedAt time.Time
+}
+
+// dailyPrefix は日替わりの問題のIDの接頭辞
+const dailyPrefix = "daily-"
+
+// dailyWindow は日替わりの問題を作り直せる今日の前後の日数（利用者とのタイムゾーンの差を許す）
+const dailyWindow = 1
+
+// DailyID は日替わりの問題のIDを返す（date は YYYY-MM-DD）
+func DailyID(date, ruleSet string) string {
+	return dailyPrefix + date + "-" + ruleSet
+}
+
+// ParseDailyID は日替わりの問題のIDから日付とルールセット名を返す
+func ParseDailyID(id string) (date, ruleSet string, ok bool) {
+	rest, found := strings.CutPrefix(id, dailyPrefix)
+	if !found || len(rest) < len(time.DateOnly)+2 || rest[len(time.DateOnly)] != '-' {
+		return "", "", false
+	}
+	date, ruleSet = rest[:len(time.DateOnly)], rest[len(time.DateOnly)+1:]
+	if _, err := time.Parse(time.DateOnly, date); err != nil {
+		return "", "", false
+	}
+	return date, ruleSet, true
+}
+
+// IsCurrentDaily は日替わりの問題の日付（YYYY-MM-DD）が now の日付の前後 dailyWindow 日以内かを返す
+func IsCurrentDaily(date string, now time.Time) bool {
+	d, err := time.ParseInLocation(time.DateOnly, date, now.Location())
+	if err != nil {
+		return false
+	}
+	y, m, day := now.Date()
+	today := time.Date(y, m, day, 0, 0, 0, 0, now.Location())
+	return !d.Before(today.AddDate(0, 0, -dailyWindow)) && !d.After(today.AddDate(0, 0, dailyWindow))
+}
+
+// DailySeed は日替わりの問題の乱数の種を返す（同じIDでは誰にでも同じ問題になる）
+func DailySeed(id string) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(id))
+	return h.Sum64()
+}
+
+// Best はエンジンが最善とする打牌を返す
+func (q *Quiz) Best() mahjong.DiscardCandidate {
+	return q.Candidates[0]
+}
+
+// Grade は回答の採点結果
+type Grade struct {
+	Discard mahjong.DiscardCandidate
+	Correct bool
+	// Score は得点（0-100）
+	Score int
+	// Evaluation はエンジンによる評価の要約
+	Evaluation string
+}
+
+// Grade は回答の打牌をエンジンの評価と比べて採点する
+// 正解は100点、向聴数の同じ不正解は受け入れ枚数（と打点期待値）の最善との割合に応じて30-89点、
+// 向聴数を戻す不正解は戻した向聴数に応じて20点以下とする
+func (q *Quiz) Grade(discard mahjong.Tile) (*Grade, error) {
+	i := slices.IndexFunc(q.Candidates, func(c mahjong.DiscardCandidate) bool { return c.Tile == discard })
+	if i < 0 {
+		return nil, fmt.Errorf("%w: %s is not in the hand %s", entity.ErrInvalidRequest, discard, q.Hand)
+	}
+	actual, best := q.Candidates[i], q.Best()
+
+	g := &Grade{Discard: actual}
+	switch {
+	case slices.Contains(q.Accepted, discard):
+		g.Correct = true
+		g.Score = 100
+	case actual.Shanten == best.Shanten:
+		ratio := fraction(actual.Ukeire.Total, best.Ukeire.Total)
+		if actual.Simulated && best.Simulated && best.Simulation.ExpectedValue > 0 {
+			ratio = (ratio + min(1, max(0, actual.Simulation.ExpectedValue/best.Simulation.ExpectedValue))) / 2
+		}
+		g.Score = sameShantenBase + int(sameShantenRange*ratio)
+	default:
+		g.Score = worseShantenScore / (actual.Shanten - best.Shanten)
+	}
+	g.Evaluation = candidateText(actual) + " / 最善 " + candidateText(best)
+	return g, nil
+}
+
+// acceptedDiscards は正解とする打牌を返す
+// シミュレーションした候補は打点期待値が最善の95%以上、していない候補は受け入れ枚数と改良枚数が最善と同じものを同等とする
+func acceptedDiscards(candidates []mahjong.DiscardCandidate) []mahjong.Tile {
+	best := candidates[0]
+	var accepted []mahjong.Tile
+	for _, c := range candidates {
+		if c.Shanten != best.Shanten {
+			break
+		}
+		equivalent := c.Ukeire.Total == best.Ukeire.Total && c.ImprovementCount == best.ImprovementCount
+		if c.Simulated && best.Simulated && best.Simulation.ExpectedValue > 0 {
+			equivalent = c.Simulation.ExpectedValue >= best.Simulation.ExpectedValue*acceptedEVRatio
+		}
+		if equivalent {
+			accepted = append(accepted, c.Tile)
+		}
+	}
+	return accepted
+}
+
+// candidateText は打牌候補の評価を表記する
+func candidateText(c mahjong.DiscardCandidate) string {
+	text := fmt.Sprintf("打%s: %s 受け入れ%d枚", c.Tile, shantenText(c.Shanten), c.Ukeire.Total)
+	if c.Simulated {
+		text += fmt.Sprintf(" 和了率%.0f%% 打点期待値%.0f点", c.Simulation.WinRate*100, c.Simulation.ExpectedValue)
+	}
+	return text
+}
+
+// shantenText は向聴数を表記する
+func shantenText(shanten int) string {
+	switch {
+	case shanten < 0:
+		return "和了"
+	case shanten == 0:
+		return "聴牌"
+	default:
+		return fmt.Sprintf("%d向聴", shanten)
+	}
+}
+
+// fraction は n/d を0から1の範囲で返す（d が0の場合は1）
+func fraction(n, d int) float64 {
+	if d <= 0 {
+		return 1
+	}
+	return min(1, max(0, float64(n)/float64(d)))
+}
+
+// formatHand は赤5を 0 として手牌をMPSZ表記にする
+func formatHand(tiles []wallTile) string {
+	sorted := slices.Clone(tiles)
+	slices.SortStableFunc(sorted, func(a, b wallTile) int { return int(a.tile) - int(b.tile) })
+	var b strings.Builder
+	for suit := mahjong.SuitMan; suit <= mahjong.SuitHonor; suit++ {
+		wrote := false
+		for _, t := range sorted {
+			if t.tile.Suit() != suit {
+				continue
+			}
+			if t.red {
+				b.WriteByte('0')
+			} else {
+				b.WriteByte(byte('0' + t.tile.Number()))
+			}
+			wrote = true
+		}
+		if wrote {
+			b.WriteByte("mpsz"[suit])
+		}
+	}
+	return b.String()
+}
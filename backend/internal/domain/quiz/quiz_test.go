@@ -0,0 +1,52 @@
+package quiz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDailyID(t *testing.T) {
+	tests := []struct {
+		id      string
+		date    string
+		ruleSet string
+		ok      bool
+	}{
+		{id: DailyID("2026-10-18", "tenhou"), date: "2026-10-18", ruleSet: "tenhou", ok: true},
+		{id: "daily-2026-13-01-tenhou"},
+		{id: "daily-2026-10-18"},
+		{id: "2026-10-18-tenhou"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			date, ruleSet, ok := ParseDailyID(tt.id)
+			if date != tt.date || ruleSet != tt.ruleSet || ok != tt.ok {
+				t.Errorf("ParseDailyID(%q) = %q, %q, %v, want %q, %q, %v", tt.id, date, ruleSet, ok, tt.date, tt.ruleSet, tt.ok)
+			}
+		})
+	}
+}
+
+func TestIsCurrentDaily(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	now := time.Date(2026, 10, 18, 0, 30, 0, 0, jst)
+	tests := []struct {
+		date string
+		want bool
+	}{
+		{date: "2026-10-18", want: true},
+		{date: "2026-10-17", want: true},
+		{date: "2026-10-19", want: true},
+		{date: "2026-10-16", want: false},
+		{date: "2026-10-20", want: false},
+		{date: "1999-01-01", want: false},
+		{date: "not-a-date", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.date, func(t *testing.T) {
+			if got := IsCurrentDaily(tt.date, now); got != tt.want {
+				t.Errorf("IsCurrentDaily(%q) = %v, want %v", tt.date, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package quiz
+
+import (
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// statsDays は日ごとに集計する直近の日数
+const statsDays = 30
+
+// Attempt はユーザーが問題に回答した記録
+type Attempt struct {
+	UserID     string
+	QuizID     string
+	Discard    mahjong.Tile
+	Correct    bool
+	Score      int
+	AnsweredAt time.Time
+}
+
+// DailyStats は日ごとの成績
+type DailyStats struct {
+	// Date は日付（YYYY-MM-DD）
+	Date         string
+	Attempts     int
+	Correct      int
+	AverageScore float64
+}
+
+// Stats はユーザーの成績
+type Stats struct {
+	UserID   string
+	Attempts int
+	Correct  int
+	// Accuracy は正解率（0-1）
+	Accuracy     float64
+	AverageScore float64
+	// Streak は直近の連続正解数
+	Streak int
+	// Daily は直近30日の日ごとの成績（古い順、回答のない日は含めない）
+	Daily []DailyStats
+}
+
+// Summarize は回答の記録（古い順）から成績を集計する
+// 日ごとの成績は now のタイムゾーンの日付で、now から30日前の日までを集計する
+func Summarize(userID string, attempts []Attempt, now time.Time) *Stats {
+	stats := &Stats{UserID: userID, Attempts: len(attempts)}
+	since := now.AddDate(0, 0, -(statsDays - 1)).Format(time.DateOnly)
+	total := 0
+	for _, a := range attempts {
+		total += a.Score
+		if a.Correct {
+			stats.Correct++
+			stats.Streak++
+		} else {
+			stats.Streak = 0
+		}
+
+		date := a.AnsweredAt.In(now.Location()).Format(time.DateOnly)
+		if date < since {
+			continue
+		}
+		if n := len(stats.Daily); n == 0 || stats.Daily[n-1].Date != date {
+			stats.Daily = append(stats.Daily, DailyStats{Date: date})
+		}
+		day := &stats.Daily[len(stats.Daily)-1]
+		// 平均得点は回答を加えるたびに更新する
+		day.AverageScore = (day.AverageScore*float64(day.Attempts) + float64(a.Score)) / float64(day.Attempts+1)
+		day.Attempts++
+		if a.Correct {
+			day.Correct++
+		}
+	}
+	if stats.Attempts > 0 {
+		stats.Accuracy = float64(stats.Correct) / float64(stats.Attempts)
+		stats.AverageScore = float64(total) / float64(stats.Attempts)
+	}
+	return stats
+}
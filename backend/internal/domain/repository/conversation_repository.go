@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// ConversationRepository は会話単位の設定の保存先を抽象化するリポジトリインターフェース
+type ConversationRepository interface {
+	// Get は会話を取得する（存在しない場合は nil, nil を返す）
+	Get(ctx context.Context, id string) (*entity.Conversation, error)
+
+	// Save は会話を保存する
+	Save(ctx context.Context, conversation *entity.Conversation) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+)
+
+// GameLogRepository は取り込んだ牌譜の保存先を抽象化するリポジトリインターフェース
+type GameLogRepository interface {
+	// Get は牌譜を取得する（存在しない場合は nil, nil を返す）
+	Get(ctx context.Context, id string) (*gamelog.Game, error)
+
+	// Save は牌譜を保存する
+	Save(ctx context.Context, game *gamelog.Game) error
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+)
+
+// GameReviewRepository は牌譜の検討結果の保存先を抽象化するリポジトリインターフェース
+type GameReviewRepository interface {
+	// Get は検討結果を取得する（存在しない場合は nil, nil を返す）
+	Get(ctx context.Context, id string) (*gamelog.Review, error)
+
+	// Save は検討結果を保存する
+	Save(ctx context.Context, review *gamelog.Review) error
+}
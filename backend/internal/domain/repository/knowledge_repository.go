@@ -0,0 +1,34 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+)
+
+// KnowledgeRepository はルールの資料の読み込み元を抽象化するリポジトリインターフェース
+type KnowledgeRepository interface {
+	// Passages は資料を読み込み、節ごとの抜粋に分けて返す
+	Passages(ctx context.Context) ([]knowledge.Passage, error)
+}
+
+// VectorIndexRepository は抜粋の埋め込みベクトルの索引の保存先を抽象化するリポジトリインターフェース
+type VectorIndexRepository interface {
+	// Load は索引を読み込む（保存されていない場合は nil, nil を返す）
+	Load(ctx context.Context) (*knowledge.VectorIndex, error)
+
+	// Save は索引を保存する
+	Save(ctx context.Context, index *knowledge.VectorIndex) error
+}
+
+// EmbeddingRepository は文章の埋め込みベクトルを求めるサービスを抽象化するリポジトリインターフェース
+type EmbeddingRepository interface {
+	// Model は埋め込みモデルの名前を返す
+	Model() string
+
+	// EmbedPassages は検索される抜粋の埋め込みベクトルを抜粋と同じ順で返す
+	EmbedPassages(ctx context.Context, passages []knowledge.Passage) ([][]float32, error)
+
+	// EmbedQuery は検索する質問の埋め込みベクトルを返す
+	EmbedQuery(ctx context.Context, text string) ([]float32, error)
+}
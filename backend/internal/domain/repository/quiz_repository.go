@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/quiz"
+)
+
+// QuizRepository は何切る問題と回答の記録の保存先を抽象化するリポジトリインターフェース
+type QuizRepository interface {
+	// Get は問題を取得する（存在しない場合は nil, nil を返す）
+	Get(ctx context.Context, id string) (*quiz.Quiz, error)
+
+	// Save は問題を保存する
+	Save(ctx context.Context, q *quiz.Quiz) error
+
+	// SaveAttempt は回答を記録する（同じユーザーが同じ問題に回答済みの場合は記録せず false を返す）
+	SaveAttempt(ctx context.Context, attempt quiz.Attempt) (bool, error)
+
+	// Attempts はユーザーの回答を古い順に返す
+	Attempts(ctx context.Context, userID string) ([]quiz.Attempt, error)
+}
@@ -0,0 +1,374 @@
+package infrastructure
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// replayChunkRunes はキャッシュした回答をストリーミングで送り直すときの1チャンクの最大文字数
+const replayChunkRunes = 32
+
+// ResponseCacheOptions は回答のキャッシュの設定
+type ResponseCacheOptions struct {
+	// TTL は回答をキャッシュする期間
+	TTL time.Duration
+	// MaxEntries はキャッシュする回答の最大数（超えると最も長く使われていない回答から捨てる）
+	MaxEntries int
+	// SemanticThreshold は質問の埋め込みベクトルのコサイン類似度がこの値以上であれば同じ質問とみなす
+	// （0 の場合、または埋め込みを使わない場合は質問の完全一致のみ）
+	SemanticThreshold float64
+}
+
+// cacheEntry はキャッシュした回答
+type cacheEntry struct {
+	key string
+	// settingsKey は質問以外の条件（コンテキスト・システムプロンプト・モデル・生成の設定・添付した画像）のキー
+	settingsKey string
+	// vector は質問の埋め込みベクトル（意味的な一致を使わない場合は nil）
+	vector    []float32
+	response  entity.AIResponse
+	expiresAt time.Time
+}
+
+// CachedAIRepository はAIの回答をキャッシュするリポジトリの実装
+//
+// 正規化した質問・コンテキスト・システムプロンプト（ペルソナ）・モデル・生成の設定が同じリクエストには、
+// AIに問い合わせずにキャッシュした回答を返す。埋め込みを使う場合は、質問以外の条件が同じで
+// 質問が言い換えにすぎないリクエストにもキャッシュした回答を返す。
+type CachedAIRepository struct {
+	repository.AIRepository
+	// embeddingRepo は質問の埋め込みベクトルを求めるサービス（nil の場合は完全一致のみ）
+	embeddingRepo repository.EmbeddingRepository
+	options       ResponseCacheOptions
+	logger        *logrus.Logger
+
+	mu sync.Mutex
+	// entries は最近使われた順の回答（先頭が最新）
+	entries *list.List
+	byKey   map[string]*list.Element
+}
+
+// NewCachedAIRepository は repo の回答をキャッシュするリポジトリを作成する
+// embeddingRepo が nil の場合、または options.SemanticThreshold が0の場合は質問の完全一致のみを使う
+func NewCachedAIRepository(repo repository.AIRepository, embeddingRepo repository.EmbeddingRepository, options ResponseCacheOptions, logger *logrus.Logger) repository.AIRepository {
+	if options.SemanticThreshold <= 0 {
+		embeddingRepo = nil
+	}
+	return &CachedAIRepository{
+		AIRepository:  repo,
+		embeddingRepo: embeddingRepo,
+		options:       options,
+		logger:        logger,
+		entries:       list.New(),
+		byKey:         map[string]*list.Element{},
+	}
+}
+
+// AskAI はキャッシュした回答があれば返し、なければAIに問い合わせて回答をキャッシュする
+func (c *CachedAIRepository) AskAI(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+	startTime := time.Now()
+	key, settingsKey := c.keys(request)
+	cached, vector, ok := c.find(ctx, request, key, settingsKey)
+	if ok {
+		cached.ProcessingMs = time.Since(startTime).Milliseconds()
+		return cached, nil
+	}
+
+	response, err := c.AIRepository.AskAI(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	c.store(key, settingsKey, vector, response)
+	return response, nil
+}
+
+// AskAIStream はキャッシュした回答があればストリーミングに分けて送り直し、
+// なければAIの回答をそのまま流して、最後まで受け取れた回答をキャッシュする
+func (c *CachedAIRepository) AskAIStream(ctx context.Context, request *entity.AIRequest) (<-chan *entity.AIResponse, <-chan error) {
+	startTime := time.Now()
+	key, settingsKey := c.keys(request)
+	cached, vector, ok := c.find(ctx, request, key, settingsKey)
+	if ok {
+		return replay(ctx, cached, startTime)
+	}
+
+	responseChan, errChan := c.AIRepository.AskAIStream(ctx, request)
+	out := make(chan *entity.AIResponse)
+	outErr := make(chan error, 1)
+	go func() {
+		defer close(outErr)
+		defer close(out)
+
+		var (
+			text      strings.Builder
+			toolCalls []entity.ToolCall
+			tokens    int32
+		)
+		for response := range responseChan {
+			text.WriteString(response.Response)
+			toolCalls = append(toolCalls, response.ToolCalls...)
+			tokens += response.TokensUsed
+			select {
+			case out <- response:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := <-errChan; err != nil {
+			outErr <- err
+			return
+		}
+		if text.Len() == 0 {
+			return
+		}
+		complete := entity.NewAIResponseWithMetrics(text.String(), tokens, 0, time.Since(startTime).Milliseconds())
+		complete.ToolCalls = toolCalls
+		c.store(key, settingsKey, vector, complete)
+	}()
+	return out, outErr
+}
+
+// replay はキャッシュした回答をストリーミングの回答と同じ形（ツールの呼び出し・本文のチャンク・最後のメトリクス）で送る
+func replay(ctx context.Context, cached *entity.AIResponse, startTime time.Time) (<-chan *entity.AIResponse, <-chan error) {
+	out := make(chan *entity.AIResponse)
+	outErr := make(chan error, 1)
+	go func() {
+		defer close(outErr)
+		defer close(out)
+
+		var chunks []*entity.AIResponse
+		for _, call := range cached.ToolCalls {
+			chunks = append(chunks, &entity.AIResponse{ToolCalls: []entity.ToolCall{call}, Cached: true})
+		}
+		runes := []rune(cached.Response)
+		for start := 0; start < len(runes); start += replayChunkRunes {
+			end := min(start+replayChunkRunes, len(runes))
+			chunks = append(chunks, &entity.AIResponse{Response: string(runes[start:end]), Cached: true})
+		}
+		final := entity.NewAIResponseWithMetrics("", 0, 0, time.Since(startTime).Milliseconds())
+		final.Cached = true
+		chunks = append(chunks, final)
+
+		for _, chunk := range chunks {
+			select {
+			case out <- chunk:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, outErr
+}
+
+// find はキャッシュした回答を探す
+// 質問が完全に一致する回答があれば埋め込みを求めずに返し、なければ質問を埋め込んで似た質問の回答を探す
+// 見つからなかった場合は、回答をキャッシュするときに使う埋め込みベクトルを返す
+func (c *CachedAIRepository) find(ctx context.Context, request *entity.AIRequest, key, settingsKey string) (*entity.AIResponse, []float32, bool) {
+	if cached, ok := c.lookup(key, settingsKey, nil); ok {
+		return cached, nil, true
+	}
+	vector := c.embed(ctx, request)
+	if vector == nil {
+		return nil, nil, false
+	}
+	cached, ok := c.lookup(key, settingsKey, vector)
+	return cached, vector, ok
+}
+
+// lookup はキャッシュした回答を探し、見つかった回答を最近使われたものとして複製して返す
+// 完全に一致するキーがなければ、質問以外の条件が同じで質問の類似度が最も高い回答を探す
+func (c *CachedAIRepository) lookup(key, settingsKey string, vector []float32) (*entity.AIResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	elem, ok := c.byKey[key]
+	if ok && now.After(elem.Value.(*cacheEntry).expiresAt) {
+		c.remove(elem)
+		ok = false
+	}
+	similarity := 1.0
+	if !ok && vector != nil {
+		elem, similarity = c.nearest(settingsKey, vector, now)
+		ok = elem != nil
+	}
+	if !ok {
+		return nil, false
+	}
+
+	c.entries.MoveToFront(elem)
+	entry := elem.Value.(*cacheEntry)
+	c.logger.WithFields(logrus.Fields{
+		"semantic":   entry.key != key,
+		"similarity": similarity,
+	}).Debug("Response cache hit")
+	response := entry.response
+	response.ToolCalls = append([]entity.ToolCall(nil), entry.response.ToolCalls...)
+	// キャッシュした回答にはトークンを使っていない
+	response.TokensUsed = 0
+	response.Cached = true
+	return &response, true
+}
+
+// nearest は質問以外の条件が同じで、質問の類似度がしきい値以上のうち最も高い回答を返す
+func (c *CachedAIRepository) nearest(settingsKey string, vector []float32, now time.Time) (*list.Element, float64) {
+	var (
+		best      *list.Element
+		bestScore float64
+	)
+	for elem := c.entries.Front(); elem != nil; elem = elem.Next() {
+		entry := elem.Value.(*cacheEntry)
+		if entry.settingsKey != settingsKey || entry.vector == nil || now.After(entry.expiresAt) {
+			continue
+		}
+		if score := knowledge.Cosine(vector, entry.vector); score >= c.options.SemanticThreshold && score > bestScore {
+			best, bestScore = elem, score
+		}
+	}
+	return best, bestScore
+}
+
+// store は回答をキャッシュし、期限切れの回答と最大数を超えた古い回答を捨てる
+func (c *CachedAIRepository) store(key, settingsKey string, vector []float32, response *entity.AIResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &cacheEntry{
+		key:         key,
+		settingsKey: settingsKey,
+		vector:      vector,
+		response:    *response,
+		expiresAt:   time.Now().Add(c.options.TTL),
+	}
+	entry.response.ToolCalls = append([]entity.ToolCall(nil), response.ToolCalls...)
+	if elem, ok := c.byKey[key]; ok {
+		c.remove(elem)
+	}
+	c.byKey[key] = c.entries.PushFront(entry)
+
+	now := time.Now()
+	for elem := c.entries.Back(); elem != nil; {
+		prev := elem.Prev()
+		if c.entries.Len() > c.options.MaxEntries || now.After(elem.Value.(*cacheEntry).expiresAt) {
+			c.remove(elem)
+		}
+		elem = prev
+	}
+}
+
+// remove はキャッシュから回答を取り除く（mu を保持して呼び出す）
+func (c *CachedAIRepository) remove(elem *list.Element) {
+	c.entries.Remove(elem)
+	delete(c.byKey, elem.Value.(*cacheEntry).key)
+}
+
+// embed は意味的な一致に使う質問の埋め込みベクトルを求める（使わない場合と失敗した場合は nil）
+func (c *CachedAIRepository) embed(ctx context.Context, request *entity.AIRequest) []float32 {
+	if c.embeddingRepo == nil {
+		return nil
+	}
+	vector, err := c.embeddingRepo.EmbedQuery(ctx, normalizePrompt(request.Prompt))
+	if err != nil {
+		c.logger.WithError(err).Warn("Failed to embed prompt for response cache, using exact match only")
+		return nil
+	}
+	return vector
+}
+
+// cacheKeyFields はキャッシュのキーに含めるリクエストの条件
+type cacheKeyFields struct {
+	Model          string   `json:"model"`
+	SystemPrompt   string   `json:"system_prompt"`
+	Context        []string `json:"context"`
+	MaxTokens      int32    `json:"max_tokens"`
+	Temperature    float32  `json:"temperature"`
+	Tools          []string `json:"tools"`
+	ResponseSchema string   `json:"response_schema"`
+	Sample         int      `json:"sample"`
+	Attachments    []string `json:"attachments,omitempty"`
+	Prompt         string   `json:"prompt,omitempty"`
+}
+
+// keys はリクエストのキャッシュのキーと、質問以外の条件のキーを返す
+func (c *CachedAIRepository) keys(request *entity.AIRequest) (key, settingsKey string) {
+	fields := cacheKeyFields{
+		SystemPrompt: request.SystemPrompt,
+		Context:      request.Context,
+		MaxTokens:    request.MaxTokens,
+		Temperature:  request.Temperature,
+		Sample:       request.Sample,
+	}
+	if m, ok := c.AIRepository.(interface{ Model() string }); ok {
+		fields.Model = m.Model()
+	}
+	for _, tool := range request.Tools {
+		fields.Tools = append(fields.Tools, tool.Name)
+	}
+	if request.ResponseSchema != nil {
+		fields.ResponseSchema = request.ResponseSchema.Name
+	}
+	// 添付した画像は内容のハッシュ値で区別する（同じ画像への質問だけを似た質問として扱う）
+	for _, attachment := range request.Attachments {
+		sum := sha256.Sum256(append([]byte(attachment.MIMEType+"\n"), attachment.Data...))
+		fields.Attachments = append(fields.Attachments, hex.EncodeToString(sum[:]))
+	}
+	settingsKey = hashKey(fields)
+	fields.Prompt = normalizePrompt(request.Prompt)
+	return hashKey(fields), settingsKey
+}
+
+// hashKey は条件をJSONにしたハッシュ値を返す
+func hashKey(fields cacheKeyFields) string {
+	data, _ := json.Marshal(fields)
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizePrompt は表記の揺れで同じ質問が別のキーにならないよう質問を正規化する
+// 全角の英数字・記号を半角に、英字を小文字にそろえ、英単語の間以外の空白と末尾の句読点・疑問符を取り除く
+func normalizePrompt(prompt string) string {
+	folded := strings.Map(func(r rune) rune {
+		if r >= '！' && r <= '～' {
+			r -= '！' - '!'
+		}
+		return unicode.ToLower(r)
+	}, prompt)
+
+	words := strings.Fields(folded)
+	var b strings.Builder
+	for i, word := range words {
+		// 日本語は分かち書きをしないため、日本語の文字に接する空白は表記の揺れとみなす
+		if i > 0 && lastRune(words[i-1]) < unicode.MaxASCII && firstRune(word) < unicode.MaxASCII {
+			b.WriteByte(' ')
+		}
+		b.WriteString(word)
+	}
+	return strings.TrimRight(b.String(), "?!.。、")
+}
+
+// firstRune は文字列の最初の文字を返す
+func firstRune(s string) rune {
+	for _, r := range s {
+		return r
+	}
+	return 0
+}
+
+// lastRune は文字列の最後の文字を返す
+func lastRune(s string) rune {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
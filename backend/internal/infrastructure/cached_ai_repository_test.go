@@ -0,0 +1,304 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/sirupsen/logrus"
+)
+
+// countingAIRepository は質問をそのまま含む回答を返し、問い合わせの回数を数える
+type countingAIRepository struct {
+	mu      sync.Mutex
+	calls   int
+	chunks  []string
+	toolUse []entity.ToolCall
+	err     error
+}
+
+func (r *countingAIRepository) AskAI(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls++
+	if r.err != nil {
+		return nil, r.err
+	}
+	return entity.NewAIResponseWithMetrics("回答: "+request.Prompt, 50, 0.8, 10), nil
+}
+
+func (r *countingAIRepository) AskAIStream(ctx context.Context, request *entity.AIRequest) (<-chan *entity.AIResponse, <-chan error) {
+	r.mu.Lock()
+	r.calls++
+	chunks, toolUse, err := r.chunks, r.toolUse, r.err
+	r.mu.Unlock()
+
+	responseChan := make(chan *entity.AIResponse)
+	errorChan := make(chan error, 1)
+	go func() {
+		defer close(responseChan)
+		defer close(errorChan)
+		for _, call := range toolUse {
+			responseChan <- &entity.AIResponse{ToolCalls: []entity.ToolCall{call}}
+		}
+		for _, chunk := range chunks {
+			responseChan <- &entity.AIResponse{Response: chunk, TokensUsed: 5}
+		}
+		if err != nil {
+			errorChan <- err
+		}
+	}()
+	return responseChan, errorChan
+}
+
+func (r *countingAIRepository) HealthCheck(ctx context.Context) error {
+	return nil
+}
+
+func (r *countingAIRepository) callCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.calls
+}
+
+// stubEmbeddingRepository は正規化した質問ごとに決まったベクトルを返し、埋め込みの回数を数える
+type stubEmbeddingRepository struct {
+	mu      sync.Mutex
+	vectors map[string][]float32
+	queries []string
+}
+
+func (r *stubEmbeddingRepository) Model() string {
+	return "stub"
+}
+
+func (r *stubEmbeddingRepository) EmbedPassages(ctx context.Context, passages []knowledge.Passage) ([][]float32, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *stubEmbeddingRepository) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.queries = append(r.queries, text)
+	vector, ok := r.vectors[text]
+	if !ok {
+		return nil, errors.New("unknown query")
+	}
+	return vector, nil
+}
+
+func (r *stubEmbeddingRepository) queryCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.queries)
+}
+
+func newTestCache(repo *countingAIRepository, embedder *stubEmbeddingRepository, options ResponseCacheOptions) *CachedAIRepository {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	if embedder == nil {
+		return NewCachedAIRepository(repo, nil, options, logger).(*CachedAIRepository)
+	}
+	return NewCachedAIRepository(repo, embedder, options, logger).(*CachedAIRepository)
+}
+
+// ask は質問して回答を返す
+func ask(t *testing.T, cache *CachedAIRepository, request *entity.AIRequest) *entity.AIResponse {
+	t.Helper()
+	response, err := cache.AskAI(context.Background(), request)
+	if err != nil {
+		t.Fatalf("AskAI(%q): %v", request.Prompt, err)
+	}
+	return response
+}
+
+func TestCachedAIRepositoryExactMatch(t *testing.T) {
+	repo := &countingAIRepository{}
+	cache := newTestCache(repo, nil, ResponseCacheOptions{TTL: time.Hour, MaxEntries: 10})
+
+	first := ask(t, cache, entity.NewAIRequest("立直の条件は？"))
+	if first.Cached || repo.callCount() != 1 {
+		t.Fatalf("first answer cached = %v with %d calls", first.Cached, repo.callCount())
+	}
+	// 表記の揺れは同じ質問とみなす
+	second := ask(t, cache, entity.NewAIRequest(" 立直の条件は "))
+	if !second.Cached || second.Response != first.Response || second.TokensUsed != 0 || repo.callCount() != 1 {
+		t.Errorf("second answer = %+v with %d calls, want the cached answer without tokens", second, repo.callCount())
+	}
+
+	// 質問以外の条件が違えば問い合わせる
+	other := entity.NewAIRequest("立直の条件は？")
+	other.SystemPrompt = "別のペルソナ"
+	if ask(t, cache, other).Cached || repo.callCount() != 2 {
+		t.Errorf("request with another system prompt was answered from the cache")
+	}
+
+	// 失敗した問い合わせはキャッシュしない
+	repo.err = errors.New("unavailable")
+	if _, err := cache.AskAI(context.Background(), entity.NewAIRequest("ドラとは")); err == nil {
+		t.Fatal("AskAI() = nil, want the AI error")
+	}
+	repo.err = nil
+	if ask(t, cache, entity.NewAIRequest("ドラとは")).Cached {
+		t.Error("failed answer was cached")
+	}
+}
+
+func TestCachedAIRepositoryTTL(t *testing.T) {
+	repo := &countingAIRepository{}
+	cache := newTestCache(repo, nil, ResponseCacheOptions{TTL: 10 * time.Millisecond, MaxEntries: 10})
+
+	ask(t, cache, entity.NewAIRequest("立直の条件は"))
+	if !ask(t, cache, entity.NewAIRequest("立直の条件は")).Cached {
+		t.Fatal("answer was not cached within the TTL")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if ask(t, cache, entity.NewAIRequest("立直の条件は")).Cached || repo.callCount() != 2 {
+		t.Errorf("expired answer was returned (%d calls)", repo.callCount())
+	}
+}
+
+func TestCachedAIRepositoryLRU(t *testing.T) {
+	repo := &countingAIRepository{}
+	cache := newTestCache(repo, nil, ResponseCacheOptions{TTL: time.Hour, MaxEntries: 2})
+
+	ask(t, cache, entity.NewAIRequest("A"))
+	ask(t, cache, entity.NewAIRequest("B"))
+	// A を使うと、最も長く使われていない B が捨てられる
+	if !ask(t, cache, entity.NewAIRequest("A")).Cached {
+		t.Fatal("A was not cached")
+	}
+	ask(t, cache, entity.NewAIRequest("C"))
+	if cache.entries.Len() != 2 {
+		t.Errorf("%d entries, want 2", cache.entries.Len())
+	}
+	if !ask(t, cache, entity.NewAIRequest("A")).Cached {
+		t.Error("recently used A was evicted")
+	}
+	if ask(t, cache, entity.NewAIRequest("B")).Cached {
+		t.Error("least recently used B was not evicted")
+	}
+}
+
+func TestCachedAIRepositorySemanticMatch(t *testing.T) {
+	repo := &countingAIRepository{}
+	embedder := &stubEmbeddingRepository{vectors: map[string][]float32{
+		"立直の条件は":   {1, 0, 0},
+		"立直できる条件":  {0.98, 0.2, 0},
+		"ドラの数え方":   {0, 1, 0},
+		"リーチの条件は?": {0.95, 0, 0.3},
+	}}
+	cache := newTestCache(repo, embedder, ResponseCacheOptions{TTL: time.Hour, MaxEntries: 10, SemanticThreshold: 0.9})
+
+	first := ask(t, cache, entity.NewAIRequest("立直の条件は？"))
+	// 言い換えの質問にはキャッシュした回答を返す
+	paraphrase := ask(t, cache, entity.NewAIRequest("立直できる条件"))
+	if !paraphrase.Cached || paraphrase.Response != first.Response || repo.callCount() != 1 {
+		t.Errorf("paraphrase = %+v with %d calls, want the cached answer", paraphrase, repo.callCount())
+	}
+	// 似ていない質問は問い合わせる
+	if ask(t, cache, entity.NewAIRequest("ドラの数え方")).Cached || repo.callCount() != 2 {
+		t.Error("unrelated question was answered from the cache")
+	}
+	// 質問以外の条件が違う回答は使わない
+	other := entity.NewAIRequest("立直できる条件")
+	other.Context = []string{"三人麻雀"}
+	if ask(t, cache, other).Cached || repo.callCount() != 3 {
+		t.Error("answer with another context was used for a similar question")
+	}
+
+	// 完全に一致する質問は埋め込みを求めずに返す
+	embedded := embedder.queryCount()
+	if !ask(t, cache, entity.NewAIRequest("立直の条件は")).Cached {
+		t.Fatal("exact question was not cached")
+	}
+	if embedder.queryCount() != embedded {
+		t.Errorf("EmbedQuery called %d times for an exact match, want 0", embedder.queryCount()-embedded)
+	}
+
+	// 埋め込みに失敗した質問は完全一致だけを使う
+	if ask(t, cache, entity.NewAIRequest("未知の質問")).Cached || !ask(t, cache, entity.NewAIRequest("未知の質問")).Cached {
+		t.Error("question without an embedding was not cached by exact match")
+	}
+}
+
+// collect はストリーミングの回答をすべて受け取る
+func collect(responseChan <-chan *entity.AIResponse, errChan <-chan error) ([]*entity.AIResponse, error) {
+	var chunks []*entity.AIResponse
+	for response := range responseChan {
+		chunks = append(chunks, response)
+	}
+	return chunks, <-errChan
+}
+
+func TestCachedAIRepositoryStreamReplay(t *testing.T) {
+	long := strings.Repeat("押し引き", replayChunkRunes/2)
+	repo := &countingAIRepository{
+		chunks:  []string{"立直は", long},
+		toolUse: []entity.ToolCall{{Name: "recommend_discard", Result: map[string]any{"discard": "5s"}}},
+	}
+	cache := newTestCache(repo, nil, ResponseCacheOptions{TTL: time.Hour, MaxEntries: 10})
+	ctx := context.Background()
+
+	chunks, err := collect(cache.AskAIStream(ctx, entity.NewAIRequest("押し引きは")))
+	if err != nil || len(chunks) != 3 || chunks[0].Cached {
+		t.Fatalf("first stream = %d chunks, %v", len(chunks), err)
+	}
+
+	// 2回目はキャッシュから、ツールの呼び出し・本文のチャンク・最後のメトリクスの順に送り直す
+	replayed, err := collect(cache.AskAIStream(ctx, entity.NewAIRequest("押し引きは")))
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if repo.callCount() != 1 {
+		t.Errorf("AskAIStream called %d times, want 1", repo.callCount())
+	}
+	var text strings.Builder
+	for i, chunk := range replayed {
+		if !chunk.Cached {
+			t.Errorf("chunk %d is not marked as cached", i)
+		}
+		if n := len([]rune(chunk.Response)); n > replayChunkRunes {
+			t.Errorf("chunk %d has %d runes, want at most %d", i, n, replayChunkRunes)
+		}
+		text.WriteString(chunk.Response)
+	}
+	if len(replayed[0].ToolCalls) != 1 || replayed[0].ToolCalls[0].Name != "recommend_discard" {
+		t.Errorf("first replayed chunk = %+v, want the tool call", replayed[0])
+	}
+	if want := "立直は" + long; text.String() != want {
+		t.Errorf("replayed text = %q, want %q", text.String(), want)
+	}
+	if last := replayed[len(replayed)-1]; last.Response != "" || last.TokensUsed != 0 {
+		t.Errorf("last replayed chunk = %+v, want the metrics only", last)
+	}
+
+	// ストリーミングでキャッシュした回答は単発の問い合わせにも使う
+	if response := ask(t, cache, entity.NewAIRequest("押し引きは")); !response.Cached || response.Response != "立直は"+long {
+		t.Errorf("AskAI() = %+v, want the streamed answer", response)
+	}
+}
+
+func TestCachedAIRepositoryStreamError(t *testing.T) {
+	repo := &countingAIRepository{chunks: []string{"途中まで"}, err: errors.New("stream broken")}
+	cache := newTestCache(repo, nil, ResponseCacheOptions{TTL: time.Hour, MaxEntries: 10})
+	ctx := context.Background()
+
+	if _, err := collect(cache.AskAIStream(ctx, entity.NewAIRequest("押し引きは"))); err == nil {
+		t.Fatal("stream error was not returned")
+	}
+	// 最後まで受け取れなかった回答はキャッシュしない
+	repo.mu.Lock()
+	repo.err = nil
+	repo.mu.Unlock()
+	chunks, err := collect(cache.AskAIStream(ctx, entity.NewAIRequest("押し引きは")))
+	if err != nil || len(chunks) == 0 || chunks[0].Cached || repo.callCount() != 2 {
+		t.Errorf("second stream = %d chunks cached %v with %d calls, want a new answer", len(chunks), len(chunks) > 0 && chunks[0].Cached, repo.callCount())
+	}
+}
@@ -0,0 +1,26 @@
+package infrastructure
+
+import (
+	"fmt"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// localEmbeddingDims はローカルの埋め込みベクトルの次元
+const localEmbeddingDims = 256
+
+// NewEmbeddingClient は埋め込みモデルの種類（gemini / local / off）に応じたクライアントを作成する
+// off の場合は nil を返す
+func NewEmbeddingClient(provider, apiKey string, logger *logrus.Logger) (repository.EmbeddingRepository, error) {
+	switch provider {
+	case "gemini":
+		return NewGeminiEmbeddingClient(apiKey, logger)
+	case "local":
+		return NewLocalEmbeddingClient(localEmbeddingDims), nil
+	case "off", "":
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider %q (use gemini, local or off)", provider)
+	}
+}
@@ -0,0 +1,60 @@
+package infrastructure
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// FileVectorIndexRepository は抜粋の埋め込みベクトルの索引をJSONファイルに保存するリポジトリ
+type FileVectorIndexRepository struct {
+	path string
+}
+
+// NewFileVectorIndexRepository は新しいFileVectorIndexRepositoryを作成する
+func NewFileVectorIndexRepository(path string) repository.VectorIndexRepository {
+	return &FileVectorIndexRepository{path: path}
+}
+
+// Load は索引を読み込む（ファイルがない場合は nil, nil を返す）
+func (r *FileVectorIndexRepository) Load(ctx context.Context) (*knowledge.VectorIndex, error) {
+	data, err := os.ReadFile(r.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vector index %q: %w", r.path, err)
+	}
+	var index knowledge.VectorIndex
+	if err := json.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("failed to parse vector index %q: %w", r.path, err)
+	}
+	return &index, nil
+}
+
+// Save は索引を保存する
+// 書き込み途中のファイルを読み込まないよう、一時ファイルに書いてから置き換える
+func (r *FileVectorIndexRepository) Save(ctx context.Context, index *knowledge.VectorIndex) error {
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for vector index %q: %w", r.path, err)
+	}
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write vector index %q: %w", r.path, err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf("failed to write vector index %q: %w", r.path, err)
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/generative-ai-go/genai"
@@ -14,13 +15,16 @@ import (
 
 // GeminiClient はGemini APIクライアントの実装
 type GeminiClient struct {
-	client *genai.Client
-	model  *genai.GenerativeModel
-	logger *logrus.Logger
+	client    *genai.Client
+	modelName string
+	logger    *logrus.Logger
+	// contextCache はシステム指示とツールのコンテキストキャッシュ（nil の場合はキャッシュしない）
+	contextCache *geminiContextCache
 }
 
 // NewGeminiClient は新しいGeminiClientを作成する
-func NewGeminiClient(apiKey string, logger *logrus.Logger) (repository.AIRepository, error) {
+// contextCacheTTL が正の場合は、システム指示とツールをその期限でGeminiのコンテキストキャッシュに置く
+func NewGeminiClient(apiKey string, contextCacheTTL time.Duration, logger *logrus.Logger) (repository.AIRepository, error) {
 	ctx := context.Background()
 
 	client, err := genai.NewClient(ctx, option.WithAPIKey(apiKey))
@@ -29,20 +33,90 @@ func NewGeminiClient(apiKey string, logger *logrus.Logger) (repository.AIReposit
 	}
 
 	// Gemini 2.5 Flash モデルを使用
-	model := client.GenerativeModel("gemini-2.5-flash")
+	g := &GeminiClient{
+		client:    client,
+		modelName: "gemini-2.5-flash",
+		logger:    logger,
+	}
+	if contextCacheTTL > 0 {
+		g.contextCache = newGeminiContextCache(client, g.modelName, contextCacheTTL, logger)
+	}
+	return g, nil
+}
+
+// Model は回答を生成するモデルの名前を返す
+func (g *GeminiClient) Model() string {
+	return g.modelName
+}
 
+// newModel はリクエストの設定を反映したモデルを作成する
+// モデルはリクエストごとに作成し、並行リクエスト間で設定が混ざらないようにする
+func (g *GeminiClient) newModel(request *entity.AIRequest) *genai.GenerativeModel {
+	model := g.client.GenerativeModel(g.modelName)
+	model.SystemInstruction = systemInstruction(request)
+	model.Tools = requestTools(request)
+	setGenerationConfig(model, request)
+	return model
+}
+
+// model はリクエストに使うモデルと、参照したコンテキストキャッシュのキー（参照しない場合は空）を返す
+// システム指示をキャッシュできた場合は、システム指示とツールを送らずにキャッシュを参照する
+func (g *GeminiClient) model(ctx context.Context, request *entity.AIRequest) (*genai.GenerativeModel, string) {
+	instruction := systemInstruction(request)
+	if g.contextCache == nil || instruction == nil {
+		return g.newModel(request), ""
+	}
+	key, name, ok := g.contextCache.lookup(ctx, instruction, requestTools(request))
+	if !ok {
+		return g.newModel(request), ""
+	}
+	model := g.client.GenerativeModel(g.modelName)
+	model.CachedContentName = name
+	setGenerationConfig(model, request)
+	return model, key
+}
+
+// systemInstruction はリクエストのシステム指示を返す（指定がない場合は nil）
+func systemInstruction(request *entity.AIRequest) *genai.Content {
 	// 麻雀AIとしての設定を追加
-	model.SystemInstruction = &genai.Content{
-		Parts: []genai.Part{
-			genai.Text("あなたは麻雀の専門家です。麻雀に関する質問に対して、正確で分かりやすい回答を日本語で提供してください。戦術、ルール、確率計算など、麻雀に関するあらゆる側面について回答できます。"),
-		},
+	if request.SystemPrompt == "" {
+		return nil
+	}
+	return &genai.Content{Parts: []genai.Part{genai.Text(request.SystemPrompt)}}
+}
+
+// requestTools はリクエストで公開するツールを返す
+// Geminiは関数呼び出しとJSONの出力を同時に使えないため、形式を指定した場合はツールを公開しない
+func requestTools(request *entity.AIRequest) []*genai.Tool {
+	if request.ResponseSchema != nil {
+		return nil
 	}
+	// 麻雀エンジンなどのツールを関数呼び出しとして公開
+	return toGenaiTools(request.Tools)
+}
 
-	return &GeminiClient{
-		client: client,
-		model:  model,
-		logger: logger,
-	}, nil
+// setGenerationConfig はリクエストの生成の設定をモデルに反映する
+func setGenerationConfig(model *genai.GenerativeModel, request *entity.AIRequest) {
+	model.SetTemperature(request.Temperature)
+	model.SetMaxOutputTokens(request.MaxTokens)
+	// 形式を指定した場合はJSONで出力させる
+	if request.ResponseSchema != nil {
+		model.ResponseMIMEType = "application/json"
+		model.ResponseSchema = toGenaiSchema(request.ResponseSchema.Root)
+	}
+}
+
+// requestParts はリクエストのコンテキスト・添付した画像・質問を送る順に並べる
+func requestParts(request *entity.AIRequest) []genai.Part {
+	var parts []genai.Part
+	for _, ctx := range request.Context {
+		parts = append(parts, genai.Text(ctx))
+	}
+	// 画像は質問の前に置く（添付できる形式はすべて image/*）
+	for _, attachment := range request.Attachments {
+		parts = append(parts, genai.ImageData(strings.TrimPrefix(attachment.MIMEType, "image/"), attachment.Data))
+	}
+	return append(parts, genai.Text(request.Prompt))
 }
 
 // AskAI はGemini APIにプロンプトを送信してレスポンスを取得する
@@ -54,25 +128,40 @@ func (g *GeminiClient) AskAI(ctx context.Context, request *entity.AIRequest) (*e
 		"max_tokens":  request.MaxTokens,
 		"temperature": request.Temperature,
 		"context":     request.Context,
+		"attachments": len(request.Attachments),
 	}).Debug("Sending request to Gemini API")
 
-	// モデルの設定を更新
-	g.model.SetTemperature(request.Temperature)
-	g.model.SetMaxOutputTokens(request.MaxTokens)
-
-	// コンテキストがある場合は追加
-	var parts []genai.Part
-	for _, ctx := range request.Context {
-		parts = append(parts, genai.Text(ctx))
+	model, cacheKey := g.model(ctx, request)
+	parts := requestParts(request)
+
+	// Gemini APIにリクエストを送信し、ツールの呼び出しが要求されたら結果を返して回答を続けさせる
+	session := model.StartChat()
+	resp, err := session.SendMessage(ctx, parts...)
+	if err != nil && cacheKey != "" && isContextCacheError(err) {
+		// キャッシュが消えている場合はキャッシュを使わずに送り直す
+		g.contextCache.invalidate(cacheKey)
+		session = g.newModel(request).StartChat()
+		resp, err = session.SendMessage(ctx, parts...)
 	}
-	parts = append(parts, genai.Text(request.Prompt))
-
-	// Gemini APIにリクエストを送信
-	resp, err := g.model.GenerateContent(ctx, parts...)
 	if err != nil {
 		g.logger.WithError(err).Error("Failed to generate content with Gemini API")
 		return nil, fmt.Errorf("failed to generate content: %w", err)
 	}
+	tokensUsed := usageTokens(resp)
+	var toolCalls []entity.ToolCall
+	for round := 0; round < maxToolRounds && len(resp.Candidates) > 0; round++ {
+		calls := resp.Candidates[0].FunctionCalls()
+		if len(calls) == 0 {
+			break
+		}
+		responses, records := g.callTools(ctx, request.Tools, calls)
+		toolCalls = append(toolCalls, records...)
+		if resp, err = session.SendMessage(ctx, responses...); err != nil {
+			g.logger.WithError(err).Error("Failed to generate content with Gemini API")
+			return nil, fmt.Errorf("failed to generate content: %w", err)
+		}
+		tokensUsed += usageTokens(resp)
+	}
 
 	processingTime := time.Since(startTime).Milliseconds()
 
@@ -95,21 +184,35 @@ func (g *GeminiClient) AskAI(ctx context.Context, request *entity.AIRequest) (*e
 		return nil, entity.ErrAIServiceUnavailable
 	}
 
-	// メトリクスを含むレスポンスを作成
-	tokensUsed := int32(0)
-	if resp.UsageMetadata != nil {
-		tokensUsed = int32(resp.UsageMetadata.TotalTokenCount)
-	}
-
-	confidence := float32(0.8) // Geminiは信頼度スコアを提供しないため、デフォルト値を使用
-
 	g.logger.WithFields(logrus.Fields{
 		"response_length": len(responseText),
 		"tokens_used":     tokensUsed,
+		"cached_tokens":   cachedTokens(resp),
+		"tool_calls":      len(toolCalls),
 		"processing_time": processingTime,
 	}).Debug("Received response from Gemini API")
 
-	return entity.NewAIResponseWithMetrics(responseText, tokensUsed, confidence, processingTime), nil
+	// メトリクスを含むレスポンスを作成
+	// Geminiは信頼度スコアを提供しないため、信頼度はユースケースで回答を検証して求める
+	response := entity.NewAIResponseWithMetrics(responseText, tokensUsed, 0, processingTime)
+	response.ToolCalls = toolCalls
+	return response, nil
+}
+
+// usageTokens はレスポンスの使用トークン数を返す
+func usageTokens(resp *genai.GenerateContentResponse) int32 {
+	if resp.UsageMetadata == nil {
+		return 0
+	}
+	return resp.UsageMetadata.TotalTokenCount
+}
+
+// cachedTokens はレスポンスの入力のうちコンテキストキャッシュから読み込んだトークン数を返す
+func cachedTokens(resp *genai.GenerateContentResponse) int32 {
+	if resp.UsageMetadata == nil {
+		return 0
+	}
+	return resp.UsageMetadata.CachedContentTokenCount
 }
 
 // AskAIStream はGemini APIにプロンプトを送信してストリーミングレスポンスを取得する
@@ -128,55 +231,58 @@ func (g *GeminiClient) AskAIStream(ctx context.Context, request *entity.AIReques
 			"max_tokens":  request.MaxTokens,
 			"temperature": request.Temperature,
 			"context":     request.Context,
+			"attachments": len(request.Attachments),
 		}).Debug("Sending streaming request to Gemini API")
 
-		// モデルの設定を更新
-		g.model.SetTemperature(request.Temperature)
-		g.model.SetMaxOutputTokens(request.MaxTokens)
-
-		// コンテキストがある場合は追加
-		var parts []genai.Part
-		for _, ctx := range request.Context {
-			parts = append(parts, genai.Text(ctx))
-		}
-		parts = append(parts, genai.Text(request.Prompt))
+		model, cacheKey := g.model(ctx, request)
+		parts := requestParts(request)
 
 		// ストリーミングリクエストを送信
-		iter := g.model.GenerateContentStream(ctx, parts...)
+		session := model.StartChat()
+		iter := session.SendMessageStream(ctx, parts...)
 
 		fullResponse := ""
-		for {
-			resp, err := iter.Next()
+		received := false
+		for round := 0; ; round++ {
+			text, calls, got, err := receiveStream(ctx, iter, responseChan)
+			fullResponse += text
 			if err != nil {
-				if err.Error() == "iterator is done" {
-					break
+				if !received && !got && cacheKey != "" && isContextCacheError(err) {
+					// キャッシュが消えている場合は、まだ何も送っていなければキャッシュを使わずに送り直す
+					g.contextCache.invalidate(cacheKey)
+					cacheKey = ""
+					session = g.newModel(request).StartChat()
+					iter = session.SendMessageStream(ctx, parts...)
+					round--
+					continue
 				}
 				errorChan <- fmt.Errorf("failed to get stream response: %w", err)
 				return
 			}
+			received = received || got
+			if len(calls) == 0 || round >= maxToolRounds {
+				break
+			}
 
-			// レスポンスチャンクを処理
-			for _, candidate := range resp.Candidates {
-				for _, part := range candidate.Content.Parts {
-					if textPart, ok := part.(genai.Text); ok {
-						chunkText := string(textPart)
-						fullResponse += chunkText
-
-						// チャンクレスポンスを送信
-						responseChan <- entity.NewAIResponse(chunkText)
-					}
+			// ツールを実行して呼び出しを通知し、結果を返して回答を続けさせる
+			responses, records := g.callTools(ctx, request.Tools, calls)
+			for _, record := range records {
+				if !sendResponse(ctx, responseChan, &entity.AIResponse{ToolCalls: []entity.ToolCall{record}}) {
+					return
 				}
 			}
+			iter = session.SendMessageStream(ctx, responses...)
 		}
 
 		processingTime := time.Since(startTime).Milliseconds()
 
 		// 最終レスポンスのメタデータを送信
 		tokensUsed := int32(len(fullResponse) / 4) // 概算
-		confidence := float32(0.8)
 
-		finalResponse := entity.NewAIResponseWithMetrics("", tokensUsed, confidence, processingTime)
-		responseChan <- finalResponse
+		finalResponse := entity.NewAIResponseWithMetrics("", tokensUsed, 0, processingTime)
+		if !sendResponse(ctx, responseChan, finalResponse) {
+			return
+		}
 
 		g.logger.WithFields(logrus.Fields{
 			"total_response_length": len(fullResponse),
@@ -187,19 +293,75 @@ func (g *GeminiClient) AskAIStream(ctx context.Context, request *entity.AIReques
 	return responseChan, errorChan
 }
 
+// streamIterator はストリーミングのレスポンスを順に返す（genai.GenerateContentResponseIterator）
+type streamIterator interface {
+	Next() (*genai.GenerateContentResponse, error)
+}
+
+// receiveStream はストリームが終わるまでテキストのチャンクを responseChan に送り、
+// 受け取ったテキストと関数呼び出し、レスポンスを1つでも受け取ったかを返す
+// 受信側が読むのをやめて ctx が終了した場合は ctx.Err() を返す
+func receiveStream(ctx context.Context, iter streamIterator, responseChan chan<- *entity.AIResponse) (string, []genai.FunctionCall, bool, error) {
+	text := ""
+	var calls []genai.FunctionCall
+	received := false
+	for {
+		resp, err := iter.Next()
+		if err != nil {
+			if err.Error() == "iterator is done" {
+				return text, calls, received, nil
+			}
+			return text, calls, received, err
+		}
+		received = true
+
+		// レスポンスチャンクを処理
+		for _, candidate := range resp.Candidates {
+			if candidate.Content == nil {
+				continue
+			}
+			for _, part := range candidate.Content.Parts {
+				switch p := part.(type) {
+				case genai.Text:
+					chunkText := string(p)
+					text += chunkText
+
+					// チャンクレスポンスを送信
+					if !sendResponse(ctx, responseChan, entity.NewAIResponse(chunkText)) {
+						return text, calls, received, ctx.Err()
+					}
+				case genai.FunctionCall:
+					calls = append(calls, p)
+				}
+			}
+		}
+	}
+}
+
+// sendResponse はレスポンスを送る。受信側が読むのをやめて ctx が終了した場合は false を返す
+func sendResponse(ctx context.Context, responseChan chan<- *entity.AIResponse, response *entity.AIResponse) bool {
+	select {
+	case responseChan <- response:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // HealthCheck はGemini APIの健康状態を確認する
 func (g *GeminiClient) HealthCheck(ctx context.Context) error {
-	// 簡単なテストクエリを送信
-	testRequest := entity.NewAIRequest("Hello")
-	_, err := g.AskAI(ctx, testRequest)
-	if err != nil {
+	// 生成リクエストは高コストなため、モデルのメタデータ取得で疎通を確認する
+	if _, err := g.client.GenerativeModel(g.modelName).Info(ctx); err != nil {
 		return fmt.Errorf("health check failed: %w", err)
 	}
 	return nil
 }
 
-// Close はクライアントを閉じる
+// Close は作成したコンテキストキャッシュを削除し、クライアントを閉じる
 func (g *GeminiClient) Close() error {
+	if g.contextCache != nil {
+		g.contextCache.close()
+	}
 	if g.client != nil {
 		return g.client.Close()
 	}
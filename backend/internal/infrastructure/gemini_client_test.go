@@ -0,0 +1,55 @@
+package infrastructure
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+)
+
+// endlessIterator は終わらないテキストのチャンクを返す
+type endlessIterator struct{}
+
+func (endlessIterator) Next() (*genai.GenerateContentResponse, error) {
+	return &genai.GenerateContentResponse{
+		Candidates: []*genai.Candidate{{Content: &genai.Content{Parts: []genai.Part{genai.Text("chunk")}}}},
+	}, nil
+}
+
+func TestReceiveStreamStopsWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	responseChan := make(chan *entity.AIResponse)
+	done := make(chan error, 1)
+	go func() {
+		_, _, _, err := receiveStream(ctx, endlessIterator{}, responseChan)
+		done <- err
+	}()
+
+	// 受信側が途中で読むのをやめる
+	for range 3 {
+		if r := <-responseChan; r.Response != "chunk" {
+			t.Fatalf("response = %q, want chunk", r.Response)
+		}
+	}
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("receiveStream error = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("receiveStream did not return after the context was canceled")
+	}
+}
+
+func TestSendResponseStopsWhenCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if sendResponse(ctx, make(chan *entity.AIResponse), entity.NewAIResponse("chunk")) {
+		t.Error("sendResponse succeeded without a receiver after the context was canceled")
+	}
+}
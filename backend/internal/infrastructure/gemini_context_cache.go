@@ -0,0 +1,272 @@
+package infrastructure
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// contextCacheTimeout はコンテキストキャッシュの作成・延長・削除を打ち切るまでの時間
+const contextCacheTimeout = 30 * time.Second
+
+// maxContextCacheEntries は同時に持つコンテキストキャッシュの最大数
+// ペルソナ・ルールセット・レベル・言語の組み合わせを超える数は想定しないため、超えた分はキャッシュせずに送る
+const maxContextCacheEntries = 256
+
+// contextCacheEntry はシステム指示とツールの組み合わせごとのコンテキストキャッシュ
+type contextCacheEntry struct {
+	// ready は作成が終わると閉じられる（作成中の同じキャッシュを重複して作らないようにする）
+	ready chan struct{}
+	// name はキャッシュのリソース名（cachedContents/{id}）
+	name string
+	// expireTime はキャッシュの期限。作成に失敗した場合は再び作成を試みるまでの期限
+	expireTime time.Time
+	// failed は作成に失敗したか（短すぎるシステム指示など。期限まではキャッシュを使わない）
+	failed     bool
+	refreshing bool
+}
+
+// done は作成が終わったかを返す
+func (e *contextCacheEntry) done() bool {
+	select {
+	case <-e.ready:
+		return true
+	default:
+		return false
+	}
+}
+
+// geminiContextCache はペルソナ・ルールセットごとのシステム指示とツールの宣言を
+// Geminiのコンテキストキャッシュに置き、リクエストごとに送り直さないようにする
+//
+// キャッシュは期限が近づくと延長し、期限切れのキャッシュは作り直す。
+// 作成に失敗した場合（キャッシュできる最小のトークン数に満たない場合など）は、期限まではキャッシュを使わずに送る。
+type geminiContextCache struct {
+	client    *genai.Client
+	modelName string
+	ttl       time.Duration
+	logger    *logrus.Logger
+
+	mu      sync.Mutex
+	entries map[string]*contextCacheEntry
+}
+
+// newGeminiContextCache は期限が ttl のコンテキストキャッシュを管理する geminiContextCache を作成する
+func newGeminiContextCache(client *genai.Client, modelName string, ttl time.Duration, logger *logrus.Logger) *geminiContextCache {
+	return &geminiContextCache{
+		client:    client,
+		modelName: modelName,
+		ttl:       ttl,
+		logger:    logger,
+		entries:   map[string]*contextCacheEntry{},
+	}
+}
+
+// lookup はシステム指示とツールのコンテキストキャッシュのキーとリソース名を返す
+// キャッシュがなければ作成し、作成できない場合は ok に false を返す
+func (c *geminiContextCache) lookup(ctx context.Context, systemInstruction *genai.Content, tools []*genai.Tool) (key, name string, ok bool) {
+	key = contextCacheKey(systemInstruction, tools)
+
+	c.mu.Lock()
+	entry := c.entries[key]
+	if entry != nil && entry.done() && time.Now().After(entry.expireTime) {
+		if !entry.failed {
+			c.logger.WithField("cached_content", entry.name).Debug("Gemini context cache expired")
+		}
+		delete(c.entries, key)
+		entry = nil
+	}
+	if entry == nil {
+		if len(c.entries) >= maxContextCacheEntries {
+			c.pruneExpired(time.Now())
+		}
+		if len(c.entries) >= maxContextCacheEntries {
+			c.mu.Unlock()
+			c.logger.WithField("entries", maxContextCacheEntries).Warn("Too many Gemini context caches, sending system instruction without cache")
+			return key, "", false
+		}
+		entry = &contextCacheEntry{ready: make(chan struct{})}
+		c.entries[key] = entry
+		c.mu.Unlock()
+		c.create(ctx, entry, systemInstruction, tools)
+	} else {
+		c.mu.Unlock()
+		select {
+		case <-entry.ready:
+		case <-ctx.Done():
+			return key, "", false
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry.failed {
+		return key, "", false
+	}
+	// 期限の残りが短くなったキャッシュはバックグラウンドで延長する
+	if time.Until(entry.expireTime) < c.ttl/4 && !entry.refreshing {
+		entry.refreshing = true
+		go c.refresh(key, entry)
+	}
+	return key, entry.name, true
+}
+
+// create はコンテキストキャッシュを作成する
+// リクエストが取り消されても、待っている他のリクエストのために作成は続ける
+func (c *geminiContextCache) create(ctx context.Context, entry *contextCacheEntry, systemInstruction *genai.Content, tools []*genai.Tool) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), contextCacheTimeout)
+	defer cancel()
+
+	cc, err := c.client.CreateCachedContent(ctx, &genai.CachedContent{
+		Model:             c.modelName,
+		SystemInstruction: systemInstruction,
+		Tools:             tools,
+		Expiration:        genai.ExpireTimeOrTTL{TTL: c.ttl},
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	defer close(entry.ready)
+	if err != nil {
+		c.logger.WithError(err).Warn("Failed to create Gemini context cache, sending system instruction without cache")
+		entry.failed = true
+		entry.expireTime = time.Now().Add(c.ttl)
+		return
+	}
+	entry.name = cc.Name
+	entry.expireTime = cc.Expiration.ExpireTime
+	if entry.expireTime.IsZero() {
+		entry.expireTime = time.Now().Add(c.ttl)
+	}
+	fields := logrus.Fields{
+		"cached_content": cc.Name,
+		"expire_time":    entry.expireTime,
+	}
+	if cc.UsageMetadata != nil {
+		fields["cached_tokens"] = cc.UsageMetadata.TotalTokenCount
+	}
+	c.logger.WithFields(fields).Info("Gemini context cache created")
+}
+
+// refresh はコンテキストキャッシュの期限を延長する（延長できない場合は次のリクエストで作り直す）
+func (c *geminiContextCache) refresh(key string, entry *contextCacheEntry) {
+	ctx, cancel := context.WithTimeout(context.Background(), contextCacheTimeout)
+	defer cancel()
+
+	cc, err := c.client.UpdateCachedContent(ctx, &genai.CachedContent{Name: entry.name}, &genai.CachedContentToUpdate{
+		Expiration: &genai.ExpireTimeOrTTL{TTL: c.ttl},
+	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.refreshing = false
+	if err != nil {
+		c.logger.WithError(err).WithField("cached_content", entry.name).Warn("Failed to refresh Gemini context cache")
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		return
+	}
+	entry.expireTime = cc.Expiration.ExpireTime
+	if entry.expireTime.IsZero() {
+		entry.expireTime = time.Now().Add(c.ttl)
+	}
+	c.logger.WithFields(logrus.Fields{
+		"cached_content": entry.name,
+		"expire_time":    entry.expireTime,
+	}).Debug("Gemini context cache refreshed")
+}
+
+// pruneExpired は期限の過ぎたキャッシュと、作成に失敗して期限の過ぎた記録を捨てる（c.mu を保持して呼ぶ）
+func (c *geminiContextCache) pruneExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if entry.done() && now.After(entry.expireTime) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// invalidate はGeminiに見つからなかったコンテキストキャッシュを捨て、次のリクエストで作り直す
+func (c *geminiContextCache) invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry, ok := c.entries[key]; ok && entry.done() {
+		c.logger.WithField("cached_content", entry.name).Warn("Gemini context cache is missing or expired, falling back to uncached request")
+		delete(c.entries, key)
+	}
+}
+
+// close は作成したコンテキストキャッシュを削除する（期限まで課金されるため、終了時に片付ける）
+func (c *geminiContextCache) close() {
+	c.mu.Lock()
+	var names []string
+	for key, entry := range c.entries {
+		if entry.done() && !entry.failed {
+			names = append(names, entry.name)
+		}
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), contextCacheTimeout)
+	defer cancel()
+	for _, name := range names {
+		if err := c.client.DeleteCachedContent(ctx, name); err != nil {
+			c.logger.WithError(err).WithField("cached_content", name).Warn("Failed to delete Gemini context cache")
+		}
+	}
+}
+
+// contextCacheKey はシステム指示とツールの宣言から求めたキャッシュのキーを返す
+func contextCacheKey(systemInstruction *genai.Content, tools []*genai.Tool) string {
+	data, _ := json.Marshal(struct {
+		SystemInstruction *genai.Content `json:"system_instruction"`
+		Tools             []*genai.Tool  `json:"tools"`
+	}{systemInstruction, tools})
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// isContextCacheError はキャッシュを参照したリクエストの失敗が、キャッシュがない・期限切れであることによるものかを返す
+// 生成はRESTで、キャッシュの操作はgRPCで呼び出されるため、いずれのエラーも判定する
+// NOT_FOUND 以外は、メッセージがキャッシュ（cachedContent）に触れている場合のみとし、
+// プロンプトや設定の誤りによる失敗をキャッシュなしで送り直さないようにする
+func isContextCacheError(err error) bool {
+	var apiErr *apierror.APIError
+	if errors.As(err, &apiErr) && apiErr.HTTPCode() > 0 {
+		switch apiErr.HTTPCode() {
+		case http.StatusNotFound:
+			return true
+		case http.StatusBadRequest, http.StatusForbidden:
+			return mentionsCachedContent(err)
+		default:
+			return false
+		}
+	}
+	switch status.Code(err) {
+	case codes.NotFound:
+		return true
+	case codes.InvalidArgument, codes.PermissionDenied, codes.FailedPrecondition:
+		return mentionsCachedContent(err)
+	default:
+		return false
+	}
+}
+
+// mentionsCachedContent はエラーのメッセージがコンテキストキャッシュについてのものかを返す
+func mentionsCachedContent(err error) bool {
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "cachedcontent") || strings.Contains(message, "cached content")
+}
@@ -0,0 +1,79 @@
+package infrastructure
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpAPIError はRESTの呼び出しが返すエラーを作る
+func httpAPIError(t *testing.T, code int, message string) error {
+	t.Helper()
+	err, ok := apierror.FromError(&googleapi.Error{Code: code, Message: message})
+	if !ok {
+		t.Fatalf("apierror.FromError(%d) failed", code)
+	}
+	return fmt.Errorf("failed to generate content: %w", err)
+}
+
+func TestIsContextCacheError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "HTTP 404", err: httpAPIError(t, 404, "Requested entity was not found."), want: true},
+		{name: "HTTP 400 のキャッシュのエラー", err: httpAPIError(t, 400, "CachedContent not found (or permission denied)"), want: true},
+		{name: "HTTP 403 のキャッシュのエラー", err: httpAPIError(t, 403, "Permission denied on cached content"), want: true},
+		{name: "HTTP 400 のプロンプトのエラー", err: httpAPIError(t, 400, "Request contains an invalid argument."), want: false},
+		{name: "HTTP 429", err: httpAPIError(t, 429, "Resource has been exhausted"), want: false},
+		{name: "gRPC NOT_FOUND", err: status.Error(codes.NotFound, "not found"), want: true},
+		{name: "gRPC INVALID_ARGUMENT のキャッシュのエラー", err: status.Error(codes.InvalidArgument, "cachedContents/abc is expired"), want: true},
+		{name: "gRPC INVALID_ARGUMENT のその他のエラー", err: status.Error(codes.InvalidArgument, "temperature out of range"), want: false},
+		{name: "gRPC UNAVAILABLE", err: status.Error(codes.Unavailable, "cachedContent unavailable"), want: false},
+		{name: "APIエラーでない", err: errors.New("cachedContent"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isContextCacheError(tt.err); got != tt.want {
+				t.Errorf("isContextCacheError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContextCachePruneExpired(t *testing.T) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	c := newGeminiContextCache(nil, "model", time.Hour, logger)
+	now := time.Now()
+	closed := func() chan struct{} {
+		ch := make(chan struct{})
+		close(ch)
+		return ch
+	}
+	c.entries["expired"] = &contextCacheEntry{ready: closed(), name: "cachedContents/a", expireTime: now.Add(-time.Minute)}
+	c.entries["failed"] = &contextCacheEntry{ready: closed(), failed: true, expireTime: now.Add(-time.Second)}
+	c.entries["live"] = &contextCacheEntry{ready: closed(), name: "cachedContents/b", expireTime: now.Add(time.Minute)}
+	c.entries["creating"] = &contextCacheEntry{ready: make(chan struct{})}
+
+	c.pruneExpired(now)
+	for _, key := range []string{"expired", "failed"} {
+		if _, ok := c.entries[key]; ok {
+			t.Errorf("entry %s was not pruned", key)
+		}
+	}
+	for _, key := range []string{"live", "creating"} {
+		if _, ok := c.entries[key]; !ok {
+			t.Errorf("entry %s was pruned", key)
+		}
+	}
+}
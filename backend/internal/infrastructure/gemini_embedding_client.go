@@ -0,0 +1,89 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/option"
+)
+
+// maxEmbeddingBatch は1回のリクエストで埋め込みベクトルを求める文章の最大数
+const maxEmbeddingBatch = 100
+
+// GeminiEmbeddingClient はGemini APIの埋め込みモデルのクライアント
+type GeminiEmbeddingClient struct {
+	client    *genai.Client
+	modelName string
+	logger    *logrus.Logger
+}
+
+// NewGeminiEmbeddingClient は新しいGeminiEmbeddingClientを作成する
+func NewGeminiEmbeddingClient(apiKey string, logger *logrus.Logger) (repository.EmbeddingRepository, error) {
+	client, err := genai.NewClient(context.Background(), option.WithAPIKey(apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+	return &GeminiEmbeddingClient{
+		client:    client,
+		modelName: "text-embedding-004",
+		logger:    logger,
+	}, nil
+}
+
+// Model は埋め込みモデルの名前を返す
+func (g *GeminiEmbeddingClient) Model() string {
+	return g.modelName
+}
+
+// EmbedPassages は抜粋の出典を題名として、本文の埋め込みベクトルを求める
+func (g *GeminiEmbeddingClient) EmbedPassages(ctx context.Context, passages []knowledge.Passage) ([][]float32, error) {
+	model := g.client.EmbeddingModel(g.modelName)
+	model.TaskType = genai.TaskTypeRetrievalDocument
+
+	vectors := make([][]float32, 0, len(passages))
+	for start := 0; start < len(passages); start += maxEmbeddingBatch {
+		end := min(start+maxEmbeddingBatch, len(passages))
+		batch := model.NewBatch()
+		for _, p := range passages[start:end] {
+			batch.AddContentWithTitle(p.Label(), genai.Text(p.Text))
+		}
+		resp, err := model.BatchEmbedContents(ctx, batch)
+		if err != nil {
+			return nil, fmt.Errorf("failed to embed passages: %w", err)
+		}
+		if len(resp.Embeddings) != end-start {
+			return nil, fmt.Errorf("failed to embed passages: got %d embeddings for %d passages", len(resp.Embeddings), end-start)
+		}
+		for _, e := range resp.Embeddings {
+			vectors = append(vectors, e.Values)
+		}
+		g.logger.WithField("passages", len(vectors)).Debug("Embedded passages with Gemini API")
+	}
+	return vectors, nil
+}
+
+// EmbedQuery は質問の埋め込みベクトルを求める
+func (g *GeminiEmbeddingClient) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	model := g.client.EmbeddingModel(g.modelName)
+	model.TaskType = genai.TaskTypeRetrievalQuery
+	resp, err := model.EmbedContent(ctx, genai.Text(text))
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	if resp.Embedding == nil {
+		return nil, fmt.Errorf("failed to embed query: empty embedding")
+	}
+	return resp.Embedding.Values, nil
+}
+
+// Close はクライアントを閉じる
+func (g *GeminiEmbeddingClient) Close() error {
+	if g.client != nil {
+		return g.client.Close()
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package infrastructure
+
+import (
+	"context"
+
+	"github.com/google/generative-ai-go/genai"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/sirupsen/logrus"
+)
+
+// maxToolRounds はツールの呼び出しと応答を繰り返す最大回数
+const maxToolRounds = 5
+
+// schemaTypes はツールの引数の型とGeminiのスキーマの型の対応
+var schemaTypes = map[entity.ToolParameterType]genai.Type{
+	entity.ToolParameterString:  genai.TypeString,
+	entity.ToolParameterInteger: genai.TypeInteger,
+	entity.ToolParameterNumber:  genai.TypeNumber,
+	entity.ToolParameterBoolean: genai.TypeBoolean,
+}
+
+// toGenaiTools はツールをGeminiの関数宣言に変換する
+func toGenaiTools(tools []*entity.Tool) []*genai.Tool {
+	if len(tools) == 0 {
+		return nil
+	}
+	declarations := make([]*genai.FunctionDeclaration, 0, len(tools))
+	for _, tool := range tools {
+		schema := &genai.Schema{Type: genai.TypeObject, Properties: map[string]*genai.Schema{}}
+		for _, p := range tool.Parameters {
+			schema.Properties[p.Name] = &genai.Schema{
+				Type:        schemaTypes[p.Type],
+				Description: p.Description,
+				Enum:        p.Enum,
+			}
+			if p.Required {
+				schema.Required = append(schema.Required, p.Name)
+			}
+		}
+		declarations = append(declarations, &genai.FunctionDeclaration{
+			Name:        tool.Name,
+			Description: tool.Description,
+			Parameters:  schema,
+		})
+	}
+	return []*genai.Tool{{FunctionDeclarations: declarations}}
+}
+
+// genaiSchemaTypes は構造化出力の値の型とGeminiのスキーマの型の対応
+var genaiSchemaTypes = map[entity.SchemaType]genai.Type{
+	entity.SchemaObject:  genai.TypeObject,
+	entity.SchemaArray:   genai.TypeArray,
+	entity.SchemaString:  genai.TypeString,
+	entity.SchemaInteger: genai.TypeInteger,
+	entity.SchemaNumber:  genai.TypeNumber,
+	entity.SchemaBoolean: genai.TypeBoolean,
+}
+
+// toGenaiSchema は構造化出力の形式をGeminiのスキーマに変換する
+func toGenaiSchema(schema *entity.Schema) *genai.Schema {
+	if schema == nil {
+		return nil
+	}
+	converted := &genai.Schema{
+		Type:        genaiSchemaTypes[schema.Type],
+		Description: schema.Description,
+		Required:    schema.Required,
+		Items:       toGenaiSchema(schema.Items),
+	}
+	if len(schema.Enum) > 0 {
+		converted.Format = "enum"
+		converted.Enum = schema.Enum
+	}
+	if len(schema.Properties) > 0 {
+		converted.Properties = make(map[string]*genai.Schema, len(schema.Properties))
+		for name, property := range schema.Properties {
+			converted.Properties[name] = toGenaiSchema(property)
+		}
+	}
+	return converted
+}
+
+// callTools はAIが要求したツールを実行し、AIに返す関数の応答と呼び出しの記録を返す
+// ツールのエラーはAIに伝えて回答を続けさせるため、呼び出し全体のエラーにはしない
+func (g *GeminiClient) callTools(ctx context.Context, tools []*entity.Tool, calls []genai.FunctionCall) ([]genai.Part, []entity.ToolCall) {
+	parts := make([]genai.Part, 0, len(calls))
+	records := make([]entity.ToolCall, 0, len(calls))
+	for _, call := range calls {
+		record := entity.ToolCall{Name: call.Name, Arguments: call.Args}
+
+		var tool *entity.Tool
+		for _, t := range tools {
+			if t.Name == call.Name {
+				tool = t
+				break
+			}
+		}
+		if tool == nil {
+			record.Error = "unknown tool: " + call.Name
+		} else if result, err := tool.Call(ctx, call.Args); err != nil {
+			record.Error = err.Error()
+		} else {
+			record.Result = result
+		}
+
+		g.logger.WithFields(logrus.Fields{
+			"tool":  call.Name,
+			"error": record.Error,
+		}).Debug("Called tool requested by Gemini API")
+
+		response := record.Result
+		if record.Error != "" {
+			response = map[string]any{"error": record.Error}
+		}
+		parts = append(parts, genai.FunctionResponse{Name: call.Name, Response: response})
+		records = append(records, record)
+	}
+	return parts, records
+}
@@ -0,0 +1,85 @@
+package infrastructure
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// bundledKnowledge は同梱のルールの資料と役の定義
+//
+//go:embed knowledge/*.md
+var bundledKnowledge embed.FS
+
+// MarkdownKnowledgeRepository は同梱の資料とディレクトリ以下のMarkdownを読み込むリポジトリ
+type MarkdownKnowledgeRepository struct {
+	// dir は同梱の資料に加えて読み込むディレクトリ（空の場合は同梱の資料のみ）
+	dir string
+}
+
+// NewMarkdownKnowledgeRepository は新しいMarkdownKnowledgeRepositoryを作成する
+func NewMarkdownKnowledgeRepository(dir string) repository.KnowledgeRepository {
+	return &MarkdownKnowledgeRepository{dir: dir}
+}
+
+// Passages は同梱の資料とディレクトリ以下のMarkdownを読み込み、節ごとの抜粋に分ける
+// ディレクトリに同梱の資料と同じパスの文書があれば置き換える。呼び出すたびに読み込み直す
+func (r *MarkdownKnowledgeRepository) Passages(ctx context.Context) ([]knowledge.Passage, error) {
+	dir := r.dir
+	bundled, err := fs.Sub(bundledKnowledge, "knowledge")
+	if err != nil {
+		return nil, err
+	}
+	documents, err := readMarkdown(bundled)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read bundled knowledge: %w", err)
+	}
+	if dir != "" {
+		extra, err := readMarkdown(os.DirFS(dir))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read knowledge dir %q: %w", dir, err)
+		}
+		for name, content := range extra {
+			documents[name] = content
+		}
+	}
+
+	names := make([]string, 0, len(documents))
+	for name := range documents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var passages []knowledge.Passage
+	for _, name := range names {
+		passages = append(passages, knowledge.ParseMarkdown(name, documents[name])...)
+	}
+	return passages, nil
+}
+
+// readMarkdown はファイルシステム以下のMarkdownをパスごとに読み込む
+func readMarkdown(fsys fs.FS) (map[string]string, error) {
+	documents := map[string]string{}
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !strings.EqualFold(path.Ext(name), ".md") {
+			return nil
+		}
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		documents[name] = string(data)
+		return nil
+	})
+	return documents, err
+}
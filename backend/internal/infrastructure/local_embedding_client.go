@@ -0,0 +1,69 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// LocalEmbeddingClient は外部のサービスを使わずに埋め込みベクトルを求めるクライアント
+//
+// 検索の語をハッシュで固定の次元に割り当てる（feature hashing）だけのため、語の一致しか捉えず、
+// 言い換えの検索には向かない。APIキーのない環境での動作確認やテストに使う。
+type LocalEmbeddingClient struct {
+	dims int
+}
+
+// NewLocalEmbeddingClient は dims 次元の埋め込みベクトルを求めるLocalEmbeddingClientを作成する
+func NewLocalEmbeddingClient(dims int) repository.EmbeddingRepository {
+	return &LocalEmbeddingClient{dims: dims}
+}
+
+// Model は埋め込みモデルの名前を返す
+func (l *LocalEmbeddingClient) Model() string {
+	return fmt.Sprintf("local-hash-%d", l.dims)
+}
+
+// EmbedPassages は抜粋の出典と本文の埋め込みベクトルを求める
+func (l *LocalEmbeddingClient) EmbedPassages(ctx context.Context, passages []knowledge.Passage) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(passages))
+	for _, p := range passages {
+		vectors = append(vectors, l.embed(p.Label()+"\n"+p.Text))
+	}
+	return vectors, nil
+}
+
+// EmbedQuery は質問の埋め込みベクトルを求める
+func (l *LocalEmbeddingClient) EmbedQuery(ctx context.Context, text string) ([]float32, error) {
+	return l.embed(text), nil
+}
+
+// embed は語のハッシュで次元と符号を決めて数え、長さ1に正規化する
+func (l *LocalEmbeddingClient) embed(text string) []float32 {
+	vector := make([]float32, l.dims)
+	for _, token := range knowledge.Tokenize(text) {
+		h := fnv.New64a()
+		h.Write([]byte(token))
+		sum := h.Sum64()
+		sign := float32(1)
+		if sum>>63 == 1 {
+			sign = -1
+		}
+		vector[sum%uint64(l.dims)] += sign
+	}
+	var norm float64
+	for _, v := range vector {
+		norm += float64(v) * float64(v)
+	}
+	if norm > 0 {
+		scale := float32(1 / math.Sqrt(norm))
+		for i := range vector {
+			vector[i] *= scale
+		}
+	}
+	return vector
+}
@@ -0,0 +1,50 @@
+package infrastructure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+)
+
+func TestVectorIndexSearch(t *testing.T) {
+	ctx := context.Background()
+	client := NewLocalEmbeddingClient(256)
+	passages := []knowledge.Passage{
+		{ID: "riichi", Title: "役", Section: "立直", Text: "門前で聴牌したときに立直を宣言できる。立直棒を1000点供託する。"},
+		{ID: "pinfu", Title: "役", Section: "平和", Text: "門前で4面子が順子、雀頭が役牌でなく、両面待ちの手。"},
+		{ID: "furiten", Title: "ルール", Section: "振聴", Text: "自分の捨て牌に和了牌がある場合はロンできない。"},
+	}
+	vectors, err := client.EmbedPassages(ctx, passages)
+	if err != nil {
+		t.Fatalf("EmbedPassages: %v", err)
+	}
+	index := &knowledge.VectorIndex{Model: client.Model()}
+	for i, p := range passages {
+		index.Entries = append(index.Entries, knowledge.VectorEntry{PassageID: p.ID, Hash: p.Hash(), Vector: vectors[i]})
+	}
+
+	query, err := client.EmbedQuery(ctx, "立直を宣言するときの供託")
+	if err != nil {
+		t.Fatalf("EmbedQuery: %v", err)
+	}
+	results := index.Search(passages, query, 2)
+	if len(results) == 0 || results[0].Passage.ID != "riichi" {
+		t.Fatalf("Search = %v, want riichi first", results)
+	}
+	if len(results) > 2 {
+		t.Errorf("Search returned %d results, want at most 2", len(results))
+	}
+
+	// 本文が変わった抜粋は、ベクトルを求め直すまで検索しない
+	changed := append([]knowledge.Passage(nil), passages...)
+	changed[0].Text += "一発がつくことがある。"
+	for _, r := range index.Search(changed, query, 3) {
+		if r.Passage.ID == "riichi" {
+			t.Errorf("Search returned the changed passage %s", r.Passage.ID)
+		}
+	}
+	if got := index.Search(passages, query, 0); got != nil {
+		t.Errorf("Search with k=0 = %v, want nil", got)
+	}
+}
@@ -0,0 +1,63 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// MemoryConversationRepository は会話をメモリ上に保持するリポジトリの実装
+// 最後の更新から ttl を過ぎた会話は破棄される
+type MemoryConversationRepository struct {
+	ttl time.Duration
+
+	mu            sync.Mutex
+	conversations map[string]*entity.Conversation
+}
+
+// NewMemoryConversationRepository は新しいMemoryConversationRepositoryを作成する
+func NewMemoryConversationRepository(ttl time.Duration) repository.ConversationRepository {
+	return &MemoryConversationRepository{
+		ttl:           ttl,
+		conversations: make(map[string]*entity.Conversation),
+	}
+}
+
+// Get は会話を取得する
+func (r *MemoryConversationRepository) Get(ctx context.Context, id string) (*entity.Conversation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	conversation, ok := r.conversations[id]
+	if !ok {
+		return nil, nil
+	}
+	if time.Since(conversation.UpdatedAt) > r.ttl {
+		delete(r.conversations, id)
+		return nil, nil
+	}
+	copied := *conversation
+	return &copied, nil
+}
+
+// Save は会話を保存する
+func (r *MemoryConversationRepository) Save(ctx context.Context, conversation *entity.Conversation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 期限切れの会話を掃除する
+	now := time.Now()
+	for id, c := range r.conversations {
+		if now.Sub(c.UpdatedAt) > r.ttl {
+			delete(r.conversations, id)
+		}
+	}
+
+	copied := *conversation
+	copied.UpdatedAt = now
+	r.conversations[conversation.ID] = &copied
+	return nil
+}
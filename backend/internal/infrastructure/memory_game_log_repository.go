@@ -0,0 +1,67 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// storedGameLog は保存した牌譜と保存日時
+type storedGameLog struct {
+	game    *gamelog.Game
+	savedAt time.Time
+}
+
+// MemoryGameLogRepository は牌譜をメモリ上に保持するリポジトリの実装
+// 保存から ttl を過ぎた牌譜は破棄される
+// 牌譜は取り込み後に変更されないため、コピーせずに共有する
+type MemoryGameLogRepository struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	games map[string]storedGameLog
+}
+
+// NewMemoryGameLogRepository は新しいMemoryGameLogRepositoryを作成する
+func NewMemoryGameLogRepository(ttl time.Duration) repository.GameLogRepository {
+	return &MemoryGameLogRepository{
+		ttl:   ttl,
+		games: make(map[string]storedGameLog),
+	}
+}
+
+// Get は牌譜を取得する
+func (r *MemoryGameLogRepository) Get(ctx context.Context, id string) (*gamelog.Game, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, ok := r.games[id]
+	if !ok {
+		return nil, nil
+	}
+	if time.Since(stored.savedAt) > r.ttl {
+		delete(r.games, id)
+		return nil, nil
+	}
+	return stored.game, nil
+}
+
+// Save は牌譜を保存する
+func (r *MemoryGameLogRepository) Save(ctx context.Context, game *gamelog.Game) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 期限切れの牌譜を掃除する
+	now := time.Now()
+	for id, g := range r.games {
+		if now.Sub(g.savedAt) > r.ttl {
+			delete(r.games, id)
+		}
+	}
+
+	r.games[game.ID] = storedGameLog{game: game, savedAt: now}
+	return nil
+}
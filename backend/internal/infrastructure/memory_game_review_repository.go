@@ -0,0 +1,69 @@
+package infrastructure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// MemoryGameReviewRepository は牌譜の検討結果をメモリ上に保持するリポジトリの実装
+// 最後の更新から ttl を過ぎた検討結果は破棄される
+type MemoryGameReviewRepository struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	reviews map[string]*gamelog.Review
+}
+
+// NewMemoryGameReviewRepository は新しいMemoryGameReviewRepositoryを作成する
+func NewMemoryGameReviewRepository(ttl time.Duration) repository.GameReviewRepository {
+	return &MemoryGameReviewRepository{
+		ttl:     ttl,
+		reviews: make(map[string]*gamelog.Review),
+	}
+}
+
+// Get は検討結果を取得する
+func (r *MemoryGameReviewRepository) Get(ctx context.Context, id string) (*gamelog.Review, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	review, ok := r.reviews[id]
+	if !ok {
+		return nil, nil
+	}
+	if time.Since(review.UpdatedAt) > r.ttl {
+		delete(r.reviews, id)
+		return nil, nil
+	}
+	return copyReview(review), nil
+}
+
+// Save は検討結果を保存する
+func (r *MemoryGameReviewRepository) Save(ctx context.Context, review *gamelog.Review) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 期限切れの検討結果を掃除する
+	now := time.Now()
+	for id, v := range r.reviews {
+		if now.Sub(v.UpdatedAt) > r.ttl {
+			delete(r.reviews, id)
+		}
+	}
+
+	copied := copyReview(review)
+	copied.UpdatedAt = now
+	r.reviews[review.ID] = copied
+	return nil
+}
+
+// copyReview は悪手の一覧を含めて検討結果を複製する
+func copyReview(review *gamelog.Review) *gamelog.Review {
+	copied := *review
+	copied.Mistakes = append([]gamelog.Mistake(nil), review.Mistakes...)
+	return &copied
+}
@@ -0,0 +1,110 @@
+package infrastructure
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/quiz"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+)
+
+// MemoryQuizRepository は何切る問題と回答の記録をメモリ上に保持するリポジトリの実装
+// 作成から ttl を過ぎた問題は破棄し、問題は新しい maxQuizzes 問まで、回答の記録はユーザーごとに新しい maxAttempts 件まで保持する
+type MemoryQuizRepository struct {
+	ttl         time.Duration
+	maxQuizzes  int
+	maxAttempts int
+
+	mu       sync.Mutex
+	quizzes  map[string]*quiz.Quiz
+	attempts map[string][]quiz.Attempt
+}
+
+// NewMemoryQuizRepository は新しいMemoryQuizRepositoryを作成する
+func NewMemoryQuizRepository(ttl time.Duration, maxQuizzes, maxAttempts int) repository.QuizRepository {
+	return &MemoryQuizRepository{
+		ttl:         ttl,
+		maxQuizzes:  maxQuizzes,
+		maxAttempts: maxAttempts,
+		quizzes:     make(map[string]*quiz.Quiz),
+		attempts:    make(map[string][]quiz.Attempt),
+	}
+}
+
+// Get は問題を取得する
+func (r *MemoryQuizRepository) Get(ctx context.Context, id string) (*quiz.Quiz, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	q, ok := r.quizzes[id]
+	if !ok {
+		return nil, nil
+	}
+	if time.Since(q.CreatedAt) > r.ttl {
+		delete(r.quizzes, id)
+		return nil, nil
+	}
+	return copyQuiz(q), nil
+}
+
+// Save は問題を保存する
+func (r *MemoryQuizRepository) Save(ctx context.Context, q *quiz.Quiz) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// 期限切れの問題を掃除する
+	now := time.Now()
+	for id, v := range r.quizzes {
+		if now.Sub(v.CreatedAt) > r.ttl {
+			delete(r.quizzes, id)
+		}
+	}
+
+	// 上限に達している場合は最も古い問題から破棄する
+	if _, ok := r.quizzes[q.ID]; !ok {
+		for len(r.quizzes) >= r.maxQuizzes && len(r.quizzes) > 0 {
+			oldest := ""
+			for id, v := range r.quizzes {
+				if oldest == "" || v.CreatedAt.Before(r.quizzes[oldest].CreatedAt) {
+					oldest = id
+				}
+			}
+			delete(r.quizzes, oldest)
+		}
+	}
+
+	r.quizzes[q.ID] = copyQuiz(q)
+	return nil
+}
+
+// SaveAttempt は回答を記録する
+func (r *MemoryQuizRepository) SaveAttempt(ctx context.Context, attempt quiz.Attempt) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempts := r.attempts[attempt.UserID]
+	if slices.ContainsFunc(attempts, func(a quiz.Attempt) bool { return a.QuizID == attempt.QuizID }) {
+		return false, nil
+	}
+	attempts = append(attempts, attempt)
+	if len(attempts) > r.maxAttempts {
+		attempts = slices.Clone(attempts[len(attempts)-r.maxAttempts:])
+	}
+	r.attempts[attempt.UserID] = attempts
+	return true, nil
+}
+
+// Attempts はユーザーの回答を古い順に返す
+func (r *MemoryQuizRepository) Attempts(ctx context.Context, userID string) ([]quiz.Attempt, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.attempts[userID]), nil
+}
+
+// copyQuiz は問題を複製する（局面と打牌候補は作成後に変更しないため共有する）
+func copyQuiz(q *quiz.Quiz) *quiz.Quiz {
+	copied := *q
+	return &copied
+}
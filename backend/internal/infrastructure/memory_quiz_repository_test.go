@@ -0,0 +1,40 @@
+package infrastructure
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/quiz"
+)
+
+func TestMemoryQuizRepositoryEvictsOldest(t *testing.T) {
+	ctx := context.Background()
+	repo := NewMemoryQuizRepository(time.Hour, 3, 10)
+	start := time.Now()
+	for i := range 5 {
+		q := &quiz.Quiz{ID: fmt.Sprintf("q%d", i), CreatedAt: start.Add(time.Duration(i) * time.Second)}
+		if err := repo.Save(ctx, q); err != nil {
+			t.Fatalf("Save: %v", err)
+		}
+	}
+	for i := range 5 {
+		id := fmt.Sprintf("q%d", i)
+		q, err := repo.Get(ctx, id)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if kept := i >= 2; (q != nil) != kept {
+			t.Errorf("Get(%s) found = %v, want %v", id, q != nil, kept)
+		}
+	}
+
+	// 保存済みの問題の上書きでは破棄しない
+	if err := repo.Save(ctx, &quiz.Quiz{ID: "q4", CreatedAt: start.Add(5 * time.Second)}); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if q, _ := repo.Get(ctx, "q2"); q == nil {
+		t.Error("overwriting q4 evicted q2")
+	}
+}
@@ -1,27 +1,367 @@
 package config
 
 import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"gopkg.in/yaml.v3"
 )
 
+// DefaultSystemPrompt はdefaultペルソナのシステムプロンプト
+const DefaultSystemPrompt = "あなたは麻雀の専門家です。麻雀に関する質問に対して、正確で分かりやすい回答を日本語で提供してください。戦術、ルール、確率計算など、麻雀に関するあらゆる側面について回答できます。"
+
 // Config はアプリケーションの設定を管理する
+//
+// 設定は デフォルト値 → 設定ファイル → 環境変数 → コマンドライン引数 の順に上書きされる
 type Config struct {
-	GeminiAPIKey     string
-	GRPCPort         string
-	HTTPPort         string
-	CORSAllowOrigins string
-	LogLevel         string
+	GeminiAPIKey     string `yaml:"gemini_api_key"`
+	GRPCPort         string `yaml:"grpc_port"`
+	HTTPPort         string `yaml:"http_port"`
+	CORSAllowOrigins string `yaml:"cors_allow_origins"`
+	LogLevel         string `yaml:"log_level"`
+
+	// MjaiPort は mjai プロトコルを TCP で待ち受けるポート（空の場合は TCP で待ち受けない）
+	MjaiPort string `yaml:"mjai_port"`
+
+	// ヘルスチェック
+	HealthProbeInterval time.Duration `yaml:"health_probe_interval"`
+	HealthProbeTimeout  time.Duration `yaml:"health_probe_timeout"`
+
+	// AI
+	// SystemPrompt はdefaultペルソナのテンプレート
+	SystemPrompt   string                   `yaml:"system_prompt"`
+	DefaultPersona string                   `yaml:"default_persona"`
+	Personas       map[string]PersonaConfig `yaml:"personas"`
+	// DefaultRuleSet はリクエスト・会話でルールセットが指定されない場合に使うルールセット名
+	DefaultRuleSet string `yaml:"default_rule_set"`
+	// AnswerVerification は回答の向聴数・待ち・点数をエンジンで検証して誤りを見つけた場合の扱い（off / annotate / regenerate）
+	AnswerVerification string `yaml:"answer_verification"`
+
+	// GeminiContextCacheTTL はペルソナ・ルールセットごとのシステム指示とツールをGeminiのコンテキストキャッシュに置く期限（0 の場合はキャッシュしない）
+	GeminiContextCacheTTL time.Duration `yaml:"gemini_context_cache_ttl"`
+
+	// ルールの資料
+	// KnowledgeDir は同梱の資料に加えて読み込むMarkdownのディレクトリ（空の場合は同梱の資料のみ）
+	KnowledgeDir string `yaml:"knowledge_dir"`
+	// KnowledgeTopK は質問ごとにAIに渡す資料の抜粋の最大数（0 の場合は資料を検索しない）
+	KnowledgeTopK int `yaml:"knowledge_top_k"`
+	// EmbeddingProvider は資料のベクトル検索に使う埋め込みモデル（gemini / local / off）
+	EmbeddingProvider string `yaml:"embedding_provider"`
+	// KnowledgeIndexPath は資料の埋め込みベクトルの索引を保存するファイル
+	KnowledgeIndexPath string `yaml:"knowledge_index_path"`
+
+	// ResponseCache はAIの回答のキャッシュ
+	ResponseCache ResponseCacheConfig `yaml:"response_cache"`
+
+	// Attachments は質問に添付できる画像の制限
+	Attachments AttachmentConfig `yaml:"attachments"`
+
+	// レート制限
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+
+	// ConfigFile は読み込んだ設定ファイルのパス（空の場合は設定ファイルなし）
+	ConfigFile string `yaml:"-"`
+}
+
+// PersonaConfig はペルソナの設定
+// Template はGoのtext/templateで、{{.RuleSet}} {{.UserLevel}} {{.Language}} を参照できる
+type PersonaConfig struct {
+	Description string `yaml:"description"`
+	Template    string `yaml:"template"`
+}
+
+// ResponseCacheConfig はAIの回答のキャッシュの設定
+type ResponseCacheConfig struct {
+	// TTL は回答をキャッシュする期間（0 の場合はキャッシュしない）
+	TTL time.Duration `yaml:"ttl"`
+	// MaxEntries はキャッシュする回答の最大数（超えると最も長く使われていない回答から捨てる）
+	MaxEntries int `yaml:"max_entries"`
+	// SemanticThreshold は質問の埋め込みベクトルのコサイン類似度がこの値以上であれば同じ質問とみなす（0 の場合は完全一致のみ）
+	SemanticThreshold float64 `yaml:"semantic_threshold"`
 }
 
-// LoadConfig は環境変数から設定を読み込む
-func LoadConfig() *Config {
+// AttachmentConfig は質問に添付できる画像の制限
+type AttachmentConfig struct {
+	// MaxBytes は1つの画像の最大の大きさ（バイト）
+	MaxBytes int `yaml:"max_bytes"`
+	// MaxCount は1つの質問に添付できる画像の最大数（0 の場合は添付できない）
+	MaxCount int `yaml:"max_count"`
+}
+
+// RateLimitConfig はAI APIへのリクエストのレート制限設定
+type RateLimitConfig struct {
+	// RequestsPerSecond は1秒あたりの許容リクエスト数（0以下で無制限）
+	RequestsPerSecond float64 `yaml:"requests_per_second"`
+	// Burst は瞬間的に許容するリクエスト数
+	Burst int `yaml:"burst"`
+}
+
+// defaultConfig はデフォルト値で初期化した設定を返す
+func defaultConfig() *Config {
 	return &Config{
-		GeminiAPIKey:     getEnv("GEMINI_API_KEY", ""),
-		GRPCPort:         getEnv("GRPC_PORT", "8080"),
-		HTTPPort:         getEnv("HTTP_PORT", "8081"),
-		CORSAllowOrigins: getEnv("CORS_ALLOW_ORIGINS", "*"),
-		LogLevel:         getEnv("LOG_LEVEL", "info"),
+		GRPCPort:              "8080",
+		HTTPPort:              "8081",
+		MjaiPort:              "11600",
+		CORSAllowOrigins:      "*",
+		LogLevel:              "info",
+		HealthProbeInterval:   30 * time.Second,
+		HealthProbeTimeout:    5 * time.Second,
+		SystemPrompt:          DefaultSystemPrompt,
+		DefaultPersona:        entity.DefaultPersonaName,
+		Personas:              defaultPersonas(),
+		DefaultRuleSet:        mahjong.RuleSetTenhou.Name,
+		AnswerVerification:    string(entity.VerificationRegenerate),
+		GeminiContextCacheTTL: time.Hour,
+		KnowledgeTopK:         3,
+		EmbeddingProvider:     "gemini",
+		KnowledgeIndexPath:    "knowledge_index.json",
+		ResponseCache: ResponseCacheConfig{
+			TTL:        time.Hour,
+			MaxEntries: 1000,
+		},
+		Attachments: AttachmentConfig{
+			MaxBytes: 4 << 20,
+			MaxCount: 4,
+		},
+		RateLimit: RateLimitConfig{
+			RequestsPerSecond: 0,
+			Burst:             10,
+		},
+	}
+}
+
+// LoadConfig は設定ファイル・環境変数・コマンドライン引数から設定を読み込み、検証する
+func LoadConfig(args []string) (*Config, error) {
+	flags, err := parseFlags(args)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := defaultConfig()
+
+	// 設定ファイル
+	cfg.ConfigFile = getEnv("CONFIG_FILE", "")
+	if flags.configFile != "" {
+		cfg.ConfigFile = flags.configFile
+	}
+	if cfg.ConfigFile != "" {
+		if err := loadFile(cfg, cfg.ConfigFile); err != nil {
+			return nil, err
+		}
+	}
+
+	// 環境変数
+	if err := applyEnv(cfg); err != nil {
+		return nil, err
+	}
+
+	// コマンドライン引数
+	flags.apply(cfg)
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// loadFile はYAML形式の設定ファイルを読み込む
+func loadFile(cfg *Config, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read config file %q: %w", path, err)
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	// 空ファイルはデフォルト値のまま扱う
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("failed to parse config file %q: %w", path, err)
+	}
+	return nil
+}
+
+// applyEnv は環境変数で設定を上書きする
+func applyEnv(cfg *Config) error {
+	cfg.GeminiAPIKey = getEnv("GEMINI_API_KEY", cfg.GeminiAPIKey)
+	cfg.GRPCPort = getEnv("GRPC_PORT", cfg.GRPCPort)
+	cfg.HTTPPort = getEnv("HTTP_PORT", cfg.HTTPPort)
+	cfg.MjaiPort = getEnv("MJAI_PORT", cfg.MjaiPort)
+	cfg.CORSAllowOrigins = getEnv("CORS_ALLOW_ORIGINS", cfg.CORSAllowOrigins)
+	cfg.LogLevel = getEnv("LOG_LEVEL", cfg.LogLevel)
+	cfg.SystemPrompt = getEnv("SYSTEM_PROMPT", cfg.SystemPrompt)
+	cfg.DefaultPersona = getEnv("DEFAULT_PERSONA", cfg.DefaultPersona)
+	cfg.DefaultRuleSet = getEnv("DEFAULT_RULE_SET", cfg.DefaultRuleSet)
+	cfg.AnswerVerification = getEnv("ANSWER_VERIFICATION", cfg.AnswerVerification)
+	cfg.KnowledgeDir = getEnv("KNOWLEDGE_DIR", cfg.KnowledgeDir)
+	cfg.EmbeddingProvider = getEnv("EMBEDDING_PROVIDER", cfg.EmbeddingProvider)
+	cfg.KnowledgeIndexPath = getEnv("KNOWLEDGE_INDEX_PATH", cfg.KnowledgeIndexPath)
+
+	var err error
+	if cfg.HealthProbeInterval, err = getEnvDuration("HEALTH_PROBE_INTERVAL", cfg.HealthProbeInterval); err != nil {
+		return err
+	}
+	if cfg.HealthProbeTimeout, err = getEnvDuration("HEALTH_PROBE_TIMEOUT", cfg.HealthProbeTimeout); err != nil {
+		return err
 	}
+	if cfg.GeminiContextCacheTTL, err = getEnvDuration("GEMINI_CONTEXT_CACHE_TTL", cfg.GeminiContextCacheTTL); err != nil {
+		return err
+	}
+	if value := os.Getenv("KNOWLEDGE_TOP_K"); value != "" {
+		topK, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid KNOWLEDGE_TOP_K %q: %w", value, err)
+		}
+		cfg.KnowledgeTopK = topK
+	}
+	if cfg.ResponseCache.TTL, err = getEnvDuration("RESPONSE_CACHE_TTL", cfg.ResponseCache.TTL); err != nil {
+		return err
+	}
+	if value := os.Getenv("RESPONSE_CACHE_MAX_ENTRIES"); value != "" {
+		maxEntries, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid RESPONSE_CACHE_MAX_ENTRIES %q: %w", value, err)
+		}
+		cfg.ResponseCache.MaxEntries = maxEntries
+	}
+	if value := os.Getenv("RESPONSE_CACHE_SEMANTIC_THRESHOLD"); value != "" {
+		threshold, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid RESPONSE_CACHE_SEMANTIC_THRESHOLD %q: %w", value, err)
+		}
+		cfg.ResponseCache.SemanticThreshold = threshold
+	}
+	if value := os.Getenv("ATTACHMENT_MAX_BYTES"); value != "" {
+		maxBytes, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid ATTACHMENT_MAX_BYTES %q: %w", value, err)
+		}
+		cfg.Attachments.MaxBytes = maxBytes
+	}
+	if value := os.Getenv("ATTACHMENT_MAX_COUNT"); value != "" {
+		maxCount, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid ATTACHMENT_MAX_COUNT %q: %w", value, err)
+		}
+		cfg.Attachments.MaxCount = maxCount
+	}
+	if value := os.Getenv("RATE_LIMIT_RPS"); value != "" {
+		rps, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid RATE_LIMIT_RPS %q: %w", value, err)
+		}
+		cfg.RateLimit.RequestsPerSecond = rps
+	}
+	if value := os.Getenv("RATE_LIMIT_BURST"); value != "" {
+		burst, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid RATE_LIMIT_BURST %q: %w", value, err)
+		}
+		cfg.RateLimit.Burst = burst
+	}
+	return nil
+}
+
+// cliFlags はコマンドライン引数で指定された値
+type cliFlags struct {
+	configFile       string
+	grpcPort         string
+	httpPort         string
+	mjaiPort         string
+	corsAllowOrigins string
+	logLevel         string
+}
+
+// parseFlags はコマンドライン引数を解析する
+func parseFlags(args []string) (*cliFlags, error) {
+	f := &cliFlags{}
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.StringVar(&f.configFile, "config", "", "path to YAML config file")
+	fs.StringVar(&f.grpcPort, "grpc-port", "", "gRPC server port")
+	fs.StringVar(&f.httpPort, "http-port", "", "Connect HTTP server port")
+	fs.StringVar(&f.mjaiPort, "mjai-port", "", "mjai TCP server port")
+	fs.StringVar(&f.corsAllowOrigins, "cors-allow-origins", "", "comma separated list of allowed CORS origins")
+	fs.StringVar(&f.logLevel, "log-level", "", "log level (debug, info, warn, error)")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// apply は指定されたコマンドライン引数で設定を上書きする
+func (f *cliFlags) apply(cfg *Config) {
+	if f.grpcPort != "" {
+		cfg.GRPCPort = f.grpcPort
+	}
+	if f.httpPort != "" {
+		cfg.HTTPPort = f.httpPort
+	}
+	if f.mjaiPort != "" {
+		cfg.MjaiPort = f.mjaiPort
+	}
+	if f.corsAllowOrigins != "" {
+		cfg.CORSAllowOrigins = f.corsAllowOrigins
+	}
+	if f.logLevel != "" {
+		cfg.LogLevel = f.logLevel
+	}
+}
+
+// BuildPersonas は設定からペルソナを作成する
+// system_prompt はdefaultペルソナとして扱い、personas で同名の定義があればそちらを優先する
+func (c *Config) BuildPersonas() (map[string]*entity.Persona, error) {
+	personas := make(map[string]*entity.Persona, len(c.Personas)+1)
+	defaultPersona, err := entity.NewPersona(entity.DefaultPersonaName, "汎用の麻雀エキスパート", c.SystemPrompt)
+	if err != nil {
+		return nil, err
+	}
+	personas[entity.DefaultPersonaName] = defaultPersona
+
+	for name, pc := range c.Personas {
+		persona, err := entity.NewPersona(name, pc.Description, pc.Template)
+		if err != nil {
+			return nil, err
+		}
+		personas[name] = persona
+	}
+	return personas, nil
+}
+
+// RuleSet はデフォルトのルールセットを返す（見つからない場合は天鳳ルール）
+func (c *Config) RuleSet() mahjong.RuleSet {
+	if rs, ok := mahjong.LookupRuleSet(c.DefaultRuleSet); ok {
+		return rs
+	}
+	return mahjong.RuleSetTenhou
+}
+
+// VerificationMode は回答の検証の扱いを返す
+func (c *Config) VerificationMode() entity.VerificationMode {
+	return entity.VerificationMode(c.AnswerVerification)
+}
+
+// MaxMessageBytes は gRPC・Connect で受け付けるリクエストの最大の大きさを返す
+// 添付できる画像をすべて含めても収まるよう、画像の合計に余裕を足した大きさ（最小 10MiB）とする
+func (c *Config) MaxMessageBytes() int {
+	return max(10<<20, c.Attachments.MaxBytes*c.Attachments.MaxCount+1<<20)
+}
+
+// AllowedOrigins はCORSで許可するオリジンの一覧を返す
+func (c *Config) AllowedOrigins() []string {
+	var origins []string
+	for _, origin := range strings.Split(c.CORSAllowOrigins, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	return origins
 }
 
 // getEnv は環境変数を取得し、存在しない場合はデフォルト値を返す
@@ -31,3 +371,16 @@ func getEnv(key, defaultValue string) string {
 	}
 	return defaultValue
 }
+
+// getEnvDuration は環境変数を時間として取得し、存在しない場合はデフォルト値を返す
+func getEnvDuration(key string, defaultValue time.Duration) (time.Duration, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return d, nil
+}
@@ -0,0 +1,165 @@
+package config
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/sirupsen/logrus"
+)
+
+// Manager は設定を保持し、SIGHUPや設定ファイルの変更でホットリロードする
+//
+// リロードで反映されるのはログレベル・システムプロンプト・ペルソナ・デフォルトのルールセット・レート制限・CORSオリジンのみで、
+// ポートやAPIキーなどの変更は再起動するまで反映されない
+type Manager struct {
+	args   []string
+	logger *logrus.Logger
+	hup    chan os.Signal
+
+	mu        sync.RWMutex
+	current   *Config
+	listeners []func(*Config)
+}
+
+// NewManager は新しいManagerを作成する
+// Watchの開始前に届いたSIGHUPで既定の動作（プロセスの終了）にならないよう、ここでシグナルを登録する
+func NewManager(cfg *Config, args []string, logger *logrus.Logger) *Manager {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	return &Manager{
+		args:    args,
+		logger:  logger,
+		hup:     hup,
+		current: cfg,
+	}
+}
+
+// Current は現在有効な設定を返す
+func (m *Manager) Current() *Config {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.current
+}
+
+// OnReload は設定がリロードされるたびに呼ばれるコールバックを登録する
+func (m *Manager) OnReload(fn func(*Config)) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.listeners = append(m.listeners, fn)
+}
+
+// Reload は設定を再読み込みし、検証に成功した場合のみ安全な項目を反映する
+func (m *Manager) Reload() error {
+	loaded, err := LoadConfig(m.args)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	prev := m.current
+	next := *prev
+	next.LogLevel = loaded.LogLevel
+	next.SystemPrompt = loaded.SystemPrompt
+	next.DefaultPersona = loaded.DefaultPersona
+	next.Personas = loaded.Personas
+	next.DefaultRuleSet = loaded.DefaultRuleSet
+	next.RateLimit = loaded.RateLimit
+	next.CORSAllowOrigins = loaded.CORSAllowOrigins
+	m.current = &next
+	listeners := append([]func(*Config){}, m.listeners...)
+	m.mu.Unlock()
+
+	m.warnRestartRequired(prev, loaded)
+	m.logger.WithFields(logrus.Fields{
+		"log_level":          next.LogLevel,
+		"default_persona":    next.DefaultPersona,
+		"personas":           len(next.Personas),
+		"default_rule_set":   next.DefaultRuleSet,
+		"cors_allow_origins": next.CORSAllowOrigins,
+		"rate_limit_rps":     next.RateLimit.RequestsPerSecond,
+		"rate_limit_burst":   next.RateLimit.Burst,
+	}).Info("Configuration reloaded")
+
+	for _, fn := range listeners {
+		fn(&next)
+	}
+	return nil
+}
+
+// warnRestartRequired は再起動しないと反映されない項目の変更を警告する
+func (m *Manager) warnRestartRequired(prev, loaded *Config) {
+	changed := map[string]bool{
+		"gemini_api_key":        prev.GeminiAPIKey != loaded.GeminiAPIKey,
+		"grpc_port":             prev.GRPCPort != loaded.GRPCPort,
+		"http_port":             prev.HTTPPort != loaded.HTTPPort,
+		"mjai_port":             prev.MjaiPort != loaded.MjaiPort,
+		"health_probe_interval": prev.HealthProbeInterval != loaded.HealthProbeInterval,
+		"health_probe_timeout":  prev.HealthProbeTimeout != loaded.HealthProbeTimeout,
+	}
+	for key, ok := range changed {
+		if ok {
+			m.logger.WithField("key", key).Warn("Configuration change requires a restart and was ignored")
+		}
+	}
+}
+
+// Watch はctxがキャンセルされるまでSIGHUPと設定ファイルの変更を監視してリロードする
+func (m *Manager) Watch(ctx context.Context) {
+	defer signal.Stop(m.hup)
+
+	var fileEvents <-chan fsnotify.Event
+	var fileErrors <-chan error
+	if path := m.Current().ConfigFile; path != "" {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			m.logger.WithError(err).Warn("Failed to watch config file; only SIGHUP reload is available")
+		} else {
+			defer watcher.Close()
+			// エディタによる置き換え保存にも追従するためディレクトリを監視する
+			if err := watcher.Add(filepath.Dir(path)); err != nil {
+				m.logger.WithError(err).Warn("Failed to watch config file; only SIGHUP reload is available")
+			} else {
+				fileEvents = watcher.Events
+				fileErrors = watcher.Errors
+			}
+		}
+	}
+
+	// 保存時に連続して発生するイベントをまとめる
+	var debounce <-chan time.Time
+	for {
+		select {
+		case <-m.hup:
+			m.logger.Info("SIGHUP received, reloading configuration")
+			m.reload()
+		case event := <-fileEvents:
+			if filepath.Clean(event.Name) != filepath.Clean(m.Current().ConfigFile) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0 {
+				debounce = time.After(200 * time.Millisecond)
+			}
+		case <-debounce:
+			debounce = nil
+			m.logger.Info("Config file changed, reloading configuration")
+			m.reload()
+		case err := <-fileErrors:
+			m.logger.WithError(err).Warn("Config file watcher error")
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reload はリロードを実行し、失敗した場合は現在の設定を維持する
+func (m *Manager) reload() {
+	if err := m.Reload(); err != nil {
+		m.logger.WithError(err).Error("Failed to reload configuration; keeping the current configuration")
+	}
+}
@@ -0,0 +1,102 @@
+package config
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// newTestManager は一時的な設定ファイルを読み込んだManagerを作成する
+func newTestManager(t *testing.T, yaml string) (*Manager, string) {
+	t.Helper()
+	t.Setenv("GEMINI_API_KEY", "test-key")
+	// 実行環境の環境変数で設定ファイルの値が上書きされないようにする
+	for _, key := range []string{"LOG_LEVEL", "GRPC_PORT", "RATE_LIMIT_RPS", "RATE_LIMIT_BURST"} {
+		t.Setenv(key, "")
+	}
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeFile(t, path, yaml)
+
+	args := []string{"-config", path}
+	cfg, err := LoadConfig(args)
+	if err != nil {
+		t.Fatalf("LoadConfig: %v", err)
+	}
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewManager(cfg, args, logger), path
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestManagerReload(t *testing.T) {
+	m, path := newTestManager(t, "log_level: info\ngrpc_port: \"9000\"\n")
+
+	var reloaded []*Config
+	m.OnReload(func(c *Config) { reloaded = append(reloaded, c) })
+
+	writeFile(t, path, "log_level: debug\ngrpc_port: \"9100\"\nrate_limit:\n  requests_per_second: 3\n  burst: 5\n")
+	if err := m.Reload(); err != nil {
+		t.Fatalf("Reload: %v", err)
+	}
+	got := m.Current()
+	if got.LogLevel != "debug" || got.RateLimit.RequestsPerSecond != 3 || got.RateLimit.Burst != 5 {
+		t.Errorf("Current() = log %q rate %+v, want the reloaded safe settings", got.LogLevel, got.RateLimit)
+	}
+	if got.GRPCPort != "9000" {
+		t.Errorf("GRPCPort = %q, want %q (requires a restart)", got.GRPCPort, "9000")
+	}
+	if len(reloaded) != 1 || reloaded[0] != got {
+		t.Errorf("listeners called %d times, want once with the current config", len(reloaded))
+	}
+
+	// 検証に失敗した設定は反映しない
+	writeFile(t, path, "log_level: verbose\n")
+	if err := m.Reload(); err == nil {
+		t.Fatal("Reload() = nil, want a validation error")
+	}
+	if m.Current() != got || len(reloaded) != 1 {
+		t.Error("invalid configuration was applied")
+	}
+}
+
+func TestManagerWatchReloadsOnSIGHUP(t *testing.T) {
+	m, path := newTestManager(t, "log_level: info\n")
+	reloaded := make(chan *Config, 1)
+	m.OnReload(func(c *Config) {
+		select {
+		case reloaded <- c:
+		default:
+		}
+	})
+
+	// Watchの開始前に届いたSIGHUPも取りこぼさない
+	writeFile(t, path, "log_level: warn\n")
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.Watch(ctx)
+
+	select {
+	case c := <-reloaded:
+		if c.LogLevel != "warn" {
+			t.Errorf("LogLevel = %q, want %q", c.LogLevel, "warn")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("configuration was not reloaded after SIGHUP")
+	}
+}
@@ -0,0 +1,173 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+)
+
+// maxKnowledgeTopK は質問ごとにAIに渡す資料の抜粋の最大数の上限
+const maxKnowledgeTopK = 10
+
+// maxAttachmentTotalBytes は1つの質問に添付できる画像の合計の上限（Geminiのリクエストに直接含められる大きさ）
+const maxAttachmentTotalBytes = 20 << 20
+
+// embeddingProviders は資料のベクトル検索に使える埋め込みモデルの種類
+var embeddingProviders = []string{"gemini", "local", "off"}
+
+// ValidationError は設定の検証エラーをまとめて表す
+type ValidationError struct {
+	Problems []string
+}
+
+// Error はすべての問題を列挙したメッセージを返す
+func (e *ValidationError) Error() string {
+	return "invalid configuration:\n  - " + strings.Join(e.Problems, "\n  - ")
+}
+
+// Validate は設定の妥当性を検証し、問題があればすべてを列挙したエラーを返す
+func (c *Config) Validate() error {
+	var problems []string
+	add := func(format string, args ...any) {
+		problems = append(problems, fmt.Sprintf(format, args...))
+	}
+
+	if c.GeminiAPIKey == "" {
+		add("gemini_api_key is required (set GEMINI_API_KEY or gemini_api_key in the config file)")
+	}
+	if err := validatePort(c.GRPCPort); err != nil {
+		add("grpc_port: %v", err)
+	}
+	if err := validatePort(c.HTTPPort); err != nil {
+		add("http_port: %v", err)
+	}
+	if c.GRPCPort == c.HTTPPort {
+		add("grpc_port and http_port must differ (both are %q)", c.GRPCPort)
+	}
+	if c.MjaiPort != "" {
+		if err := validatePort(c.MjaiPort); err != nil {
+			add("mjai_port: %v", err)
+		}
+		if c.MjaiPort == c.GRPCPort || c.MjaiPort == c.HTTPPort {
+			add("mjai_port must differ from grpc_port and http_port (got %q)", c.MjaiPort)
+		}
+	}
+	if len(c.AllowedOrigins()) == 0 {
+		add("cors_allow_origins must contain at least one origin")
+	}
+	if _, err := logrus.ParseLevel(c.LogLevel); err != nil {
+		add("log_level: %q is not a valid level (use trace, debug, info, warn, error, fatal or panic)", c.LogLevel)
+	}
+	if c.HealthProbeInterval <= 0 {
+		add("health_probe_interval must be positive (got %s)", c.HealthProbeInterval)
+	}
+	if c.HealthProbeTimeout <= 0 {
+		add("health_probe_timeout must be positive (got %s)", c.HealthProbeTimeout)
+	}
+	if strings.TrimSpace(c.SystemPrompt) == "" {
+		add("system_prompt cannot be empty")
+	}
+	validatePersonas(c, add)
+	if _, ok := mahjong.LookupRuleSet(c.DefaultRuleSet); !ok {
+		add("default_rule_set: %q is not a known rule set (use one of %s)", c.DefaultRuleSet, strings.Join(mahjong.RuleSetNames(), ", "))
+	}
+	if !slices.Contains(entity.VerificationModes(), c.VerificationMode()) {
+		add("answer_verification: %q is not valid (use off, annotate or regenerate)", c.AnswerVerification)
+	}
+	if c.GeminiContextCacheTTL < 0 {
+		add("gemini_context_cache_ttl must be >= 0 (got %s)", c.GeminiContextCacheTTL)
+	}
+	if c.KnowledgeDir != "" {
+		if info, err := os.Stat(c.KnowledgeDir); err != nil || !info.IsDir() {
+			add("knowledge_dir: %q is not a readable directory", c.KnowledgeDir)
+		}
+	}
+	if c.KnowledgeTopK < 0 || c.KnowledgeTopK > maxKnowledgeTopK {
+		add("knowledge_top_k must be between 0 and %d (got %d)", maxKnowledgeTopK, c.KnowledgeTopK)
+	}
+	if !slices.Contains(embeddingProviders, c.EmbeddingProvider) {
+		add("embedding_provider: %q is not valid (use %s)", c.EmbeddingProvider, strings.Join(embeddingProviders, ", "))
+	}
+	if c.EmbeddingProvider != "off" && strings.TrimSpace(c.KnowledgeIndexPath) == "" {
+		add("knowledge_index_path cannot be empty when embedding_provider is %q", c.EmbeddingProvider)
+	}
+	if c.ResponseCache.TTL < 0 {
+		add("response_cache.ttl must be >= 0 (got %s)", c.ResponseCache.TTL)
+	}
+	if c.ResponseCache.TTL > 0 && c.ResponseCache.MaxEntries <= 0 {
+		add("response_cache.max_entries must be > 0 when caching is enabled (got %d)", c.ResponseCache.MaxEntries)
+	}
+	if c.ResponseCache.SemanticThreshold < 0 || c.ResponseCache.SemanticThreshold > 1 {
+		add("response_cache.semantic_threshold must be between 0 and 1 (got %g)", c.ResponseCache.SemanticThreshold)
+	}
+	if c.ResponseCache.SemanticThreshold > 0 && c.EmbeddingProvider == "off" {
+		add("response_cache.semantic_threshold requires embedding_provider other than \"off\"")
+	}
+	if c.Attachments.MaxCount < 0 {
+		add("attachments.max_count must be >= 0 (got %d)", c.Attachments.MaxCount)
+	}
+	if c.Attachments.MaxCount > 0 && c.Attachments.MaxBytes <= 0 {
+		add("attachments.max_bytes must be > 0 when attachments are enabled (got %d)", c.Attachments.MaxBytes)
+	}
+	if c.Attachments.MaxBytes > 0 && c.Attachments.MaxCount > 0 && c.Attachments.MaxBytes*c.Attachments.MaxCount > maxAttachmentTotalBytes {
+		add("attachments.max_bytes * attachments.max_count must be at most %d bytes (got %d)", maxAttachmentTotalBytes, c.Attachments.MaxBytes*c.Attachments.MaxCount)
+	}
+	if c.RateLimit.RequestsPerSecond < 0 {
+		add("rate_limit.requests_per_second must be >= 0 (got %g)", c.RateLimit.RequestsPerSecond)
+	}
+	if c.RateLimit.RequestsPerSecond > 0 && c.RateLimit.Burst <= 0 {
+		add("rate_limit.burst must be > 0 when rate limiting is enabled (got %d)", c.RateLimit.Burst)
+	}
+
+	if len(problems) > 0 {
+		return &ValidationError{Problems: problems}
+	}
+	return nil
+}
+
+// validatePersonas はペルソナのテンプレートが解析・展開できるかを確認する
+func validatePersonas(c *Config, add func(format string, args ...any)) {
+	for name, pc := range c.Personas {
+		if name == "" {
+			add("personas: persona name cannot be empty")
+		}
+		if strings.TrimSpace(pc.Template) == "" {
+			add("personas.%s.template cannot be empty", name)
+		}
+	}
+
+	personas, err := c.BuildPersonas()
+	if err != nil {
+		add("personas: %v", err)
+		return
+	}
+	if _, ok := personas[c.DefaultPersona]; !ok {
+		add("default_persona: %q is not defined in personas", c.DefaultPersona)
+	}
+	// 未定義の変数を参照していないか、サンプル値で展開して確認する
+	sample := entity.PersonaVariables{RuleSet: "tenhou", UserLevel: "beginner", Language: "ja"}
+	for _, persona := range personas {
+		if _, err := persona.Render(sample); err != nil {
+			add("personas: %v", err)
+		}
+	}
+}
+
+// validatePort はポート番号として有効かを確認する
+func validatePort(port string) error {
+	n, err := strconv.Atoi(port)
+	if err != nil {
+		return errors.New("must be a number, got " + strconv.Quote(port))
+	}
+	if n < 1 || n > 65535 {
+		return fmt.Errorf("must be between 1 and 65535, got %d", n)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package config
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func validConfig() *Config {
+	cfg := defaultConfig()
+	cfg.GeminiAPIKey = "test-key"
+	return cfg
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		problem string // 空の場合は妥当
+	}{
+		{name: "デフォルト値", modify: func(c *Config) {}},
+		{name: "APIキーなし", modify: func(c *Config) { c.GeminiAPIKey = "" }, problem: "gemini_api_key is required"},
+		{name: "ポートが数値でない", modify: func(c *Config) { c.GRPCPort = "grpc" }, problem: "grpc_port: must be a number"},
+		{name: "ポートが範囲外", modify: func(c *Config) { c.HTTPPort = "70000" }, problem: "http_port: must be between 1 and 65535"},
+		{name: "ポートの重複", modify: func(c *Config) { c.HTTPPort = c.GRPCPort }, problem: "grpc_port and http_port must differ"},
+		{name: "mjaiポートの重複", modify: func(c *Config) { c.MjaiPort = c.HTTPPort }, problem: "mjai_port must differ"},
+		{name: "CORSオリジンなし", modify: func(c *Config) { c.CORSAllowOrigins = " , " }, problem: "cors_allow_origins must contain"},
+		{name: "不明なログレベル", modify: func(c *Config) { c.LogLevel = "verbose" }, problem: "log_level"},
+		{name: "プローブ間隔が0", modify: func(c *Config) { c.HealthProbeInterval = 0 }, problem: "health_probe_interval must be positive"},
+		{name: "空のシステムプロンプト", modify: func(c *Config) { c.SystemPrompt = " " }, problem: "system_prompt cannot be empty"},
+		{name: "未定義のペルソナ", modify: func(c *Config) { c.DefaultPersona = "missing" }, problem: "default_persona"},
+		{name: "展開できないペルソナ", modify: func(c *Config) {
+			c.Personas = map[string]PersonaConfig{"broken": {Template: "{{.Unknown}}"}}
+		}, problem: "personas"},
+		{name: "不明なルールセット", modify: func(c *Config) { c.DefaultRuleSet = "unknown" }, problem: "default_rule_set"},
+		{name: "負のレート", modify: func(c *Config) { c.RateLimit.RequestsPerSecond = -1 }, problem: "rate_limit.requests_per_second"},
+		{name: "バーストなしのレート制限", modify: func(c *Config) {
+			c.RateLimit.RequestsPerSecond = 5
+			c.RateLimit.Burst = 0
+		}, problem: "rate_limit.burst must be > 0"},
+		{name: "添付の合計が大きすぎる", modify: func(c *Config) {
+			c.Attachments.MaxBytes = 10 << 20
+			c.Attachments.MaxCount = 4
+		}, problem: "attachments.max_bytes * attachments.max_count"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(cfg)
+			err := cfg.Validate()
+			if tt.problem == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) {
+				t.Fatalf("Validate() = %v, want *ValidationError", err)
+			}
+			if !strings.Contains(verr.Error(), tt.problem) {
+				t.Errorf("Validate() = %v, want a problem containing %q", err, tt.problem)
+			}
+		})
+	}
+}
+
+func TestValidateReportsAllProblems(t *testing.T) {
+	cfg := validConfig()
+	cfg.GeminiAPIKey = ""
+	cfg.LogLevel = "verbose"
+	cfg.HealthProbeTimeout = -time.Second
+
+	var verr *ValidationError
+	if err := cfg.Validate(); !errors.As(err, &verr) {
+		t.Fatalf("Validate() = %v, want *ValidationError", err)
+	}
+	if len(verr.Problems) != 3 {
+		t.Errorf("Problems = %q, want 3 problems", verr.Problems)
+	}
+}
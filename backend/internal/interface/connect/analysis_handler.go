@@ -0,0 +1,177 @@
+package connecthandler
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// requestIDFrom はリクエストメタデータのリクエストIDを返す（未指定の場合は生成する）
+func requestIDFrom(metadata *aiv1.RequestMetadata) string {
+	if metadata.GetRequestId() != "" {
+		return metadata.GetRequestId()
+	}
+	return uuid.New().String()
+}
+
+// newResponseMetadata はレスポンスメタデータを作成する
+func newResponseMetadata(requestID string, startTime time.Time) *aiv1.ResponseMetadata {
+	return &aiv1.ResponseMetadata{
+		RequestId:        requestID,
+		Timestamp:        timestamppb.New(time.Now()),
+		ProcessingTimeMs: time.Since(startTime).Milliseconds(),
+		ServerVersion:    "1.0.0",
+	}
+}
+
+// newErrorInfo はエラーからエラー情報を作成する
+func newErrorInfo(err error, details string) *aiv1.ErrorInfo {
+	return &aiv1.ErrorInfo{
+		Code:    errorCode(err),
+		Message: err.Error(),
+		Details: details,
+	}
+}
+
+// GetWaits は待ち判定API
+func (h *MahjongAIConnectHandler) GetWaits(ctx context.Context, req *connect.Request[aiv1.GetWaitsRequest]) (*connect.Response[aiv1.GetWaitsResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] GetWaits called")
+
+	output, err := h.analysisUsecase.GetWaits(ctx, protoconv.ToWaitsInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to analyze waits")
+		res := &aiv1.GetWaitsResponse{
+			Result:   &aiv1.GetWaitsResponse_Error{Error: newErrorInfo(err, "Failed to analyze waits")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.GetWaitsResponse{
+		Result:   &aiv1.GetWaitsResponse_Waits{Waits: protoconv.FromWaitsOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// RecommendDiscard は打牌推奨API
+func (h *MahjongAIConnectHandler) RecommendDiscard(ctx context.Context, req *connect.Request[aiv1.RecommendDiscardRequest]) (*connect.Response[aiv1.RecommendDiscardResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] RecommendDiscard called")
+
+	output, err := h.analysisUsecase.RecommendDiscard(ctx, protoconv.ToDiscardInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to recommend discard")
+		res := &aiv1.RecommendDiscardResponse{
+			Result:   &aiv1.RecommendDiscardResponse_Error{Error: newErrorInfo(err, "Failed to recommend discard")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.RecommendDiscardResponse{
+		Result:   &aiv1.RecommendDiscardResponse_Discard{Discard: protoconv.FromDiscardOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SimulateHand は手牌のシミュレーションAPI
+func (h *MahjongAIConnectHandler) SimulateHand(ctx context.Context, req *connect.Request[aiv1.SimulateHandRequest]) (*connect.Response[aiv1.SimulateHandResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] SimulateHand called")
+
+	output, err := h.analysisUsecase.SimulateHand(ctx, protoconv.ToSimulationInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to simulate hand")
+		res := &aiv1.SimulateHandResponse{
+			Result:   &aiv1.SimulateHandResponse_Error{Error: newErrorInfo(err, "Failed to simulate hand")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.SimulateHandResponse{
+		Result:   &aiv1.SimulateHandResponse_Simulation{Simulation: protoconv.FromSimulationOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// AssessSafety は安全度評価API
+func (h *MahjongAIConnectHandler) AssessSafety(ctx context.Context, req *connect.Request[aiv1.AssessSafetyRequest]) (*connect.Response[aiv1.AssessSafetyResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] AssessSafety called")
+
+	tiles, err := h.analysisUsecase.AssessSafety(ctx, protoconv.ToSafetyInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to assess safety")
+		res := &aiv1.AssessSafetyResponse{
+			Result:   &aiv1.AssessSafetyResponse_Error{Error: newErrorInfo(err, "Failed to assess safety")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.AssessSafetyResponse{
+		Result:   &aiv1.AssessSafetyResponse_Safety{Safety: protoconv.FromTileSafety(tiles)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// EvaluatePushFold は押し引き評価API
+func (h *MahjongAIConnectHandler) EvaluatePushFold(ctx context.Context, req *connect.Request[aiv1.EvaluatePushFoldRequest]) (*connect.Response[aiv1.EvaluatePushFoldResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] EvaluatePushFold called")
+
+	output, err := h.analysisUsecase.EvaluatePushFold(ctx, protoconv.ToPushFoldInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to evaluate push/fold")
+		res := &aiv1.EvaluatePushFoldResponse{
+			Result:   &aiv1.EvaluatePushFoldResponse_Error{Error: newErrorInfo(err, "Failed to evaluate push/fold")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.EvaluatePushFoldResponse{
+		Result:   &aiv1.EvaluatePushFoldResponse_PushFold{PushFold: protoconv.FromPushFoldOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// CalculatePlacement はオーラスの点数状況の計算API
+func (h *MahjongAIConnectHandler) CalculatePlacement(ctx context.Context, req *connect.Request[aiv1.CalculatePlacementRequest]) (*connect.Response[aiv1.CalculatePlacementResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] CalculatePlacement called")
+
+	output, err := h.analysisUsecase.CalculatePlacement(ctx, protoconv.ToPlacementInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to calculate placement")
+		res := &aiv1.CalculatePlacementResponse{
+			Result:   &aiv1.CalculatePlacementResponse_Error{Error: newErrorInfo(err, "Failed to calculate placement")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.CalculatePlacementResponse{
+		Result:   &aiv1.CalculatePlacementResponse_Placement{Placement: protoconv.FromPlacementOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
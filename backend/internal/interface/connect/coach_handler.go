@@ -0,0 +1,66 @@
+package connecthandler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// Coach は双方向ストリーミングAPI（対局の出来事を受け取りながら、推奨・警告・解説を送信する）
+func (h *MahjongAIConnectHandler) Coach(ctx context.Context, stream *connect.BidiStream[aiv1.CoachRequest, aiv1.CoachResponse]) error {
+	startTime := time.Now()
+
+	// リクエストIDは最初のリクエストのメタデータから決める
+	first, err := stream.Receive()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	requestID := requestIDFrom(first.GetMetadata())
+	logger := h.logger.WithField("request_id", requestID)
+	logger.Info("[connect] Coach called")
+
+	inputs := make(chan usecase.CoachInput)
+	go func() {
+		defer close(inputs)
+		for req := first; ; {
+			select {
+			case inputs <- protoconv.ToCoachInput(req):
+			case <-ctx.Done():
+				return
+			}
+			if req, err = stream.Receive(); err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					logger.WithError(err).Warn("[connect] Failed to receive coach request")
+				}
+				return
+			}
+		}
+	}()
+
+	outputChan, errChan := h.coachUsecase.Coach(ctx, inputs)
+	for output := range outputChan {
+		res := protoconv.FromCoachOutput(output)
+		if output.Err != nil {
+			logger.WithError(output.Err).Warn("[connect] Failed to handle coach request")
+			res.Payload = &aiv1.CoachResponse_Error{Error: newErrorInfo(output.Err, "Failed to handle coach request")}
+		}
+		res.Metadata = newResponseMetadata(requestID, startTime)
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+	if err := <-errChan; err != nil {
+		return err
+	}
+	logger.Info("[connect] Coach finished")
+	return nil
+}
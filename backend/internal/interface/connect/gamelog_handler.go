@@ -0,0 +1,62 @@
+package connecthandler
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// ImportGameLog は牌譜の取り込みAPI
+func (h *MahjongAIConnectHandler) ImportGameLog(ctx context.Context, req *connect.Request[aiv1.ImportGameLogRequest]) (*connect.Response[aiv1.ImportGameLogResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithField("request_id", requestID).Info("[connect] ImportGameLog called")
+
+	game, err := h.gameLogUsecase.ImportGameLog(ctx, protoconv.ToImportGameLogInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to import game log")
+		res := &aiv1.ImportGameLogResponse{
+			Result:   &aiv1.ImportGameLogResponse_Error{Error: newErrorInfo(err, "Failed to import game log")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.ImportGameLogResponse{
+		Result:   &aiv1.ImportGameLogResponse_GameLog{GameLog: protoconv.FromGameLog(game)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetGameLogStep は牌譜の打牌の判断の取得API
+func (h *MahjongAIConnectHandler) GetGameLogStep(ctx context.Context, req *connect.Request[aiv1.GetGameLogStepRequest]) (*connect.Response[aiv1.GetGameLogStepResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"game_id":    req.Msg.GetPosition().GetGameId(),
+		"round":      req.Msg.GetPosition().GetRound(),
+		"step":       req.Msg.GetPosition().GetStep(),
+	}).Info("[connect] GetGameLogStep called")
+
+	output, err := h.gameLogUsecase.GetGameLogStep(ctx, protoconv.ToGameLogPosition(req.Msg.GetPosition()))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to get game log step")
+		res := &aiv1.GetGameLogStepResponse{
+			Result:   &aiv1.GetGameLogStepResponse_Error{Error: newErrorInfo(err, "Failed to get game log step")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.GetGameLogStepResponse{
+		Result:   &aiv1.GetGameLogStepResponse_Step{Step: protoconv.FromGameLogStep(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
@@ -2,10 +2,13 @@ package connecthandler
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	connect "connectrpc.com/connect"
 	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
 	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
 	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
 	"github.com/sirupsen/logrus"
@@ -14,13 +17,21 @@ import (
 
 // MahjongAIConnectHandler はConnect用サービス実装
 type MahjongAIConnectHandler struct {
-	aiUsecase *usecase.AIUsecase
-	logger    *logrus.Logger
+	aiUsecase        *usecase.AIUsecase
+	analysisUsecase  *usecase.AnalysisUsecase
+	gameLogUsecase   *usecase.GameLogUsecase
+	reviewUsecase    *usecase.ReviewUsecase
+	coachUsecase     *usecase.CoachUsecase
+	knowledgeUsecase *usecase.KnowledgeUsecase
+	renderUsecase    *usecase.RenderUsecase
+	quizUsecase      *usecase.QuizUsecase
+	healthUsecase    *usecase.HealthUsecase
+	logger           *logrus.Logger
 }
 
 // NewMahjongAIConnectHandler は新しいハンドラを作成
-func NewMahjongAIConnectHandler(aiUsecase *usecase.AIUsecase, logger *logrus.Logger) *MahjongAIConnectHandler {
-	return &MahjongAIConnectHandler{aiUsecase: aiUsecase, logger: logger}
+func NewMahjongAIConnectHandler(aiUsecase *usecase.AIUsecase, analysisUsecase *usecase.AnalysisUsecase, gameLogUsecase *usecase.GameLogUsecase, reviewUsecase *usecase.ReviewUsecase, coachUsecase *usecase.CoachUsecase, knowledgeUsecase *usecase.KnowledgeUsecase, renderUsecase *usecase.RenderUsecase, quizUsecase *usecase.QuizUsecase, healthUsecase *usecase.HealthUsecase, logger *logrus.Logger) *MahjongAIConnectHandler {
+	return &MahjongAIConnectHandler{aiUsecase: aiUsecase, analysisUsecase: analysisUsecase, gameLogUsecase: gameLogUsecase, reviewUsecase: reviewUsecase, coachUsecase: coachUsecase, knowledgeUsecase: knowledgeUsecase, renderUsecase: renderUsecase, quizUsecase: quizUsecase, healthUsecase: healthUsecase, logger: logger}
 }
 
 // AskMahjongAI は同期API
@@ -51,21 +62,12 @@ func (h *MahjongAIConnectHandler) AskMahjongAI(ctx context.Context, req *connect
 		return connect.NewResponse(res), nil
 	}
 
-	maxTokens := req.Msg.GetMaxTokens()
-	if maxTokens <= 0 {
-		maxTokens = 1000
-	}
-	temperature := req.Msg.GetTemperature()
-	if temperature <= 0 {
-		temperature = 0.7
-	}
-
-	response, err := h.aiUsecase.AskMahjongAI(ctx, req.Msg.GetPrompt(), maxTokens, temperature, req.Msg.GetContext())
+	response, err := h.aiUsecase.AskMahjongAI(ctx, toAskInput(req.Msg))
 	if err != nil {
 		h.logger.WithError(err).Error("[connect] Failed to process AI request")
 		res := &aiv1.AskMahjongAIResponse{
 			Result: &aiv1.AskMahjongAIResponse_Error{Error: &aiv1.ErrorInfo{
-				Code:    "INTERNAL_ERROR",
+				Code:    errorCode(err),
 				Message: err.Error(),
 				Details: "Failed to process AI request",
 			}},
@@ -86,9 +88,16 @@ func (h *MahjongAIConnectHandler) AskMahjongAI(ctx context.Context, req *connect
 			Timestamp:        timestamppb.New(time.Now()),
 			ProcessingTimeMs: response.ProcessingMs,
 			ServerVersion:    "1.0.0",
+			CacheHit:         response.Cached,
 		},
-		TokensUsed: response.TokensUsed,
-		Confidence: response.Confidence,
+		TokensUsed:      response.TokensUsed,
+		Confidence:      response.Confidence,
+		ToolCalls:       protoconv.FromToolCalls(response.ToolCalls),
+		Verification:    protoconv.FromAnswerVerification(response.Verification),
+		SelfConsistency: protoconv.FromSelfConsistency(response.Consistency),
+		Structured:      protoconv.FromStructured(response.Structured),
+		Citations:       protoconv.FromCitations(response.Citations),
+		Transcription:   protoconv.FromHandTranscription(response.Transcription),
 	}
 	return connect.NewResponse(res), nil
 }
@@ -113,16 +122,9 @@ func (h *MahjongAIConnectHandler) AskMahjongAIStream(ctx context.Context, req *c
 		})
 	}
 
-	maxTokens := req.Msg.GetMaxTokens()
-	if maxTokens <= 0 {
-		maxTokens = 1000
-	}
-	temperature := req.Msg.GetTemperature()
-	if temperature <= 0 {
-		temperature = 0.7
-	}
-
-	respChan, errChan := h.aiUsecase.AskMahjongAIStream(ctx, req.Msg.GetPrompt(), maxTokens, temperature, req.Msg.GetContext())
+	respChan, errChan := h.aiUsecase.AskMahjongAIStream(ctx, toAskInput(req.Msg))
+	// キャッシュした回答を送り直している場合は最後のメタデータで伝える
+	cacheHit := false
 	for {
 		select {
 		case r, ok := <-respChan:
@@ -133,21 +135,43 @@ func (h *MahjongAIConnectHandler) AskMahjongAIStream(ctx context.Context, req *c
 						Timestamp:        timestamppb.New(time.Now()),
 						ProcessingTimeMs: 0,
 						ServerVersion:    "1.0.0",
+						CacheHit:         cacheHit,
 					}},
 					IsFinal: true,
 				})
 			}
+			cacheHit = cacheHit || r.Cached
+			if r.Transcription != nil {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{Chunk: &aiv1.AskMahjongAIStreamResponse_Transcription{Transcription: protoconv.FromHandTranscription(r.Transcription)}, IsFinal: false}); err != nil {
+					return err
+				}
+			}
 			if r.Response != "" {
 				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{Chunk: &aiv1.AskMahjongAIStreamResponse_TextChunk{TextChunk: r.Response}, IsFinal: false}); err != nil {
 					return err
 				}
 			}
+			for _, call := range r.ToolCalls {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{Chunk: &aiv1.AskMahjongAIStreamResponse_ToolCall{ToolCall: protoconv.FromToolCall(call)}, IsFinal: false}); err != nil {
+					return err
+				}
+			}
+			if len(r.Citations) > 0 {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{Chunk: &aiv1.AskMahjongAIStreamResponse_Citations{Citations: &aiv1.CitationList{Citations: protoconv.FromCitations(r.Citations)}}, IsFinal: false}); err != nil {
+					return err
+				}
+			}
+			if r.Verification != nil {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{Chunk: &aiv1.AskMahjongAIStreamResponse_Verification{Verification: protoconv.FromAnswerVerification(r.Verification)}, IsFinal: false}); err != nil {
+					return err
+				}
+			}
 		case err := <-errChan:
 			if err != nil {
 				h.logger.WithError(err).Error("[connect] Failed to process streaming AI request")
 				return stream.Send(&aiv1.AskMahjongAIStreamResponse{
 					Chunk: &aiv1.AskMahjongAIStreamResponse_Error{Error: &aiv1.ErrorInfo{
-						Code:    "INTERNAL_ERROR",
+						Code:    errorCode(err),
 						Message: err.Error(),
 						Details: "Failed to process streaming AI request",
 					}},
@@ -161,13 +185,71 @@ func (h *MahjongAIConnectHandler) AskMahjongAIStream(ctx context.Context, req *c
 	}
 }
 
+// toAskInput はリクエストをユースケースの入力に変換する
+func toAskInput(msg *aiv1.AskMahjongAIRequest) usecase.AskInput {
+	maxTokens := msg.GetMaxTokens()
+	if maxTokens <= 0 {
+		maxTokens = 1000
+	}
+	temperature := msg.GetTemperature()
+	if temperature <= 0 {
+		temperature = 0.7
+	}
+	return usecase.AskInput{
+		Prompt:         msg.GetPrompt(),
+		MaxTokens:      maxTokens,
+		Temperature:    temperature,
+		Context:        msg.GetContext(),
+		Persona:        msg.GetPersona(),
+		RuleSet:        msg.GetRuleSet(),
+		ConversationID: msg.GetConversationId(),
+		Variables: entity.PersonaVariables{
+			UserLevel: msg.GetUserLevel(),
+			Language:  msg.GetLanguage(),
+		},
+		GameState:       protoconv.ToGameState(msg.GetGameState()),
+		GameLogPosition: protoconv.ToGameLogPosition(msg.GetGameLogPosition()),
+		Samples:         msg.Samples,
+		ResponseSchema:  msg.ResponseSchema,
+		Attachments:     protoconv.ToAttachments(msg.GetAttachments()),
+		TranscribeHand:  msg.TranscribeHand,
+	}
+}
+
+// errorCode はエラーに対応するエラーコードを返す
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, entity.ErrEmptyPrompt),
+		errors.Is(err, entity.ErrInvalidRequest),
+		errors.Is(err, entity.ErrInvalidTemperature),
+		errors.Is(err, entity.ErrInvalidMaxTokens),
+		errors.Is(err, entity.ErrUnknownPersona),
+		errors.Is(err, entity.ErrUnknownRuleSet),
+		errors.Is(err, entity.ErrUnknownResponseSchema),
+		errors.Is(err, entity.ErrInvalidTileNotation),
+		errors.Is(err, entity.ErrInvalidHand),
+		errors.Is(err, entity.ErrNotWinningHand),
+		errors.Is(err, entity.ErrInvalidGameState),
+		errors.Is(err, entity.ErrInvalidGameLog),
+		errors.Is(err, entity.ErrInvalidAttachment):
+		return "INVALID_ARGUMENT"
+	case errors.Is(err, entity.ErrGameLogNotFound),
+		errors.Is(err, entity.ErrGameReviewNotFound),
+		errors.Is(err, entity.ErrQuizNotFound):
+		return "NOT_FOUND"
+	default:
+		return "INTERNAL_ERROR"
+	}
+}
+
 // HealthCheck はヘルスチェックAPI
 func (h *MahjongAIConnectHandler) HealthCheck(ctx context.Context, req *connect.Request[aiv1.HealthCheckRequest]) (*connect.Response[aiv1.HealthCheckResponse], error) {
-	h.logger.Info("[connect] HealthCheck called")
-	if err := h.aiUsecase.HealthCheck(ctx); err != nil {
-		res := &aiv1.HealthCheckResponse{Status: aiv1.HealthCheckResponse_NOT_SERVING, Message: err.Error(), Timestamp: timestamppb.New(time.Now())}
+	h.logger.Debug("[connect] HealthCheck called")
+	status := h.healthUsecase.Status()
+	if !status.Serving {
+		res := &aiv1.HealthCheckResponse{Status: aiv1.HealthCheckResponse_NOT_SERVING, Message: status.Message, Timestamp: timestamppb.New(status.CheckedAt)}
 		return connect.NewResponse(res), nil
 	}
-	res := &aiv1.HealthCheckResponse{Status: aiv1.HealthCheckResponse_SERVING, Message: "Service is healthy", Timestamp: timestamppb.New(time.Now())}
+	res := &aiv1.HealthCheckResponse{Status: aiv1.HealthCheckResponse_SERVING, Message: status.Message, Timestamp: timestamppb.New(status.CheckedAt)}
 	return connect.NewResponse(res), nil
 }
@@ -0,0 +1,37 @@
+package connecthandler
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// ReindexKnowledge はルールの資料の索引の作り直しAPI
+func (h *MahjongAIConnectHandler) ReindexKnowledge(ctx context.Context, req *connect.Request[aiv1.ReindexKnowledgeRequest]) (*connect.Response[aiv1.ReindexKnowledgeResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"full":       req.Msg.GetFull(),
+	}).Info("[connect] ReindexKnowledge called")
+
+	output, err := h.knowledgeUsecase.Reindex(ctx, req.Msg.GetFull())
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to reindex knowledge")
+		res := &aiv1.ReindexKnowledgeResponse{
+			Result:   &aiv1.ReindexKnowledgeResponse_Error{Error: newErrorInfo(err, "Failed to reindex knowledge")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.ReindexKnowledgeResponse{
+		Result:   &aiv1.ReindexKnowledgeResponse_Index{Index: protoconv.FromKnowledgeIndex(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
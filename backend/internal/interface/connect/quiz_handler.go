@@ -0,0 +1,67 @@
+package connecthandler
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// GetQuiz は何切る問題の出題API
+func (h *MahjongAIConnectHandler) GetQuiz(ctx context.Context, req *connect.Request[aiv1.GetQuizRequest]) (*connect.Response[aiv1.GetQuizResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"quiz_id":    req.Msg.GetQuizId(),
+		"daily":      req.Msg.GetDaily(),
+	}).Info("[connect] GetQuiz called")
+
+	output, err := h.quizUsecase.GetQuiz(ctx, protoconv.ToGetQuizInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to get quiz")
+		res := &aiv1.GetQuizResponse{
+			Result:   &aiv1.GetQuizResponse_Error{Error: newErrorInfo(err, "Failed to get quiz")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.GetQuizResponse{
+		Result:   &aiv1.GetQuizResponse_Quiz{Quiz: protoconv.FromQuiz(output.Quiz)},
+		Stats:    protoconv.FromQuizStats(output.Stats),
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// SubmitQuizAnswer は何切る問題の回答の採点API
+func (h *MahjongAIConnectHandler) SubmitQuizAnswer(ctx context.Context, req *connect.Request[aiv1.SubmitQuizAnswerRequest]) (*connect.Response[aiv1.SubmitQuizAnswerResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"quiz_id":    req.Msg.GetQuizId(),
+		"discard":    req.Msg.GetDiscard(),
+	}).Info("[connect] SubmitQuizAnswer called")
+
+	output, err := h.quizUsecase.SubmitQuizAnswer(ctx, protoconv.ToSubmitQuizAnswerInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to grade quiz answer")
+		res := &aiv1.SubmitQuizAnswerResponse{
+			Result:   &aiv1.SubmitQuizAnswerResponse_Error{Error: newErrorInfo(err, "Failed to grade quiz answer")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.SubmitQuizAnswerResponse{
+		Result:   &aiv1.SubmitQuizAnswerResponse_Grade{Grade: protoconv.FromQuizGrade(output)},
+		Stats:    protoconv.FromQuizStats(output.Stats),
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,38 @@
+package connecthandler
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// RenderHand は手牌・河・局面の図の作成API
+func (h *MahjongAIConnectHandler) RenderHand(ctx context.Context, req *connect.Request[aiv1.RenderHandRequest]) (*connect.Response[aiv1.RenderHandResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"hand":       req.Msg.GetHand(),
+		"format":     req.Msg.GetFormat().String(),
+	}).Info("[connect] RenderHand called")
+
+	output, err := h.renderUsecase.RenderHand(ctx, protoconv.ToRenderInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to render hand")
+		res := &aiv1.RenderHandResponse{
+			Result:   &aiv1.RenderHandResponse_Error{Error: newErrorInfo(err, "Failed to render hand")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.RenderHandResponse{
+		Result:   &aiv1.RenderHandResponse_Diagram{Diagram: protoconv.FromDiagram(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
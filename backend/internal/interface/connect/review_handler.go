@@ -0,0 +1,95 @@
+package connecthandler
+
+import (
+	"context"
+	"time"
+
+	connect "connectrpc.com/connect"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// ReviewGame は牌譜の検討の開始API
+func (h *MahjongAIConnectHandler) ReviewGame(ctx context.Context, req *connect.Request[aiv1.ReviewGameRequest]) (*connect.Response[aiv1.GameReviewResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"game_id":    req.Msg.GetGameId(),
+		"player":     req.Msg.GetPlayer(),
+	}).Info("[connect] ReviewGame called")
+
+	review, err := h.reviewUsecase.ReviewGame(ctx, protoconv.ToReviewGameInput(req.Msg))
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to start game review")
+		res := &aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Error{Error: newErrorInfo(err, "Failed to start game review")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.GameReviewResponse{
+		Result:   &aiv1.GameReviewResponse_Review{Review: protoconv.FromGameReview(review)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// GetGameReview は牌譜の検討結果の取得API
+func (h *MahjongAIConnectHandler) GetGameReview(ctx context.Context, req *connect.Request[aiv1.GetGameReviewRequest]) (*connect.Response[aiv1.GameReviewResponse], error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"review_id":  req.Msg.GetReviewId(),
+	}).Info("[connect] GetGameReview called")
+
+	review, err := h.reviewUsecase.GetReview(ctx, req.Msg.GetReviewId())
+	if err != nil {
+		h.logger.WithError(err).Error("[connect] Failed to get game review")
+		res := &aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Error{Error: newErrorInfo(err, "Failed to get game review")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}
+		return connect.NewResponse(res), nil
+	}
+
+	res := &aiv1.GameReviewResponse{
+		Result:   &aiv1.GameReviewResponse_Review{Review: protoconv.FromGameReview(review)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}
+	return connect.NewResponse(res), nil
+}
+
+// WatchGameReview は牌譜の検討結果のサーバーストリームAPI
+func (h *MahjongAIConnectHandler) WatchGameReview(ctx context.Context, req *connect.Request[aiv1.GetGameReviewRequest], stream *connect.ServerStream[aiv1.GameReviewResponse]) error {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Msg.GetMetadata())
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"review_id":  req.Msg.GetReviewId(),
+	}).Info("[connect] WatchGameReview called")
+
+	reviewChan, errChan := h.reviewUsecase.WatchReview(ctx, req.Msg.GetReviewId())
+	for review := range reviewChan {
+		if err := stream.Send(&aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Review{Review: protoconv.FromGameReview(review)},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := <-errChan; err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		h.logger.WithError(err).Error("[connect] Failed to watch game review")
+		return stream.Send(&aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Error{Error: newErrorInfo(err, "Failed to watch game review")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		})
+	}
+	return nil
+}
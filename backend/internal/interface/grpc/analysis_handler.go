@@ -0,0 +1,164 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// requestIDFrom はリクエストメタデータのリクエストIDを返す（未指定の場合は生成する）
+func requestIDFrom(metadata *aiv1.RequestMetadata) string {
+	if metadata != nil && metadata.RequestId != "" {
+		return metadata.RequestId
+	}
+	return uuid.New().String()
+}
+
+// newResponseMetadata はレスポンスメタデータを作成する
+func newResponseMetadata(requestID string, startTime time.Time) *aiv1.ResponseMetadata {
+	return &aiv1.ResponseMetadata{
+		RequestId:        requestID,
+		Timestamp:        timestamppb.New(time.Now()),
+		ProcessingTimeMs: time.Since(startTime).Milliseconds(),
+		ServerVersion:    "1.0.0",
+	}
+}
+
+// newErrorInfo はエラーからエラー情報を作成する
+func newErrorInfo(err error, details string) *aiv1.ErrorInfo {
+	return &aiv1.ErrorInfo{
+		Code:    errorCode(err),
+		Message: err.Error(),
+		Details: details,
+	}
+}
+
+// GetWaits は聴牌形の待ち・フリテンの判定を処理する
+func (h *MahjongAIHandler) GetWaits(ctx context.Context, req *aiv1.GetWaitsRequest) (*aiv1.GetWaitsResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("GetWaits called")
+
+	output, err := h.analysisUsecase.GetWaits(ctx, protoconv.ToWaitsInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to analyze waits")
+		return &aiv1.GetWaitsResponse{
+			Result:   &aiv1.GetWaitsResponse_Error{Error: newErrorInfo(err, "Failed to analyze waits")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.GetWaitsResponse{
+		Result:   &aiv1.GetWaitsResponse_Waits{Waits: protoconv.FromWaitsOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// RecommendDiscard は打牌候補の評価を処理する
+func (h *MahjongAIHandler) RecommendDiscard(ctx context.Context, req *aiv1.RecommendDiscardRequest) (*aiv1.RecommendDiscardResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("RecommendDiscard called")
+
+	output, err := h.analysisUsecase.RecommendDiscard(ctx, protoconv.ToDiscardInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to recommend discard")
+		return &aiv1.RecommendDiscardResponse{
+			Result:   &aiv1.RecommendDiscardResponse_Error{Error: newErrorInfo(err, "Failed to recommend discard")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.RecommendDiscardResponse{
+		Result:   &aiv1.RecommendDiscardResponse_Discard{Discard: protoconv.FromDiscardOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// SimulateHand は手牌のシミュレーションを処理する
+func (h *MahjongAIHandler) SimulateHand(ctx context.Context, req *aiv1.SimulateHandRequest) (*aiv1.SimulateHandResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("SimulateHand called")
+
+	output, err := h.analysisUsecase.SimulateHand(ctx, protoconv.ToSimulationInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to simulate hand")
+		return &aiv1.SimulateHandResponse{
+			Result:   &aiv1.SimulateHandResponse_Error{Error: newErrorInfo(err, "Failed to simulate hand")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.SimulateHandResponse{
+		Result:   &aiv1.SimulateHandResponse_Simulation{Simulation: protoconv.FromSimulationOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// AssessSafety は立直者に対する安全度の評価を処理する
+func (h *MahjongAIHandler) AssessSafety(ctx context.Context, req *aiv1.AssessSafetyRequest) (*aiv1.AssessSafetyResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("AssessSafety called")
+
+	tiles, err := h.analysisUsecase.AssessSafety(ctx, protoconv.ToSafetyInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to assess safety")
+		return &aiv1.AssessSafetyResponse{
+			Result:   &aiv1.AssessSafetyResponse_Error{Error: newErrorInfo(err, "Failed to assess safety")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.AssessSafetyResponse{
+		Result:   &aiv1.AssessSafetyResponse_Safety{Safety: protoconv.FromTileSafety(tiles)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// EvaluatePushFold は押し引きの評価を処理する
+func (h *MahjongAIHandler) EvaluatePushFold(ctx context.Context, req *aiv1.EvaluatePushFoldRequest) (*aiv1.EvaluatePushFoldResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("EvaluatePushFold called")
+
+	output, err := h.analysisUsecase.EvaluatePushFold(ctx, protoconv.ToPushFoldInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to evaluate push/fold")
+		return &aiv1.EvaluatePushFoldResponse{
+			Result:   &aiv1.EvaluatePushFoldResponse_Error{Error: newErrorInfo(err, "Failed to evaluate push/fold")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.EvaluatePushFoldResponse{
+		Result:   &aiv1.EvaluatePushFoldResponse_PushFold{PushFold: protoconv.FromPushFoldOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// CalculatePlacement はオーラスの点数状況の計算を処理する
+func (h *MahjongAIHandler) CalculatePlacement(ctx context.Context, req *aiv1.CalculatePlacementRequest) (*aiv1.CalculatePlacementResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("CalculatePlacement called")
+
+	output, err := h.analysisUsecase.CalculatePlacement(ctx, protoconv.ToPlacementInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to calculate placement")
+		return &aiv1.CalculatePlacementResponse{
+			Result:   &aiv1.CalculatePlacementResponse_Error{Error: newErrorInfo(err, "Failed to calculate placement")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.CalculatePlacementResponse{
+		Result:   &aiv1.CalculatePlacementResponse_Placement{Placement: protoconv.FromPlacementOutput(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
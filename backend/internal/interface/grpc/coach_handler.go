@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"errors"
+	"io"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// Coach は対局の出来事を受け取りながら、推奨・警告・解説を送信する
+func (h *MahjongAIHandler) Coach(stream aiv1.MahjongAIService_CoachServer) error {
+	startTime := time.Now()
+	ctx := stream.Context()
+
+	// リクエストIDは最初のリクエストのメタデータから決める
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+	requestID := requestIDFrom(first.Metadata)
+	logger := h.logger.WithField("request_id", requestID)
+	logger.Info("Coach called")
+
+	inputs := make(chan usecase.CoachInput)
+	go func() {
+		defer close(inputs)
+		for req := first; ; {
+			select {
+			case inputs <- protoconv.ToCoachInput(req):
+			case <-ctx.Done():
+				return
+			}
+			if req, err = stream.Recv(); err != nil {
+				if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+					logger.WithError(err).Warn("Failed to receive coach request")
+				}
+				return
+			}
+		}
+	}()
+
+	outputChan, errChan := h.coachUsecase.Coach(ctx, inputs)
+	for output := range outputChan {
+		res := protoconv.FromCoachOutput(output)
+		if output.Err != nil {
+			logger.WithError(output.Err).Warn("Failed to handle coach request")
+			res.Payload = &aiv1.CoachResponse_Error{Error: newErrorInfo(output.Err, "Failed to handle coach request")}
+		}
+		res.Metadata = newResponseMetadata(requestID, startTime)
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+	if err := <-errChan; err != nil {
+		return err
+	}
+	logger.Info("Coach finished")
+	return nil
+}
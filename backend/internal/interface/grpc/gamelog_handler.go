@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// ImportGameLog は牌譜の取り込みを処理する
+func (h *MahjongAIHandler) ImportGameLog(ctx context.Context, req *aiv1.ImportGameLogRequest) (*aiv1.ImportGameLogResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithField("request_id", requestID).Info("ImportGameLog called")
+
+	game, err := h.gameLogUsecase.ImportGameLog(ctx, protoconv.ToImportGameLogInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to import game log")
+		return &aiv1.ImportGameLogResponse{
+			Result:   &aiv1.ImportGameLogResponse_Error{Error: newErrorInfo(err, "Failed to import game log")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.ImportGameLogResponse{
+		Result:   &aiv1.ImportGameLogResponse_GameLog{GameLog: protoconv.FromGameLog(game)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// GetGameLogStep は牌譜の打牌の判断の取得を処理する
+func (h *MahjongAIHandler) GetGameLogStep(ctx context.Context, req *aiv1.GetGameLogStepRequest) (*aiv1.GetGameLogStepResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"game_id":    req.GetPosition().GetGameId(),
+		"round":      req.GetPosition().GetRound(),
+		"step":       req.GetPosition().GetStep(),
+	}).Info("GetGameLogStep called")
+
+	output, err := h.gameLogUsecase.GetGameLogStep(ctx, protoconv.ToGameLogPosition(req.GetPosition()))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get game log step")
+		return &aiv1.GetGameLogStepResponse{
+			Result:   &aiv1.GetGameLogStepResponse_Error{Error: newErrorInfo(err, "Failed to get game log step")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.GetGameLogStepResponse{
+		Result:   &aiv1.GetGameLogStepResponse_Step{Step: protoconv.FromGameLogStep(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
 	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
 	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
 	"github.com/sirupsen/logrus"
@@ -14,15 +17,31 @@ import (
 // MahjongAIHandler はgRPCサービスのハンドラー
 type MahjongAIHandler struct {
 	aiv1.UnimplementedMahjongAIServiceServer
-	aiUsecase *usecase.AIUsecase
-	logger    *logrus.Logger
+	aiUsecase        *usecase.AIUsecase
+	analysisUsecase  *usecase.AnalysisUsecase
+	gameLogUsecase   *usecase.GameLogUsecase
+	reviewUsecase    *usecase.ReviewUsecase
+	coachUsecase     *usecase.CoachUsecase
+	knowledgeUsecase *usecase.KnowledgeUsecase
+	renderUsecase    *usecase.RenderUsecase
+	quizUsecase      *usecase.QuizUsecase
+	healthUsecase    *usecase.HealthUsecase
+	logger           *logrus.Logger
 }
 
 // NewMahjongAIHandler は新しいMahjongAIHandlerを作成する
-func NewMahjongAIHandler(aiUsecase *usecase.AIUsecase, logger *logrus.Logger) *MahjongAIHandler {
+func NewMahjongAIHandler(aiUsecase *usecase.AIUsecase, analysisUsecase *usecase.AnalysisUsecase, gameLogUsecase *usecase.GameLogUsecase, reviewUsecase *usecase.ReviewUsecase, coachUsecase *usecase.CoachUsecase, knowledgeUsecase *usecase.KnowledgeUsecase, renderUsecase *usecase.RenderUsecase, quizUsecase *usecase.QuizUsecase, healthUsecase *usecase.HealthUsecase, logger *logrus.Logger) *MahjongAIHandler {
 	return &MahjongAIHandler{
-		aiUsecase: aiUsecase,
-		logger:    logger,
+		aiUsecase:        aiUsecase,
+		analysisUsecase:  analysisUsecase,
+		gameLogUsecase:   gameLogUsecase,
+		reviewUsecase:    reviewUsecase,
+		coachUsecase:     coachUsecase,
+		knowledgeUsecase: knowledgeUsecase,
+		renderUsecase:    renderUsecase,
+		quizUsecase:      quizUsecase,
+		healthUsecase:    healthUsecase,
+		logger:           logger,
 	}
 }
 
@@ -58,26 +77,15 @@ func (h *MahjongAIHandler) AskMahjongAI(ctx context.Context, req *aiv1.AskMahjon
 		}, nil
 	}
 
-	// デフォルト値の設定
-	maxTokens := req.MaxTokens
-	if maxTokens <= 0 {
-		maxTokens = 1000
-	}
-
-	temperature := req.Temperature
-	if temperature <= 0 {
-		temperature = 0.7
-	}
-
 	// ユースケースを呼び出し
-	response, err := h.aiUsecase.AskMahjongAI(ctx, req.Prompt, maxTokens, temperature, req.Context)
+	response, err := h.aiUsecase.AskMahjongAI(ctx, toAskInput(req))
 	if err != nil {
 		h.logger.WithError(err).Error("Failed to process AI request")
 
 		return &aiv1.AskMahjongAIResponse{
 			Result: &aiv1.AskMahjongAIResponse_Error{
 				Error: &aiv1.ErrorInfo{
-					Code:    "INTERNAL_ERROR",
+					Code:    errorCode(err),
 					Message: err.Error(),
 					Details: "Failed to process AI request",
 				},
@@ -102,9 +110,16 @@ func (h *MahjongAIHandler) AskMahjongAI(ctx context.Context, req *aiv1.AskMahjon
 			Timestamp:        timestamppb.New(time.Now()),
 			ProcessingTimeMs: response.ProcessingMs,
 			ServerVersion:    "1.0.0",
+			CacheHit:         response.Cached,
 		},
-		TokensUsed: response.TokensUsed,
-		Confidence: response.Confidence,
+		TokensUsed:      response.TokensUsed,
+		Confidence:      response.Confidence,
+		ToolCalls:       protoconv.FromToolCalls(response.ToolCalls),
+		Verification:    protoconv.FromAnswerVerification(response.Verification),
+		SelfConsistency: protoconv.FromSelfConsistency(response.Consistency),
+		Structured:      protoconv.FromStructured(response.Structured),
+		Citations:       protoconv.FromCitations(response.Citations),
+		Transcription:   protoconv.FromHandTranscription(response.Transcription),
 	}, nil
 }
 
@@ -132,20 +147,11 @@ func (h *MahjongAIHandler) AskMahjongAIStream(req *aiv1.AskMahjongAIRequest, str
 		})
 	}
 
-	// デフォルト値の設定
-	maxTokens := req.MaxTokens
-	if maxTokens <= 0 {
-		maxTokens = 1000
-	}
-
-	temperature := req.Temperature
-	if temperature <= 0 {
-		temperature = 0.7
-	}
-
 	// ストリーミングユースケースを呼び出し
-	responseChan, errorChan := h.aiUsecase.AskMahjongAIStream(stream.Context(), req.Prompt, maxTokens, temperature, req.Context)
+	responseChan, errorChan := h.aiUsecase.AskMahjongAIStream(stream.Context(), toAskInput(req))
 
+	// キャッシュした回答を送り直している場合は最後のメタデータで伝える
+	cacheHit := false
 	for {
 		select {
 		case response, ok := <-responseChan:
@@ -158,12 +164,27 @@ func (h *MahjongAIHandler) AskMahjongAIStream(req *aiv1.AskMahjongAIRequest, str
 							Timestamp:        timestamppb.New(time.Now()),
 							ProcessingTimeMs: 0,
 							ServerVersion:    "1.0.0",
+							CacheHit:         cacheHit,
 						},
 					},
 					IsFinal: true,
 				})
 			}
 
+			cacheHit = cacheHit || response.Cached
+
+			// 画像の手牌を書き起こした結果を送信
+			if response.Transcription != nil {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{
+					Chunk: &aiv1.AskMahjongAIStreamResponse_Transcription{
+						Transcription: protoconv.FromHandTranscription(response.Transcription),
+					},
+					IsFinal: false,
+				}); err != nil {
+					return err
+				}
+			}
+
 			// レスポンスチャンクを送信
 			if response.Response != "" {
 				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{
@@ -175,6 +196,39 @@ func (h *MahjongAIHandler) AskMahjongAIStream(req *aiv1.AskMahjongAIRequest, str
 					return err
 				}
 			}
+			// AIが呼び出したツールを送信
+			for _, call := range response.ToolCalls {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{
+					Chunk: &aiv1.AskMahjongAIStreamResponse_ToolCall{
+						ToolCall: protoconv.FromToolCall(call),
+					},
+					IsFinal: false,
+				}); err != nil {
+					return err
+				}
+			}
+			// 回答の根拠としてAIに渡した資料の抜粋を送信
+			if len(response.Citations) > 0 {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{
+					Chunk: &aiv1.AskMahjongAIStreamResponse_Citations{
+						Citations: &aiv1.CitationList{Citations: protoconv.FromCitations(response.Citations)},
+					},
+					IsFinal: false,
+				}); err != nil {
+					return err
+				}
+			}
+			// 回答の検証結果を送信
+			if response.Verification != nil {
+				if err := stream.Send(&aiv1.AskMahjongAIStreamResponse{
+					Chunk: &aiv1.AskMahjongAIStreamResponse_Verification{
+						Verification: protoconv.FromAnswerVerification(response.Verification),
+					},
+					IsFinal: false,
+				}); err != nil {
+					return err
+				}
+			}
 
 		case err := <-errorChan:
 			if err != nil {
@@ -182,7 +236,7 @@ func (h *MahjongAIHandler) AskMahjongAIStream(req *aiv1.AskMahjongAIRequest, str
 				return stream.Send(&aiv1.AskMahjongAIStreamResponse{
 					Chunk: &aiv1.AskMahjongAIStreamResponse_Error{
 						Error: &aiv1.ErrorInfo{
-							Code:    "INTERNAL_ERROR",
+							Code:    errorCode(err),
 							Message: err.Error(),
 							Details: "Failed to process streaming AI request",
 						},
@@ -198,25 +252,83 @@ func (h *MahjongAIHandler) AskMahjongAIStream(req *aiv1.AskMahjongAIRequest, str
 	}
 }
 
+// toAskInput はリクエストをユースケースの入力に変換する
+func toAskInput(req *aiv1.AskMahjongAIRequest) usecase.AskInput {
+	// デフォルト値の設定
+	maxTokens := req.MaxTokens
+	if maxTokens <= 0 {
+		maxTokens = 1000
+	}
+
+	temperature := req.Temperature
+	if temperature <= 0 {
+		temperature = 0.7
+	}
+
+	return usecase.AskInput{
+		Prompt:         req.Prompt,
+		MaxTokens:      maxTokens,
+		Temperature:    temperature,
+		Context:        req.Context,
+		Persona:        req.Persona,
+		RuleSet:        req.RuleSet,
+		ConversationID: req.ConversationId,
+		Variables: entity.PersonaVariables{
+			UserLevel: req.UserLevel,
+			Language:  req.Language,
+		},
+		GameState:       protoconv.ToGameState(req.GetGameState()),
+		GameLogPosition: protoconv.ToGameLogPosition(req.GetGameLogPosition()),
+		Samples:         req.Samples,
+		ResponseSchema:  req.ResponseSchema,
+		Attachments:     protoconv.ToAttachments(req.GetAttachments()),
+		TranscribeHand:  req.TranscribeHand,
+	}
+}
+
+// errorCode はエラーに対応するエラーコードを返す
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, entity.ErrEmptyPrompt),
+		errors.Is(err, entity.ErrInvalidRequest),
+		errors.Is(err, entity.ErrInvalidTemperature),
+		errors.Is(err, entity.ErrInvalidMaxTokens),
+		errors.Is(err, entity.ErrUnknownPersona),
+		errors.Is(err, entity.ErrUnknownRuleSet),
+		errors.Is(err, entity.ErrUnknownResponseSchema),
+		errors.Is(err, entity.ErrInvalidTileNotation),
+		errors.Is(err, entity.ErrInvalidHand),
+		errors.Is(err, entity.ErrNotWinningHand),
+		errors.Is(err, entity.ErrInvalidGameState),
+		errors.Is(err, entity.ErrInvalidGameLog),
+		errors.Is(err, entity.ErrInvalidAttachment):
+		return "INVALID_ARGUMENT"
+	case errors.Is(err, entity.ErrGameLogNotFound),
+		errors.Is(err, entity.ErrGameReviewNotFound),
+		errors.Is(err, entity.ErrQuizNotFound):
+		return "NOT_FOUND"
+	default:
+		return "INTERNAL_ERROR"
+	}
+}
+
 // HealthCheck はサービスの健康状態を確認する
 func (h *MahjongAIHandler) HealthCheck(ctx context.Context, req *aiv1.HealthCheckRequest) (*aiv1.HealthCheckResponse, error) {
-	h.logger.Info("HealthCheck called")
+	h.logger.Debug("HealthCheck called")
 
-	// AIサービスのヘルスチェック
-	err := h.aiUsecase.HealthCheck(ctx)
-	if err != nil {
-		h.logger.WithError(err).Error("Health check failed")
+	// バックグラウンドで確認済みの状態を返す
+	status := h.healthUsecase.Status()
+	if !status.Serving {
 		return &aiv1.HealthCheckResponse{
 			Status:    aiv1.HealthCheckResponse_NOT_SERVING,
-			Message:   err.Error(),
-			Timestamp: timestamppb.New(time.Now()),
+			Message:   status.Message,
+			Timestamp: timestamppb.New(status.CheckedAt),
 		}, nil
 	}
 
-	h.logger.Info("Health check passed")
 	return &aiv1.HealthCheckResponse{
 		Status:    aiv1.HealthCheckResponse_SERVING,
-		Message:   "Service is healthy",
-		Timestamp: timestamppb.New(time.Now()),
+		Message:   status.Message,
+		Timestamp: timestamppb.New(status.CheckedAt),
 	}, nil
 }
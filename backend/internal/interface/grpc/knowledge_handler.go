@@ -0,0 +1,34 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// ReindexKnowledge はルールの資料の索引の作り直しを処理する
+func (h *MahjongAIHandler) ReindexKnowledge(ctx context.Context, req *aiv1.ReindexKnowledgeRequest) (*aiv1.ReindexKnowledgeResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"full":       req.GetFull(),
+	}).Info("ReindexKnowledge called")
+
+	output, err := h.knowledgeUsecase.Reindex(ctx, req.GetFull())
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to reindex knowledge")
+		return &aiv1.ReindexKnowledgeResponse{
+			Result:   &aiv1.ReindexKnowledgeResponse_Error{Error: newErrorInfo(err, "Failed to reindex knowledge")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.ReindexKnowledgeResponse{
+		Result:   &aiv1.ReindexKnowledgeResponse_Index{Index: protoconv.FromKnowledgeIndex(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// GetQuiz は何切る問題の出題を処理する
+func (h *MahjongAIHandler) GetQuiz(ctx context.Context, req *aiv1.GetQuizRequest) (*aiv1.GetQuizResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"quiz_id":    req.GetQuizId(),
+		"daily":      req.GetDaily(),
+	}).Info("GetQuiz called")
+
+	output, err := h.quizUsecase.GetQuiz(ctx, protoconv.ToGetQuizInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get quiz")
+		return &aiv1.GetQuizResponse{
+			Result:   &aiv1.GetQuizResponse_Error{Error: newErrorInfo(err, "Failed to get quiz")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.GetQuizResponse{
+		Result:   &aiv1.GetQuizResponse_Quiz{Quiz: protoconv.FromQuiz(output.Quiz)},
+		Stats:    protoconv.FromQuizStats(output.Stats),
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// SubmitQuizAnswer は何切る問題の回答の採点を処理する
+func (h *MahjongAIHandler) SubmitQuizAnswer(ctx context.Context, req *aiv1.SubmitQuizAnswerRequest) (*aiv1.SubmitQuizAnswerResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"quiz_id":    req.GetQuizId(),
+		"discard":    req.GetDiscard(),
+	}).Info("SubmitQuizAnswer called")
+
+	output, err := h.quizUsecase.SubmitQuizAnswer(ctx, protoconv.ToSubmitQuizAnswerInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to grade quiz answer")
+		return &aiv1.SubmitQuizAnswerResponse{
+			Result:   &aiv1.SubmitQuizAnswerResponse_Error{Error: newErrorInfo(err, "Failed to grade quiz answer")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.SubmitQuizAnswerResponse{
+		Result:   &aiv1.SubmitQuizAnswerResponse_Grade{Grade: protoconv.FromQuizGrade(output)},
+		Stats:    protoconv.FromQuizStats(output.Stats),
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
@@ -0,0 +1,35 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// RenderHand は手牌・河・局面の図の作成を処理する
+func (h *MahjongAIHandler) RenderHand(ctx context.Context, req *aiv1.RenderHandRequest) (*aiv1.RenderHandResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"hand":       req.GetHand(),
+		"format":     req.GetFormat().String(),
+	}).Info("RenderHand called")
+
+	output, err := h.renderUsecase.RenderHand(ctx, protoconv.ToRenderInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to render hand")
+		return &aiv1.RenderHandResponse{
+			Result:   &aiv1.RenderHandResponse_Error{Error: newErrorInfo(err, "Failed to render hand")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.RenderHandResponse{
+		Result:   &aiv1.RenderHandResponse_Diagram{Diagram: protoconv.FromDiagram(output)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
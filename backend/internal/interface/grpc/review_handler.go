@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/protoconv"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+)
+
+// ReviewGame は牌譜の検討の開始を処理する
+func (h *MahjongAIHandler) ReviewGame(ctx context.Context, req *aiv1.ReviewGameRequest) (*aiv1.GameReviewResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"game_id":    req.GetGameId(),
+		"player":     req.GetPlayer(),
+	}).Info("ReviewGame called")
+
+	review, err := h.reviewUsecase.ReviewGame(ctx, protoconv.ToReviewGameInput(req))
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to start game review")
+		return &aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Error{Error: newErrorInfo(err, "Failed to start game review")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.GameReviewResponse{
+		Result:   &aiv1.GameReviewResponse_Review{Review: protoconv.FromGameReview(review)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// GetGameReview は牌譜の検討結果の取得を処理する
+func (h *MahjongAIHandler) GetGameReview(ctx context.Context, req *aiv1.GetGameReviewRequest) (*aiv1.GameReviewResponse, error) {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"review_id":  req.GetReviewId(),
+	}).Info("GetGameReview called")
+
+	review, err := h.reviewUsecase.GetReview(ctx, req.GetReviewId())
+	if err != nil {
+		h.logger.WithError(err).Error("Failed to get game review")
+		return &aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Error{Error: newErrorInfo(err, "Failed to get game review")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}, nil
+	}
+
+	return &aiv1.GameReviewResponse{
+		Result:   &aiv1.GameReviewResponse_Review{Review: protoconv.FromGameReview(review)},
+		Metadata: newResponseMetadata(requestID, startTime),
+	}, nil
+}
+
+// WatchGameReview は牌譜の検討結果を更新のたびに送信する
+func (h *MahjongAIHandler) WatchGameReview(req *aiv1.GetGameReviewRequest, stream aiv1.MahjongAIService_WatchGameReviewServer) error {
+	startTime := time.Now()
+	requestID := requestIDFrom(req.Metadata)
+	h.logger.WithFields(logrus.Fields{
+		"request_id": requestID,
+		"review_id":  req.GetReviewId(),
+	}).Info("WatchGameReview called")
+
+	reviewChan, errChan := h.reviewUsecase.WatchReview(stream.Context(), req.GetReviewId())
+	for review := range reviewChan {
+		if err := stream.Send(&aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Review{Review: protoconv.FromGameReview(review)},
+			Metadata: newResponseMetadata(requestID, startTime),
+		}); err != nil {
+			return err
+		}
+	}
+	if err := <-errChan; err != nil {
+		if stream.Context().Err() != nil {
+			return stream.Context().Err()
+		}
+		h.logger.WithError(err).Error("Failed to watch game review")
+		return stream.Send(&aiv1.GameReviewResponse{
+			Result:   &aiv1.GameReviewResponse_Error{Error: newErrorInfo(err, "Failed to watch game review")},
+			Metadata: newResponseMetadata(requestID, startTime),
+		})
+	}
+	return nil
+}
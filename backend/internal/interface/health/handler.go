@@ -0,0 +1,71 @@
+package healthhandler
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// NewGRPCHealthServer は標準のgrpc.health.v1.Healthサービスを作成する
+// ステータスはHealthUsecaseのバックグラウンドチェック結果に追従する
+func NewGRPCHealthServer(healthUsecase *usecase.HealthUsecase, logger *logrus.Logger) *health.Server {
+	server := health.NewServer()
+
+	healthUsecase.Subscribe(func(status usecase.HealthStatus) {
+		servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+		if status.Serving {
+			servingStatus = healthpb.HealthCheckResponse_SERVING
+		}
+		// 空文字はサーバー全体の状態を表す
+		server.SetServingStatus("", servingStatus)
+		server.SetServingStatus(aiv1.MahjongAIService_ServiceDesc.ServiceName, servingStatus)
+	})
+
+	logger.Debug("gRPC health service created")
+	return server
+}
+
+// statusResponse は/readyzのレスポンスボディ
+type statusResponse struct {
+	Status    string `json:"status"`
+	Message   string `json:"message,omitempty"`
+	CheckedAt string `json:"checked_at,omitempty"`
+}
+
+// RegisterHTTP は/healthzと/readyzをmuxに登録する
+func RegisterHTTP(mux *http.ServeMux, healthUsecase *usecase.HealthUsecase) {
+	// liveness: プロセスが応答できれば常に成功
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, statusResponse{Status: "ok"})
+	})
+
+	// readiness: キャッシュされたAIプロバイダの状態を返す
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		status := healthUsecase.Status()
+		res := statusResponse{Message: status.Message}
+		if !status.CheckedAt.IsZero() {
+			res.CheckedAt = status.CheckedAt.Format(time.RFC3339)
+		}
+		if !status.Serving {
+			res.Status = "not_serving"
+			writeJSON(w, http.StatusServiceUnavailable, res)
+			return
+		}
+		res.Status = "serving"
+		writeJSON(w, http.StatusOK, res)
+	})
+}
+
+// writeJSON はJSONレスポンスを書き込む
+func writeJSON(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
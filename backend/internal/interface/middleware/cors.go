@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"sync"
+)
+
+// CORS は許可オリジンを実行時に差し替え可能なCORSミドルウェア
+type CORS struct {
+	mu      sync.RWMutex
+	origins map[string]bool
+	any     bool
+}
+
+// NewCORS は新しいCORSミドルウェアを作成する
+func NewCORS(origins []string) *CORS {
+	c := &CORS{}
+	c.SetOrigins(origins)
+	return c
+}
+
+// SetOrigins は許可オリジンを差し替える（"*" はすべてのオリジンを許可）
+func (c *CORS) SetOrigins(origins []string) {
+	allowed := make(map[string]bool, len(origins))
+	anyOrigin := false
+	for _, origin := range origins {
+		if origin == "*" {
+			anyOrigin = true
+		}
+		allowed[origin] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.origins = allowed
+	c.any = anyOrigin
+}
+
+// allowOrigin はレスポンスに設定するAccess-Control-Allow-Originの値を返す
+func (c *CORS) allowOrigin(origin string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.any {
+		return "*"
+	}
+	if origin != "" && c.origins[origin] {
+		return origin
+	}
+	return ""
+}
+
+// Allowed はオリジンが許可されているかを返す
+func (c *CORS) Allowed(origin string) bool {
+	return c.allowOrigin(origin) != ""
+}
+
+// Handler はCORSヘッダーを付与するハンドラを返す
+func (c *CORS) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Origin")
+		if origin := c.allowOrigin(r.Header.Get("Origin")); origin != "" {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol, Authorization")
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
+			w.Header().Set("Access-Control-Expose-Headers", "Connect-Content-Encoding, Connect-Accept-Encoding")
+		}
+		if r.Method == http.MethodOptions {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCORSHandler(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name       string
+		origins    []string
+		method     string
+		origin     string
+		wantAllow  string
+		wantStatus int
+	}{
+		{name: "許可されたオリジン", origins: []string{"https://a.example"}, method: http.MethodPost, origin: "https://a.example", wantAllow: "https://a.example", wantStatus: http.StatusOK},
+		{name: "許可されていないオリジン", origins: []string{"https://a.example"}, method: http.MethodPost, origin: "https://evil.example", wantStatus: http.StatusOK},
+		{name: "オリジンなし", origins: []string{"https://a.example"}, method: http.MethodPost, wantStatus: http.StatusOK},
+		{name: "すべて許可", origins: []string{"*"}, method: http.MethodPost, origin: "https://b.example", wantAllow: "*", wantStatus: http.StatusOK},
+		{name: "プリフライト", origins: []string{"https://a.example"}, method: http.MethodOptions, origin: "https://a.example", wantAllow: "https://a.example", wantStatus: http.StatusNoContent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			rec := httptest.NewRecorder()
+			NewCORS(tt.origins).Handler(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if got := rec.Header().Get("Access-Control-Allow-Origin"); got != tt.wantAllow {
+				t.Errorf("Access-Control-Allow-Origin = %q, want %q", got, tt.wantAllow)
+			}
+			if got := rec.Header().Get("Vary"); got != "Origin" {
+				t.Errorf("Vary = %q, want %q", got, "Origin")
+			}
+		})
+	}
+}
+
+func TestCORSSetOrigins(t *testing.T) {
+	cors := NewCORS([]string{"https://a.example"})
+	cors.SetOrigins([]string{"https://b.example"})
+
+	if got := cors.allowOrigin("https://a.example"); got != "" {
+		t.Errorf("allowOrigin(a) = %q after reload, want empty", got)
+	}
+	if got := cors.allowOrigin("https://b.example"); got != "https://b.example" {
+		t.Errorf("allowOrigin(b) = %q, want %q", got, "https://b.example")
+	}
+	if cors.Allowed("https://a.example") || !cors.Allowed("https://b.example") || cors.Allowed("") {
+		t.Error("Allowed() does not follow the reloaded origins")
+	}
+}
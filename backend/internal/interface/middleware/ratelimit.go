@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RateLimiter はAI APIへのリクエスト数を制限する
+// 制限値は実行時に差し替え可能
+type RateLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewRateLimiter は新しいRateLimiterを作成する（rps が0以下の場合は無制限）
+func NewRateLimiter(rps float64, burst int) *RateLimiter {
+	r := &RateLimiter{limiter: rate.NewLimiter(rate.Inf, burst)}
+	r.SetLimit(rps, burst)
+	return r
+}
+
+// SetLimit は制限値を差し替える
+func (r *RateLimiter) SetLimit(rps float64, burst int) {
+	limit := rate.Inf
+	if rps > 0 {
+		limit = rate.Limit(rps)
+	}
+	r.limiter.SetLimit(limit)
+	r.limiter.SetBurst(burst)
+}
+
+// Allow はリクエストを受け付けられるかを返す
+func (r *RateLimiter) Allow() bool {
+	return r.limiter.Allow()
+}
+
+// exempt はレート制限の対象外とするgRPCメソッド・Connectのパスかを判定する
+// ヘルスチェックは監視から高頻度で呼ばれ、AIを呼び出さないため制限しない
+func exempt(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.") ||
+		strings.HasPrefix(fullMethod, "/grpc.reflection.") ||
+		fullMethod == aiv1.MahjongAIService_HealthCheck_FullMethodName
+}
+
+// UnaryServerInterceptor はgRPCの単項呼び出しにレート制限を適用する
+func (r *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !exempt(info.FullMethod) && !r.Allow() {
+			return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor はgRPCのストリーミング呼び出しにレート制限を適用する
+func (r *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !exempt(info.FullMethod) && !r.Allow() {
+			return status.Error(codes.ResourceExhausted, "rate limit exceeded")
+		}
+		return handler(srv, ss)
+	}
+}
+
+// Handler はHTTPハンドラにレート制限を適用する
+func (r *RateLimiter) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodOptions && !exempt(req.URL.Path) && !r.Allow() {
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, req)
+	})
+}
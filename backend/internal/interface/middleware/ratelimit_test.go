@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestExempt(t *testing.T) {
+	tests := []struct {
+		method string
+		want   bool
+	}{
+		{method: "/grpc.health.v1.Health/Check", want: true},
+		{method: "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo", want: true},
+		{method: aiv1.MahjongAIService_HealthCheck_FullMethodName, want: true},
+		{method: aiv1.MahjongAIService_AskMahjongAI_FullMethodName, want: false},
+		{method: "/render/hand.svg", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			if got := exempt(tt.method); got != tt.want {
+				t.Errorf("exempt(%q) = %v, want %v", tt.method, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterUnaryServerInterceptor(t *testing.T) {
+	r := NewRateLimiter(0.001, 1)
+	interceptor := r.UnaryServerInterceptor()
+	handler := func(ctx context.Context, req any) (any, error) { return "ok", nil }
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	if err := call(aiv1.MahjongAIService_AskMahjongAI_FullMethodName); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if err := call(aiv1.MahjongAIService_AskMahjongAI_FullMethodName); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("second call = %v, want ResourceExhausted", err)
+	}
+	if err := call(aiv1.MahjongAIService_HealthCheck_FullMethodName); err != nil {
+		t.Errorf("HealthCheck = %v, want exempt", err)
+	}
+
+	// 制限値を外すと受け付ける
+	r.SetLimit(0, 1)
+	if err := call(aiv1.MahjongAIService_AskMahjongAI_FullMethodName); err != nil {
+		t.Errorf("call after SetLimit(0) = %v", err)
+	}
+}
+
+func TestRateLimiterHandler(t *testing.T) {
+	r := NewRateLimiter(0.001, 1)
+	handler := r.Handler(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	serve := func(method, path string) int {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(method, path, nil))
+		return rec.Code
+	}
+
+	askPath := aiv1.MahjongAIService_AskMahjongAI_FullMethodName
+	if got := serve(http.MethodPost, askPath); got != http.StatusOK {
+		t.Fatalf("first request = %d, want %d", got, http.StatusOK)
+	}
+	if got := serve(http.MethodPost, askPath); got != http.StatusTooManyRequests {
+		t.Errorf("second request = %d, want %d", got, http.StatusTooManyRequests)
+	}
+	if got := serve(http.MethodOptions, askPath); got != http.StatusOK {
+		t.Errorf("preflight = %d, want %d", got, http.StatusOK)
+	}
+	if got := serve(http.MethodPost, aiv1.MahjongAIService_HealthCheck_FullMethodName); got != http.StatusOK {
+		t.Errorf("HealthCheck = %d, want %d", got, http.StatusOK)
+	}
+}
@@ -0,0 +1,190 @@
+// Package mjaihandler は mjai プロトコルのイベントを TCP・WebSocket で受け取り、推奨する行動を返す
+//
+// TCP では1行に1つのJSON、WebSocket では1メッセージに1つのJSONを送受信する。
+// イベントは1つのオブジェクトか、まとめて適用するオブジェクトの配列で、1回の受信に1つの行動を返す。
+package mjaihandler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+)
+
+// maxLineBytes は1行（1メッセージ）の最大バイト数
+const maxLineBytes = 1024 * 1024
+
+// Server は mjai の接続を受け付ける
+type Server struct {
+	mjaiUsecase *usecase.MjaiUsecase
+	logger      *logrus.Logger
+}
+
+// NewServer は新しいServerを作成する
+func NewServer(mjaiUsecase *usecase.MjaiUsecase, logger *logrus.Logger) *Server {
+	return &Server{
+		mjaiUsecase: mjaiUsecase,
+		logger:      logger,
+	}
+}
+
+// ServeTCP は ctx がキャンセルされるまで TCP の接続を受け付ける
+func (s *Server) ServeTCP(ctx context.Context, lis net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.serveTCPConn(ctx, conn)
+		}()
+	}
+}
+
+// serveTCPConn は1つの TCP の接続を処理する
+func (s *Server) serveTCPConn(ctx context.Context, conn net.Conn) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// サーバーの停止時に読み込みを止める
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineBytes)
+	read := func() ([]byte, error) {
+		if !scanner.Scan() {
+			if err := scanner.Err(); err != nil {
+				return nil, err
+			}
+			return nil, io.EOF
+		}
+		return scanner.Bytes(), nil
+	}
+	write := func(data []byte) error {
+		_, err := conn.Write(append(data, '\n'))
+		return err
+	}
+	s.serve(ctx, "tcp", conn.RemoteAddr().String(), read, write)
+}
+
+// WebSocketHandler は WebSocket の接続を受け付けるハンドラを返す
+// ボットやシミュレーターは Origin を送らないことが多いため Origin のない接続は受け付け、
+// ブラウザからの接続は allowOrigin で許可されたオリジンに限る
+func (s *Server) WebSocketHandler(allowOrigin func(origin string) bool) http.Handler {
+	return websocket.Server{
+		Handshake: func(_ *websocket.Config, req *http.Request) error {
+			origin := req.Header.Get("Origin")
+			if origin == "" || allowOrigin(origin) {
+				return nil
+			}
+			s.logger.WithFields(logrus.Fields{"origin": origin, "remote": req.RemoteAddr}).Warn("mjai WebSocket origin rejected")
+			return fmt.Errorf("origin %q is not allowed", origin)
+		},
+		Handler: func(ws *websocket.Conn) {
+			defer ws.Close()
+			ws.MaxPayloadBytes = maxLineBytes
+			read := func() ([]byte, error) {
+				var data []byte
+				err := websocket.Message.Receive(ws, &data)
+				return data, err
+			}
+			write := func(data []byte) error {
+				return websocket.Message.Send(ws, string(data))
+			}
+			s.serve(ws.Request().Context(), "websocket", ws.Request().RemoteAddr, read, write)
+		},
+	}
+}
+
+// serve は接続が閉じられるまでイベントを受け取り、行動を返す
+// 解説が有効な場合は、行動の前に explanation のメッセージを続けて送る
+func (s *Server) serve(ctx context.Context, transport, remote string, read func() ([]byte, error), write func([]byte) error) {
+	logger := s.logger.WithFields(logrus.Fields{"transport": transport, "remote": remote})
+	logger.Info("mjai client connected")
+	defer logger.Info("mjai client disconnected")
+
+	session := s.mjaiUsecase.NewSession()
+	send := func(msg *gamelog.MjaiMessage) error {
+		data, err := json.Marshal(msg)
+		if err != nil {
+			return err
+		}
+		return write(data)
+	}
+	explain := func(text string) error {
+		return send(&gamelog.MjaiMessage{Type: gamelog.MjaiExplanation, Text: text})
+	}
+
+	for {
+		line, err := read()
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				logger.WithError(err).Warn("Failed to read mjai event")
+			}
+			return
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		var action *gamelog.MjaiMessage
+		events, err := parseEvents(line)
+		if err == nil {
+			action, err = session.Handle(ctx, events, explain)
+		}
+		if err != nil {
+			logger.WithError(err).Warn("Failed to handle mjai event")
+			action = &gamelog.MjaiMessage{Type: gamelog.MjaiError, Message: err.Error()}
+		}
+		if err := send(action); err != nil {
+			logger.WithError(err).Warn("Failed to send mjai action")
+			return
+		}
+	}
+}
+
+// parseEvents は1つのオブジェクトまたはオブジェクトの配列のイベントを解析する
+func parseEvents(line []byte) ([]*gamelog.MjaiMessage, error) {
+	line = bytes.TrimSpace(line)
+	if line[0] == '[' {
+		var events []*gamelog.MjaiMessage
+		if err := json.Unmarshal(line, &events); err != nil {
+			return nil, err
+		}
+		for _, e := range events {
+			if e == nil {
+				return nil, errors.New("event must be an object")
+			}
+		}
+		return events, nil
+	}
+	var event gamelog.MjaiMessage
+	if err := json.Unmarshal(line, &event); err != nil {
+		return nil, err
+	}
+	return []*gamelog.MjaiMessage{&event}, nil
+}
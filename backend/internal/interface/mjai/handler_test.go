@@ -0,0 +1,122 @@
+package mjaihandler
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+)
+
+func newTestServer() *Server {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return NewServer(usecase.NewMjaiUsecase(nil, logger), logger)
+}
+
+func TestParseEvents(t *testing.T) {
+	tests := []struct {
+		name      string
+		line      string
+		wantTypes []string
+		wantErr   bool
+	}{
+		{name: "1つのオブジェクト", line: `{"type":"hello"}`, wantTypes: []string{gamelog.MjaiHello}},
+		{name: "前後の空白", line: " \t{\"type\":\"hello\"}\r", wantTypes: []string{gamelog.MjaiHello}},
+		{name: "配列", line: `[{"type":"start_game","id":0},{"type":"end_game"}]`, wantTypes: []string{gamelog.MjaiStartGame, gamelog.MjaiEndGame}},
+		{name: "空の配列", line: `[]`, wantTypes: []string{}},
+		{name: "配列にnull", line: `[{"type":"hello"},null]`, wantErr: true},
+		{name: "不正なJSON", line: `{"type":`, wantErr: true},
+		{name: "オブジェクトでない", line: `"hello"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events, err := parseEvents([]byte(tt.line))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("parseEvents(%q) = %v, want an error", tt.line, events)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseEvents(%q): %v", tt.line, err)
+			}
+			if len(events) != len(tt.wantTypes) {
+				t.Fatalf("parseEvents(%q) returned %d events, want %d", tt.line, len(events), len(tt.wantTypes))
+			}
+			for i, e := range events {
+				if e.Type != tt.wantTypes[i] {
+					t.Errorf("events[%d].Type = %q, want %q", i, e.Type, tt.wantTypes[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWebSocketHandshakeOrigin(t *testing.T) {
+	allowed := func(origin string) bool { return origin == "https://app.example" }
+	handshake := newTestServer().WebSocketHandler(allowed).(websocket.Server).Handshake
+
+	tests := []struct {
+		name    string
+		origin  string
+		wantErr bool
+	}{
+		{name: "Originなし（ボット）", origin: ""},
+		{name: "許可されたオリジン", origin: "https://app.example"},
+		{name: "許可されていないオリジン", origin: "https://evil.example", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/mjai", nil)
+			if tt.origin != "" {
+				req.Header.Set("Origin", tt.origin)
+			}
+			if err := handshake(&websocket.Config{}, req); (err != nil) != tt.wantErr {
+				t.Errorf("Handshake() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebSocketHandler(t *testing.T) {
+	server := httptest.NewServer(newTestServer().WebSocketHandler(func(origin string) bool { return origin == "http://localhost" }))
+	defer server.Close()
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	if _, err := websocket.Dial(url, "", "https://evil.example"); err == nil {
+		t.Fatal("Dial from a disallowed origin succeeded")
+	}
+
+	ws, err := websocket.Dial(url, "", "http://localhost")
+	if err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	defer ws.Close()
+	if err := websocket.Message.Send(ws, `{"type":"hello","protocol":"mjsonp","protocol_version":3}`); err != nil {
+		t.Fatal(err)
+	}
+	var reply string
+	if err := websocket.Message.Receive(ws, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(reply, `"type":"join"`) {
+		t.Errorf("reply to hello = %s, want join", reply)
+	}
+
+	// 解析できないイベントにはエラーを返し、接続を続ける
+	if err := websocket.Message.Send(ws, `{"type":`); err != nil {
+		t.Fatal(err)
+	}
+	if err := websocket.Message.Receive(ws, &reply); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(reply, `"type":"error"`) {
+		t.Errorf("reply to invalid JSON = %s, want error", reply)
+	}
+}
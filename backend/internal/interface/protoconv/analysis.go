@@ -0,0 +1,439 @@
+// Package protoconv はgRPC・Connectの両ハンドラーで共通のprotoメッセージとユースケースの入出力の変換を行う
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// ToMelds は副露を変換する（種類が未指定の場合は解析時にエラーになる）
+func ToMelds(melds []*aiv1.Meld) []usecase.MeldInput {
+	result := make([]usecase.MeldInput, 0, len(melds))
+	for _, m := range melds {
+		result = append(result, usecase.MeldInput{Type: ToMeldType(m.GetType()), Tiles: m.GetTiles()})
+	}
+	return result
+}
+
+// ToMeldType は副露の種類を変換する（未指定の場合は -1）
+func ToMeldType(meldType aiv1.MeldType) mahjong.MeldType {
+	switch meldType {
+	case aiv1.MeldType_MELD_TYPE_CHI:
+		return mahjong.MeldChi
+	case aiv1.MeldType_MELD_TYPE_PON:
+		return mahjong.MeldPon
+	case aiv1.MeldType_MELD_TYPE_MINKAN:
+		return mahjong.MeldMinkan
+	case aiv1.MeldType_MELD_TYPE_ANKAN:
+		return mahjong.MeldAnkan
+	case aiv1.MeldType_MELD_TYPE_KAKAN:
+		return mahjong.MeldKakan
+	default:
+		return -1
+	}
+}
+
+// ToWind は風を牌に変換する（未指定の場合は defaultWind）
+// 範囲外の値は風牌でない牌に変換し、ユースケースの検証で不正なリクエストとして扱う
+func ToWind(wind aiv1.Wind, defaultWind mahjong.Tile) mahjong.Tile {
+	switch {
+	case wind == aiv1.Wind_WIND_UNSPECIFIED:
+		return defaultWind
+	case wind < aiv1.Wind_WIND_EAST || wind > aiv1.Wind_WIND_NORTH:
+		return mahjong.NumTileKinds
+	}
+	return mahjong.East + mahjong.Tile(wind-aiv1.Wind_WIND_EAST)
+}
+
+// ToWaitsInput は待ち判定のリクエストを変換する
+func ToWaitsInput(req *aiv1.GetWaitsRequest) usecase.WaitsInput {
+	return usecase.WaitsInput{
+		HandInput:              usecase.HandInput{Hand: req.GetHand(), Melds: ToMelds(req.GetMelds())},
+		RuleSet:                req.GetRuleSet(),
+		SeatWind:               ToWind(req.GetSeatWind(), mahjong.South),
+		RoundWind:              ToWind(req.GetRoundWind(), mahjong.East),
+		Riichi:                 req.GetRiichi(),
+		DoraIndicators:         req.GetDoraIndicators(),
+		Discards:               req.GetDiscards(),
+		PassedSinceLastDiscard: req.GetPassedSinceLastDiscard(),
+		PassedAfterRiichi:      req.GetPassedAfterRiichi(),
+		VisibleTiles:           req.GetVisibleTiles(),
+	}
+}
+
+// FromWaitsOutput は待ち判定の結果を変換する
+func FromWaitsOutput(output *usecase.WaitsOutput) *aiv1.WaitsResult {
+	result := &aiv1.WaitsResult{
+		Tenpai:  output.Analysis.Tenpai,
+		Shape:   FromWaitShape(output.Analysis.Shape),
+		CanRon:  output.CanRon,
+		RuleSet: output.RuleSet.Name,
+		Furiten: &aiv1.FuritenInfo{
+			Permanent: output.Furiten.Permanent,
+			Temporary: output.Furiten.Temporary,
+			Riichi:    output.Furiten.Riichi,
+			Tiles:     FromTiles(output.Furiten.Tiles),
+		},
+	}
+	for _, w := range output.Analysis.Waits {
+		info := &aiv1.WaitInfo{
+			Tile:        w.Tile.String(),
+			Remaining:   int32(w.Remaining),
+			NoYakuRon:   w.NoYakuRon(),
+			NoYakuTsumo: w.NoYakuTsumo(),
+			Ron:         FromAgari(w.Ron, false),
+			Tsumo:       FromAgari(w.Tsumo, true),
+		}
+		for _, s := range w.Shapes {
+			info.Shapes = append(info.Shapes, FromWaitShape(s))
+		}
+		result.Waits = append(result.Waits, info)
+	}
+	return result
+}
+
+// FromAgari は和了の評価を変換する
+func FromAgari(agari *mahjong.Agari, tsumo bool) *aiv1.AgariInfo {
+	if agari == nil {
+		return nil
+	}
+	info := &aiv1.AgariInfo{
+		Han:     int32(agari.Han),
+		Fu:      int32(agari.Fu),
+		Dora:    int32(agari.Dora),
+		AkaDora: int32(agari.AkaDora),
+		UraDora: int32(agari.UraDora),
+	}
+	for _, y := range agari.Yaku {
+		info.Yaku = append(info.Yaku, &aiv1.YakuInfo{Name: y.Name, Han: int32(y.Han), Yakuman: int32(y.Yakuman)})
+	}
+	// 役がない場合は和了できないため点数を返さない
+	if agari.HasYaku() {
+		info.Score = agari.Score.String()
+		info.Points = int32(agari.Score.Ron)
+		if tsumo {
+			info.Points = int32(agari.Score.TsumoTotal)
+		}
+	}
+	return info
+}
+
+// FromWaitShape は待ちの形を変換する
+func FromWaitShape(shape mahjong.WaitShape) aiv1.WaitShape {
+	switch shape {
+	case mahjong.WaitRyanmen:
+		return aiv1.WaitShape_WAIT_SHAPE_RYANMEN
+	case mahjong.WaitKanchan:
+		return aiv1.WaitShape_WAIT_SHAPE_KANCHAN
+	case mahjong.WaitPenchan:
+		return aiv1.WaitShape_WAIT_SHAPE_PENCHAN
+	case mahjong.WaitShanpon:
+		return aiv1.WaitShape_WAIT_SHAPE_SHANPON
+	case mahjong.WaitTanki:
+		return aiv1.WaitShape_WAIT_SHAPE_TANKI
+	case mahjong.WaitNobetan:
+		return aiv1.WaitShape_WAIT_SHAPE_NOBETAN
+	case mahjong.WaitMultiSided:
+		return aiv1.WaitShape_WAIT_SHAPE_MULTI_SIDED
+	default:
+		return aiv1.WaitShape_WAIT_SHAPE_UNSPECIFIED
+	}
+}
+
+// FromTiles は牌を1枚ずつのMPSZ表記に変換する
+func FromTiles(tiles []mahjong.Tile) []string {
+	result := make([]string, 0, len(tiles))
+	for _, t := range tiles {
+		result = append(result, t.String())
+	}
+	return result
+}
+
+// ToDiscardInput は打牌推奨のリクエストを変換する
+func ToDiscardInput(req *aiv1.RecommendDiscardRequest) usecase.DiscardInput {
+	return usecase.DiscardInput{
+		HandInput:      usecase.HandInput{Hand: req.GetHand(), Melds: ToMelds(req.GetMelds())},
+		RuleSet:        req.GetRuleSet(),
+		SeatWind:       ToWind(req.GetSeatWind(), mahjong.South),
+		RoundWind:      ToWind(req.GetRoundWind(), mahjong.East),
+		DoraIndicators: req.GetDoraIndicators(),
+		Discards:       req.GetDiscards(),
+		VisibleTiles:   req.GetVisibleTiles(),
+		Simulations:    int(req.GetSimulations()),
+		Turns:          int(req.GetTurns()),
+		Seed:           req.GetSeed(),
+	}
+}
+
+// FromDiscardOutput は打牌推奨の結果を変換する
+func FromDiscardOutput(output *usecase.DiscardOutput) *aiv1.DiscardResult {
+	result := &aiv1.DiscardResult{
+		Shanten: int32(output.Analysis.Shanten),
+		RuleSet: output.RuleSet.Name,
+	}
+	for _, c := range output.Analysis.Candidates {
+		result.Candidates = append(result.Candidates, FromDiscardCandidate(c))
+	}
+	return result
+}
+
+// FromDiscardCandidate は打牌候補の評価を変換する
+func FromDiscardCandidate(c mahjong.DiscardCandidate) *aiv1.DiscardCandidateInfo {
+	info := &aiv1.DiscardCandidateInfo{
+		Tile:             c.Tile.String(),
+		Shanten:          int32(c.Shanten),
+		UkeireCount:      int32(c.Ukeire.Total),
+		ImprovementCount: int32(c.ImprovementCount),
+		GoodShapeRate:    float32(c.GoodShapeRate),
+	}
+	for _, u := range c.Ukeire.Tiles {
+		info.Ukeire = append(info.Ukeire, &aiv1.UkeireTileInfo{Tile: u.Tile.String(), Remaining: int32(u.Remaining)})
+	}
+	if c.Simulated {
+		info.Simulation = FromSimulation(c.Simulation)
+	}
+	return info
+}
+
+// FromSimulation はモンテカルロ法の集計結果を変換する
+func FromSimulation(s mahjong.SimulationResult) *aiv1.SimulationInfo {
+	info := &aiv1.SimulationInfo{
+		Simulations:      int32(s.Simulations),
+		WinRate:          float32(s.WinRate),
+		TsumoRate:        float32(s.TsumoRate),
+		TenpaiRate:       float32(s.TenpaiRate),
+		AverageWinPoints: float32(s.AverageWinPoints),
+		ExpectedValue:    float32(s.ExpectedValue),
+	}
+	for _, t := range s.ByTurn {
+		info.ByTurn = append(info.ByTurn, &aiv1.SimulationTurnInfo{
+			Turn:          int32(t.Turn),
+			TenpaiRate:    float32(t.TenpaiRate),
+			WinRate:       float32(t.WinRate),
+			ExpectedValue: float32(t.ExpectedValue),
+		})
+	}
+	return info
+}
+
+// ToSimulationInput は手牌のシミュレーションのリクエストを変換する
+func ToSimulationInput(req *aiv1.SimulateHandRequest) usecase.SimulationInput {
+	return usecase.SimulationInput{
+		HandInput:      usecase.HandInput{Hand: req.GetHand(), Melds: ToMelds(req.GetMelds())},
+		RuleSet:        req.GetRuleSet(),
+		SeatWind:       ToWind(req.GetSeatWind(), mahjong.South),
+		RoundWind:      ToWind(req.GetRoundWind(), mahjong.East),
+		Riichi:         req.GetRiichi(),
+		DoraIndicators: req.GetDoraIndicators(),
+		Discards:       req.GetDiscards(),
+		VisibleTiles:   req.GetVisibleTiles(),
+		Simulations:    int(req.GetSimulations()),
+		Turns:          int(req.GetTurns()),
+		Seed:           req.GetSeed(),
+		Policy:         req.GetPolicy(),
+	}
+}
+
+// FromSimulationOutput は手牌のシミュレーションの結果を変換する
+func FromSimulationOutput(output *usecase.SimulationOutput) *aiv1.SimulationResult {
+	return &aiv1.SimulationResult{
+		Simulation: FromSimulation(output.Result),
+		Shanten:    int32(output.Shanten),
+		Policy:     output.Policy.Name(),
+		RuleSet:    output.RuleSet.Name,
+	}
+}
+
+// ToSafetyInput は安全度評価のリクエストを変換する
+func ToSafetyInput(req *aiv1.AssessSafetyRequest) usecase.SafetyInput {
+	input := usecase.SafetyInput{
+		HandInput:      usecase.HandInput{Hand: req.GetHand(), Melds: ToMelds(req.GetMelds())},
+		VisibleTiles:   req.GetVisibleTiles(),
+		DoraIndicators: req.GetDoraIndicators(),
+	}
+	input.Opponents = ToOpponents(req.GetOpponents())
+	return input
+}
+
+// ToOpponents は他家を変換する（自風が未指定の場合は -1）
+func ToOpponents(opponents []*aiv1.OpponentInfo) []usecase.OpponentInput {
+	result := make([]usecase.OpponentInput, 0, len(opponents))
+	for _, o := range opponents {
+		result = append(result, usecase.OpponentInput{
+			Seat:              ToWind(o.GetSeat(), -1),
+			Discards:          o.GetDiscards(),
+			PassedAfterRiichi: o.GetPassedAfterRiichi(),
+			RiichiTurn:        int(o.GetRiichiTurn()),
+			Score:             int(o.GetScore()),
+		})
+	}
+	return result
+}
+
+// FromTileSafety は安全度の評価結果を変換する
+func FromTileSafety(tiles []mahjong.TileSafety) *aiv1.SafetyResult {
+	result := &aiv1.SafetyResult{}
+	for _, t := range tiles {
+		info := &aiv1.TileSafetyInfo{
+			Tile:  t.Tile.String(),
+			Count: int32(t.Count),
+			Risk:  float32(t.Risk),
+		}
+		for _, a := range t.Against {
+			info.Against = append(info.Against, &aiv1.OpponentSafetyInfo{
+				Seat:        FromWind(a.Seat),
+				SafetyClass: FromSafetyClass(a.Class),
+				Risk:        float32(a.Risk),
+				Reasons:     a.Reasons,
+			})
+		}
+		result.Tiles = append(result.Tiles, info)
+	}
+	return result
+}
+
+// FromWind は牌を風に変換する（風牌でない場合は未指定）
+func FromWind(t mahjong.Tile) aiv1.Wind {
+	if !t.IsWind() {
+		return aiv1.Wind_WIND_UNSPECIFIED
+	}
+	return aiv1.Wind_WIND_EAST + aiv1.Wind(t-mahjong.East)
+}
+
+// FromSafetyClass は安全度の分類を変換する
+func FromSafetyClass(class mahjong.SafetyClass) aiv1.SafetyClass {
+	switch class {
+	case mahjong.SafetyGenbutsu:
+		return aiv1.SafetyClass_SAFETY_CLASS_GENBUTSU
+	case mahjong.SafetyNoChance:
+		return aiv1.SafetyClass_SAFETY_CLASS_NO_CHANCE
+	case mahjong.SafetySuji:
+		return aiv1.SafetyClass_SAFETY_CLASS_SUJI
+	case mahjong.SafetyHonor:
+		return aiv1.SafetyClass_SAFETY_CLASS_HONOR
+	case mahjong.SafetyOneChance:
+		return aiv1.SafetyClass_SAFETY_CLASS_ONE_CHANCE
+	case mahjong.SafetyHalfSuji:
+		return aiv1.SafetyClass_SAFETY_CLASS_HALF_SUJI
+	case mahjong.SafetyMusuji:
+		return aiv1.SafetyClass_SAFETY_CLASS_MUSUJI
+	default:
+		return aiv1.SafetyClass_SAFETY_CLASS_UNSPECIFIED
+	}
+}
+
+// ToPushFoldInput は押し引き評価のリクエストを変換する
+func ToPushFoldInput(req *aiv1.EvaluatePushFoldRequest) usecase.PushFoldInput {
+	return usecase.PushFoldInput{
+		HandInput:      usecase.HandInput{Hand: req.GetHand(), Melds: ToMelds(req.GetMelds())},
+		RuleSet:        req.GetRuleSet(),
+		SeatWind:       ToWind(req.GetSeatWind(), mahjong.South),
+		RoundWind:      ToWind(req.GetRoundWind(), mahjong.East),
+		Opponents:      ToOpponents(req.GetOpponents()),
+		DoraIndicators: req.GetDoraIndicators(),
+		Discards:       req.GetDiscards(),
+		VisibleTiles:   req.GetVisibleTiles(),
+		Turn:           int(req.GetTurn()),
+		Score: mahjong.ScoreSituation{
+			OwnScore:     int(req.GetOwnScore()),
+			AllLast:      req.GetAllLast(),
+			RiichiSticks: int(req.GetRiichiSticks()),
+			Honba:        int(req.GetHonba()),
+		},
+		Simulations: int(req.GetSimulations()),
+		Seed:        req.GetSeed(),
+	}
+}
+
+// FromPushFoldOutput は押し引き評価の結果を変換する
+func FromPushFoldOutput(output *usecase.PushFoldOutput) *aiv1.PushFoldResult {
+	analysis := output.Analysis
+	result := &aiv1.PushFoldResult{
+		Recommendation: FromPushFoldAction(analysis.Recommendation),
+		RemainingTurns: int32(analysis.RemainingTurns),
+		DrawRisk:       float32(analysis.DrawRisk),
+		RuleSet:        output.RuleSet.Name,
+	}
+	for _, o := range analysis.Options {
+		result.Options = append(result.Options, &aiv1.PushFoldOptionInfo{
+			Action:           FromPushFoldAction(o.Action),
+			Discard:          o.Discard.String(),
+			Shanten:          int32(o.Shanten),
+			UkeireCount:      int32(o.Ukeire),
+			DiscardRisk:      float32(o.DiscardRisk),
+			WinRate:          float32(o.WinRate),
+			DealInRate:       float32(o.DealInRate),
+			AverageWinPoints: float32(o.AverageWinPoints),
+			ExpectedValue:    float32(o.ExpectedValue),
+		})
+	}
+	for _, t := range analysis.Threats {
+		result.Threats = append(result.Threats, &aiv1.ThreatInfo{
+			Seat:           FromWind(t.Seat),
+			ExpectedLoss:   int32(t.ExpectedLoss),
+			WinRatePerTurn: float32(t.WinRatePerTurn),
+		})
+	}
+	return result
+}
+
+// FromPushFoldAction は押し引きの選択肢を変換する
+func FromPushFoldAction(action mahjong.PushFoldAction) aiv1.PushFoldAction {
+	switch action {
+	case mahjong.ActionPush:
+		return aiv1.PushFoldAction_PUSH_FOLD_ACTION_PUSH
+	case mahjong.ActionMawashi:
+		return aiv1.PushFoldAction_PUSH_FOLD_ACTION_MAWASHI
+	case mahjong.ActionFold:
+		return aiv1.PushFoldAction_PUSH_FOLD_ACTION_FOLD
+	default:
+		return aiv1.PushFoldAction_PUSH_FOLD_ACTION_UNSPECIFIED
+	}
+}
+
+// ToPlacementInput はオーラスの点数状況の計算のリクエストを変換する
+func ToPlacementInput(req *aiv1.CalculatePlacementRequest) usecase.PlacementInput {
+	input := usecase.PlacementInput{
+		RuleSet: req.GetRuleSet(),
+		PlacementInput: mahjong.PlacementInput{
+			Dealer:       int(req.GetDealer()),
+			Honba:        int(req.GetHonba()),
+			RiichiSticks: int(req.GetRiichiSticks()),
+		},
+		StartingPoints: int(req.GetStartingPoints()),
+		ReturnPoints:   int(req.GetReturnPoints()),
+	}
+	for _, s := range req.GetScores() {
+		input.Scores = append(input.Scores, int(s))
+	}
+	for _, u := range req.GetUma() {
+		input.Uma = append(input.Uma, int(u))
+	}
+	return input
+}
+
+// FromPlacementOutput はオーラスの点数状況の計算結果を変換する
+func FromPlacementOutput(output *usecase.PlacementOutput) *aiv1.PlacementResult {
+	result := &aiv1.PlacementResult{RuleSet: output.RuleSet.Name}
+	for _, s := range output.Analysis.Standings {
+		result.Standings = append(result.Standings, &aiv1.PlacementStandingInfo{
+			Player:      int32(s.Player),
+			Score:       int32(s.Score),
+			Rank:        int32(s.Rank),
+			FinalPoints: float32(s.FinalPoints),
+		})
+	}
+	for _, r := range output.Analysis.Requirements {
+		result.Requirements = append(result.Requirements, &aiv1.PlacementRequirementInfo{
+			Player:     int32(r.Player),
+			TargetRank: int32(r.Target),
+			Tsumo:      r.Tsumo(),
+			From:       int32(r.From),
+			Possible:   r.Possible,
+			Hand:       r.Describe(),
+			Points:     int32(r.Gain),
+		})
+	}
+	return result
+}
@@ -0,0 +1,29 @@
+package protoconv
+
+import (
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+func TestToWind(t *testing.T) {
+	tests := []struct {
+		name string
+		wind aiv1.Wind
+		want mahjong.Tile
+	}{
+		{name: "未指定", wind: aiv1.Wind_WIND_UNSPECIFIED, want: mahjong.South},
+		{name: "東", wind: aiv1.Wind_WIND_EAST, want: mahjong.East},
+		{name: "北", wind: aiv1.Wind_WIND_NORTH, want: mahjong.North},
+		{name: "範囲外", wind: aiv1.Wind(99), want: mahjong.NumTileKinds},
+		{name: "負の値", wind: aiv1.Wind(-1), want: mahjong.NumTileKinds},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ToWind(tt.wind, mahjong.South); got != tt.want {
+				t.Errorf("ToWind(%v) = %d, want %d", tt.wind, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// ToAttachments は質問に添付するファイルを変換する
+func ToAttachments(attachments []*aiv1.Attachment) []entity.Attachment {
+	if len(attachments) == 0 {
+		return nil
+	}
+	result := make([]entity.Attachment, 0, len(attachments))
+	for _, a := range attachments {
+		result = append(result, entity.Attachment{Data: a.GetData(), MIMEType: a.GetMimeType()})
+	}
+	return result
+}
+
+// FromHandTranscription は画像の手牌を書き起こした結果を変換する（nil の場合は nil）
+func FromHandTranscription(transcription *entity.HandTranscription) *aiv1.HandTranscription {
+	if transcription == nil {
+		return nil
+	}
+	info := &aiv1.HandTranscription{
+		Hand:     transcription.Hand,
+		Valid:    transcription.Valid,
+		Error:    transcription.Error,
+		Attempts: transcription.Attempts,
+	}
+	for _, m := range transcription.Melds {
+		meldType := aiv1.MeldType_MELD_TYPE_UNSPECIFIED
+		if t, ok := mahjong.ParseMeldType(m.Type); ok {
+			meldType = FromMeldType(t)
+		}
+		info.Melds = append(info.Melds, &aiv1.Meld{Type: meldType, Tiles: m.Tiles})
+	}
+	return info
+}
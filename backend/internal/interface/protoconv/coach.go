@@ -0,0 +1,134 @@
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// ToCoachInput はコーチングのリクエストを変換する
+// 出来事も解説の依頼も指定されていない場合は空の入力になり、ユースケースでエラーになる
+func ToCoachInput(req *aiv1.CoachRequest) usecase.CoachInput {
+	if explain := req.GetExplain(); explain != nil {
+		return usecase.CoachInput{Explain: &usecase.CoachExplainInput{Question: explain.GetQuestion()}}
+	}
+	if req.GetEvent() == nil {
+		return usecase.CoachInput{}
+	}
+	return usecase.CoachInput{Event: ToCoachEvent(req.GetEvent())}
+}
+
+// ToCoachEvent は対局の出来事を変換する（種類が未指定の場合は Kind が 0 になる）
+func ToCoachEvent(event *aiv1.CoachEvent) *usecase.CoachEvent {
+	switch e := event.GetEvent().(type) {
+	case *aiv1.CoachEvent_GameStart:
+		return &usecase.CoachEvent{
+			Kind:        usecase.CoachGameStart,
+			Self:        int(e.GameStart.GetSelf()),
+			PlayerNames: e.GameStart.GetPlayerNames(),
+			Explain:     e.GameStart.GetExplain(),
+			Persona:     e.GameStart.GetPersona(),
+		}
+	case *aiv1.CoachEvent_RoundStart:
+		scores := make([]int, 0, len(e.RoundStart.GetScores()))
+		for _, s := range e.RoundStart.GetScores() {
+			scores = append(scores, int(s))
+		}
+		return &usecase.CoachEvent{
+			Kind:         usecase.CoachRoundStart,
+			RoundWind:    ToWind(e.RoundStart.GetRoundWind(), mahjong.East),
+			Kyoku:        int(e.RoundStart.GetKyoku()),
+			Honba:        int(e.RoundStart.GetHonba()),
+			RiichiSticks: int(e.RoundStart.GetRiichiSticks()),
+			Scores:       scores,
+			Tiles:        e.RoundStart.GetDoraIndicator(),
+			Hand:         e.RoundStart.GetHand(),
+		}
+	case *aiv1.CoachEvent_Draw:
+		return &usecase.CoachEvent{Kind: usecase.CoachDraw, Player: int(e.Draw.GetPlayer()), Tiles: e.Draw.GetTile()}
+	case *aiv1.CoachEvent_Discard:
+		return &usecase.CoachEvent{
+			Kind:      usecase.CoachDiscard,
+			Player:    int(e.Discard.GetPlayer()),
+			Tiles:     e.Discard.GetTile(),
+			Tsumogiri: e.Discard.GetTsumogiri(),
+		}
+	case *aiv1.CoachEvent_Call:
+		return &usecase.CoachEvent{
+			Kind:     usecase.CoachCall,
+			Player:   int(e.Call.GetPlayer()),
+			MeldType: ToMeldType(e.Call.GetType()),
+			Tiles:    e.Call.GetTiles(),
+		}
+	case *aiv1.CoachEvent_Riichi:
+		return &usecase.CoachEvent{Kind: usecase.CoachRiichi, Player: int(e.Riichi.GetPlayer())}
+	case *aiv1.CoachEvent_Dora:
+		return &usecase.CoachEvent{Kind: usecase.CoachDora, Tiles: e.Dora.GetIndicator()}
+	case *aiv1.CoachEvent_RoundEnd:
+		return &usecase.CoachEvent{Kind: usecase.CoachRoundEnd}
+	default:
+		return &usecase.CoachEvent{}
+	}
+}
+
+// FromCoachOutput はコーチングの出力を変換する（Err はハンドラーでエラー情報にする）
+func FromCoachOutput(output *usecase.CoachOutput) *aiv1.CoachResponse {
+	switch {
+	case output.Advice != nil:
+		advice := output.Advice
+		return &aiv1.CoachResponse{Payload: &aiv1.CoachResponse_Advice{Advice: &aiv1.CoachAdvice{
+			Action: FromCoachAction(advice.Action),
+			Tile:   advice.Tile.String(),
+			Reason: advice.Reason,
+			State:  FromGameState(advice.State),
+		}}}
+	case output.Warning != nil:
+		warning := output.Warning
+		return &aiv1.CoachResponse{Payload: &aiv1.CoachResponse_Warning{Warning: &aiv1.CoachWarning{
+			Kind:    FromCoachWarningKind(warning.Kind),
+			Player:  int32(warning.Player),
+			Message: warning.Message,
+		}}}
+	case output.Explanation != nil:
+		explanation := output.Explanation
+		return &aiv1.CoachResponse{Payload: &aiv1.CoachResponse_Explanation{Explanation: &aiv1.CoachExplanation{
+			ExplanationId: explanation.ID,
+			TextChunk:     explanation.Text,
+			Done:          explanation.Done,
+			Cancelled:     explanation.Cancelled,
+			Error:         explanation.Error,
+		}}}
+	default:
+		return &aiv1.CoachResponse{}
+	}
+}
+
+// FromCoachAction は推奨する行動を変換する
+func FromCoachAction(action usecase.CoachAction) aiv1.CoachAction {
+	switch action {
+	case usecase.CoachActionDiscard:
+		return aiv1.CoachAction_COACH_ACTION_DISCARD
+	case usecase.CoachActionRiichi:
+		return aiv1.CoachAction_COACH_ACTION_RIICHI
+	case usecase.CoachActionTsumo:
+		return aiv1.CoachAction_COACH_ACTION_TSUMO
+	case usecase.CoachActionRon:
+		return aiv1.CoachAction_COACH_ACTION_RON
+	default:
+		return aiv1.CoachAction_COACH_ACTION_UNSPECIFIED
+	}
+}
+
+// FromCoachWarningKind は警告の種類を変換する
+func FromCoachWarningKind(kind usecase.CoachWarningKind) aiv1.CoachWarningKind {
+	switch kind {
+	case usecase.CoachWarningOpponentTenpai:
+		return aiv1.CoachWarningKind_COACH_WARNING_KIND_OPPONENT_TENPAI
+	case usecase.CoachWarningFuriten:
+		return aiv1.CoachWarningKind_COACH_WARNING_KIND_FURITEN
+	case usecase.CoachWarningNoYaku:
+		return aiv1.CoachWarningKind_COACH_WARNING_KIND_NO_YAKU
+	default:
+		return aiv1.CoachWarningKind_COACH_WARNING_KIND_UNSPECIFIED
+	}
+}
@@ -0,0 +1,255 @@
+package protoconv
+
+import (
+	"math"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// ToGameState は局面を変換する（未指定の場合は nil）
+// 場風が未指定の場合は東場、自風が未指定のプレイヤーや質問者は -1 として扱う
+func ToGameState(state *aiv1.GameState) *usecase.GameStateInput {
+	if state == nil {
+		return nil
+	}
+	input := &usecase.GameStateInput{
+		RoundWind:      ToWind(state.GetRoundWind(), mahjong.East),
+		Kyoku:          int(state.GetKyoku()),
+		Honba:          int(state.GetHonba()),
+		RiichiSticks:   int(state.GetRiichiSticks()),
+		DoraIndicators: state.GetDoraIndicators(),
+		Self:           ToWind(state.GetSelfSeat(), -1),
+		WallRemaining:  int(state.GetWallRemaining()),
+	}
+	for _, p := range state.GetPlayers() {
+		player := usecase.PlayerStateInput{
+			HandInput: usecase.HandInput{Hand: p.GetHand(), Melds: ToMelds(p.GetMelds())},
+			Seat:      ToWind(p.GetSeat(), -1),
+			Name:      p.GetName(),
+			Score:     int(p.GetScore()),
+		}
+		for _, d := range p.GetDiscards() {
+			player.Discards = append(player.Discards, usecase.DiscardedTileInput{
+				Tile:      d.GetTile(),
+				Tsumogiri: d.GetTsumogiri(),
+				Called:    d.GetCalled(),
+				Riichi:    d.GetRiichi(),
+			})
+		}
+		input.Players = append(input.Players, player)
+	}
+	return input
+}
+
+// FromGameState は局面を変換する
+// 手牌・副露の赤ドラは区別されない（Render の書式では枚数として含まれる）
+func FromGameState(state *mahjong.GameState) *aiv1.GameState {
+	result := &aiv1.GameState{
+		RoundWind:      FromWind(state.RoundWind),
+		Kyoku:          int32(state.Kyoku),
+		Honba:          int32(state.Honba),
+		RiichiSticks:   int32(state.RiichiSticks),
+		DoraIndicators: mahjong.FormatTiles(state.DoraIndicators),
+		SelfSeat:       FromWind(state.Self),
+		WallRemaining:  int32(state.WallRemaining),
+	}
+	for _, p := range state.Players {
+		player := &aiv1.PlayerState{
+			Seat:  FromWind(p.Seat),
+			Name:  p.Name,
+			Score: int32(p.Score),
+			Hand:  mahjong.FormatTiles(p.Hand),
+		}
+		for _, m := range p.Melds {
+			player.Melds = append(player.Melds, &aiv1.Meld{Type: FromMeldType(m.Type), Tiles: mahjong.FormatTiles(m.Tiles)})
+		}
+		for _, d := range p.Discards {
+			player.Discards = append(player.Discards, &aiv1.DiscardedTile{
+				Tile:      d.Tile.String(),
+				Tsumogiri: d.Tsumogiri,
+				Called:    d.Called,
+				Riichi:    d.Riichi,
+			})
+		}
+		result.Players = append(result.Players, player)
+	}
+	return result
+}
+
+// FromMeldType は副露の種類を変換する
+func FromMeldType(meldType mahjong.MeldType) aiv1.MeldType {
+	switch meldType {
+	case mahjong.MeldChi:
+		return aiv1.MeldType_MELD_TYPE_CHI
+	case mahjong.MeldPon:
+		return aiv1.MeldType_MELD_TYPE_PON
+	case mahjong.MeldMinkan:
+		return aiv1.MeldType_MELD_TYPE_MINKAN
+	case mahjong.MeldAnkan:
+		return aiv1.MeldType_MELD_TYPE_ANKAN
+	case mahjong.MeldKakan:
+		return aiv1.MeldType_MELD_TYPE_KAKAN
+	default:
+		return aiv1.MeldType_MELD_TYPE_UNSPECIFIED
+	}
+}
+
+// ToImportGameLogInput は牌譜の取り込みのリクエストを変換する
+func ToImportGameLogInput(req *aiv1.ImportGameLogRequest) usecase.ImportGameLogInput {
+	format := usecase.GameLogFormatAuto
+	switch req.GetFormat() {
+	case aiv1.GameLogFormat_GAME_LOG_FORMAT_TENHOU_JSON:
+		format = usecase.GameLogFormatTenhouJSON
+	case aiv1.GameLogFormat_GAME_LOG_FORMAT_MJLOG:
+		format = usecase.GameLogFormatMjlog
+	}
+	return usecase.ImportGameLogInput{Format: format, Data: []byte(req.GetData())}
+}
+
+// ToGameLogPosition は牌譜の局面の位置を変換する（未指定の場合は nil）
+func ToGameLogPosition(position *aiv1.GameLogPosition) *usecase.GameLogPosition {
+	if position == nil {
+		return nil
+	}
+	return &usecase.GameLogPosition{
+		GameID: position.GetGameId(),
+		Round:  int(position.GetRound()),
+		Step:   int(position.GetStep()),
+	}
+}
+
+// FromGameLog は取り込んだ牌譜を変換する（各打牌の局面は含めない）
+func FromGameLog(game *gamelog.Game) *aiv1.GameLog {
+	result := &aiv1.GameLog{
+		GameId:  game.ID,
+		Title:   game.Title,
+		Players: game.Players,
+		Sanma:   game.Sanma,
+		RuleSet: game.RuleSet().Name,
+	}
+	for i := range game.Rounds {
+		r := &game.Rounds[i]
+		round := &aiv1.GameLogRound{
+			Index:        int32(i),
+			Name:         r.Name(),
+			RoundWind:    FromWind(r.RoundWind),
+			Kyoku:        int32(r.Kyoku),
+			Honba:        int32(r.Honba),
+			RiichiSticks: int32(r.RiichiSticks),
+			Steps:        int32(len(r.Steps)),
+			Result:       r.Result,
+		}
+		for _, score := range r.Scores {
+			round.Scores = append(round.Scores, int32(score))
+		}
+		result.Rounds = append(result.Rounds, round)
+	}
+	return result
+}
+
+// FromGameLogStep は牌譜の打牌の判断を変換する
+func FromGameLogStep(output *usecase.GameLogStepOutput) *aiv1.GameLogStep {
+	step := output.Step
+	return &aiv1.GameLogStep{
+		Position: &aiv1.GameLogPosition{
+			GameId: output.Game.ID,
+			Round:  int32(output.Round),
+			Step:   int32(output.Index),
+		},
+		Player:    int32(step.Player),
+		Seat:      FromWind(step.State.Self),
+		Turn:      int32(step.Turn),
+		State:     FromGameState(step.State),
+		Discard:   step.Discard.String(),
+		Tsumogiri: step.Tsumogiri,
+		Riichi:    step.Riichi,
+	}
+}
+
+// ToReviewGameInput は牌譜の検討のリクエストを変換する
+func ToReviewGameInput(req *aiv1.ReviewGameRequest) usecase.ReviewGameInput {
+	return usecase.ReviewGameInput{
+		GameID:  req.GetGameId(),
+		Player:  int(req.GetPlayer()),
+		TopN:    int(req.GetTopN()),
+		Persona: req.GetPersona(),
+	}
+}
+
+// FromGameReview は牌譜の検討結果を変換する
+func FromGameReview(review *gamelog.Review) *aiv1.GameReview {
+	result := &aiv1.GameReview{
+		ReviewId:   review.ID,
+		GameId:     review.GameID,
+		Player:     int32(review.Player),
+		PlayerName: review.PlayerName,
+		Status:     FromReviewStatus(review.Status),
+		Error:      review.Error,
+		Evaluated:  int32(review.Evaluated),
+		Total:      int32(review.Total),
+	}
+	for _, m := range review.Mistakes {
+		result.Mistakes = append(result.Mistakes, &aiv1.ReviewMistake{
+			Position: &aiv1.GameLogPosition{
+				GameId: review.GameID,
+				Round:  int32(m.Round),
+				Step:   int32(m.Step),
+			},
+			RoundName:   m.RoundName,
+			Turn:        int32(m.Turn),
+			Kind:        FromDecisionKind(m.Kind),
+			Severity:    FromSeverity(m.Severity),
+			Actual:      m.Actual.String(),
+			Recommended: m.Recommended.String(),
+			Loss:        int32(math.Round(m.Loss)),
+			Evaluation:  m.Evaluation,
+			Commentary:  m.Commentary,
+		})
+	}
+	return result
+}
+
+// FromReviewStatus は検討の進み具合を変換する
+func FromReviewStatus(status gamelog.ReviewStatus) aiv1.ReviewStatus {
+	switch status {
+	case gamelog.ReviewPending:
+		return aiv1.ReviewStatus_REVIEW_STATUS_PENDING
+	case gamelog.ReviewRunning:
+		return aiv1.ReviewStatus_REVIEW_STATUS_RUNNING
+	case gamelog.ReviewCompleted:
+		return aiv1.ReviewStatus_REVIEW_STATUS_COMPLETED
+	case gamelog.ReviewFailed:
+		return aiv1.ReviewStatus_REVIEW_STATUS_FAILED
+	default:
+		return aiv1.ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+// FromDecisionKind は打牌の判断を評価した観点を変換する
+func FromDecisionKind(kind gamelog.DecisionKind) aiv1.DecisionKind {
+	switch kind {
+	case gamelog.DecisionEfficiency:
+		return aiv1.DecisionKind_DECISION_KIND_EFFICIENCY
+	case gamelog.DecisionPushFold:
+		return aiv1.DecisionKind_DECISION_KIND_PUSH_FOLD
+	default:
+		return aiv1.DecisionKind_DECISION_KIND_UNSPECIFIED
+	}
+}
+
+// FromSeverity は悪手の重大度を変換する
+func FromSeverity(severity gamelog.Severity) aiv1.MistakeSeverity {
+	switch severity {
+	case gamelog.SeverityMinor:
+		return aiv1.MistakeSeverity_MISTAKE_SEVERITY_MINOR
+	case gamelog.SeverityModerate:
+		return aiv1.MistakeSeverity_MISTAKE_SEVERITY_MODERATE
+	case gamelog.SeveritySevere:
+		return aiv1.MistakeSeverity_MISTAKE_SEVERITY_SEVERE
+	default:
+		return aiv1.MistakeSeverity_MISTAKE_SEVERITY_UNSPECIFIED
+	}
+}
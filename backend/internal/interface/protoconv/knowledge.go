@@ -0,0 +1,37 @@
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// FromCitations は回答の根拠としてAIに渡したルールの資料の抜粋を変換する
+func FromCitations(citations []entity.Citation) []*aiv1.Citation {
+	if len(citations) == 0 {
+		return nil
+	}
+	infos := make([]*aiv1.Citation, 0, len(citations))
+	for _, c := range citations {
+		infos = append(infos, &aiv1.Citation{
+			Document: c.Document,
+			Title:    c.Title,
+			Section:  c.Section,
+			Snippet:  c.Snippet,
+			Score:    c.Score,
+			Cited:    c.Cited,
+		})
+	}
+	return infos
+}
+
+// FromKnowledgeIndex はルールの資料の索引の作り直しの結果を変換する
+func FromKnowledgeIndex(output usecase.KnowledgeIndexOutput) *aiv1.KnowledgeIndexInfo {
+	return &aiv1.KnowledgeIndexInfo{
+		Passages:   int32(output.Passages),
+		Embedded:   int32(output.Embedded),
+		Reused:     int32(output.Reused),
+		Model:      output.Model,
+		DurationMs: output.Duration.Milliseconds(),
+	}
+}
@@ -0,0 +1,82 @@
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/quiz"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// ToGetQuizInput は何切る問題の取得のリクエストを変換する
+func ToGetQuizInput(req *aiv1.GetQuizRequest) usecase.GetQuizInput {
+	return usecase.GetQuizInput{
+		QuizID:  req.GetQuizId(),
+		Daily:   req.GetDaily(),
+		RuleSet: req.GetRuleSet(),
+		UserID:  req.GetUserId(),
+	}
+}
+
+// ToSubmitQuizAnswerInput は何切る問題の回答のリクエストを変換する
+func ToSubmitQuizAnswerInput(req *aiv1.SubmitQuizAnswerRequest) usecase.SubmitQuizAnswerInput {
+	return usecase.SubmitQuizAnswerInput{
+		QuizID:  req.GetQuizId(),
+		UserID:  req.GetUserId(),
+		Discard: req.GetDiscard(),
+		Persona: req.GetPersona(),
+	}
+}
+
+// FromQuiz は何切る問題を変換する（正解は含めない）
+func FromQuiz(q *quiz.Quiz) *aiv1.Quiz {
+	return &aiv1.Quiz{
+		QuizId:    q.ID,
+		GameState: FromGameState(q.State),
+		Hand:      q.Hand,
+		Turn:      int32(q.Turn),
+		RuleSet:   q.RuleSet,
+		Date:      q.Date,
+	}
+}
+
+// FromQuizGrade は何切る問題の採点結果を変換する
+func FromQuizGrade(output *usecase.SubmitQuizAnswerOutput) *aiv1.QuizGrade {
+	grade := &aiv1.QuizGrade{
+		QuizId:      output.Quiz.ID,
+		Discard:     output.Grade.Discard.Tile.String(),
+		Correct:     output.Grade.Correct,
+		Score:       int32(output.Grade.Score),
+		BestDiscard: output.Quiz.Best().Tile.String(),
+		Accepted:    FromTiles(output.Quiz.Accepted),
+		Evaluation:  output.Grade.Evaluation,
+		Explanation: output.Explanation,
+		Recorded:    output.Recorded,
+	}
+	for _, c := range output.Quiz.Candidates {
+		grade.Candidates = append(grade.Candidates, FromDiscardCandidate(c))
+	}
+	return grade
+}
+
+// FromQuizStats はユーザーの成績を変換する（nil の場合は nil）
+func FromQuizStats(stats *quiz.Stats) *aiv1.QuizStats {
+	if stats == nil {
+		return nil
+	}
+	result := &aiv1.QuizStats{
+		UserId:       stats.UserID,
+		Attempts:     int32(stats.Attempts),
+		Correct:      int32(stats.Correct),
+		Accuracy:     float32(stats.Accuracy),
+		AverageScore: float32(stats.AverageScore),
+		Streak:       int32(stats.Streak),
+	}
+	for _, d := range stats.Daily {
+		result.Daily = append(result.Daily, &aiv1.QuizDailyStats{
+			Date:         d.Date,
+			Attempts:     int32(d.Attempts),
+			Correct:      int32(d.Correct),
+			AverageScore: float32(d.AverageScore),
+		})
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// ToRenderInput は図の作成のリクエストを変換する
+func ToRenderInput(req *aiv1.RenderHandRequest) usecase.RenderInput {
+	input := usecase.RenderInput{
+		HandInput:       usecase.HandInput{Hand: req.GetHand(), Melds: ToMelds(req.GetMelds())},
+		Drawn:           req.GetDrawn(),
+		GameState:       ToGameState(req.GetGameState()),
+		GameLogPosition: ToGameLogPosition(req.GetGameLogPosition()),
+		RuleSet:         req.GetRuleSet(),
+		Format:          ToDiagramFormat(req.GetFormat()),
+		Scale:           float64(req.GetScale()),
+	}
+	for _, d := range req.GetDiscards() {
+		input.Discards = append(input.Discards, usecase.DiscardedTileInput{
+			Tile:      d.GetTile(),
+			Tsumogiri: d.GetTsumogiri(),
+			Called:    d.GetCalled(),
+			Riichi:    d.GetRiichi(),
+		})
+	}
+	return input
+}
+
+// ToDiagramFormat は図の形式を変換する（未指定の場合は SVG）
+func ToDiagramFormat(format aiv1.DiagramFormat) usecase.DiagramFormat {
+	if format == aiv1.DiagramFormat_DIAGRAM_FORMAT_PNG {
+		return usecase.DiagramFormatPNG
+	}
+	return usecase.DiagramFormatSVG
+}
+
+// FromDiagram は作成した図を変換する
+func FromDiagram(output *usecase.RenderOutput) *aiv1.Diagram {
+	return &aiv1.Diagram{
+		Data:     output.Data,
+		MimeType: output.MIMEType,
+		Width:    int32(output.Width),
+		Height:   int32(output.Height),
+	}
+}
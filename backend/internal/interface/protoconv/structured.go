@@ -0,0 +1,18 @@
+package protoconv
+
+import (
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// FromStructured は回答のJSONを解析した値を変換する（形式を指定していない場合は nil）
+// 値はJSONを解析したものであり、変換に失敗することはないため、失敗した場合も nil とする
+func FromStructured(structured map[string]any) *structpb.Struct {
+	if structured == nil {
+		return nil
+	}
+	s, err := structpb.NewStruct(structured)
+	if err != nil {
+		return nil
+	}
+	return s
+}
@@ -0,0 +1,31 @@
+package protoconv
+
+import (
+	"encoding/json"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// FromToolCalls はAIによるツールの呼び出しを変換する
+func FromToolCalls(calls []entity.ToolCall) []*aiv1.ToolCallInfo {
+	result := make([]*aiv1.ToolCallInfo, 0, len(calls))
+	for _, call := range calls {
+		result = append(result, FromToolCall(call))
+	}
+	return result
+}
+
+// FromToolCall はAIによるツールの呼び出しを引数・結果をJSONにして変換する
+func FromToolCall(call entity.ToolCall) *aiv1.ToolCallInfo {
+	info := &aiv1.ToolCallInfo{Name: call.Name, Error: call.Error}
+	if args, err := json.Marshal(call.Arguments); err == nil {
+		info.Arguments = string(args)
+	}
+	if call.Result != nil {
+		if result, err := json.Marshal(call.Result); err == nil {
+			info.Result = string(result)
+		}
+	}
+	return info
+}
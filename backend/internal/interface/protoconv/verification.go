@@ -0,0 +1,43 @@
+package protoconv
+
+import (
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
+)
+
+// FromAnswerVerification は回答の検証結果を変換する（検証していない場合は nil）
+func FromAnswerVerification(v *entity.AnswerVerification) *aiv1.AnswerVerification {
+	if v == nil {
+		return nil
+	}
+	info := &aiv1.AnswerVerification{
+		Regenerated: v.Regenerated,
+		Annotated:   v.Annotated,
+		Confidence:  v.Confidence,
+	}
+	for _, c := range v.Checks {
+		info.Checks = append(info.Checks, &aiv1.ClaimCheckInfo{
+			Kind:       c.Kind,
+			Claim:      c.Claim,
+			Correct:    c.Correct,
+			Correction: c.Correction,
+		})
+	}
+	return info
+}
+
+// FromSelfConsistency は複数の回答の結論を比べた結果を変換する（比べていない場合は nil）
+func FromSelfConsistency(c *entity.SelfConsistency) *aiv1.SelfConsistency {
+	if c == nil {
+		return nil
+	}
+	info := &aiv1.SelfConsistency{
+		Samples:    c.Samples,
+		Conclusion: c.Conclusion,
+		Agreement:  c.Agreement,
+	}
+	for _, v := range c.Votes {
+		info.Votes = append(info.Votes, &aiv1.ConclusionVote{Conclusion: v.Conclusion, Count: v.Count})
+	}
+	return info
+}
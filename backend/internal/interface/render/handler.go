@@ -0,0 +1,176 @@
+package renderhandler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
+	"github.com/sirupsen/logrus"
+)
+
+// Path は図を返すエンドポイントのパス（/render/hand.{svg,png} と /render/board.{svg,png}）
+const Path = "/render/"
+
+// NewHandler は図を画像として返すHTTPハンドラを作成する
+// チャットの回答やエクスポートから <img src> で直接参照できるよう、GET のクエリで指定する
+//
+//	/render/hand.svg?hand=123m456p&drawn=7s&melds=pon:555z,chi:406m&discards=1z,9m',5p!&scale=2
+//	/render/board.png?game_id=...&round=0&step=10
+//
+// 河は牌をカンマ区切りで並べ、' はツモ切り、^ は他家に鳴かれた牌、! は立直宣言牌を表す
+func NewHandler(renderUsecase *usecase.RenderUsecase, logger *logrus.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		file := strings.TrimPrefix(r.URL.Path, Path)
+		ext := path.Ext(file)
+		var format usecase.DiagramFormat
+		switch ext {
+		case ".svg":
+			format = usecase.DiagramFormatSVG
+		case ".png":
+			format = usecase.DiagramFormatPNG
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		var (
+			input usecase.RenderInput
+			err   error
+		)
+		switch strings.TrimSuffix(file, ext) {
+		case "hand":
+			input, err = handInput(r)
+		case "board":
+			input, err = boardInput(r)
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		if err == nil {
+			input.Format = format
+			input.RuleSet = r.URL.Query().Get("rule_set")
+			input.Scale, err = floatParam(r, "scale")
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		output, err := renderUsecase.RenderHand(r.Context(), input)
+		if err != nil {
+			logger.WithError(err).WithField("path", r.URL.Path).Warn("Failed to render diagram")
+			http.Error(w, err.Error(), statusCode(err))
+			return
+		}
+		w.Header().Set("Content-Type", output.MIMEType)
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		w.Header().Set("Content-Length", strconv.Itoa(len(output.Data)))
+		_, _ = w.Write(output.Data)
+	})
+}
+
+// handInput はクエリから手牌と河の入力を作る
+func handInput(r *http.Request) (usecase.RenderInput, error) {
+	q := r.URL.Query()
+	input := usecase.RenderInput{
+		HandInput: usecase.HandInput{Hand: q.Get("hand")},
+		Drawn:     q.Get("drawn"),
+	}
+	for _, m := range splitList(q.Get("melds")) {
+		name, tiles, ok := strings.Cut(m, ":")
+		meldType, known := mahjong.ParseMeldType(name)
+		if !ok || !known {
+			return usecase.RenderInput{}, fmt.Errorf("meld %q must be <chi|pon|minkan|ankan|kakan>:<tiles>", m)
+		}
+		input.Melds = append(input.Melds, usecase.MeldInput{Type: meldType, Tiles: tiles})
+	}
+	for _, d := range splitList(q.Get("discards")) {
+		discard := usecase.DiscardedTileInput{Tile: strings.TrimRight(d, "'^!")}
+		marks := d[len(discard.Tile):]
+		discard.Tsumogiri = strings.Contains(marks, "'")
+		discard.Called = strings.Contains(marks, "^")
+		discard.Riichi = strings.Contains(marks, "!")
+		input.Discards = append(input.Discards, discard)
+	}
+	return input, nil
+}
+
+// boardInput はクエリから取り込んだ牌譜の局面の入力を作る
+func boardInput(r *http.Request) (usecase.RenderInput, error) {
+	q := r.URL.Query()
+	round, err := intParam(r, "round")
+	if err != nil {
+		return usecase.RenderInput{}, err
+	}
+	step, err := intParam(r, "step")
+	if err != nil {
+		return usecase.RenderInput{}, err
+	}
+	return usecase.RenderInput{
+		GameLogPosition: &usecase.GameLogPosition{GameID: q.Get("game_id"), Round: round, Step: step},
+	}, nil
+}
+
+// splitList はカンマ区切りの値を空の要素を除いて分ける
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// intParam は整数のクエリを読む（未指定の場合は0）
+func intParam(r *http.Request, name string) (int, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer: %q", name, v)
+	}
+	return n, nil
+}
+
+// floatParam は数値のクエリを読む（未指定の場合は0）
+func floatParam(r *http.Request, name string) (float64, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number: %q", name, v)
+	}
+	return f, nil
+}
+
+// statusCode は図の作成のエラーをHTTPのステータスコードにする
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, entity.ErrInvalidRequest),
+		errors.Is(err, entity.ErrUnknownRuleSet),
+		errors.Is(err, entity.ErrInvalidTileNotation),
+		errors.Is(err, entity.ErrInvalidHand),
+		errors.Is(err, entity.ErrInvalidGameState):
+		return http.StatusBadRequest
+	case errors.Is(err, entity.ErrGameLogNotFound):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -2,85 +2,354 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
 
 	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
 	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
 	"github.com/sirupsen/logrus"
 )
 
+// AskInput は麻雀AIへの質問内容
+type AskInput struct {
+	Prompt      string
+	MaxTokens   int32
+	Temperature float32
+	Context     []string
+
+	// Persona はペルソナ名（空の場合はデフォルトのペルソナ）
+	Persona string
+	// RuleSet はルールセット名（空の場合は会話で指定済みのもの、なければデフォルト）
+	RuleSet string
+	// ConversationID は会話ID（空の場合は会話をまたいだ設定の引き継ぎを行わない）
+	ConversationID string
+	// Variables はペルソナのテンプレートに埋め込む変数（RuleSet は決定したルールセットで上書きされる）
+	Variables entity.PersonaVariables
+	// GameState は局面（nil の場合は局面なし）。決まった書式でコンテキストに加え、エンジンで解析する
+	GameState *GameStateInput
+	// GameLogPosition は取り込んだ牌譜の局面（nil の場合は牌譜を参照しない）。GameState とは同時に指定できない
+	GameLogPosition *GameLogPosition
+	// Samples は自己一貫性のために生成して比べる回答の数（0・1 は比べない、ストリーミングでは指定できない）
+	Samples int32
+	// ResponseSchema は回答をJSONで出力させる形式の名前（空の場合は自由な文章、ストリーミングでは指定できない）
+	ResponseSchema string
+	// Attachments は質問に添付する画像
+	Attachments []entity.Attachment
+	// TranscribeHand は回答の前に画像の手牌をMPSZ表記に書き起こし、検証してから質問に添えるか（Attachments が必要）
+	TranscribeHand bool
+
+	// state は同じパッケージのユースケースが再構成した局面（GameState・GameLogPosition とは同時に指定できない）
+	state *mahjong.GameState
+}
+
+// PromptSettings はシステムプロンプトの組み立てに使う設定
+type PromptSettings struct {
+	Personas       map[string]*entity.Persona
+	DefaultPersona string
+	DefaultRuleSet mahjong.RuleSet
+	// Verification は回答の検証で誤りを見つけた場合の扱い（空の場合は生成し直す）
+	Verification entity.VerificationMode
+	// KnowledgeTopK は質問ごとにAIに渡すルールの資料の抜粋の最大数（0 の場合は資料を検索しない）
+	KnowledgeTopK int
+}
+
 // AIUsecase は麻雀AIに関するビジネスロジックを管理する
 type AIUsecase struct {
-	aiRepo repository.AIRepository
-	logger *logrus.Logger
+	aiRepo           repository.AIRepository
+	conversationRepo repository.ConversationRepository
+	gameLogRepo      repository.GameLogRepository
+	logger           *logrus.Logger
+
+	mu       sync.RWMutex
+	settings PromptSettings
+	tools    []*entity.Tool
+	// knowledgeBase はルールの資料の検索（nil の場合は資料を検索しない）
+	knowledgeBase *KnowledgeUsecase
+	// attachmentLimits は質問に添付できるファイルの制限
+	attachmentLimits AttachmentLimits
 }
 
 // NewAIUsecase は新しいAIUsecaseを作成する
-func NewAIUsecase(aiRepo repository.AIRepository, logger *logrus.Logger) *AIUsecase {
+func NewAIUsecase(aiRepo repository.AIRepository, conversationRepo repository.ConversationRepository, gameLogRepo repository.GameLogRepository, settings PromptSettings, logger *logrus.Logger) *AIUsecase {
 	return &AIUsecase{
-		aiRepo: aiRepo,
-		logger: logger,
+		aiRepo:           aiRepo,
+		conversationRepo: conversationRepo,
+		gameLogRepo:      gameLogRepo,
+		logger:           logger,
+		settings:         settings,
+	}
+}
+
+// SetPromptSettings はペルソナとデフォルトのルールセットを差し替える
+func (u *AIUsecase) SetPromptSettings(settings PromptSettings) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.settings = settings
+}
+
+// RegisterTools はAIが回答の途中で呼び出せるツールを登録する
+func (u *AIUsecase) RegisterTools(tools ...*entity.Tool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.tools = append(u.tools, tools...)
+}
+
+// toolInstruction はツールを登録している場合にシステムプロンプトへ付け加える指示
+const toolInstruction = "牌効率や打牌の判断を答えるときは、利用できるツールで計算した結果を根拠として数値を引用し、ツールを使わずに受け入れ枚数や和了率を推測しないこと。"
+
+// systemPrompt はペルソナのテンプレートを展開し、ルールセットの説明を付け加えたシステムプロンプトを返す
+func (u *AIUsecase) systemPrompt(name string, vars entity.PersonaVariables, rules mahjong.RuleSet) (string, error) {
+	u.mu.RLock()
+	if name == "" {
+		name = u.settings.DefaultPersona
+	}
+	persona, ok := u.settings.Personas[name]
+	u.mu.RUnlock()
+
+	if !ok {
+		return "", fmt.Errorf("%w: %q", entity.ErrUnknownPersona, name)
+	}
+	if err := vars.Validate(); err != nil {
+		return "", err
 	}
+	vars.RuleSet = rules.DisplayName
+	prompt, err := persona.Render(vars)
+	if err != nil {
+		return "", err
+	}
+	prompt += "\n\n" + rules.Describe()
+
+	u.mu.RLock()
+	hasTools := len(u.tools) > 0
+	u.mu.RUnlock()
+	if hasTools {
+		prompt += "\n\n" + toolInstruction
+	}
+	return prompt, nil
+}
+
+// resolveRuleSet はリクエスト・会話・デフォルトの順にルールセットを決定する
+// リクエストで指定されたルールセットは会話に保存され、以降のリクエストに引き継がれる
+func (u *AIUsecase) resolveRuleSet(ctx context.Context, input AskInput) (mahjong.RuleSet, error) {
+	if input.RuleSet != "" {
+		rules, ok := mahjong.LookupRuleSet(input.RuleSet)
+		if !ok {
+			return mahjong.RuleSet{}, fmt.Errorf("%w: %q (use one of %s)", entity.ErrUnknownRuleSet, input.RuleSet, strings.Join(mahjong.RuleSetNames(), ", "))
+		}
+		if input.ConversationID != "" {
+			conversation := entity.NewConversation(input.ConversationID)
+			conversation.RuleSet = rules.Name
+			if err := u.conversationRepo.Save(ctx, conversation); err != nil {
+				return mahjong.RuleSet{}, err
+			}
+		}
+		return rules, nil
+	}
+
+	if input.ConversationID != "" {
+		conversation, err := u.conversationRepo.Get(ctx, input.ConversationID)
+		if err != nil {
+			return mahjong.RuleSet{}, err
+		}
+		if conversation != nil {
+			if rules, ok := mahjong.LookupRuleSet(conversation.RuleSet); ok {
+				return rules, nil
+			}
+		}
+	}
+
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.settings.DefaultRuleSet, nil
+}
+
+// buildRequest は入力からリクエストエンティティを作成して検証する
+// 回答の検証に使う局面の手牌とルールもあわせて返す
+func (u *AIUsecase) buildRequest(ctx context.Context, input AskInput) (*entity.AIRequest, answerFacts, error) {
+	var request *entity.AIRequest
+	if input.MaxTokens > 0 || input.Temperature > 0 || len(input.Context) > 0 {
+		request = entity.NewAIRequestWithOptions(input.Prompt, input.MaxTokens, input.Temperature, input.Context)
+	} else {
+		request = entity.NewAIRequest(input.Prompt)
+	}
+
+	var (
+		state *mahjong.GameState
+		rules mahjong.RuleSet
+		err   error
+	)
+	if input.GameLogPosition != nil {
+		if input.GameState != nil {
+			return nil, answerFacts{}, fmt.Errorf("%w: game_state and game_log_position cannot be combined", entity.ErrInvalidRequest)
+		}
+		game, step, err := loadGameLogStep(ctx, u.gameLogRepo, input.GameLogPosition)
+		if err != nil {
+			return nil, answerFacts{}, err
+		}
+		state = step.State
+		// ルールセットの指定がない場合は会話の設定ではなく牌譜の対局のルールに合わせる
+		if input.RuleSet == "" {
+			rules = game.RuleSet()
+		}
+	}
+	if input.state != nil {
+		if input.GameState != nil || input.GameLogPosition != nil {
+			return nil, answerFacts{}, fmt.Errorf("%w: game state is already given", entity.ErrInvalidRequest)
+		}
+		state = input.state
+	}
+	if rules.Name == "" {
+		if rules, err = u.resolveRuleSet(ctx, input); err != nil {
+			return nil, answerFacts{}, err
+		}
+	}
+	systemPrompt, err := u.systemPrompt(input.Persona, input.Variables, rules)
+	if err != nil {
+		return nil, answerFacts{}, err
+	}
+	request.SystemPrompt = systemPrompt
+	if err := u.validateAttachments(input.Attachments); err != nil {
+		return nil, answerFacts{}, err
+	}
+	request.Attachments = input.Attachments
+
+	if input.GameState != nil {
+		if state, err = parseGameState(input.GameState, rules); err != nil {
+			return nil, answerFacts{}, err
+		}
+	}
+	if state != nil {
+		text, err := gameStateContext(state, rules)
+		if err != nil {
+			return nil, answerFacts{}, err
+		}
+		request.Context = append(append([]string{}, request.Context...), text)
+	}
+	// 画像の手牌を書き起こし、検証に通れば質問に添えて回答の検証にも使う
+	var facts answerFacts
+	if input.TranscribeHand {
+		if len(input.Attachments) == 0 {
+			return nil, answerFacts{}, fmt.Errorf("%w: transcribe_hand requires attachments", entity.ErrInvalidRequest)
+		}
+		var hand *mahjong.Hand
+		if facts.transcription, hand, facts.transcriptionTokens, err = u.transcribeHand(ctx, input.Attachments); err != nil {
+			return nil, answerFacts{}, err
+		}
+		if hand != nil {
+			request.Context = append(append([]string{}, request.Context...), transcriptionContext(hand))
+			facts.hand = hand
+		}
+	}
+	// 質問に関係するルールの資料の抜粋を根拠として渡す
+	passages := u.retrieve(ctx, input.Prompt)
+	if len(passages) > 0 {
+		request.Context = append(append([]string{}, request.Context...), knowledgeContext(passages))
+	}
+
+	u.mu.RLock()
+	request.Tools = u.tools
+	u.mu.RUnlock()
+	if request.ResponseSchema, err = resolveResponseSchema(input.ResponseSchema); err != nil {
+		return nil, answerFacts{}, err
+	}
+
+	// バリデーション
+	if err := request.Validate(); err != nil {
+		return nil, answerFacts{}, err
+	}
+
+	facts.rules, facts.passages = rules, passages
+	if state != nil {
+		// 手牌が成り立たない場合は局面の解析で検出済みのため、検証には使わないだけにする
+		// 局面に手牌がない場合は画像から書き起こした手牌で検証する
+		if hand, _ := state.SelfHand(); hand != nil {
+			facts.hand = hand
+		}
+	}
+	return request, facts, nil
 }
 
 // AskMahjongAI は麻雀AIにプロンプトを送信してレスポンスを取得する
-func (u *AIUsecase) AskMahjongAI(ctx context.Context, prompt string, maxTokens int32, temperature float32, context []string) (*entity.AIResponse, error) {
+func (u *AIUsecase) AskMahjongAI(ctx context.Context, input AskInput) (*entity.AIResponse, error) {
 	// ログ出力
 	u.logger.WithFields(logrus.Fields{
-		"prompt_length": len(prompt),
-		"max_tokens":    maxTokens,
-		"temperature":   temperature,
-		"context_count": len(context),
+		"prompt_length": len(input.Prompt),
+		"max_tokens":    input.MaxTokens,
+		"temperature":   input.Temperature,
+		"context_count": len(input.Context),
+		"persona":       input.Persona,
+		"rule_set":      input.RuleSet,
+		"game_state":    input.GameState != nil || input.state != nil,
+		"game_log":      input.GameLogPosition != nil,
+		"samples":       input.Samples,
+		"schema":        input.ResponseSchema,
+		"attachments":   len(input.Attachments),
+		"transcribe":    input.TranscribeHand,
 	}).Info("AI request received")
 
 	// リクエストエンティティを作成
-	var request *entity.AIRequest
-	if maxTokens > 0 || temperature > 0 || len(context) > 0 {
-		request = entity.NewAIRequestWithOptions(prompt, maxTokens, temperature, context)
-	} else {
-		request = entity.NewAIRequest(prompt)
+	if err := validateSamples(input.Samples); err != nil {
+		u.logger.WithError(err).Error("Request validation failed")
+		return nil, err
 	}
-	
-	// バリデーション
-	if err := request.Validate(); err != nil {
+	request, facts, err := u.buildRequest(ctx, input)
+	if err != nil {
 		u.logger.WithError(err).Error("Request validation failed")
 		return nil, err
 	}
 
-	// AIリポジトリを通してAIサービスにリクエストを送信
-	response, err := u.aiRepo.AskAI(ctx, request)
+	// AIリポジトリを通してAIサービスにリクエストを送信（複数の回答を生成する場合は結論の多数派を使う）
+	response, consistency, err := u.askSamples(ctx, request, input.Samples)
 	if err != nil {
 		u.logger.WithError(err).Error("Failed to get AI response")
 		return nil, err
 	}
+	// 回答の向聴数・待ち・点数をエンジンで検証する
+	response = u.verifyAnswer(ctx, request, response, facts)
+	applyConsistency(response, consistency)
+	response.Citations = citations(facts.passages, response.Response)
+	response.Transcription = facts.transcription
+	response.TokensUsed += facts.transcriptionTokens
 
 	u.logger.WithFields(logrus.Fields{
 		"response_length": len(response.Response),
 		"tokens_used":     response.TokensUsed,
 		"confidence":      response.Confidence,
+		"tool_calls":      len(response.ToolCalls),
+		"structured":      response.Structured != nil,
+		"citations":       len(response.Citations),
+		"transcribed":     response.Transcription != nil && response.Transcription.Valid,
 	}).Info("AI response received successfully")
 
 	return response, nil
 }
 
 // AskMahjongAIStream は麻雀AIにプロンプトを送信してストリーミングレスポンスを取得する
-func (u *AIUsecase) AskMahjongAIStream(ctx context.Context, prompt string, maxTokens int32, temperature float32, context []string) (<-chan *entity.AIResponse, <-chan error) {
+func (u *AIUsecase) AskMahjongAIStream(ctx context.Context, input AskInput) (<-chan *entity.AIResponse, <-chan error) {
 	u.logger.WithFields(logrus.Fields{
-		"prompt_length": len(prompt),
-		"max_tokens":    maxTokens,
-		"temperature":   temperature,
-		"context_count": len(context),
+		"prompt_length": len(input.Prompt),
+		"max_tokens":    input.MaxTokens,
+		"temperature":   input.Temperature,
+		"context_count": len(input.Context),
+		"persona":       input.Persona,
+		"rule_set":      input.RuleSet,
+		"game_state":    input.GameState != nil || input.state != nil,
+		"game_log":      input.GameLogPosition != nil,
+		"attachments":   len(input.Attachments),
+		"transcribe":    input.TranscribeHand,
 	}).Info("AI stream request received")
 
 	// リクエストエンティティを作成
-	var request *entity.AIRequest
-	if maxTokens > 0 || temperature > 0 || len(context) > 0 {
-		request = entity.NewAIRequestWithOptions(prompt, maxTokens, temperature, context)
-	} else {
-		request = entity.NewAIRequest(prompt)
+	request, facts, err := u.buildRequest(ctx, input)
+	if err == nil && (input.Samples < 0 || input.Samples > 1) {
+		err = fmt.Errorf("%w: samples cannot be used with streaming", entity.ErrInvalidRequest)
 	}
-
-	// バリデーション
-	if err := request.Validate(); err != nil {
+	if err == nil && request.ResponseSchema != nil {
+		err = fmt.Errorf("%w: response schema cannot be used with streaming", entity.ErrInvalidRequest)
+	}
+	if err != nil {
 		u.logger.WithError(err).Error("Stream request validation failed")
 		errChan := make(chan error, 1)
 		errChan <- err
@@ -88,20 +357,9 @@ func (u *AIUsecase) AskMahjongAIStream(ctx context.Context, prompt string, maxTo
 		return nil, errChan
 	}
 
-	// AIリポジトリを通してストリーミングリクエストを送信
-	return u.aiRepo.AskAIStream(ctx, request)
-}
-
-// HealthCheck はAIサービスの健康状態を確認する
-func (u *AIUsecase) HealthCheck(ctx context.Context) error {
-	u.logger.Info("Health check request received")
-	
-	err := u.aiRepo.HealthCheck(ctx)
-	if err != nil {
-		u.logger.WithError(err).Error("Health check failed")
-		return err
-	}
-	
-	u.logger.Info("Health check passed")
-	return nil
+	// AIリポジトリを通してストリーミングリクエストを送信し、最後に引用を送って回答を検証する
+	responseChan, errChan := u.aiRepo.AskAIStream(ctx, request)
+	responseChan, errChan = citeStream(ctx, responseChan, errChan, facts.passages)
+	responseChan, errChan = u.verifyStream(ctx, responseChan, errChan, facts)
+	return transcriptionStream(ctx, responseChan, errChan, facts.transcription)
 }
@@ -0,0 +1,315 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// maxToolCandidates はツールの結果に含める打牌候補の最大数
+const maxToolCandidates = 5
+
+// windNames はツールの引数で受け付ける風の名前
+var windNames = map[string]mahjong.Tile{
+	"east":  mahjong.East,
+	"south": mahjong.South,
+	"west":  mahjong.West,
+	"north": mahjong.North,
+}
+
+// Tools はAIが回答の根拠として呼び出せる麻雀エンジンのツールを返す
+func (u *AnalysisUsecase) Tools() []*entity.Tool {
+	windEnum := []string{"east", "south", "west", "north"}
+	return []*entity.Tool{
+		{
+			Name: "recommend_discard",
+			Description: "14枚の手牌（門前）の打牌候補を向聴数・受け入れ枚数・改良枚数・良形率・モンテカルロ法による和了率と打点期待値で評価し、推奨順に返す。" +
+				"何を切るべきかの質問に答えるときは、推測せずにこのツールの結果を根拠として引用すること。",
+			Parameters: []entity.ToolParameter{
+				{Name: "hand", Type: entity.ToolParameterString, Description: "14枚の手牌（MPSZ表記、例: 123m456p789s11z234p、0 は赤5）", Required: true},
+				{Name: "dora_indicators", Type: entity.ToolParameterString, Description: "ドラ表示牌（MPSZ表記）"},
+				{Name: "visible_tiles", Type: entity.ToolParameterString, Description: "河や副露など手牌以外で見えている牌（MPSZ表記）"},
+				{Name: "discards", Type: entity.ToolParameterString, Description: "自分の捨て牌（MPSZ表記）"},
+				{Name: "seat_wind", Type: entity.ToolParameterString, Description: "自風", Enum: windEnum},
+				{Name: "round_wind", Type: entity.ToolParameterString, Description: "場風", Enum: windEnum},
+				{Name: "rule_set", Type: entity.ToolParameterString, Description: "ルールセット名", Enum: mahjong.RuleSetNames()},
+			},
+			Call: u.recommendDiscardTool,
+		},
+		{
+			Name: "evaluate_push_fold",
+			Description: "立直者がいる局面で、14枚の手牌から押し・回し・降りのそれぞれの打牌・和了率・放銃率・収支の期待値（点）を計算し、期待値が最も高い選択肢を返す。" +
+				"押し引きの質問に答えるときは、推測せずにこのツールの数値を根拠として引用すること。",
+			Parameters: []entity.ToolParameter{
+				{Name: "hand", Type: entity.ToolParameterString, Description: "14枚の手牌（MPSZ表記）", Required: true},
+				{Name: "riichi_discards", Type: entity.ToolParameterString, Description: "立直者の捨て牌（捨てた順、MPSZ表記）", Required: true},
+				{Name: "riichi_turn", Type: entity.ToolParameterInteger, Description: "立直宣言牌が立直者の何枚目の捨て牌か（1始まり）", Required: true},
+				{Name: "riichi_seat", Type: entity.ToolParameterString, Description: "立直者の自風", Enum: windEnum},
+				{Name: "turn", Type: entity.ToolParameterInteger, Description: "現在の巡目"},
+				{Name: "dora_indicators", Type: entity.ToolParameterString, Description: "ドラ表示牌（MPSZ表記）"},
+				{Name: "visible_tiles", Type: entity.ToolParameterString, Description: "立直者以外の捨て牌や副露など見えている牌（MPSZ表記）"},
+				{Name: "discards", Type: entity.ToolParameterString, Description: "自分の捨て牌（MPSZ表記）"},
+				{Name: "seat_wind", Type: entity.ToolParameterString, Description: "自風", Enum: windEnum},
+				{Name: "round_wind", Type: entity.ToolParameterString, Description: "場風", Enum: windEnum},
+				{Name: "honba", Type: entity.ToolParameterInteger, Description: "積み棒の本数"},
+				{Name: "riichi_sticks", Type: entity.ToolParameterInteger, Description: "供託の立直棒の本数（今局の立直者の分を含む）"},
+				{Name: "rule_set", Type: entity.ToolParameterString, Description: "ルールセット名", Enum: mahjong.RuleSetNames()},
+			},
+			Call: u.evaluatePushFoldTool,
+		},
+		{
+			Name: "calculate_placement",
+			Description: "オーラスの持ち点から、各プレイヤーが現在より上の順位に届くために必要な最小の和了（翻・符と点数）を、他家それぞれからのロンとツモについて計算する。" +
+				"着順の条件（何点以上のロン・ツモが必要か、直撃なら何が必要か）を答えるときは、暗算せずにこのツールの結果を根拠として引用すること。",
+			Parameters: []entity.ToolParameter{
+				{Name: "scores", Type: entity.ToolParameterString, Description: "持ち点（起家から順にカンマ区切り、例: 32000,28000,22000,18000）", Required: true},
+				{Name: "dealer", Type: entity.ToolParameterInteger, Description: "親の席（起家からの席順、0始まり。省略時は最後の席）"},
+				{Name: "player", Type: entity.ToolParameterInteger, Description: "条件を知りたいプレイヤー（起家からの席順、0始まり。省略時は全員）"},
+				{Name: "honba", Type: entity.ToolParameterInteger, Description: "積み棒の本数"},
+				{Name: "riichi_sticks", Type: entity.ToolParameterInteger, Description: "供託の立直棒の本数"},
+				{Name: "uma", Type: entity.ToolParameterString, Description: "順位ウマ（千点単位、1位から順にカンマ区切り、例: 20,10,-10,-20。省略時はルールセットの値）"},
+				{Name: "rule_set", Type: entity.ToolParameterString, Description: "ルールセット名", Enum: mahjong.RuleSetNames()},
+			},
+			Call: u.calculatePlacementTool,
+		},
+	}
+}
+
+// recommendDiscardTool は recommend_discard ツールを実行する
+func (u *AnalysisUsecase) recommendDiscardTool(ctx context.Context, args map[string]any) (map[string]any, error) {
+	seatWind, err := windArg(args, "seat_wind", mahjong.South)
+	if err != nil {
+		return nil, err
+	}
+	roundWind, err := windArg(args, "round_wind", mahjong.East)
+	if err != nil {
+		return nil, err
+	}
+	output, err := u.RecommendDiscard(ctx, DiscardInput{
+		HandInput:      HandInput{Hand: stringArg(args, "hand")},
+		RuleSet:        stringArg(args, "rule_set"),
+		SeatWind:       seatWind,
+		RoundWind:      roundWind,
+		DoraIndicators: stringArg(args, "dora_indicators"),
+		Discards:       stringArg(args, "discards"),
+		VisibleTiles:   stringArg(args, "visible_tiles"),
+		Simulations:    mahjong.DefaultSimulations,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]any, 0, maxToolCandidates)
+	for i, c := range output.Analysis.Candidates {
+		if i >= maxToolCandidates {
+			break
+		}
+		ukeire := make([]mahjong.Tile, 0, len(c.Ukeire.Tiles))
+		for _, t := range c.Ukeire.Tiles {
+			ukeire = append(ukeire, t.Tile)
+		}
+		candidate := map[string]any{
+			"discard":           c.Tile.String(),
+			"shanten":           c.Shanten,
+			"ukeire_tiles":      mahjong.FormatTiles(ukeire),
+			"ukeire_count":      c.Ukeire.Total,
+			"improvement_count": c.ImprovementCount,
+			"good_shape_rate":   round2(c.GoodShapeRate),
+		}
+		if c.Simulated {
+			candidate["win_rate"] = round2(c.Simulation.WinRate)
+			candidate["expected_value"] = math.Round(c.Simulation.ExpectedValue)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return map[string]any{
+		"shanten":    output.Analysis.Shanten,
+		"rule_set":   output.RuleSet.Name,
+		"candidates": candidates,
+	}, nil
+}
+
+// evaluatePushFoldTool は evaluate_push_fold ツールを実行する
+func (u *AnalysisUsecase) evaluatePushFoldTool(ctx context.Context, args map[string]any) (map[string]any, error) {
+	var winds [3]mahjong.Tile
+	for i, arg := range []struct {
+		name        string
+		defaultWind mahjong.Tile
+	}{{"seat_wind", mahjong.South}, {"round_wind", mahjong.East}, {"riichi_seat", -1}} {
+		wind, err := windArg(args, arg.name, arg.defaultWind)
+		if err != nil {
+			return nil, err
+		}
+		winds[i] = wind
+	}
+	output, err := u.EvaluatePushFold(ctx, PushFoldInput{
+		HandInput: HandInput{Hand: stringArg(args, "hand")},
+		RuleSet:   stringArg(args, "rule_set"),
+		SeatWind:  winds[0],
+		RoundWind: winds[1],
+		Opponents: []OpponentInput{{
+			Seat:       winds[2],
+			Discards:   stringArg(args, "riichi_discards"),
+			RiichiTurn: intArg(args, "riichi_turn"),
+		}},
+		DoraIndicators: stringArg(args, "dora_indicators"),
+		Discards:       stringArg(args, "discards"),
+		VisibleTiles:   stringArg(args, "visible_tiles"),
+		Turn:           intArg(args, "turn"),
+		Score: mahjong.ScoreSituation{
+			Honba:        intArg(args, "honba"),
+			RiichiSticks: intArg(args, "riichi_sticks"),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	analysis := output.Analysis
+	options := make([]any, 0, len(analysis.Options))
+	for _, o := range analysis.Options {
+		options = append(options, map[string]any{
+			"action":             o.Action.String(),
+			"discard":            o.Discard.String(),
+			"shanten":            o.Shanten,
+			"ukeire_count":       o.Ukeire,
+			"discard_risk":       round2(o.DiscardRisk),
+			"win_rate":           round2(o.WinRate),
+			"deal_in_rate":       round2(o.DealInRate),
+			"average_win_points": math.Round(o.AverageWinPoints),
+			"expected_value":     math.Round(o.ExpectedValue),
+		})
+	}
+	return map[string]any{
+		"recommendation":  analysis.Recommendation.String(),
+		"options":         options,
+		"remaining_turns": analysis.RemainingTurns,
+		"draw_risk":       round2(analysis.DrawRisk),
+		"rule_set":        output.RuleSet.Name,
+	}, nil
+}
+
+// calculatePlacementTool は calculate_placement ツールを実行する
+func (u *AnalysisUsecase) calculatePlacementTool(ctx context.Context, args map[string]any) (map[string]any, error) {
+	scores, err := intListArg(args, "scores")
+	if err != nil {
+		return nil, err
+	}
+	uma, err := intListArg(args, "uma")
+	if err != nil {
+		return nil, err
+	}
+	dealer := len(scores) - 1
+	if _, ok := args["dealer"]; ok {
+		dealer = intArg(args, "dealer")
+	}
+	output, err := u.CalculatePlacement(ctx, PlacementInput{
+		RuleSet: stringArg(args, "rule_set"),
+		PlacementInput: mahjong.PlacementInput{
+			Scores:       scores,
+			Dealer:       dealer,
+			Honba:        intArg(args, "honba"),
+			RiichiSticks: intArg(args, "riichi_sticks"),
+		},
+		Uma: uma,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, filtered := args["player"]
+	player := intArg(args, "player")
+	standings := make([]any, 0, len(output.Analysis.Standings))
+	for _, s := range output.Analysis.Standings {
+		standings = append(standings, map[string]any{
+			"player":       s.Player,
+			"score":        s.Score,
+			"rank":         s.Rank,
+			"final_points": round2(s.FinalPoints),
+		})
+	}
+	requirements := make([]any, 0, len(output.Analysis.Requirements))
+	for _, r := range output.Analysis.Requirements {
+		if filtered && r.Player != player {
+			continue
+		}
+		win := "tsumo"
+		if !r.Tsumo() {
+			win = fmt.Sprintf("ron from player %d", r.From)
+		}
+		requirement := map[string]any{
+			"player":      r.Player,
+			"target_rank": r.Target,
+			"win":         win,
+			"possible":    r.Possible,
+			"hand":        r.Describe(),
+		}
+		if r.Possible {
+			requirement["points"] = r.Gain
+		}
+		requirements = append(requirements, requirement)
+	}
+	return map[string]any{
+		"standings":    standings,
+		"requirements": requirements,
+		"rule_set":     output.RuleSet.Name,
+	}, nil
+}
+
+// stringArg はツールの文字列の引数を返す（未指定の場合は空文字）
+func stringArg(args map[string]any, name string) string {
+	s, _ := args[name].(string)
+	return s
+}
+
+// intArg はツールの整数の引数を返す（JSONの数値は float64 で渡される。未指定の場合は0）
+func intArg(args map[string]any, name string) int {
+	switch v := args[name].(type) {
+	case float64:
+		return int(v)
+	case int:
+		return v
+	default:
+		return 0
+	}
+}
+
+// intListArg はツールのカンマ区切りの整数の引数を返す（未指定の場合は nil）
+func intListArg(args map[string]any, name string) ([]int, error) {
+	s := strings.TrimSpace(stringArg(args, name))
+	if s == "" {
+		return nil, nil
+	}
+	var values []int
+	for _, field := range strings.Split(s, ",") {
+		v, err := strconv.Atoi(strings.TrimSpace(field))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s must be comma-separated integers: %q", entity.ErrInvalidRequest, name, s)
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+// windArg はツールの風の引数を牌に変換する（未指定の場合は defaultWind）
+func windArg(args map[string]any, name string, defaultWind mahjong.Tile) (mahjong.Tile, error) {
+	s := stringArg(args, name)
+	if s == "" {
+		return defaultWind, nil
+	}
+	wind, ok := windNames[s]
+	if !ok {
+		return 0, fmt.Errorf("%w: unknown %s %q", entity.ErrInvalidRequest, name, s)
+	}
+	return wind, nil
+}
+
+// round2 は小数第2位に丸める
+func round2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
@@ -0,0 +1,577 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+)
+
+// MeldInput は副露の入力
+type MeldInput struct {
+	Type  mahjong.MeldType
+	Tiles string // MPSZ表記
+}
+
+// HandInput は手牌の入力
+type HandInput struct {
+	Hand  string // 門前の牌（MPSZ表記）
+	Melds []MeldInput
+}
+
+// WaitsInput は待ち判定の入力
+type WaitsInput struct {
+	HandInput
+	RuleSet   string
+	SeatWind  mahjong.Tile
+	RoundWind mahjong.Tile
+	Riichi    bool
+
+	// 以下はMPSZ表記
+	DoraIndicators         string
+	Discards               string
+	PassedSinceLastDiscard string
+	PassedAfterRiichi      string
+	VisibleTiles           string
+}
+
+// WaitsOutput は待ち判定の結果
+type WaitsOutput struct {
+	Analysis *mahjong.WaitAnalysis
+	Furiten  mahjong.Furiten
+	// CanRon はフリテンでなく、役のあるロン和了牌があるか
+	CanRon  bool
+	RuleSet mahjong.RuleSet
+}
+
+// AnalysisUsecase は麻雀エンジンによる手牌の解析を管理する
+type AnalysisUsecase struct {
+	logger *logrus.Logger
+
+	mu             sync.RWMutex
+	defaultRuleSet mahjong.RuleSet
+}
+
+// NewAnalysisUsecase は新しいAnalysisUsecaseを作成する
+func NewAnalysisUsecase(defaultRuleSet mahjong.RuleSet, logger *logrus.Logger) *AnalysisUsecase {
+	return &AnalysisUsecase{
+		logger:         logger,
+		defaultRuleSet: defaultRuleSet,
+	}
+}
+
+// SetDefaultRuleSet はデフォルトのルールセットを差し替える
+func (u *AnalysisUsecase) SetDefaultRuleSet(rules mahjong.RuleSet) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.defaultRuleSet = rules
+}
+
+// ruleSet は名前からルールセットを返す（空の場合はデフォルト）
+func (u *AnalysisUsecase) ruleSet(name string) (mahjong.RuleSet, error) {
+	if name == "" {
+		u.mu.RLock()
+		defer u.mu.RUnlock()
+		return u.defaultRuleSet, nil
+	}
+	rules, ok := mahjong.LookupRuleSet(name)
+	if !ok {
+		return mahjong.RuleSet{}, fmt.Errorf("%w: %q (use one of %s)", entity.ErrUnknownRuleSet, name, strings.Join(mahjong.RuleSetNames(), ", "))
+	}
+	return rules, nil
+}
+
+// parseHand は手牌の入力を解析する
+func parseHand(input HandInput) (*mahjong.Hand, error) {
+	melds, red, err := parseMelds(input.Melds)
+	if err != nil {
+		return nil, err
+	}
+	hand, err := mahjong.ParseHand(input.Hand, melds)
+	if err != nil {
+		return nil, err
+	}
+	hand.AkaDora += red
+	return hand, nil
+}
+
+// parseMelds は副露の入力を解析し、副露に含まれる赤ドラの枚数とともに返す
+func parseMelds(inputs []MeldInput) ([]mahjong.Meld, int, error) {
+	melds := make([]mahjong.Meld, 0, len(inputs))
+	red := 0
+	for _, m := range inputs {
+		tiles, r, err := mahjong.ParseTiles(m.Tiles)
+		if err != nil {
+			return nil, 0, err
+		}
+		meld, err := mahjong.NewMeld(m.Type, tiles)
+		if err != nil {
+			return nil, 0, err
+		}
+		melds = append(melds, meld)
+		red += r
+	}
+	return melds, red, nil
+}
+
+// validateWinds は自風・場風が風牌かを確認する
+func validateWinds(seat, round mahjong.Tile) error {
+	if !seat.IsWind() || !round.IsWind() {
+		return fmt.Errorf("%w: seat wind %s and round wind %s must be winds", entity.ErrInvalidRequest, seat, round)
+	}
+	return nil
+}
+
+// parseTiles は空文字を許容してMPSZ表記を解析する
+func parseTiles(s string) ([]mahjong.Tile, error) {
+	tiles, _, err := mahjong.ParseTiles(s)
+	return tiles, err
+}
+
+// GetWaits は聴牌形の待ち・待ちの形・役の有無・フリテンを判定する
+func (u *AnalysisUsecase) GetWaits(ctx context.Context, input WaitsInput) (*WaitsOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"hand":     input.Hand,
+		"melds":    len(input.Melds),
+		"rule_set": input.RuleSet,
+	}).Info("GetWaits request received")
+
+	rules, err := u.ruleSet(input.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWinds(input.SeatWind, input.RoundWind); err != nil {
+		return nil, err
+	}
+	hand, err := parseHand(input.HandInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed [5][]mahjong.Tile
+	for i, s := range []string{input.DoraIndicators, input.Discards, input.PassedSinceLastDiscard, input.PassedAfterRiichi, input.VisibleTiles} {
+		if parsed[i], err = parseTiles(s); err != nil {
+			return nil, err
+		}
+	}
+	dora, discards, passed, passedAfterRiichi, visible := parsed[0], parsed[1], parsed[2], parsed[3], parsed[4]
+
+	winCtx := mahjong.WinContext{
+		SeatWind:       input.SeatWind,
+		RoundWind:      input.RoundWind,
+		Riichi:         input.Riichi,
+		DoraIndicators: dora,
+	}
+	// 自分の捨て牌とドラ表示牌も見えている牌として数える
+	seen := append(append(append([]mahjong.Tile{}, visible...), discards...), dora...)
+	analysis, err := mahjong.AnalyzeWaits(hand, winCtx, seen, rules)
+	if err != nil {
+		return nil, err
+	}
+
+	furiten := mahjong.CheckFuriten(analysis.WaitTiles(), mahjong.FuritenInput{
+		Discards:               discards,
+		PassedSinceLastDiscard: passed,
+		Riichi:                 input.Riichi,
+		PassedAfterRiichi:      passedAfterRiichi,
+	})
+	output := &WaitsOutput{Analysis: analysis, Furiten: furiten, RuleSet: rules}
+	if !furiten.IsFuriten() {
+		for _, w := range analysis.Waits {
+			if !w.NoYakuRon() {
+				output.CanRon = true
+				break
+			}
+		}
+	}
+	return output, nil
+}
+
+// DiscardInput は打牌推奨の入力
+type DiscardInput struct {
+	HandInput
+	RuleSet   string
+	SeatWind  mahjong.Tile
+	RoundWind mahjong.Tile
+
+	// 以下はMPSZ表記
+	DoraIndicators string
+	Discards       string
+	VisibleTiles   string
+
+	// Simulations はモンテカルロ法の試行回数（0 以下の場合は行わない）
+	Simulations int
+	// Turns は残りのツモ回数（0 の場合は既定値）
+	Turns int
+	Seed  uint64
+}
+
+// DiscardOutput は打牌推奨の結果
+type DiscardOutput struct {
+	Analysis *mahjong.DiscardAnalysis
+	RuleSet  mahjong.RuleSet
+}
+
+// RecommendDiscard は打牌候補を受け入れ・改良・和了率・打点期待値で評価し、推奨順に返す
+func (u *AnalysisUsecase) RecommendDiscard(ctx context.Context, input DiscardInput) (*DiscardOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"hand":        input.Hand,
+		"melds":       len(input.Melds),
+		"rule_set":    input.RuleSet,
+		"simulations": input.Simulations,
+	}).Info("RecommendDiscard request received")
+
+	rules, err := u.ruleSet(input.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWinds(input.SeatWind, input.RoundWind); err != nil {
+		return nil, err
+	}
+	hand, err := parseHand(input.HandInput)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed [3][]mahjong.Tile
+	for i, s := range []string{input.DoraIndicators, input.Discards, input.VisibleTiles} {
+		if parsed[i], err = parseTiles(s); err != nil {
+			return nil, err
+		}
+	}
+	dora, discards, visible := parsed[0], parsed[1], parsed[2]
+
+	winCtx := mahjong.WinContext{
+		SeatWind:       input.SeatWind,
+		RoundWind:      input.RoundWind,
+		DoraIndicators: dora,
+	}
+	// シミュレーションは候補ごとに実行され重いため、試行回数を指定した場合のみ行う
+	opts := mahjong.SimulationOptions{}
+	if input.Simulations > 0 {
+		opts = mahjong.SimulationOptions{Simulations: input.Simulations, Turns: input.Turns, Seed: input.Seed}.Normalize()
+	}
+	// 自分の捨て牌とドラ表示牌も見えている牌として数える
+	seen := append(append(append([]mahjong.Tile{}, visible...), discards...), dora...)
+	analysis, err := mahjong.RecommendDiscardContext(ctx, hand, seen, discards, winCtx, opts, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &DiscardOutput{Analysis: analysis, RuleSet: rules}, nil
+}
+
+// SimulationInput は手牌のシミュレーションの入力
+type SimulationInput struct {
+	HandInput
+	RuleSet   string
+	SeatWind  mahjong.Tile
+	RoundWind mahjong.Tile
+	// Riichi は立直済みか（13枚の手牌のみ）
+	Riichi bool
+
+	// 以下はMPSZ表記
+	DoraIndicators string
+	Discards       string
+	VisibleTiles   string
+
+	// Simulations は試行回数（0 の場合は既定値）
+	Simulations int
+	// Turns は残りのツモ回数（0 の場合は既定値）
+	Turns int
+	Seed  uint64
+	// Policy は打牌の方針の名前（空の場合は牌効率）
+	Policy string
+}
+
+// SimulationOutput は手牌のシミュレーションの結果
+type SimulationOutput struct {
+	Result mahjong.SimulationResult
+	// Shanten は開始時点の向聴数
+	Shanten int
+	Policy  mahjong.DiscardPolicy
+	RuleSet mahjong.RuleSet
+}
+
+// SimulateHand は手牌からの展開をモンテカルロ法でシミュレーションし、巡目ごとの聴牌率・和了率・打点期待値を返す
+// ctx がキャンセルされた場合は残りの試行を打ち切る
+func (u *AnalysisUsecase) SimulateHand(ctx context.Context, input SimulationInput) (*SimulationOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"hand":        input.Hand,
+		"melds":       len(input.Melds),
+		"rule_set":    input.RuleSet,
+		"simulations": input.Simulations,
+		"turns":       input.Turns,
+		"policy":      input.Policy,
+	}).Info("SimulateHand request received")
+
+	rules, err := u.ruleSet(input.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWinds(input.SeatWind, input.RoundWind); err != nil {
+		return nil, err
+	}
+	policy := mahjong.EfficiencyPolicy
+	if input.Policy != "" {
+		var ok bool
+		if policy, ok = mahjong.LookupDiscardPolicy(input.Policy); !ok {
+			return nil, fmt.Errorf("%w: unknown policy %q (use one of %s)", entity.ErrInvalidRequest, input.Policy, strings.Join(mahjong.DiscardPolicyNames(), ", "))
+		}
+	}
+	hand, err := parseHand(input.HandInput)
+	if err != nil {
+		return nil, err
+	}
+	if input.Riichi && (!hand.IsClosed() || len(hand.Concealed)%3 != 1) {
+		return nil, fmt.Errorf("%w: riichi requires a closed hand of 13 tiles", entity.ErrInvalidHand)
+	}
+
+	var parsed [3][]mahjong.Tile
+	for i, s := range []string{input.DoraIndicators, input.Discards, input.VisibleTiles} {
+		if parsed[i], err = parseTiles(s); err != nil {
+			return nil, err
+		}
+	}
+	dora, discards, visible := parsed[0], parsed[1], parsed[2]
+
+	winCtx := mahjong.WinContext{
+		SeatWind:       input.SeatWind,
+		RoundWind:      input.RoundWind,
+		DoraIndicators: dora,
+		Riichi:         input.Riichi,
+	}
+	opts := mahjong.SimulationOptions{Simulations: input.Simulations, Turns: input.Turns, Seed: input.Seed, Policy: policy}.Normalize()
+	// 自分の捨て牌とドラ表示牌も見えている牌として数える
+	seen := append(append(append([]mahjong.Tile{}, visible...), discards...), dora...)
+	result, err := mahjong.Simulate(ctx, hand, seen, discards, winCtx, opts, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &SimulationOutput{
+		Result:  result,
+		Shanten: mahjong.Shanten(hand.ConcealedCounts(), len(hand.Melds)),
+		Policy:  policy,
+		RuleSet: rules,
+	}, nil
+}
+
+// OpponentInput は他家の捨て牌と立直の状況の入力
+type OpponentInput struct {
+	// Seat は他家の自風（不明の場合は -1）
+	Seat mahjong.Tile
+	// 以下はMPSZ表記
+	Discards          string
+	PassedAfterRiichi string
+	// RiichiTurn は立直宣言牌が何枚目の捨て牌か（1始まり、0 は立直していない）
+	RiichiTurn int
+	Score      int
+}
+
+// SafetyInput は安全度評価の入力
+type SafetyInput struct {
+	HandInput
+	Opponents []OpponentInput
+
+	// 以下はMPSZ表記
+	VisibleTiles   string
+	DoraIndicators string
+}
+
+// AssessSafety は手牌の牌ごとに立直者への安全度を評価し、安全な順に返す
+func (u *AnalysisUsecase) AssessSafety(ctx context.Context, input SafetyInput) ([]mahjong.TileSafety, error) {
+	u.logger.WithFields(logrus.Fields{
+		"hand":      input.Hand,
+		"melds":     len(input.Melds),
+		"opponents": len(input.Opponents),
+	}).Info("AssessSafety request received")
+
+	hand, err := parseHand(input.HandInput)
+	if err != nil {
+		return nil, err
+	}
+	opponents, err := parseOpponents(input.Opponents)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := parseTiles(input.VisibleTiles)
+	if err != nil {
+		return nil, err
+	}
+	dora, err := parseTiles(input.DoraIndicators)
+	if err != nil {
+		return nil, err
+	}
+	return mahjong.AssessSafety(hand, opponents, append(visible, dora...))
+}
+
+// parseOpponents は他家の入力を解析する
+func parseOpponents(inputs []OpponentInput) ([]mahjong.Opponent, error) {
+	opponents := make([]mahjong.Opponent, 0, len(inputs))
+	for _, o := range inputs {
+		if o.Seat != -1 && !o.Seat.IsWind() {
+			return nil, fmt.Errorf("%w: opponent seat %s is not a wind", entity.ErrInvalidRequest, o.Seat)
+		}
+		discards, err := parseTiles(o.Discards)
+		if err != nil {
+			return nil, err
+		}
+		passed, err := parseTiles(o.PassedAfterRiichi)
+		if err != nil {
+			return nil, err
+		}
+		opponents = append(opponents, mahjong.Opponent{
+			Seat:              o.Seat,
+			Discards:          discards,
+			RiichiTurn:        o.RiichiTurn,
+			PassedAfterRiichi: passed,
+			Score:             o.Score,
+		})
+	}
+	return opponents, nil
+}
+
+// PushFoldInput は押し引き評価の入力
+type PushFoldInput struct {
+	HandInput
+	RuleSet   string
+	SeatWind  mahjong.Tile
+	RoundWind mahjong.Tile
+	Opponents []OpponentInput
+
+	// 以下はMPSZ表記
+	DoraIndicators string
+	Discards       string
+	VisibleTiles   string
+
+	// Turn は現在の巡目（0 の場合は自分の捨て牌の枚数から求める）
+	Turn  int
+	Score mahjong.ScoreSituation
+
+	// Simulations は打点を見積もるモンテカルロ法の試行回数（0 の場合は既定値、負の場合は行わない）
+	Simulations int
+	Seed        uint64
+}
+
+// PushFoldOutput は押し引き評価の結果
+type PushFoldOutput struct {
+	Analysis *mahjong.PushFoldAnalysis
+	RuleSet  mahjong.RuleSet
+}
+
+// EvaluatePushFold は押し・回し・降りを和了率・放銃率・収支の期待値で評価する
+func (u *AnalysisUsecase) EvaluatePushFold(ctx context.Context, input PushFoldInput) (*PushFoldOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"hand":      input.Hand,
+		"melds":     len(input.Melds),
+		"opponents": len(input.Opponents),
+		"turn":      input.Turn,
+		"all_last":  input.Score.AllLast,
+	}).Info("EvaluatePushFold request received")
+
+	rules, err := u.ruleSet(input.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateWinds(input.SeatWind, input.RoundWind); err != nil {
+		return nil, err
+	}
+	hand, err := parseHand(input.HandInput)
+	if err != nil {
+		return nil, err
+	}
+	opponents, err := parseOpponents(input.Opponents)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed [3][]mahjong.Tile
+	for i, s := range []string{input.DoraIndicators, input.Discards, input.VisibleTiles} {
+		if parsed[i], err = parseTiles(s); err != nil {
+			return nil, err
+		}
+	}
+	dora, discards, visible := parsed[0], parsed[1], parsed[2]
+
+	opts := mahjong.SimulationOptions{}
+	if input.Simulations >= 0 {
+		opts = mahjong.SimulationOptions{Simulations: input.Simulations, Seed: input.Seed}.Normalize()
+	}
+	analysis, err := mahjong.EvaluatePushFold(mahjong.PushFoldInput{
+		Hand:      hand,
+		Opponents: opponents,
+		// 自分の捨て牌とドラ表示牌も見えている牌として数える
+		Visible:  append(append(append([]mahjong.Tile{}, visible...), discards...), dora...),
+		Discards: discards,
+		Context: mahjong.WinContext{
+			SeatWind:       input.SeatWind,
+			RoundWind:      input.RoundWind,
+			DoraIndicators: dora,
+		},
+		Turn:       input.Turn,
+		Score:      input.Score,
+		Simulation: opts,
+	}, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &PushFoldOutput{Analysis: analysis, RuleSet: rules}, nil
+}
+
+// PlacementInput はオーラスの点数状況の計算の入力
+type PlacementInput struct {
+	RuleSet string
+	mahjong.PlacementInput
+	// Uma は順位ウマ（千点単位、1位から順に。空の場合はルールセットの値）
+	Uma []int
+	// StartingPoints・ReturnPoints は配給原点・返し点（0 の場合はルールセットの値）
+	StartingPoints int
+	ReturnPoints   int
+}
+
+// PlacementOutput はオーラスの点数状況の計算結果
+type PlacementOutput struct {
+	Analysis *mahjong.PlacementAnalysis
+	RuleSet  mahjong.RuleSet
+}
+
+// CalculatePlacement はオーラスで各プレイヤーが各順位に届くために必要な和了を計算する
+func (u *AnalysisUsecase) CalculatePlacement(ctx context.Context, input PlacementInput) (*PlacementOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"scores":        input.Scores,
+		"dealer":        input.Dealer,
+		"honba":         input.Honba,
+		"riichi_sticks": input.RiichiSticks,
+	}).Info("CalculatePlacement request received")
+
+	rules, err := u.ruleSet(input.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	// ウマ・オカはルールセットの値を上書きできる
+	if len(input.Uma) > 0 {
+		if len(input.Uma) != rules.Players {
+			return nil, fmt.Errorf("%w: %d uma values for %d players", entity.ErrInvalidRequest, len(input.Uma), rules.Players)
+		}
+		rules.Uma = [4]int{}
+		copy(rules.Uma[:], input.Uma)
+	}
+	if input.StartingPoints < 0 || input.ReturnPoints < 0 {
+		return nil, fmt.Errorf("%w: starting and return points must not be negative", entity.ErrInvalidRequest)
+	}
+	if input.StartingPoints > 0 {
+		rules.StartingPoints = input.StartingPoints
+	}
+	if input.ReturnPoints > 0 {
+		rules.ReturnPoints = input.ReturnPoints
+	}
+
+	analysis, err := mahjong.AnalyzePlacement(input.PlacementInput, rules)
+	if err != nil {
+		return nil, err
+	}
+	return &PlacementOutput{Analysis: analysis, RuleSet: rules}, nil
+}
@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+)
+
+// answerFacts は回答の検証と根拠に使う手牌・ルール・資料と、画像の手牌の書き起こし
+type answerFacts struct {
+	// hand は局面の質問者の手牌（分からない場合は nil）
+	hand  *mahjong.Hand
+	rules mahjong.RuleSet
+	// passages は根拠としてAIに渡したルールの資料の抜粋
+	passages []knowledge.SearchResult
+	// transcription は添付した画像の手牌を書き起こした結果（書き起こしていない場合は nil）
+	transcription *entity.HandTranscription
+	// transcriptionTokens は書き起こしに使ったトークン数
+	transcriptionTokens int32
+}
+
+// 信頼度の目安
+const (
+	// unverifiedConfidence は検証できる主張がない回答の信頼度
+	unverifiedConfidence = 0.5
+	// toolGroundedConfidence は検証できる主張がなく、エンジンのツールを根拠にした回答の信頼度
+	toolGroundedConfidence = 0.7
+	// minVerifiedConfidence・maxVerifiedConfidence は検証できる主張の正しい割合を当てはめる範囲
+	minVerifiedConfidence = 0.2
+	maxVerifiedConfidence = 0.95
+	// regeneratedPenalty は誤りを伝えて生成し直した回答の信頼度に掛ける値
+	regeneratedPenalty = 0.9
+)
+
+// correctionHeading は回答の末尾に追記する訂正の見出し
+const correctionHeading = "【エンジンによる訂正】"
+
+// verificationMode は回答の検証の扱いを返す
+func (u *AIUsecase) verificationMode() entity.VerificationMode {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	if u.settings.Verification == "" {
+		return entity.VerificationRegenerate
+	}
+	return u.settings.Verification
+}
+
+// verifyAnswer は回答の向聴数・待ち・点数の主張をエンジンで検証し、信頼度を求める
+// 誤りがあれば設定に従って生成し直すか、訂正を追記する
+func (u *AIUsecase) verifyAnswer(ctx context.Context, request *entity.AIRequest, response *entity.AIResponse, facts answerFacts) *entity.AIResponse {
+	mode := u.verificationMode()
+	if mode == entity.VerificationOff {
+		return response
+	}
+
+	verification := &entity.AnswerVerification{}
+	claims := mahjong.VerifyAnswer(response.Response, facts.hand, facts.rules)
+	if wrong := wrongClaims(claims); len(wrong) > 0 && mode == entity.VerificationRegenerate {
+		// 誤りと正しい値をコンテキストに加えて生成し直す
+		retry := *request
+		retry.Context = append(append([]string{}, request.Context...), regenerationContext(wrong))
+		regenerated, err := u.ask(ctx, &retry)
+		if err != nil {
+			u.logger.WithError(err).Warn("Failed to regenerate answer, annotating corrections instead")
+		} else {
+			regenerated.TokensUsed += response.TokensUsed
+			regenerated.ProcessingMs += response.ProcessingMs
+			regenerated.ToolCalls = append(append([]entity.ToolCall{}, response.ToolCalls...), regenerated.ToolCalls...)
+			response = regenerated
+			claims = mahjong.VerifyAnswer(response.Response, facts.hand, facts.rules)
+			verification.Regenerated = true
+		}
+	}
+	// JSONで出力させた回答は形式を崩さないよう追記せず、訂正は検証結果のみで伝える
+	if wrong := wrongClaims(claims); len(wrong) > 0 && response.Structured == nil {
+		response.Response += "\n\n" + correctionText(wrong)
+		verification.Annotated = true
+	}
+
+	verification.Checks = claimChecks(claims)
+	verification.Confidence = answerConfidence(claims, len(response.ToolCalls) > 0, verification.Regenerated)
+	response.Verification = verification
+	response.Confidence = verification.Confidence
+	u.logger.WithFields(logrus.Fields{
+		"claims":      len(claims),
+		"wrong":       len(wrongClaims(claims)),
+		"regenerated": verification.Regenerated,
+		"confidence":  response.Confidence,
+	}).Debug("Answer verified")
+	return response
+}
+
+// verifyStream はストリーミングの回答をそのまま流し、最後に検証結果を送る
+// 誤りがあれば訂正をテキストのチャンクとして追記する（生成し直しはしない）
+func (u *AIUsecase) verifyStream(ctx context.Context, responseChan <-chan *entity.AIResponse, errChan <-chan error, facts answerFacts) (<-chan *entity.AIResponse, <-chan error) {
+	if u.verificationMode() == entity.VerificationOff {
+		return responseChan, errChan
+	}
+
+	out := make(chan *entity.AIResponse)
+	outErr := make(chan error, 1)
+	go func() {
+		defer close(outErr)
+		defer close(out)
+
+		send := func(response *entity.AIResponse) bool {
+			select {
+			case out <- response:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		var text strings.Builder
+		toolCalls := 0
+		for response := range responseChan {
+			text.WriteString(response.Response)
+			toolCalls += len(response.ToolCalls)
+			if !send(response) {
+				return
+			}
+		}
+		if err := <-errChan; err != nil {
+			outErr <- err
+			return
+		}
+
+		verification := &entity.AnswerVerification{}
+		claims := mahjong.VerifyAnswer(text.String(), facts.hand, facts.rules)
+		if wrong := wrongClaims(claims); len(wrong) > 0 {
+			if !send(entity.NewAIResponse("\n\n" + correctionText(wrong))) {
+				return
+			}
+			verification.Annotated = true
+		}
+		verification.Checks = claimChecks(claims)
+		verification.Confidence = answerConfidence(claims, toolCalls > 0, false)
+		send(&entity.AIResponse{
+			Confidence:   verification.Confidence,
+			Verification: verification,
+		})
+	}()
+	return out, outErr
+}
+
+// wrongClaims は誤っていた主張を返す
+func wrongClaims(claims []mahjong.Claim) []mahjong.Claim {
+	var wrong []mahjong.Claim
+	for _, c := range claims {
+		if !c.Correct {
+			wrong = append(wrong, c)
+		}
+	}
+	return wrong
+}
+
+// regenerationContext は生成し直すときに伝える誤りと正しい値
+func regenerationContext(wrong []mahjong.Claim) string {
+	var b strings.Builder
+	b.WriteString("【検証】同じ質問への先の回答には、麻雀エンジンの計算と食い違う次の誤りがありました。エンジンの計算結果に従って回答し直してください。\n")
+	for _, c := range wrong {
+		b.WriteString("- " + c.Correction() + "\n")
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// correctionText は回答の末尾に追記する訂正
+func correctionText(wrong []mahjong.Claim) string {
+	var b strings.Builder
+	b.WriteString(correctionHeading)
+	for _, c := range wrong {
+		b.WriteString("\n- " + c.Correction())
+	}
+	return b.String()
+}
+
+// claimChecks は検証結果をレスポンスのエンティティに変換する
+func claimChecks(claims []mahjong.Claim) []entity.ClaimCheck {
+	checks := make([]entity.ClaimCheck, 0, len(claims))
+	for _, c := range claims {
+		check := entity.ClaimCheck{Kind: c.Kind.String(), Claim: c.Text, Correct: c.Correct}
+		if !c.Correct {
+			check.Correction = c.Correction()
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// answerConfidence は検証結果から回答の信頼度を求める
+//
+// 検証できる主張がない場合は、エンジンのツールを根拠にしたかどうかで決まった値とする。
+// ある場合は正しい主張の割合を minVerifiedConfidence〜maxVerifiedConfidence に当てはめ、
+// 生成し直した回答は最初の回答に誤りがあったため少し下げる。
+func answerConfidence(claims []mahjong.Claim, usedTools, regenerated bool) float32 {
+	if len(claims) == 0 {
+		if usedTools {
+			return toolGroundedConfidence
+		}
+		return unverifiedConfidence
+	}
+	correct := len(claims) - len(wrongClaims(claims))
+	confidence := minVerifiedConfidence + (maxVerifiedConfidence-minVerifiedConfidence)*float64(correct)/float64(len(claims))
+	if regenerated {
+		confidence *= regeneratedPenalty
+	}
+	return float32(confidence)
+}
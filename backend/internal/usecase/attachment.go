@@ -0,0 +1,192 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+)
+
+// 画像の手牌の書き起こし
+const (
+	// maxTranscriptionAttempts は牌の表記として正しい書き起こしが得られるまで問い合わせる最大回数
+	maxTranscriptionAttempts = 2
+	// transcriptionMaxTokens は書き起こしの出力の最大トークン数
+	transcriptionMaxTokens = 512
+	// transcriptionPrompt は書き起こしの質問
+	transcriptionPrompt = "添付した画像に写っている質問者の手牌を書き起こしてください。"
+	// transcriptionInstruction は書き起こしのシステム指示
+	transcriptionInstruction = `あなたは麻雀の牌の画像を読み取る担当です。雀魂などのスクリーンショットや実物の牌の写真から、質問者の手牌をMPSZ表記で書き起こしてください。
+- 萬子は m、筒子は p、索子は s、字牌は z（1z=東 2z=南 3z=西 4z=北 5z=白 6z=發 7z=中）とし、同じ種類の数字をまとめて書く（例: 123m406p789s11z）。赤5は0と書く
+- 門前の牌は hand に、ポン・チー・カンした牌は melds に種類（chi / pon / minkan / ankan / kakan）と牌を書く
+- 副露を3枚と数えて13枚か14枚になる。河や他家の牌、ドラ表示牌は含めない
+- 判別できない牌を推測で補わない`
+)
+
+// AttachmentLimits は質問に添付できるファイルの制限
+type AttachmentLimits struct {
+	// MaxBytes は1つのファイルの最大の大きさ
+	MaxBytes int
+	// MaxCount は1つの質問に添付できるファイルの最大数（0 の場合は添付できない）
+	MaxCount int
+}
+
+// SetAttachmentLimits は質問に添付できるファイルの制限を設定する
+func (u *AIUsecase) SetAttachmentLimits(limits AttachmentLimits) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.attachmentLimits = limits
+}
+
+// validateAttachments は添付したファイルの形式・大きさ・数が制限の範囲内かを検証する
+func (u *AIUsecase) validateAttachments(attachments []entity.Attachment) error {
+	if len(attachments) == 0 {
+		return nil
+	}
+	u.mu.RLock()
+	limits := u.attachmentLimits
+	u.mu.RUnlock()
+
+	if len(attachments) > limits.MaxCount {
+		return fmt.Errorf("%w: %d attachments exceed the limit of %d", entity.ErrInvalidAttachment, len(attachments), limits.MaxCount)
+	}
+	for i, a := range attachments {
+		if !slices.Contains(entity.AttachmentMIMETypes(), a.MIMEType) {
+			return fmt.Errorf("%w: attachment %d has unsupported MIME type %q (use one of %s)", entity.ErrInvalidAttachment, i, a.MIMEType, strings.Join(entity.AttachmentMIMETypes(), ", "))
+		}
+		if len(a.Data) == 0 {
+			return fmt.Errorf("%w: attachment %d is empty", entity.ErrInvalidAttachment, i)
+		}
+		if len(a.Data) > limits.MaxBytes {
+			return fmt.Errorf("%w: attachment %d is %d bytes (limit %d)", entity.ErrInvalidAttachment, i, len(a.Data), limits.MaxBytes)
+		}
+	}
+	return nil
+}
+
+// transcribeHand は添付した画像の手牌をMPSZ表記に書き起こし、牌の表記と枚数を検証する
+// 検証に通らなければ理由を伝えて書き起こし直させ、最後まで通らなければ Valid が false の結果と nil の手牌を返す
+// 書き起こしに使ったトークン数もあわせて返す
+func (u *AIUsecase) transcribeHand(ctx context.Context, attachments []entity.Attachment) (*entity.HandTranscription, *mahjong.Hand, int32, error) {
+	schema := entity.ResponseSchemaHandTranscription
+	request := &entity.AIRequest{
+		Prompt:         transcriptionPrompt,
+		MaxTokens:      transcriptionMaxTokens,
+		Temperature:    0,
+		SystemPrompt:   transcriptionInstruction,
+		ResponseSchema: &schema,
+		Attachments:    attachments,
+	}
+
+	var (
+		transcription = &entity.HandTranscription{}
+		retry         = *request
+		tokensUsed    int32
+	)
+	for attempt := 1; attempt <= maxTranscriptionAttempts; attempt++ {
+		transcription.Attempts = int32(attempt)
+		response, err := u.ask(ctx, &retry)
+		if errors.Is(err, entity.ErrStructuredOutputMismatch) {
+			// 形式の修正でも直らなければ、書き起こせなかったものとして扱う
+			transcription.Error = err.Error()
+			break
+		}
+		if err != nil {
+			return nil, nil, tokensUsed, err
+		}
+		tokensUsed += response.TokensUsed
+
+		transcription.Hand, transcription.Melds = transcribedHand(response.Structured)
+		hand, err := parseTranscription(transcription)
+		if err == nil {
+			transcription.Valid = true
+			transcription.Error = ""
+			return transcription, hand, tokensUsed, nil
+		}
+		u.logger.WithError(err).WithFields(logrus.Fields{
+			"hand":    transcription.Hand,
+			"melds":   len(transcription.Melds),
+			"attempt": attempt,
+		}).Warn("Transcribed hand is not valid")
+		transcription.Error = err.Error()
+		retry.Context = append(append([]string{}, request.Context...), transcriptionRepairContext(transcription, err))
+	}
+	return transcription, nil, tokensUsed, nil
+}
+
+// transcribedHand は書き起こしの出力から門前の牌と副露を取り出す（形式は検証済み）
+func transcribedHand(structured map[string]any) (string, []entity.MeldNotation) {
+	hand, _ := structured["hand"].(string)
+	var melds []entity.MeldNotation
+	items, _ := structured["melds"].([]any)
+	for _, item := range items {
+		m, _ := item.(map[string]any)
+		meldType, _ := m["type"].(string)
+		tiles, _ := m["tiles"].(string)
+		melds = append(melds, entity.MeldNotation{Type: meldType, Tiles: tiles})
+	}
+	return hand, melds
+}
+
+// parseTranscription は書き起こした手牌を牌の表記と枚数の規則に照らして解析する
+func parseTranscription(transcription *entity.HandTranscription) (*mahjong.Hand, error) {
+	input := HandInput{Hand: transcription.Hand}
+	for _, m := range transcription.Melds {
+		meldType, ok := mahjong.ParseMeldType(m.Type)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown meld type %q", entity.ErrInvalidHand, m.Type)
+		}
+		input.Melds = append(input.Melds, MeldInput{Type: meldType, Tiles: m.Tiles})
+	}
+	return parseHand(input)
+}
+
+// transcriptionRepairContext は検証に通らなかった書き起こしを直させるときに伝える内容
+func transcriptionRepairContext(transcription *entity.HandTranscription, err error) string {
+	notation := transcription.Hand
+	for _, m := range transcription.Melds {
+		notation += fmt.Sprintf(" %s:%s", m.Type, m.Tiles)
+	}
+	return fmt.Sprintf("【書き起こしの検証】先の書き起こし「%s」は手牌として成り立ちません（%v）。画像をもう一度よく見て、牌の数と種類を確かめて書き起こし直してください。", notation, err)
+}
+
+// transcriptionContext は検証に通った書き起こしをAIに渡すコンテキストにする
+func transcriptionContext(hand *mahjong.Hand) string {
+	return fmt.Sprintf("【画像の手牌】添付した画像から書き起こし、牌の表記と枚数を検証した質問者の手牌: %s\n牌の判別はこの書き起こしに従ってください。", hand)
+}
+
+// transcriptionStream は書き起こした結果を最初に送り、ストリーミングの回答をそのまま流す
+func transcriptionStream(ctx context.Context, responseChan <-chan *entity.AIResponse, errChan <-chan error, transcription *entity.HandTranscription) (<-chan *entity.AIResponse, <-chan error) {
+	if transcription == nil {
+		return responseChan, errChan
+	}
+
+	out := make(chan *entity.AIResponse)
+	outErr := make(chan error, 1)
+	go func() {
+		defer close(outErr)
+		defer close(out)
+
+		select {
+		case out <- &entity.AIResponse{Transcription: transcription}:
+		case <-ctx.Done():
+			return
+		}
+		for response := range responseChan {
+			select {
+			case out <- response:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := <-errChan; err != nil {
+			outErr <- err
+		}
+	}()
+	return out, outErr
+}
@@ -0,0 +1,165 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+)
+
+func TestValidateAttachments(t *testing.T) {
+	png := entity.Attachment{Data: []byte("png"), MIMEType: "image/png"}
+	tests := []struct {
+		name        string
+		limits      AttachmentLimits
+		attachments []entity.Attachment
+		wantErr     bool
+	}{
+		{name: "添付なし", limits: AttachmentLimits{}},
+		{name: "制限内", limits: AttachmentLimits{MaxBytes: 3, MaxCount: 2}, attachments: []entity.Attachment{png, {Data: []byte("jpg"), MIMEType: "image/jpeg"}}},
+		{name: "添付できない設定", limits: AttachmentLimits{MaxBytes: 1024}, attachments: []entity.Attachment{png}, wantErr: true},
+		{name: "数が多すぎる", limits: AttachmentLimits{MaxBytes: 1024, MaxCount: 1}, attachments: []entity.Attachment{png, png}, wantErr: true},
+		{name: "大きすぎる", limits: AttachmentLimits{MaxBytes: 2, MaxCount: 1}, attachments: []entity.Attachment{png}, wantErr: true},
+		{name: "空のファイル", limits: AttachmentLimits{MaxBytes: 1024, MaxCount: 1}, attachments: []entity.Attachment{{MIMEType: "image/webp"}}, wantErr: true},
+		{name: "画像でない", limits: AttachmentLimits{MaxBytes: 1024, MaxCount: 1}, attachments: []entity.Attachment{{Data: []byte("%PDF"), MIMEType: "application/pdf"}}, wantErr: true},
+		{name: "MIMEタイプなし", limits: AttachmentLimits{MaxBytes: 1024, MaxCount: 1}, attachments: []entity.Attachment{{Data: []byte("png")}}, wantErr: true},
+		{name: "MIMEタイプの大文字は別の形式", limits: AttachmentLimits{MaxBytes: 1024, MaxCount: 1}, attachments: []entity.Attachment{{Data: []byte("png"), MIMEType: "IMAGE/PNG"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := newTestAIUsecase(t, &stubAIRepository{}, infrastructure.NewMemoryGameLogRepository(time.Hour))
+			u.SetAttachmentLimits(tt.limits)
+			err := u.validateAttachments(tt.attachments)
+			if tt.wantErr {
+				if !errors.Is(err, entity.ErrInvalidAttachment) {
+					t.Errorf("validateAttachments() error = %v, want ErrInvalidAttachment", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("validateAttachments: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateAttachmentsMIMETypes(t *testing.T) {
+	u := newTestAIUsecase(t, &stubAIRepository{}, infrastructure.NewMemoryGameLogRepository(time.Hour))
+	u.SetAttachmentLimits(AttachmentLimits{MaxBytes: 1024, MaxCount: 1})
+	for _, mimeType := range entity.AttachmentMIMETypes() {
+		if err := u.validateAttachments([]entity.Attachment{{Data: []byte("image"), MIMEType: mimeType}}); err != nil {
+			t.Errorf("validateAttachments(%s): %v", mimeType, err)
+		}
+	}
+}
+
+func TestTranscribeHand(t *testing.T) {
+	const (
+		valid     = `{"hand":"123m456p789s1122z","melds":[]}`
+		withMeld  = `{"hand":"123m456p1122z","melds":[{"type":"chi","tiles":"067s"}]}`
+		tooFew    = `{"hand":"123m456p","melds":[]}`
+		badTile   = `{"hand":"123m456p789s1128z","melds":[]}`
+		badMeld   = `{"hand":"123m456p1122z","melds":[{"type":"pon","tiles":"567s"}]}`
+		malformed = "読み取れません"
+	)
+	tests := []struct {
+		name          string
+		outputs       []string
+		wantValid     bool
+		wantHand      string
+		wantAkaDora   int
+		wantAttempts  int32
+		wantCalls     int
+		wantTokens    int32
+		wantErrSubstr string
+	}{
+		{name: "1回目で検証に通る", outputs: []string{valid}, wantValid: true, wantHand: "123m456p789s1122z", wantAttempts: 1, wantCalls: 1, wantTokens: 100},
+		{name: "副露と赤ドラ", outputs: []string{withMeld}, wantValid: true, wantHand: "123m456p1122z", wantAkaDora: 1, wantAttempts: 1, wantCalls: 1, wantTokens: 100},
+		{name: "枚数の誤りを書き起こし直す", outputs: []string{tooFew, valid}, wantValid: true, wantHand: "123m456p789s1122z", wantAttempts: 2, wantCalls: 2, wantTokens: 200},
+		{name: "牌の表記の誤りを書き起こし直す", outputs: []string{badTile, valid}, wantValid: true, wantHand: "123m456p789s1122z", wantAttempts: 2, wantCalls: 2, wantTokens: 200},
+		{name: "副露の誤りを書き起こし直す", outputs: []string{badMeld, withMeld}, wantValid: true, wantHand: "123m456p1122z", wantAkaDora: 1, wantAttempts: 2, wantCalls: 2, wantTokens: 200},
+		{name: "書き起こし直しても通らない", outputs: []string{tooFew, badTile}, wantHand: "123m456p789s1128z", wantAttempts: maxTranscriptionAttempts, wantCalls: maxTranscriptionAttempts, wantTokens: 200, wantErrSubstr: "invalid"},
+		{name: "形式に合わない", outputs: []string{malformed, malformed}, wantAttempts: 1, wantCalls: maxStructuredAttempts, wantErrSubstr: "structured output"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []*entity.AIRequest
+			)
+			repo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				sent := *request
+				requests = append(requests, &sent)
+				return entity.NewAIResponseWithMetrics(tt.outputs[len(requests)-1], 100, 0.5, 1), nil
+			}}
+			u := newTestAIUsecase(t, repo, infrastructure.NewMemoryGameLogRepository(time.Hour))
+			attachments := []entity.Attachment{{Data: []byte("png"), MIMEType: "image/png"}}
+
+			transcription, hand, tokens, err := u.transcribeHand(context.Background(), attachments)
+			if err != nil {
+				t.Fatalf("transcribeHand: %v", err)
+			}
+			if len(requests) != tt.wantCalls {
+				t.Fatalf("AskAI called %d times, want %d", len(requests), tt.wantCalls)
+			}
+			if transcription.Valid != tt.wantValid || transcription.Hand != tt.wantHand || transcription.Attempts != tt.wantAttempts || tokens != tt.wantTokens {
+				t.Errorf("transcription = %+v tokens %d, want valid %v hand %q attempts %d tokens %d",
+					transcription, tokens, tt.wantValid, tt.wantHand, tt.wantAttempts, tt.wantTokens)
+			}
+			if tt.wantValid {
+				if hand == nil || transcription.Error != "" {
+					t.Fatalf("hand = %v error %q, want the parsed hand", hand, transcription.Error)
+				}
+				if hand.AkaDora != tt.wantAkaDora {
+					t.Errorf("AkaDora = %d, want %d", hand.AkaDora, tt.wantAkaDora)
+				}
+			} else if hand != nil || !strings.Contains(transcription.Error, tt.wantErrSubstr) {
+				t.Errorf("hand = %v error %q, want no hand and an error containing %q", hand, transcription.Error, tt.wantErrSubstr)
+			}
+
+			for _, r := range requests {
+				if len(r.Attachments) != 1 || !bytes.Equal(r.Attachments[0].Data, attachments[0].Data) || r.Temperature != 0 ||
+					r.ResponseSchema == nil || r.ResponseSchema.Name != entity.ResponseSchemaHandTranscription.Name {
+					t.Errorf("request = %+v, want the image with the transcription schema", r)
+				}
+			}
+			// 書き起こし直すときは、先の書き起こしと検証に通らなかった理由を伝える
+			if tt.wantAttempts > 1 {
+				retry := requests[1]
+				if len(retry.Context) != 1 || !strings.Contains(retry.Context[0], "書き起こしの検証") {
+					t.Errorf("retry context = %q, want the repair instruction", retry.Context)
+				}
+			}
+		})
+	}
+}
+
+func TestTranscribeHandRepairContext(t *testing.T) {
+	transcription := &entity.HandTranscription{Hand: "123m456p1122z", Melds: []entity.MeldNotation{{Type: "pon", Tiles: "567s"}}}
+	got := transcriptionRepairContext(transcription, entity.ErrInvalidHand)
+	if !strings.Contains(got, "123m456p1122z pon:567s") || !strings.Contains(got, entity.ErrInvalidHand.Error()) {
+		t.Errorf("transcriptionRepairContext() = %q, want the notation and the reason", got)
+	}
+}
+
+func TestTranscribeHandError(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	repo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+		return nil, errUnavailable
+	}}
+	u := newTestAIUsecase(t, repo, infrastructure.NewMemoryGameLogRepository(time.Hour))
+
+	// AIのエラーは書き起こし直さずにそのまま返す
+	transcription, hand, _, err := u.transcribeHand(context.Background(), []entity.Attachment{{Data: []byte("png"), MIMEType: "image/png"}})
+	if !errors.Is(err, errUnavailable) || transcription != nil || hand != nil || repo.calls != 1 {
+		t.Errorf("transcribeHand() = %+v, %v, %v with %d calls, want the AI error without retry", transcription, hand, err, repo.calls)
+	}
+}
@@ -0,0 +1,630 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+)
+
+// CoachEventKind は対局の出来事の種類
+type CoachEventKind int
+
+const (
+	CoachGameStart  CoachEventKind = iota + 1 // 対局の開始
+	CoachRoundStart                           // 局の開始
+	CoachDraw                                 // ツモ（嶺上牌を含む）
+	CoachDiscard                              // 打牌
+	CoachCall                                 // 副露
+	CoachRiichi                               // 立直の宣言
+	CoachDora                                 // 槓ドラの表示
+	CoachRoundEnd                             // 局の終了
+)
+
+// CoachEvent は対局の出来事（Kind によって使う項目が異なる）
+type CoachEvent struct {
+	Kind CoachEventKind
+	// Player は行動したプレイヤー（起家からの席順）
+	Player int
+	// Tiles は牌（MPSZ表記、0 は赤5）
+	// ツモ牌（自分のみ）・打牌・副露の牌（鳴いた牌を含む。加槓は加えた1枚）・ドラ表示牌
+	Tiles     string
+	Tsumogiri bool
+	MeldType  mahjong.MeldType
+
+	// 対局の開始: Self は自分の席、Explain は推奨のたびにAIに解説させるか
+	Self        int
+	PlayerNames []string
+	Explain     bool
+	Persona     string
+
+	// 局の開始: Kyoku は1始まり、Hand は自分の配牌（ドラ表示牌は Tiles）
+	RoundWind    mahjong.Tile
+	Kyoku        int
+	Honba        int
+	RiichiSticks int
+	Scores       []int
+	Hand         string
+}
+
+// CoachExplainInput は解説の依頼
+type CoachExplainInput struct {
+	// Question は質問（空の場合は直前の推奨を解説する）
+	Question string
+}
+
+// CoachInput はコーチングの入力（Event と Explain のどちらか1つ）
+type CoachInput struct {
+	Event   *CoachEvent
+	Explain *CoachExplainInput
+}
+
+// CoachAction は推奨する行動の種類
+type CoachAction int
+
+const (
+	CoachActionDiscard CoachAction = iota + 1 // 打牌
+	CoachActionRiichi                         // 立直（Tile は宣言牌）
+	CoachActionTsumo                          // ツモ和了
+	CoachActionRon                            // ロン和了
+)
+
+// CoachAdvice はエンジンによる推奨
+type CoachAdvice struct {
+	Action CoachAction
+	Tile   mahjong.Tile
+	// Reason は推奨の理由の要約（和了の場合は空）
+	Reason string
+	// State は推奨した局面（自分の視点）
+	State *mahjong.GameState
+}
+
+// CoachWarningKind は警告の種類
+type CoachWarningKind int
+
+const (
+	CoachWarningOpponentTenpai CoachWarningKind = iota + 1 // 他家が聴牌している可能性が高い
+	CoachWarningFuriten                                    // 自分がフリテン
+	CoachWarningNoYaku                                     // 自分の聴牌にロンで和了できる役がない
+)
+
+// CoachWarning は状況の変化の警告
+type CoachWarning struct {
+	Kind CoachWarningKind
+	// Player は対象のプレイヤー（起家からの席順）
+	Player  int
+	Message string
+}
+
+// CoachExplanation はAIによる解説の一部
+type CoachExplanation struct {
+	ID   string
+	Text string
+	// Done は解説の最後か、Cancelled は新しい入力が届いたため打ち切ったか
+	Done      bool
+	Cancelled bool
+	// Error は解説の作成に失敗した理由
+	Error string
+}
+
+// CoachOutput はコーチングの出力（いずれか1つ）
+// Err は入力を処理できなかった場合のエラーで、ストリームは続く
+type CoachOutput struct {
+	Advice      *CoachAdvice
+	Warning     *CoachWarning
+	Explanation *CoachExplanation
+	Err         error
+}
+
+// CoachUsecase は対局の出来事を受け取りながら、推奨・警告・解説を返す
+// 局面の追跡と行動の判断は mjai の対局と共通にする
+type CoachUsecase struct {
+	mjaiUsecase *MjaiUsecase
+	logger      *logrus.Logger
+}
+
+// NewCoachUsecase は新しいCoachUsecaseを作成する
+func NewCoachUsecase(mjaiUsecase *MjaiUsecase, logger *logrus.Logger) *CoachUsecase {
+	return &CoachUsecase{
+		mjaiUsecase: mjaiUsecase,
+		logger:      logger,
+	}
+}
+
+// coachSession は1つのストリームの対局
+type coachSession struct {
+	u       *CoachUsecase
+	mjai    *MjaiSession
+	outputs chan<- *CoachOutput
+	// explain・persona は対局の開始で指定された解説の設定
+	explain bool
+	persona string
+	// riichi は立直を宣言し、宣言牌をまだ切っていないプレイヤー
+	riichi map[int]bool
+	// tenpai は局の中で聴牌の警告を送った他家
+	tenpai map[int]bool
+	// furiten・noYaku は自分がフリテン・役なしの聴牌として警告済みか
+	furiten bool
+	noYaku  bool
+	// lastAdvice は直前の推奨（解説の対象）
+	lastAdvice *mjaiDecision
+	// generation は作成中の解説
+	generation *coachGeneration
+}
+
+// coachGeneration は作成中の解説
+type coachGeneration struct {
+	id     string
+	cancel context.CancelFunc
+	done   chan struct{}
+	// finished は解説を最後まで送ったか（done の close 後に参照する）
+	finished bool
+}
+
+// Coach は入力を順に処理し、推奨・警告・解説を出力する
+// 新しい入力が届くと作成中の解説を打ち切る。inputs が閉じられると作成中の解説を待って終了する
+func (u *CoachUsecase) Coach(ctx context.Context, inputs <-chan CoachInput) (<-chan *CoachOutput, <-chan error) {
+	outputs := make(chan *CoachOutput)
+	errChan := make(chan error, 1)
+	s := &coachSession{
+		u:       u,
+		mjai:    u.mjaiUsecase.NewSession(),
+		outputs: outputs,
+		riichi:  make(map[int]bool),
+		tenpai:  make(map[int]bool),
+	}
+
+	go func() {
+		defer close(outputs)
+		defer close(errChan)
+		defer s.waitGeneration()
+
+		for {
+			select {
+			case input, ok := <-inputs:
+				if !ok {
+					return
+				}
+				if err := s.handle(ctx, input); err != nil {
+					errChan <- err
+					return
+				}
+			case <-ctx.Done():
+				errChan <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return outputs, errChan
+}
+
+// handle は1つの入力を処理する（返すエラーはストリームの終了を表す）
+func (s *coachSession) handle(ctx context.Context, input CoachInput) error {
+	// 新しい出来事・依頼が届いたら作成中の解説を打ち切る
+	if err := s.cancelGeneration(ctx); err != nil {
+		return err
+	}
+	switch {
+	case input.Event != nil:
+		return s.applyEvent(ctx, input.Event)
+	case input.Explain != nil:
+		return s.explainOnDemand(ctx, input.Explain.Question)
+	default:
+		return s.send(ctx, &CoachOutput{Err: fmt.Errorf("%w: event or explain is required", entity.ErrInvalidRequest)})
+	}
+}
+
+// applyEvent は出来事を局面に適用し、推奨と警告を出力する
+func (s *coachSession) applyEvent(ctx context.Context, event *CoachEvent) error {
+	events, err := s.toMjai(event)
+	if err == nil {
+		for _, e := range events {
+			if err = s.mjai.game.Apply(e); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		s.u.logger.WithError(err).Warn("Failed to apply coach event")
+		return s.send(ctx, &CoachOutput{Err: err})
+	}
+
+	switch event.Kind {
+	case CoachGameStart:
+		s.explain = event.Explain
+		s.persona = event.Persona
+		s.u.logger.WithFields(logrus.Fields{
+			"self":    s.mjai.game.Self(),
+			"explain": s.explain,
+		}).Info("Coach game started")
+	case CoachRoundStart:
+		s.riichi = make(map[int]bool)
+		s.tenpai = make(map[int]bool)
+		s.furiten, s.noYaku = false, false
+		s.lastAdvice = nil
+	case CoachRiichi:
+		s.riichi[event.Player] = true
+	case CoachDiscard:
+		delete(s.riichi, event.Player)
+	}
+
+	warnings, err := s.warnings()
+	if err != nil {
+		return s.send(ctx, &CoachOutput{Err: err})
+	}
+	for _, w := range warnings {
+		if err := s.send(ctx, &CoachOutput{Warning: w}); err != nil {
+			return err
+		}
+	}
+
+	// 自分の立直の宣言には続く打牌で応じるため推奨しない
+	if events[0].Type == gamelog.MjaiReach {
+		return nil
+	}
+	decision, err := s.mjai.decide(events[0])
+	if err != nil {
+		return s.send(ctx, &CoachOutput{Err: err})
+	}
+	advice := s.advice(decision)
+	if advice == nil {
+		return nil
+	}
+	s.lastAdvice = decision
+	if err := s.send(ctx, &CoachOutput{Advice: advice}); err != nil {
+		return err
+	}
+	if s.explain && decision.reason != "" {
+		s.startGeneration(ctx, advicePrompt(decision.reason))
+	}
+	return nil
+}
+
+// toMjai は出来事を mjai のイベントにする
+// 他家の手牌とツモ牌は分からないため ? とし、立直の宣言牌の打牌には reach_accepted を続ける
+func (s *coachSession) toMjai(event *CoachEvent) ([]*gamelog.MjaiMessage, error) {
+	actor := event.Player
+	switch event.Kind {
+	case CoachGameStart:
+		self := event.Self
+		return []*gamelog.MjaiMessage{{Type: gamelog.MjaiStartGame, ID: &self, Names: event.PlayerNames}}, nil
+
+	case CoachRoundStart:
+		if !event.RoundWind.IsWind() {
+			return nil, fmt.Errorf("%w: round wind %s is not a wind", entity.ErrInvalidRequest, event.RoundWind)
+		}
+		hand, err := gamelog.MjaiTiles(event.Hand)
+		if err != nil {
+			return nil, err
+		}
+		dora, err := singleMjaiTile(event.Tiles)
+		if err != nil {
+			return nil, err
+		}
+		self := s.mjai.game.Self()
+		tehais := make([][]string, s.mjai.game.RuleSet().Players)
+		for i := range tehais {
+			if i == self {
+				tehais[i] = hand
+				continue
+			}
+			tehais[i] = make([]string, len(hand))
+			for j := range tehais[i] {
+				tehais[i][j] = "?"
+			}
+		}
+		return []*gamelog.MjaiMessage{{
+			Type:       gamelog.MjaiStartKyoku,
+			Bakaze:     gamelog.MjaiWind(event.RoundWind),
+			DoraMarker: dora,
+			Kyoku:      event.Kyoku,
+			Honba:      event.Honba,
+			Kyotaku:    event.RiichiSticks,
+			Oya:        event.Kyoku - 1,
+			Scores:     event.Scores,
+			Tehais:     tehais,
+		}}, nil
+
+	case CoachDraw:
+		msg := gamelog.NewMjaiAction(gamelog.MjaiTsumo, actor)
+		msg.Pai = "?"
+		if actor == s.mjai.game.Self() {
+			pai, err := singleMjaiTile(event.Tiles)
+			if err != nil {
+				return nil, err
+			}
+			msg.Pai = pai
+		}
+		return []*gamelog.MjaiMessage{msg}, nil
+
+	case CoachDiscard:
+		pai, err := singleMjaiTile(event.Tiles)
+		if err != nil {
+			return nil, err
+		}
+		msg := gamelog.NewMjaiAction(gamelog.MjaiDahai, actor)
+		msg.Pai = pai
+		msg.Tsumogiri = &event.Tsumogiri
+		events := []*gamelog.MjaiMessage{msg}
+		if s.riichi[actor] {
+			events = append(events, gamelog.NewMjaiAction(gamelog.MjaiReachAccept, actor))
+		}
+		return events, nil
+
+	case CoachCall:
+		return s.callToMjai(event)
+
+	case CoachRiichi:
+		return []*gamelog.MjaiMessage{gamelog.NewMjaiAction(gamelog.MjaiReach, actor)}, nil
+
+	case CoachDora:
+		dora, err := singleMjaiTile(event.Tiles)
+		if err != nil {
+			return nil, err
+		}
+		return []*gamelog.MjaiMessage{{Type: gamelog.MjaiDora, DoraMarker: dora}}, nil
+
+	case CoachRoundEnd:
+		return []*gamelog.MjaiMessage{{Type: gamelog.MjaiEndKyoku}}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown coach event %d", entity.ErrInvalidRequest, event.Kind)
+}
+
+// callToMjai は副露を mjai のイベントにする（チー・ポン・大明槓は直前の打牌を鳴いた牌とする）
+func (s *coachSession) callToMjai(event *CoachEvent) ([]*gamelog.MjaiMessage, error) {
+	msg := &gamelog.MjaiMessage{Actor: &event.Player}
+	switch event.MeldType {
+	case mahjong.MeldAnkan:
+		pais, err := gamelog.MjaiTiles(event.Tiles)
+		if err != nil {
+			return nil, err
+		}
+		msg.Type = gamelog.MjaiAnkan
+		msg.Consumed = pais
+		return []*gamelog.MjaiMessage{msg}, nil
+	case mahjong.MeldKakan:
+		pai, err := singleMjaiTile(event.Tiles)
+		if err != nil {
+			return nil, err
+		}
+		msg.Type = gamelog.MjaiKakan
+		msg.Pai = pai
+		return []*gamelog.MjaiMessage{msg}, nil
+	case mahjong.MeldChi:
+		msg.Type = gamelog.MjaiChi
+	case mahjong.MeldPon:
+		msg.Type = gamelog.MjaiPon
+	case mahjong.MeldMinkan:
+		msg.Type = gamelog.MjaiDaiminkan
+	default:
+		return nil, fmt.Errorf("%w: unknown meld type %d", entity.ErrInvalidRequest, event.MeldType)
+	}
+
+	target, called, ok := s.mjai.game.LastDiscard()
+	if !ok {
+		return nil, fmt.Errorf("%w: no discard to call", entity.ErrInvalidRequest)
+	}
+	pais, err := gamelog.MjaiTiles(event.Tiles)
+	if err != nil {
+		return nil, err
+	}
+	calledPai, err := singleMjaiTile(called.String())
+	if err != nil {
+		return nil, err
+	}
+	for i, p := range pais {
+		if strings.TrimSuffix(p, "r") == calledPai {
+			msg.Target = &target
+			msg.Pai = p
+			msg.Consumed = append(append([]string{}, pais[:i]...), pais[i+1:]...)
+			return []*gamelog.MjaiMessage{msg}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: call tiles %s do not include the discarded %s", entity.ErrInvalidRequest, event.Tiles, called)
+}
+
+// singleMjaiTile は1枚の牌（MPSZ表記）を mjai の表記にする
+func singleMjaiTile(s string) (string, error) {
+	pais, err := gamelog.MjaiTiles(s)
+	if err != nil {
+		return "", err
+	}
+	if len(pais) != 1 {
+		return "", fmt.Errorf("%w: expected 1 tile, got %q", entity.ErrInvalidRequest, s)
+	}
+	return pais[0], nil
+}
+
+// advice は決めた行動を推奨にする（何もしない場合は nil）
+func (s *coachSession) advice(decision *mjaiDecision) *CoachAdvice {
+	advice := &CoachAdvice{Tile: decision.tile, Reason: decision.reason}
+	switch decision.action.Type {
+	case gamelog.MjaiDahai:
+		advice.Action = CoachActionDiscard
+	case gamelog.MjaiReach:
+		advice.Action = CoachActionRiichi
+	case gamelog.MjaiHora:
+		advice.Action = CoachActionRon
+		if *decision.action.Target == s.mjai.game.Self() {
+			advice.Action = CoachActionTsumo
+		}
+	default:
+		return nil
+	}
+	advice.State = s.mjai.game.State()
+	return advice
+}
+
+// warnings は新たに当てはまった警告を返す
+// 他家の聴牌は局ごとに1回、自分のフリテン・役なしは当てはまるようになったときに送る
+func (s *coachSession) warnings() ([]*CoachWarning, error) {
+	state := s.mjai.game.State()
+	if state == nil {
+		return nil, nil
+	}
+	self := s.mjai.game.Self()
+	var warnings []*CoachWarning
+	for i := range state.Players {
+		if i == self || s.tenpai[i] {
+			continue
+		}
+		if signal := state.Players[i].TenpaiSignal(); signal != "" {
+			s.tenpai[i] = true
+			warnings = append(warnings, &CoachWarning{
+				Kind:    CoachWarningOpponentTenpai,
+				Player:  i,
+				Message: fmt.Sprintf("%sが聴牌している可能性が高い（%s）", playerLabel(state, i), signal),
+			})
+		}
+	}
+
+	hand, err := state.SelfHand()
+	if err != nil {
+		return nil, err
+	}
+	// 打牌の前（14枚）は直前の判定を保つ
+	if hand == nil || len(hand.Concealed)%3 != 1 {
+		return warnings, nil
+	}
+	var waits []mahjong.Tile
+	furiten, noYaku := false, false
+	if mahjong.Shanten(hand.ConcealedCounts(), len(hand.Melds)) == 0 {
+		analysis, err := mahjong.AnalyzeWaits(hand, state.WinContext(), state.Seen(), s.mjai.game.RuleSet())
+		if err != nil {
+			return nil, err
+		}
+		waits = analysis.WaitTiles()
+		furiten = len(waits) > 0 && mahjong.CheckFuriten(waits, s.mjai.game.Furiten()).IsFuriten()
+		noYaku = len(analysis.Waits) > 0
+		for _, w := range analysis.Waits {
+			noYaku = noYaku && w.NoYakuRon()
+		}
+	}
+	if furiten && !s.furiten {
+		warnings = append(warnings, &CoachWarning{
+			Kind:    CoachWarningFuriten,
+			Player:  self,
+			Message: fmt.Sprintf("フリテンのためロン和了できない（待ち: %s）", mahjong.FormatTiles(waits)),
+		})
+	}
+	if noYaku && !s.noYaku {
+		warnings = append(warnings, &CoachWarning{
+			Kind:    CoachWarningNoYaku,
+			Player:  self,
+			Message: fmt.Sprintf("聴牌しているがロンで和了できる役がない（待ち: %s）", mahjong.FormatTiles(waits)),
+		})
+	}
+	s.furiten, s.noYaku = furiten, noYaku
+	return warnings, nil
+}
+
+// playerLabel はプレイヤーを自風と名前で表記する（例: 南家 Bob）
+func playerLabel(state *mahjong.GameState, i int) string {
+	p := state.Players[i]
+	label := string([]rune("東南西北")[p.Seat-mahjong.East]) + "家"
+	if p.Name != "" {
+		label += " " + p.Name
+	}
+	return label
+}
+
+// explainOnDemand は質問（空の場合は直前の推奨）についての解説を開始する
+func (s *coachSession) explainOnDemand(ctx context.Context, question string) error {
+	if !s.mjai.game.InRound() {
+		return s.send(ctx, &CoachOutput{Err: fmt.Errorf("%w: no round in progress", entity.ErrInvalidRequest)})
+	}
+	prompt := question
+	if prompt == "" {
+		if s.lastAdvice == nil || s.lastAdvice.reason == "" {
+			return s.send(ctx, &CoachOutput{Err: fmt.Errorf("%w: no advice to explain", entity.ErrInvalidRequest)})
+		}
+		prompt = advicePrompt(s.lastAdvice.reason)
+	}
+	s.startGeneration(ctx, prompt)
+	return nil
+}
+
+// startGeneration は現在の局面についての解説の作成をバックグラウンドで開始する
+func (s *coachSession) startGeneration(ctx context.Context, prompt string) {
+	genCtx, cancel := context.WithCancel(ctx)
+	g := &coachGeneration{id: uuid.New().String(), cancel: cancel, done: make(chan struct{})}
+	// 局面は以降の出来事で変わるため、開始時点の局面で質問する
+	input := s.mjai.explanationInput(prompt, s.persona)
+	s.generation = g
+	go func() {
+		defer close(g.done)
+		defer cancel()
+		g.finished = s.streamExplanation(genCtx, g.id, input)
+	}()
+}
+
+// streamExplanation は解説を届いた順に出力し、最後まで出力できたかを返す
+// AIのエラーは解説のエラーとして出力し、ストリームは続ける
+func (s *coachSession) streamExplanation(ctx context.Context, id string, input AskInput) bool {
+	responseChan, errChan := s.u.mjaiUsecase.aiUsecase.AskMahjongAIStream(ctx, input)
+	for {
+		select {
+		case response, ok := <-responseChan:
+			if !ok {
+				return s.send(ctx, &CoachOutput{Explanation: &CoachExplanation{ID: id, Done: true}}) == nil
+			}
+			if response.Response == "" {
+				continue
+			}
+			if err := s.send(ctx, &CoachOutput{Explanation: &CoachExplanation{ID: id, Text: response.Response}}); err != nil {
+				return false
+			}
+		case err := <-errChan:
+			if ctx.Err() != nil {
+				return false
+			}
+			explanation := &CoachExplanation{ID: id, Done: true}
+			if err != nil {
+				s.u.logger.WithError(err).Warn("Failed to explain coach advice")
+				explanation.Error = err.Error()
+			}
+			return s.send(ctx, &CoachOutput{Explanation: explanation}) == nil
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+// cancelGeneration は作成中の解説を打ち切り、最後まで出力していなければ打ち切ったことを出力する
+func (s *coachSession) cancelGeneration(ctx context.Context) error {
+	g := s.generation
+	if g == nil {
+		return nil
+	}
+	s.generation = nil
+	g.cancel()
+	<-g.done
+	if g.finished {
+		return nil
+	}
+	s.u.logger.WithField("explanation_id", g.id).Debug("Coach explanation cancelled")
+	return s.send(ctx, &CoachOutput{Explanation: &CoachExplanation{ID: g.id, Done: true, Cancelled: true}})
+}
+
+// waitGeneration は作成中の解説が終わるのを待つ
+func (s *coachSession) waitGeneration() {
+	if s.generation != nil {
+		<-s.generation.done
+	}
+}
+
+// send は出力を送る（ctx がキャンセルされた場合はエラーを返す）
+func (s *coachSession) send(ctx context.Context, output *CoachOutput) error {
+	select {
+	case s.outputs <- output:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
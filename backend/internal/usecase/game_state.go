@@ -0,0 +1,228 @@
+package usecase
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+)
+
+// gameStateCandidates は局面の解析に含める打牌候補・安全牌の数
+const gameStateCandidates = 3
+
+// DiscardedTileInput は河の1枚の入力
+type DiscardedTileInput struct {
+	Tile      string // 1枚のMPSZ表記
+	Tsumogiri bool
+	Called    bool
+	Riichi    bool
+}
+
+// PlayerStateInput はプレイヤーの状況の入力
+type PlayerStateInput struct {
+	// HandInput の Hand は分かる場合のみ（空の場合は副露だけを解析する）
+	HandInput
+	Seat     mahjong.Tile
+	Name     string
+	Score    int
+	Discards []DiscardedTileInput
+}
+
+// GameStateInput は局の状況の入力
+type GameStateInput struct {
+	RoundWind      mahjong.Tile
+	Kyoku          int
+	Honba          int
+	RiichiSticks   int
+	DoraIndicators string // MPSZ表記
+	Players        []PlayerStateInput
+	// Self は質問者の自風（-1 の場合は観戦者）
+	Self          mahjong.Tile
+	WallRemaining int
+}
+
+// parseGameState は局の状況の入力を解析し、ルールセットに照らして検証する
+func parseGameState(input *GameStateInput, rules mahjong.RuleSet) (*mahjong.GameState, error) {
+	dora, err := parseTiles(input.DoraIndicators)
+	if err != nil {
+		return nil, err
+	}
+	state := &mahjong.GameState{
+		RoundWind:      input.RoundWind,
+		Kyoku:          input.Kyoku,
+		Honba:          input.Honba,
+		RiichiSticks:   input.RiichiSticks,
+		DoraIndicators: dora,
+		Self:           input.Self,
+		WallRemaining:  input.WallRemaining,
+	}
+	for _, p := range input.Players {
+		melds, meldRed, err := parseMelds(p.Melds)
+		if err != nil {
+			return nil, err
+		}
+		hand, red, err := mahjong.ParseTiles(p.Hand)
+		if err != nil {
+			return nil, err
+		}
+		player := mahjong.PlayerState{
+			Seat:    p.Seat,
+			Name:    p.Name,
+			Score:   p.Score,
+			Hand:    hand,
+			AkaDora: red + meldRed,
+			Melds:   melds,
+		}
+		for _, d := range p.Discards {
+			tile, err := mahjong.ParseTile(d.Tile)
+			if err != nil {
+				return nil, err
+			}
+			player.Discards = append(player.Discards, mahjong.DiscardedTile{
+				Tile:      tile,
+				Tsumogiri: d.Tsumogiri,
+				Called:    d.Called,
+				Riichi:    d.Riichi,
+			})
+		}
+		state.Players = append(state.Players, player)
+	}
+	if err := state.Validate(rules); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+// gameStateContext は局面をAIに渡すコンテキストにする
+// 質問者の手牌が分かる場合は、エンジンによる解析結果を付け加える
+func gameStateContext(state *mahjong.GameState, rules mahjong.RuleSet) (string, error) {
+	text := state.Render()
+	lines, err := analyzeGameState(state, rules)
+	if err != nil {
+		return "", err
+	}
+	if len(lines) > 0 {
+		text += "\n\n【エンジンによる解析】\n" + strings.Join(lines, "\n")
+	}
+	return text, nil
+}
+
+// analyzeGameState は質問者の手牌を麻雀エンジンで解析し、結果を1行ずつ返す
+// 13枚の場合は向聴数と待ち、14枚の場合は打牌候補、立直者がいる場合は安全牌と押し引きを解析する
+func analyzeGameState(state *mahjong.GameState, rules mahjong.RuleSet) ([]string, error) {
+	hand, err := state.SelfHand()
+	if err != nil || hand == nil {
+		return nil, err
+	}
+	winCtx := state.WinContext()
+	seen := state.Seen()
+	discards := state.Player(state.Self).DiscardTiles()
+	drawn := len(hand.Concealed)%3 == 2
+
+	var lines []string
+	if drawn {
+		analysis, err := mahjong.RecommendDiscard(hand, seen, discards, winCtx, mahjong.SimulationOptions{}, rules)
+		if err != nil {
+			return nil, err
+		}
+		candidates := make([]string, 0, gameStateCandidates)
+		for i, c := range analysis.Candidates {
+			if i >= gameStateCandidates {
+				break
+			}
+			candidates = append(candidates, fmt.Sprintf("打%s（%s 受け入れ%d枚）", c.Tile, shantenName(c.Shanten), c.Ukeire.Total))
+		}
+		lines = append(lines, "打牌候補（牌効率順）: "+strings.Join(candidates, " "))
+	} else {
+		line, err := describeWaits(hand, winCtx, seen, discards, rules)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+	}
+
+	opponents := state.Opponents()
+	riichi := false
+	for _, o := range opponents {
+		riichi = riichi || o.IsRiichi()
+	}
+	if !riichi {
+		return lines, nil
+	}
+
+	safety, err := mahjong.AssessSafety(hand, opponents, state.Visible())
+	if err != nil {
+		return nil, err
+	}
+	safest := make([]string, 0, gameStateCandidates)
+	for i, s := range safety {
+		if i >= gameStateCandidates {
+			break
+		}
+		safest = append(safest, fmt.Sprintf("%s（放銃率%s）", s.Tile, percent(s.Risk)))
+	}
+	lines = append(lines, "立直者に対する安全牌: "+strings.Join(safest, " "))
+
+	if drawn {
+		analysis, err := mahjong.EvaluatePushFold(mahjong.PushFoldInput{
+			Hand:      hand,
+			Opponents: opponents,
+			Visible:   state.Visible(),
+			Discards:  discards,
+			Context:   winCtx,
+			Score:     state.ScoreSituation(),
+		}, rules)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]string, 0, len(analysis.Options))
+		for _, o := range analysis.Options {
+			options = append(options, fmt.Sprintf("%s: 打%s 和了率%s 放銃率%s 収支%+d点",
+				o.Action, o.Discard, percent(o.WinRate), percent(o.DealInRate), int(math.Round(o.ExpectedValue))))
+		}
+		lines = append(lines, fmt.Sprintf("押し引き: %sを推奨（%s）", analysis.Recommendation, strings.Join(options, " / ")))
+	}
+	return lines, nil
+}
+
+// describeWaits は13枚の手牌の向聴数、聴牌している場合は待ちを表記する
+func describeWaits(hand *mahjong.Hand, winCtx mahjong.WinContext, seen, discards []mahjong.Tile, rules mahjong.RuleSet) (string, error) {
+	if shanten := mahjong.Shanten(hand.ConcealedCounts(), len(hand.Melds)); shanten > 0 {
+		return "向聴数: " + shantenName(shanten), nil
+	}
+	analysis, err := mahjong.AnalyzeWaits(hand, winCtx, seen, rules)
+	if err != nil {
+		return "", err
+	}
+	if len(analysis.Waits) == 0 {
+		return "聴牌: 和了牌をすべて自分で使っている（形式聴牌）", nil
+	}
+	waits := make([]string, 0, len(analysis.Waits))
+	for _, w := range analysis.Waits {
+		s := fmt.Sprintf("%s（残り%d枚", w.Tile, w.Remaining)
+		if w.NoYakuRon() {
+			s += "、ロンは役なし"
+		}
+		waits = append(waits, s+"）")
+	}
+	line := fmt.Sprintf("聴牌: %s待ち %s", analysis.Shape, strings.Join(waits, " "))
+	furiten := mahjong.CheckFuriten(analysis.WaitTiles(), mahjong.FuritenInput{Discards: discards})
+	if furiten.IsFuriten() {
+		line += "（フリテン）"
+	}
+	return line, nil
+}
+
+// shantenName は向聴数を表記する
+func shantenName(shanten int) string {
+	if shanten == 0 {
+		return "聴牌"
+	}
+	return fmt.Sprintf("%d向聴", shanten)
+}
+
+// percent は確率を百分率で表記する
+func percent(p float64) string {
+	return fmt.Sprintf("%.0f%%", p*100)
+}
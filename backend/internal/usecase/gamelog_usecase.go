@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// GameLogFormat は牌譜の形式
+type GameLogFormat int
+
+const (
+	// GameLogFormatAuto は内容から形式を判定する
+	GameLogFormatAuto GameLogFormat = iota
+	// GameLogFormatTenhouJSON は天鳳のJSON形式
+	GameLogFormatTenhouJSON
+	// GameLogFormatMjlog は天鳳のXML形式（mjlog）
+	GameLogFormatMjlog
+)
+
+// ImportGameLogInput は牌譜の取り込みの入力
+type ImportGameLogInput struct {
+	Format GameLogFormat
+	Data   []byte
+}
+
+// GameLogPosition は牌譜の中の打牌の判断の位置
+type GameLogPosition struct {
+	GameID string
+	// Round と Step はいずれも0始まり
+	Round int
+	Step  int
+}
+
+// GameLogStepOutput は牌譜の打牌の判断
+type GameLogStepOutput struct {
+	Game  *gamelog.Game
+	Round int
+	Index int
+	Step  *gamelog.Step
+}
+
+// GameLogUsecase は牌譜の取り込みと再生を管理する
+type GameLogUsecase struct {
+	gameLogRepo repository.GameLogRepository
+	logger      *logrus.Logger
+}
+
+// NewGameLogUsecase は新しいGameLogUsecaseを作成する
+func NewGameLogUsecase(gameLogRepo repository.GameLogRepository, logger *logrus.Logger) *GameLogUsecase {
+	return &GameLogUsecase{
+		gameLogRepo: gameLogRepo,
+		logger:      logger,
+	}
+}
+
+// ImportGameLog は牌譜を解析して保存する
+func (u *GameLogUsecase) ImportGameLog(ctx context.Context, input ImportGameLogInput) (*gamelog.Game, error) {
+	u.logger.WithFields(logrus.Fields{
+		"format": input.Format,
+		"size":   len(input.Data),
+	}).Info("ImportGameLog request received")
+
+	game, err := parseGameLog(input)
+	if err != nil {
+		return nil, err
+	}
+	if err := game.Validate(); err != nil {
+		return nil, err
+	}
+	game.ID = uuid.New().String()
+	if err := u.gameLogRepo.Save(ctx, game); err != nil {
+		return nil, err
+	}
+
+	u.logger.WithFields(logrus.Fields{
+		"game_id": game.ID,
+		"rounds":  len(game.Rounds),
+		"sanma":   game.Sanma,
+	}).Info("Game log imported")
+	return game, nil
+}
+
+// GetGameLogStep は牌譜の打牌の判断とその直前の局面を返す
+func (u *GameLogUsecase) GetGameLogStep(ctx context.Context, position *GameLogPosition) (*GameLogStepOutput, error) {
+	game, step, err := loadGameLogStep(ctx, u.gameLogRepo, position)
+	if err != nil {
+		return nil, err
+	}
+	return &GameLogStepOutput{Game: game, Round: position.Round, Index: position.Step, Step: step}, nil
+}
+
+// parseGameLog は形式に応じて牌譜を解析する
+func parseGameLog(input ImportGameLogInput) (*gamelog.Game, error) {
+	format := input.Format
+	if format == GameLogFormatAuto {
+		// JSON はオブジェクト、mjlog は XML の要素から始まる
+		switch data := bytes.TrimSpace(input.Data); {
+		case bytes.HasPrefix(data, []byte("{")):
+			format = GameLogFormatTenhouJSON
+		case bytes.HasPrefix(data, []byte("<")):
+			format = GameLogFormatMjlog
+		default:
+			return nil, fmt.Errorf("%w: format cannot be detected", entity.ErrInvalidGameLog)
+		}
+	}
+	switch format {
+	case GameLogFormatTenhouJSON:
+		return gamelog.ParseTenhouJSON(input.Data)
+	case GameLogFormatMjlog:
+		return gamelog.ParseMjlog(input.Data)
+	default:
+		return nil, fmt.Errorf("%w: unknown game log format %d", entity.ErrInvalidRequest, format)
+	}
+}
+
+// loadGameLogStep は保存された牌譜から打牌の判断を取り出す
+func loadGameLogStep(ctx context.Context, repo repository.GameLogRepository, position *GameLogPosition) (*gamelog.Game, *gamelog.Step, error) {
+	if position == nil || position.GameID == "" {
+		return nil, nil, fmt.Errorf("%w: game id is required", entity.ErrInvalidRequest)
+	}
+	game, err := repo.Get(ctx, position.GameID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if game == nil {
+		return nil, nil, fmt.Errorf("%w: %s", entity.ErrGameLogNotFound, position.GameID)
+	}
+	step, err := game.Step(position.Round, position.Step)
+	if err != nil {
+		return nil, nil, err
+	}
+	return game, step, nil
+}
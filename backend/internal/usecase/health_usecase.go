@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// HealthStatus はAIプロバイダの健康状態のスナップショット
+type HealthStatus struct {
+	Serving   bool
+	Message   string
+	CheckedAt time.Time
+}
+
+// HealthUsecase はAIプロバイダの健康状態をバックグラウンドで確認し、結果をキャッシュする
+type HealthUsecase struct {
+	aiRepo   repository.AIRepository
+	interval time.Duration
+	timeout  time.Duration
+	logger   *logrus.Logger
+
+	mu        sync.RWMutex
+	status    HealthStatus
+	listeners []func(HealthStatus)
+}
+
+// NewHealthUsecase は新しいHealthUsecaseを作成する
+func NewHealthUsecase(aiRepo repository.AIRepository, interval, timeout time.Duration, logger *logrus.Logger) *HealthUsecase {
+	return &HealthUsecase{
+		aiRepo:   aiRepo,
+		interval: interval,
+		timeout:  timeout,
+		logger:   logger,
+		status: HealthStatus{
+			Serving: false,
+			Message: "health check has not run yet",
+		},
+	}
+}
+
+// Run はctxがキャンセルされるまで一定間隔でヘルスチェックを実行する
+func (u *HealthUsecase) Run(ctx context.Context) {
+	u.probe(ctx)
+
+	ticker := time.NewTicker(u.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			u.probe(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Status はキャッシュされた最新の健康状態を返す
+func (u *HealthUsecase) Status() HealthStatus {
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.status
+}
+
+// Subscribe は健康状態が更新されるたびに呼ばれるコールバックを登録する
+func (u *HealthUsecase) Subscribe(fn func(HealthStatus)) {
+	u.mu.Lock()
+	u.listeners = append(u.listeners, fn)
+	status := u.status
+	u.mu.Unlock()
+
+	// 登録時点の状態を通知
+	fn(status)
+}
+
+// probe はAIプロバイダに問い合わせて結果をキャッシュする
+func (u *HealthUsecase) probe(ctx context.Context) {
+	probeCtx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	status := HealthStatus{
+		Serving:   true,
+		Message:   "Service is healthy",
+		CheckedAt: time.Now(),
+	}
+	if err := u.aiRepo.HealthCheck(probeCtx); err != nil {
+		if ctx.Err() != nil {
+			// シャットダウン中の失敗は状態に反映しない
+			return
+		}
+		status.Serving = false
+		status.Message = err.Error()
+	}
+
+	u.mu.Lock()
+	changed := u.status.Serving != status.Serving
+	u.status = status
+	listeners := append([]func(HealthStatus){}, u.listeners...)
+	u.mu.Unlock()
+
+	if changed {
+		entry := u.logger.WithField("serving", status.Serving)
+		if status.Serving {
+			entry.Info("AI provider health status changed")
+		} else {
+			entry.WithField("message", status.Message).Warn("AI provider health status changed")
+		}
+	} else {
+		u.logger.WithField("serving", status.Serving).Debug("AI provider health probed")
+	}
+
+	for _, fn := range listeners {
+		fn(status)
+	}
+}
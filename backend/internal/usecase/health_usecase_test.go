@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+	"github.com/sirupsen/logrus"
+)
+
+// stubAIRepository は応答とヘルスチェックの結果を差し替えられるAIRepository
+type stubAIRepository struct {
+	mu        sync.Mutex
+	healthErr error
+	ask       func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error)
+	calls     int
+}
+
+func (r *stubAIRepository) AskAI(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+	r.mu.Lock()
+	r.calls++
+	ask := r.ask
+	r.mu.Unlock()
+	if ask == nil {
+		return entity.NewAIResponse(""), nil
+	}
+	return ask(ctx, request)
+}
+
+func (r *stubAIRepository) AskAIStream(ctx context.Context, request *entity.AIRequest) (<-chan *entity.AIResponse, <-chan error) {
+	// GeminiClient と同じく、応答を送り終えてからチャネルを閉じる
+	responseChan := make(chan *entity.AIResponse)
+	errorChan := make(chan error, 1)
+	go func() {
+		defer close(responseChan)
+		defer close(errorChan)
+		response, err := r.AskAI(ctx, request)
+		if err != nil {
+			errorChan <- err
+			return
+		}
+		select {
+		case responseChan <- response:
+		case <-ctx.Done():
+		}
+	}()
+	return responseChan, errorChan
+}
+
+func (r *stubAIRepository) HealthCheck(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.healthErr
+}
+
+func (r *stubAIRepository) setHealthErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.healthErr = err
+}
+
+// newTestAIUsecase は講師のペルソナだけを持ち、回答を検証しないAIUsecaseを作成する
+func newTestAIUsecase(t *testing.T, aiRepo *stubAIRepository, gameLogRepo repository.GameLogRepository) *AIUsecase {
+	t.Helper()
+	persona, err := entity.NewPersona("teacher", "講師", "あなたは麻雀の講師です。")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewAIUsecase(aiRepo, infrastructure.NewMemoryConversationRepository(time.Hour), gameLogRepo, PromptSettings{
+		Personas:       map[string]*entity.Persona{"teacher": persona},
+		DefaultPersona: "teacher",
+		Verification:   entity.VerificationOff,
+	}, newTestLogger())
+}
+
+func newTestLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func TestHealthUsecaseProbe(t *testing.T) {
+	repo := &stubAIRepository{}
+	u := NewHealthUsecase(repo, time.Hour, time.Second, newTestLogger())
+	if u.Status().Serving {
+		t.Fatal("Status().Serving = true before the first probe")
+	}
+
+	var notified []HealthStatus
+	u.Subscribe(func(s HealthStatus) { notified = append(notified, s) })
+
+	tests := []struct {
+		name        string
+		err         error
+		wantServing bool
+		wantMessage string
+	}{
+		{name: "正常", wantServing: true, wantMessage: "Service is healthy"},
+		{name: "異常", err: errors.New("quota exceeded"), wantServing: false, wantMessage: "quota exceeded"},
+		{name: "回復", wantServing: true, wantMessage: "Service is healthy"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo.setHealthErr(tt.err)
+			u.probe(context.Background())
+			got := u.Status()
+			if got.Serving != tt.wantServing || got.Message != tt.wantMessage {
+				t.Errorf("Status() = %+v, want serving %v message %q", got, tt.wantServing, tt.wantMessage)
+			}
+			if got.CheckedAt.IsZero() {
+				t.Error("Status().CheckedAt is zero")
+			}
+		})
+	}
+
+	// 登録時の通知と各プローブの通知
+	if len(notified) != len(tests)+1 {
+		t.Fatalf("listener called %d times, want %d", len(notified), len(tests)+1)
+	}
+	if notified[0].Serving {
+		t.Error("initial notification reports serving")
+	}
+}
+
+func TestHealthUsecaseIgnoresFailureDuringShutdown(t *testing.T) {
+	repo := &stubAIRepository{}
+	u := NewHealthUsecase(repo, time.Hour, time.Second, newTestLogger())
+	u.probe(context.Background())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	repo.setHealthErr(context.Canceled)
+	u.probe(ctx)
+
+	if got := u.Status(); !got.Serving {
+		t.Errorf("Status() = %+v, want the previous serving status to be kept", got)
+	}
+}
+
+func TestHealthUsecaseRun(t *testing.T) {
+	repo := &stubAIRepository{}
+	u := NewHealthUsecase(repo, 10*time.Millisecond, time.Second, newTestLogger())
+
+	probed := make(chan HealthStatus, 16)
+	u.Subscribe(func(s HealthStatus) {
+		select {
+		case probed <- s:
+		default:
+		}
+	})
+	<-probed // 登録時の通知
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		u.Run(ctx)
+		close(done)
+	}()
+
+	// 初回と定期実行の2回分のプローブを待つ
+	for range 2 {
+		select {
+		case s := <-probed:
+			if !s.Serving {
+				t.Errorf("probe status = %+v, want serving", s)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("probe did not run")
+		}
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Run did not return after cancel")
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/sirupsen/logrus"
+)
+
+// citationSnippetLength は引用に含める抜粋の本文の最大文字数
+const citationSnippetLength = 80
+
+// SetKnowledgeBase は質問に関係するルールの資料を検索するユースケースを設定する（nil の場合は検索しない）
+func (u *AIUsecase) SetKnowledgeBase(knowledgeBase *KnowledgeUsecase) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.knowledgeBase = knowledgeBase
+}
+
+// retrieve は質問に関係するルールの資料の抜粋を検索する
+func (u *AIUsecase) retrieve(ctx context.Context, prompt string) []knowledge.SearchResult {
+	u.mu.RLock()
+	knowledgeBase, topK := u.knowledgeBase, u.settings.KnowledgeTopK
+	u.mu.RUnlock()
+	if knowledgeBase == nil || topK <= 0 {
+		return nil
+	}
+	results := knowledgeBase.Retrieve(ctx, prompt, topK)
+	u.logger.WithFields(logrus.Fields{
+		"passages": len(results),
+		"top_k":    topK,
+	}).Debug("Knowledge retrieved")
+	return results
+}
+
+// knowledgeContext は検索した抜粋をAIに渡すコンテキストにする
+func knowledgeContext(results []knowledge.SearchResult) string {
+	var b strings.Builder
+	b.WriteString("【参考資料】以下は質問に関係する可能性があるルールの資料の抜粋です。")
+	b.WriteString("ルールや役について回答する場合は資料に基づき、根拠にした抜粋を [1] のように番号で示してください。関係のない抜粋は無視してください。\n")
+	for i, r := range results {
+		fmt.Fprintf(&b, "\n[%d] %s\n%s\n", i+1, r.Passage.Label(), r.Passage.Text)
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// citations は検索した抜粋を引用に変換し、回答が番号を示して引用したかを記録する
+func citations(results []knowledge.SearchResult, answer string) []entity.Citation {
+	if len(results) == 0 {
+		return nil
+	}
+	cited := make([]entity.Citation, 0, len(results))
+	for i, r := range results {
+		cited = append(cited, entity.Citation{
+			Document: r.Passage.Document,
+			Title:    r.Passage.Title,
+			Section:  r.Passage.Section,
+			Snippet:  knowledge.Snippet(r.Passage.Text, citationSnippetLength),
+			Score:    float32(r.Score),
+			Cited:    strings.Contains(answer, fmt.Sprintf("[%d]", i+1)),
+		})
+	}
+	return cited
+}
+
+// citeStream はストリーミングの回答をそのまま流し、最後に引用を送る
+func citeStream(ctx context.Context, responseChan <-chan *entity.AIResponse, errChan <-chan error, results []knowledge.SearchResult) (<-chan *entity.AIResponse, <-chan error) {
+	if len(results) == 0 {
+		return responseChan, errChan
+	}
+
+	out := make(chan *entity.AIResponse)
+	outErr := make(chan error, 1)
+	go func() {
+		defer close(outErr)
+		defer close(out)
+
+		var text strings.Builder
+		for response := range responseChan {
+			text.WriteString(response.Response)
+			select {
+			case out <- response:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if err := <-errChan; err != nil {
+			outErr <- err
+			return
+		}
+		select {
+		case out <- &entity.AIResponse{Citations: citations(results, text.String())}:
+		case <-ctx.Done():
+		}
+	}()
+	return out, outErr
+}
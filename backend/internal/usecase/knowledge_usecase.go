@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// minLexicalCandidates は全文検索とベクトル検索の順位をまとめる前に全文検索で取り出す抜粋の最小数
+const minLexicalCandidates = 20
+
+// KnowledgeIndexOutput は資料の索引の作り直しの結果
+type KnowledgeIndexOutput struct {
+	// Passages は索引に含まれる抜粋の数
+	Passages int
+	// Embedded は埋め込みベクトルを新たに求めた抜粋の数
+	Embedded int
+	// Reused は本文が変わっておらず、保存済みのベクトルを使った抜粋の数
+	Reused int
+	// Model は埋め込みモデルの名前（埋め込みを使わない場合は空）
+	Model    string
+	Duration time.Duration
+}
+
+// KnowledgeUsecase はルールの資料の索引と検索を管理する
+//
+// 全文検索（BM25）の索引は起動時に資料から作り、埋め込みベクトルの索引は保存済みのものを読み込む。
+// 埋め込みを使える場合は、言い換えた質問にもヒットするよう全文検索とベクトル検索の順位をまとめる。
+type KnowledgeUsecase struct {
+	knowledgeRepo repository.KnowledgeRepository
+	vectorRepo    repository.VectorIndexRepository
+	// embeddingRepo は埋め込みベクトルを求めるサービス（nil の場合は全文検索のみ）
+	embeddingRepo repository.EmbeddingRepository
+	logger        *logrus.Logger
+
+	mu      sync.RWMutex
+	lexical *knowledge.Index
+	vectors *knowledge.VectorIndex
+	// reindexMu は索引の作り直しが同時に実行されないようにする
+	reindexMu sync.Mutex
+}
+
+// NewKnowledgeUsecase は新しいKnowledgeUsecaseを作成する（embeddingRepo が nil の場合は全文検索のみ）
+func NewKnowledgeUsecase(knowledgeRepo repository.KnowledgeRepository, vectorRepo repository.VectorIndexRepository, embeddingRepo repository.EmbeddingRepository, logger *logrus.Logger) *KnowledgeUsecase {
+	return &KnowledgeUsecase{
+		knowledgeRepo: knowledgeRepo,
+		vectorRepo:    vectorRepo,
+		embeddingRepo: embeddingRepo,
+		logger:        logger,
+		lexical:       knowledge.NewIndex(nil),
+	}
+}
+
+// Load は資料を読み込んで全文検索の索引を作り、保存済みの埋め込みベクトルの索引を読み込む
+// 埋め込みベクトルの索引がない場合や、埋め込みモデルが異なる場合は全文検索のみを使う
+func (u *KnowledgeUsecase) Load(ctx context.Context) (KnowledgeIndexOutput, error) {
+	passages, err := u.knowledgeRepo.Passages(ctx)
+	if err != nil {
+		return KnowledgeIndexOutput{}, err
+	}
+	lexical := knowledge.NewIndex(passages)
+
+	var vectors *knowledge.VectorIndex
+	output := KnowledgeIndexOutput{Passages: len(passages)}
+	if u.embeddingRepo != nil {
+		if vectors, err = u.vectorRepo.Load(ctx); err != nil {
+			return KnowledgeIndexOutput{}, err
+		}
+		if vectors != nil && vectors.Model != u.embeddingRepo.Model() {
+			u.logger.WithFields(logrus.Fields{
+				"index_model":     vectors.Model,
+				"embedding_model": u.embeddingRepo.Model(),
+			}).Warn("Vector index was built with a different embedding model, ignoring it until reindexed")
+			vectors = nil
+		}
+		if vectors != nil {
+			output.Model = vectors.Model
+			for _, p := range passages {
+				if _, ok := vectors.Lookup(p); ok {
+					output.Reused++
+				}
+			}
+		}
+	}
+
+	u.mu.Lock()
+	u.lexical, u.vectors = lexical, vectors
+	u.mu.Unlock()
+	return output, nil
+}
+
+// Retrieve は質問に関係する抜粋を最大 k 件返す
+//
+// 埋め込みベクトルの索引がある場合は全文検索とベクトル検索の順位を Reciprocal Rank Fusion でまとめる。
+// 質問の埋め込みに失敗した場合は全文検索の結果だけを返す。
+func (u *KnowledgeUsecase) Retrieve(ctx context.Context, query string, k int) []knowledge.SearchResult {
+	if k <= 0 {
+		return nil
+	}
+	u.mu.RLock()
+	lexical, vectors := u.lexical, u.vectors
+	u.mu.RUnlock()
+
+	if vectors == nil || len(vectors.Entries) == 0 {
+		return lexical.Search(query, k)
+	}
+	candidates := max(4*k, minLexicalCandidates)
+	lexicalResults := lexical.Search(query, candidates)
+	queryVector, err := u.embeddingRepo.EmbedQuery(ctx, query)
+	if err != nil {
+		u.logger.WithError(err).Warn("Failed to embed query, falling back to lexical search")
+		if len(lexicalResults) > k {
+			lexicalResults = lexicalResults[:k]
+		}
+		return lexicalResults
+	}
+	semanticResults := vectors.Search(lexical.Passages(), queryVector, candidates)
+	u.logger.WithFields(logrus.Fields{
+		"lexical":  len(lexicalResults),
+		"semantic": len(semanticResults),
+	}).Debug("Hybrid knowledge search")
+	return knowledge.FuseRankings(k, lexicalResults, semanticResults)
+}
+
+// Reindex は資料を読み込み直し、全文検索の索引と埋め込みベクトルの索引を作り直して保存する
+// full が false の場合は、本文が変わっていない抜粋に保存済みのベクトルを使う
+func (u *KnowledgeUsecase) Reindex(ctx context.Context, full bool) (KnowledgeIndexOutput, error) {
+	u.reindexMu.Lock()
+	defer u.reindexMu.Unlock()
+
+	start := time.Now()
+	passages, err := u.knowledgeRepo.Passages(ctx)
+	if err != nil {
+		return KnowledgeIndexOutput{}, err
+	}
+	lexical := knowledge.NewIndex(passages)
+	output := KnowledgeIndexOutput{Passages: len(passages)}
+	if u.embeddingRepo == nil {
+		u.mu.Lock()
+		u.lexical = lexical
+		u.mu.Unlock()
+		output.Duration = time.Since(start)
+		return output, nil
+	}
+
+	model := u.embeddingRepo.Model()
+	var previous *knowledge.VectorIndex
+	if !full {
+		if previous, err = u.vectorRepo.Load(ctx); err != nil {
+			return KnowledgeIndexOutput{}, err
+		}
+		if previous != nil && previous.Model != model {
+			previous = nil
+		}
+	}
+
+	entries := make([]knowledge.VectorEntry, len(passages))
+	var pending []int
+	for i, p := range passages {
+		entries[i] = knowledge.VectorEntry{PassageID: p.ID, Hash: p.Hash()}
+		if vector, ok := previous.Lookup(p); ok {
+			entries[i].Vector = vector
+			output.Reused++
+			continue
+		}
+		pending = append(pending, i)
+	}
+	if len(pending) > 0 {
+		targets := make([]knowledge.Passage, len(pending))
+		for j, i := range pending {
+			targets[j] = passages[i]
+		}
+		vectors, err := u.embeddingRepo.EmbedPassages(ctx, targets)
+		if err != nil {
+			return KnowledgeIndexOutput{}, err
+		}
+		if len(vectors) != len(targets) {
+			return KnowledgeIndexOutput{}, fmt.Errorf("embedding returned %d vectors for %d passages", len(vectors), len(targets))
+		}
+		for j, i := range pending {
+			entries[i].Vector = vectors[j]
+		}
+		output.Embedded = len(pending)
+	}
+
+	index := &knowledge.VectorIndex{Model: model, Entries: entries}
+	if err := u.vectorRepo.Save(ctx, index); err != nil {
+		return KnowledgeIndexOutput{}, err
+	}
+	u.mu.Lock()
+	u.lexical, u.vectors = lexical, index
+	u.mu.Unlock()
+
+	output.Model = model
+	output.Duration = time.Since(start)
+	u.logger.WithFields(logrus.Fields{
+		"passages": output.Passages,
+		"embedded": output.Embedded,
+		"reused":   output.Reused,
+		"model":    output.Model,
+		"duration": output.Duration,
+	}).Info("Knowledge base reindexed")
+	return output, nil
+}
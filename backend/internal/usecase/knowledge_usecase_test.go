@@ -0,0 +1,67 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/knowledge"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+	"github.com/sirupsen/logrus"
+)
+
+// staticKnowledgeRepository は固定の抜粋を返す
+type staticKnowledgeRepository struct {
+	passages []knowledge.Passage
+}
+
+func (r *staticKnowledgeRepository) Passages(ctx context.Context) ([]knowledge.Passage, error) {
+	return append([]knowledge.Passage(nil), r.passages...), nil
+}
+
+func TestKnowledgeReindexReusesEmbeddings(t *testing.T) {
+	ctx := context.Background()
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	repo := &staticKnowledgeRepository{passages: []knowledge.Passage{
+		{ID: "yaku.md#1", Title: "役", Section: "立直", Text: "門前で聴牌したときに立直を宣言できる。"},
+		{ID: "yaku.md#2", Title: "役", Section: "平和", Text: "門前で4面子が順子、両面待ちの手。"},
+		{ID: "rules.md#1", Title: "ルール", Section: "振聴", Text: "自分の捨て牌に和了牌がある場合はロンできない。"},
+	}}
+	vectorRepo := infrastructure.NewFileVectorIndexRepository(filepath.Join(t.TempDir(), "vectors.json"))
+	u := NewKnowledgeUsecase(repo, vectorRepo, infrastructure.NewLocalEmbeddingClient(128), logger)
+
+	reindex := func(full bool, wantEmbedded, wantReused int) {
+		t.Helper()
+		output, err := u.Reindex(ctx, full)
+		if err != nil {
+			t.Fatalf("Reindex: %v", err)
+		}
+		if output.Passages != len(repo.passages) || output.Embedded != wantEmbedded || output.Reused != wantReused {
+			t.Errorf("Reindex(full=%v) = %d passages, %d embedded, %d reused, want %d, %d, %d",
+				full, output.Passages, output.Embedded, output.Reused, len(repo.passages), wantEmbedded, wantReused)
+		}
+	}
+	reindex(false, 3, 0)
+	reindex(false, 0, 3)
+
+	// 本文を変えた抜粋と追加した抜粋だけ求め直す
+	repo.passages[1].Text += "雀頭は役牌でない。"
+	repo.passages = append(repo.passages, knowledge.Passage{ID: "rules.md#2", Title: "ルール", Section: "流局", Text: "山がなくなると流局する。"})
+	reindex(false, 2, 2)
+	reindex(true, 4, 0)
+
+	// 読み込み直しても保存した索引を使う
+	loaded := NewKnowledgeUsecase(repo, vectorRepo, infrastructure.NewLocalEmbeddingClient(128), logger)
+	output, err := loaded.Load(ctx)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if output.Reused != 4 {
+		t.Errorf("Load reused %d vectors, want 4", output.Reused)
+	}
+	if results := loaded.Retrieve(ctx, "振聴でロンできない", 1); len(results) != 1 || results[0].Passage.ID != "rules.md#1" {
+		t.Errorf("Retrieve = %v, want rules.md#1", results)
+	}
+}
@@ -0,0 +1,327 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// mjai の hello に応答する名前と部屋
+const (
+	mjaiPlayerName = "mahjong-ai"
+	mjaiRoom       = "default"
+)
+
+// 立直できる条件（持ち点と山の残り枚数）
+const (
+	riichiMinScore = 1000
+	riichiMinWall  = 4
+)
+
+// 1つの接続でAIに解説させる頻度の上限（超えた分は解説せずに行動だけを返す）
+const (
+	mjaiExplainInterval = 10 * time.Second
+	mjaiExplainBurst    = 3
+)
+
+// MjaiUsecase は mjai プロトコルの対局で、局面を追跡してエンジンの推奨する行動を返す
+type MjaiUsecase struct {
+	aiUsecase *AIUsecase
+	logger    *logrus.Logger
+}
+
+// NewMjaiUsecase は新しいMjaiUsecaseを作成する
+func NewMjaiUsecase(aiUsecase *AIUsecase, logger *logrus.Logger) *MjaiUsecase {
+	return &MjaiUsecase{
+		aiUsecase: aiUsecase,
+		logger:    logger,
+	}
+}
+
+// mjaiDecision はイベントに対して決めた行動
+type mjaiDecision struct {
+	action *gamelog.MjaiMessage
+	// tile は打牌・和了の牌（立直では宣言牌）
+	tile mahjong.Tile
+	// reason は解説に使う推奨の理由（解説しない場合は空）
+	reason string
+}
+
+// noAction は何もしない行動を返す
+func noAction() *mjaiDecision {
+	return &mjaiDecision{action: gamelog.NewMjaiNone()}
+}
+
+// MjaiSession は1つの接続の対局
+type MjaiSession struct {
+	u    *MjaiUsecase
+	game *gamelog.MjaiSession
+	// explain・persona は start_game で指定されたAIによる解説の設定
+	explain bool
+	persona string
+	// explainLimiter は接続ごとの解説の頻度を制限する
+	explainLimiter *rate.Limiter
+}
+
+// NewSession は新しい接続の対局を始める
+func (u *MjaiUsecase) NewSession() *MjaiSession {
+	return &MjaiSession{
+		u:              u,
+		game:           gamelog.NewMjaiSession(),
+		explainLimiter: rate.NewLimiter(rate.Every(mjaiExplainInterval), mjaiExplainBurst),
+	}
+}
+
+// Handle はイベントを順に適用し、最後のイベントに対する行動を返す
+// 解説が有効な場合は、自分の打牌・立直を決めた後、行動を返す前に解説を少しずつ explain に渡す
+// 解説の頻度が接続ごとの上限を超えた場合は解説せずに行動だけを返す
+func (s *MjaiSession) Handle(ctx context.Context, events []*gamelog.MjaiMessage, explain func(text string) error) (*gamelog.MjaiMessage, error) {
+	if len(events) == 0 {
+		return gamelog.NewMjaiNone(), nil
+	}
+	for _, e := range events {
+		if e.Type == gamelog.MjaiStartGame {
+			s.explain = e.Explain
+			s.persona = e.Persona
+		}
+		if err := s.game.Apply(e); err != nil {
+			return nil, err
+		}
+		if e.Type == gamelog.MjaiStartGame {
+			s.u.logger.WithFields(logrus.Fields{
+				"self":    s.game.Self(),
+				"explain": s.explain,
+			}).Info("mjai game started")
+		}
+	}
+
+	last := events[len(events)-1]
+	decision, err := s.decide(last)
+	if err != nil {
+		return nil, err
+	}
+	s.u.logger.WithFields(logrus.Fields{
+		"event":  last.Type,
+		"action": decision.action.Type,
+		"pai":    decision.action.Pai,
+	}).Debug("mjai action decided")
+
+	if decision.reason != "" && s.explain && explain != nil {
+		if !s.explainLimiter.Allow() {
+			s.u.logger.WithField("event", last.Type).Debug("mjai explanation skipped by rate limit")
+		} else if err := s.explainAction(ctx, decision.reason, explain); err != nil {
+			return nil, err
+		}
+	}
+	return decision.action, nil
+}
+
+// decide はイベントに対する行動を決める
+func (s *MjaiSession) decide(last *gamelog.MjaiMessage) (*mjaiDecision, error) {
+	self := s.game.Self()
+	if last.Type == gamelog.MjaiHello {
+		return &mjaiDecision{action: &gamelog.MjaiMessage{Type: gamelog.MjaiJoin, Name: mjaiPlayerName, Room: mjaiRoom}}, nil
+	}
+	if !s.game.InRound() || last.Actor == nil {
+		return noAction(), nil
+	}
+
+	switch last.Type {
+	case gamelog.MjaiTsumo, gamelog.MjaiReach, gamelog.MjaiChi, gamelog.MjaiPon:
+		if *last.Actor == self {
+			return s.ownTurn(last)
+		}
+	case gamelog.MjaiDahai:
+		if *last.Actor != self {
+			return s.ron(last)
+		}
+	}
+	return noAction(), nil
+}
+
+// ownTurn は自分のツモ・立直の宣言・鳴きの後の行動を決める
+// 和了できればツモ和了、立直後はツモ切り、それ以外は牌効率（立直者がいる場合は押し引き）で打牌を選び、
+// 門前で聴牌をとれる場合は立直する
+func (s *MjaiSession) ownTurn(last *gamelog.MjaiMessage) (*mjaiDecision, error) {
+	self := s.game.Self()
+	state := s.game.State()
+	hand, err := state.SelfHand()
+	if err != nil {
+		return nil, err
+	}
+	if hand == nil || len(hand.Concealed)%3 != 2 {
+		return noAction(), nil
+	}
+	rules := s.game.RuleSet()
+	winCtx := state.WinContext()
+	player := state.Player(state.Self)
+
+	if drawn, ok := s.game.Drawn(); ok && last.Type == gamelog.MjaiTsumo {
+		if mahjong.Shanten(hand.ConcealedCounts(), len(hand.Melds)) < 0 {
+			tsumoCtx := winCtx
+			tsumoCtx.WinTile = drawn
+			tsumoCtx.Tsumo = true
+			agari, err := mahjong.EvaluateWin(hand, tsumoCtx, rules)
+			if err != nil {
+				return nil, err
+			}
+			if agari.HasYaku() {
+				action := gamelog.NewMjaiAction(gamelog.MjaiHora, self)
+				action.Target = &self
+				action.Pai, _ = s.game.Pai(drawn)
+				return &mjaiDecision{action: action, tile: drawn}, nil
+			}
+		}
+		if player.RiichiTurn() > 0 {
+			return &mjaiDecision{action: s.dahai(drawn), tile: drawn}, nil
+		}
+	}
+
+	discards := player.DiscardTiles()
+	analysis, err := mahjong.RecommendDiscard(hand, state.Seen(), discards, winCtx, mahjong.SimulationOptions{}, rules)
+	if err != nil {
+		return nil, err
+	}
+	// 鳴いた牌と同じ牌はその巡に切れない（喰い替え）
+	forbidden := mahjong.Tile(-1)
+	if last.Type == gamelog.MjaiChi || last.Type == gamelog.MjaiPon {
+		if _, t, ok := s.game.LastDiscard(); ok {
+			forbidden = t
+		}
+	}
+	candidates := make(map[mahjong.Tile]mahjong.DiscardCandidate, len(analysis.Candidates))
+	var best *mahjong.DiscardCandidate
+	for i, c := range analysis.Candidates {
+		candidates[c.Tile] = c
+		if best == nil && c.Tile != forbidden && (!s.game.RiichiDeclared() || c.Shanten == 0) {
+			best = &analysis.Candidates[i]
+		}
+	}
+	if best == nil {
+		return noAction(), nil
+	}
+	choice := best.Tile
+	reason := fmt.Sprintf("打%s（%s 受け入れ%d枚）", best.Tile, shantenName(best.Shanten), best.Ukeire.Total)
+
+	opponents := state.Opponents()
+	riichi := false
+	for _, o := range opponents {
+		riichi = riichi || o.IsRiichi()
+	}
+	if riichi && !s.game.RiichiDeclared() {
+		pushFold, err := mahjong.EvaluatePushFold(mahjong.PushFoldInput{
+			Hand:      hand,
+			Opponents: opponents,
+			Visible:   state.Visible(),
+			Discards:  discards,
+			Context:   winCtx,
+			Score:     state.ScoreSituation(),
+		}, rules)
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range pushFold.Options {
+			if o.Action == pushFold.Recommendation && o.Discard != forbidden {
+				choice = o.Discard
+				reason = fmt.Sprintf("%s・打%s（%s 放銃率%s 和了率%s）",
+					o.Action, o.Discard, shantenName(o.Shanten), percent(o.DiscardRisk), percent(o.WinRate))
+			}
+		}
+	}
+
+	canRiichi := last.Type == gamelog.MjaiTsumo && hand.IsClosed() && player.RiichiTurn() == 0 &&
+		candidates[choice].Shanten == 0 && player.Score >= riichiMinScore && state.WallRemaining >= riichiMinWall
+	if canRiichi {
+		return &mjaiDecision{action: gamelog.NewMjaiAction(gamelog.MjaiReach, self), tile: choice, reason: "立直・" + reason}, nil
+	}
+	return &mjaiDecision{action: s.dahai(choice), tile: choice, reason: reason}, nil
+}
+
+// dahai は自分の手牌から kind の牌を切る行動を返す
+func (s *MjaiSession) dahai(kind mahjong.Tile) *gamelog.MjaiMessage {
+	action := gamelog.NewMjaiAction(gamelog.MjaiDahai, s.game.Self())
+	pai, tsumogiri := s.game.Pai(kind)
+	action.Pai = pai
+	action.Tsumogiri = &tsumogiri
+	return action
+}
+
+// ron は他家の打牌でロン和了できる場合に和了する行動を返す
+// 役がない場合とフリテンの場合は見逃す（槍槓は判断しない）
+func (s *MjaiSession) ron(last *gamelog.MjaiMessage) (*mjaiDecision, error) {
+	target, discarded, ok := s.game.LastDiscard()
+	if !ok {
+		return noAction(), nil
+	}
+	state := s.game.State()
+	hand, err := state.SelfHand()
+	if err != nil {
+		return nil, err
+	}
+	if hand == nil || len(hand.Concealed)%3 != 1 || mahjong.Shanten(hand.ConcealedCounts(), len(hand.Melds)) > 0 {
+		return noAction(), nil
+	}
+	analysis, err := mahjong.AnalyzeWaits(hand, state.WinContext(), state.Seen(), s.game.RuleSet())
+	if err != nil {
+		return nil, err
+	}
+	if mahjong.CheckFuriten(analysis.WaitTiles(), s.game.Furiten()).IsFuriten() {
+		return noAction(), nil
+	}
+	for _, w := range analysis.Waits {
+		if w.Tile == discarded && !w.NoYakuRon() {
+			action := gamelog.NewMjaiAction(gamelog.MjaiHora, s.game.Self())
+			action.Target = &target
+			action.Pai = last.Pai
+			return &mjaiDecision{action: action, tile: discarded}, nil
+		}
+	}
+	return noAction(), nil
+}
+
+// explainAction は推奨する行動の理由をAIに解説させ、届いた順に explain に渡す
+// AIのエラーは対局を止めないよう記録だけして無視し、explain のエラー（接続の切断など）は返す
+func (s *MjaiSession) explainAction(ctx context.Context, reason string, explain func(text string) error) error {
+	responseChan, errChan := s.u.aiUsecase.AskMahjongAIStream(ctx, s.explanationInput(advicePrompt(reason), s.persona))
+	for {
+		select {
+		case response, ok := <-responseChan:
+			if !ok {
+				return nil
+			}
+			if response.Response != "" {
+				if err := explain(response.Response); err != nil {
+					return err
+				}
+			}
+		case err := <-errChan:
+			if err != nil {
+				s.u.logger.WithError(err).Warn("Failed to explain mjai action")
+			}
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// explanationInput は現在の局面についてAIに質問する入力を返す
+func (s *MjaiSession) explanationInput(prompt, persona string) AskInput {
+	return AskInput{
+		Prompt:  prompt,
+		Persona: persona,
+		RuleSet: s.game.RuleSet().Name,
+		state:   s.game.State(),
+	}
+}
+
+// advicePrompt はエンジンの推奨の理由を解説させるプロンプトを返す
+func advicePrompt(reason string) string {
+	return fmt.Sprintf("対局中の局面です。エンジンの推奨: %s\n"+
+		"この判断の理由を、局面の特徴に触れながら簡潔に解説してください。", reason)
+}
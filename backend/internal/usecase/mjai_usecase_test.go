@@ -0,0 +1,147 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+	"golang.org/x/time/rate"
+)
+
+// mjai の対局の始まり（自分は東家で、東を暗刻にできる東と北のシャンポン待ち）
+const (
+	mjaiStartGame  = `{"type":"start_game","id":0,"names":["a","b","c","d"]}`
+	mjaiStartKyoku = `{"type":"start_kyoku","bakaze":"E","dora_marker":"2s","kyoku":1,"honba":0,"kyotaku":0,"oya":0,"scores":[25000,25000,25000,25000],` +
+		`"tehais":[["1m","2m","3m","4p","5p","6p","7s","8s","9s","E","E","N","N"],` +
+		`["?","?","?","?","?","?","?","?","?","?","?","?","?"],["?","?","?","?","?","?","?","?","?","?","?","?","?"],["?","?","?","?","?","?","?","?","?","?","?","?","?"]]}`
+)
+
+// mjaiEvents はJSONのイベントを解析する
+func mjaiEvents(t *testing.T, lines ...string) []*gamelog.MjaiMessage {
+	t.Helper()
+	events := make([]*gamelog.MjaiMessage, 0, len(lines))
+	for _, line := range lines {
+		var e gamelog.MjaiMessage
+		if err := json.Unmarshal([]byte(line), &e); err != nil {
+			t.Fatalf("invalid event %s: %v", line, err)
+		}
+		events = append(events, &e)
+	}
+	return events
+}
+
+func newTestMjaiUsecase(t *testing.T, aiRepo *stubAIRepository) *MjaiUsecase {
+	t.Helper()
+	aiUsecase := newTestAIUsecase(t, aiRepo, infrastructure.NewMemoryGameLogRepository(time.Hour))
+	return NewMjaiUsecase(aiUsecase, newTestLogger())
+}
+
+func TestMjaiSessionHandle(t *testing.T) {
+	tests := []struct {
+		name       string
+		events     []string
+		wantType   string
+		wantPai    string
+		wantTarget int
+		wantErr    error
+	}{
+		{name: "イベントなし", wantType: gamelog.MjaiNone},
+		{name: "hello", events: []string{`{"type":"hello","protocol":"mjsonp","protocol_version":3}`}, wantType: gamelog.MjaiJoin},
+		{name: "対局の開始", events: []string{mjaiStartGame}, wantType: gamelog.MjaiNone},
+		{name: "配牌", events: []string{mjaiStartGame, mjaiStartKyoku}, wantType: gamelog.MjaiNone},
+		{name: "ツモ和了", events: []string{mjaiStartGame, mjaiStartKyoku, `{"type":"tsumo","actor":0,"pai":"E"}`}, wantType: gamelog.MjaiHora, wantPai: "E", wantTarget: 0},
+		{name: "牌の表記が不正", events: []string{mjaiStartGame, mjaiStartKyoku, `{"type":"tsumo","actor":0,"pai":"1z"}`}, wantErr: entity.ErrInvalidGameLog},
+		{name: "他家の打牌でロン和了", events: []string{mjaiStartGame, mjaiStartKyoku, `{"type":"dahai","actor":1,"pai":"E","tsumogiri":true}`}, wantType: gamelog.MjaiHora, wantPai: "E", wantTarget: 1},
+		{name: "役のない牌は見逃す", events: []string{mjaiStartGame, mjaiStartKyoku, `{"type":"dahai","actor":1,"pai":"N","tsumogiri":true}`}, wantType: gamelog.MjaiNone},
+		{name: "待ちでない打牌", events: []string{mjaiStartGame, mjaiStartKyoku, `{"type":"dahai","actor":1,"pai":"9m","tsumogiri":true}`}, wantType: gamelog.MjaiNone},
+		{name: "配牌前のツモ", events: []string{mjaiStartGame, `{"type":"tsumo","actor":0,"pai":"E"}`}, wantErr: entity.ErrInvalidGameLog},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := newTestMjaiUsecase(t, &stubAIRepository{}).NewSession()
+			action, err := session.Handle(context.Background(), mjaiEvents(t, tt.events...), nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Handle() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Handle: %v", err)
+			}
+			if action.Type != tt.wantType {
+				t.Fatalf("Handle() = %+v, want %s", action, tt.wantType)
+			}
+			if action.Type != gamelog.MjaiHora {
+				return
+			}
+			if action.Pai != tt.wantPai || action.Actor == nil || *action.Actor != 0 || action.Target == nil || *action.Target != tt.wantTarget {
+				t.Errorf("Handle() = %+v, want hora on %s from %d", action, tt.wantPai, tt.wantTarget)
+			}
+		})
+	}
+}
+
+func TestMjaiSessionHandleRiichi(t *testing.T) {
+	session := newTestMjaiUsecase(t, &stubAIRepository{}).NewSession()
+	ctx := context.Background()
+	action, err := session.Handle(ctx, mjaiEvents(t, mjaiStartGame, mjaiStartKyoku, `{"type":"tsumo","actor":0,"pai":"C"}`), nil)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if action.Type != gamelog.MjaiReach || action.Actor == nil || *action.Actor != 0 {
+		t.Fatalf("Handle() = %+v, want reach", action)
+	}
+
+	// 立直の宣言に続けて、聴牌を保つツモ切りの白を宣言牌にする
+	action, err = session.Handle(ctx, mjaiEvents(t, `{"type":"reach","actor":0}`), nil)
+	if err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+	if action.Type != gamelog.MjaiDahai || action.Pai != "C" || action.Tsumogiri == nil || !*action.Tsumogiri {
+		t.Errorf("Handle() = %+v, want tsumogiri of C", action)
+	}
+}
+
+func TestMjaiSessionExplainLimit(t *testing.T) {
+	aiRepo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+		return entity.NewAIResponse("聴牌を保つ打牌です。"), nil
+	}}
+	session := newTestMjaiUsecase(t, aiRepo).NewSession()
+	session.explainLimiter = rate.NewLimiter(rate.Every(time.Hour), 1)
+
+	var explanations []string
+	explain := func(text string) error {
+		explanations = append(explanations, text)
+		return nil
+	}
+	start := `{"type":"start_game","id":0,"names":["a","b","c","d"],"explain":true}`
+	if _, err := session.Handle(context.Background(), mjaiEvents(t, start, mjaiStartKyoku), explain); err != nil {
+		t.Fatalf("Handle: %v", err)
+	}
+
+	// 立直の宣言牌は上限を超えるため、解説せずに行動だけを返す
+	for _, tt := range []struct {
+		event string
+		want  string
+	}{
+		{event: `{"type":"tsumo","actor":0,"pai":"C"}`, want: gamelog.MjaiReach},
+		{event: `{"type":"reach","actor":0}`, want: gamelog.MjaiDahai},
+	} {
+		action, err := session.Handle(context.Background(), mjaiEvents(t, tt.event), explain)
+		if err != nil {
+			t.Fatalf("Handle(%s): %v", tt.event, err)
+		}
+		if action.Type != tt.want {
+			t.Fatalf("Handle(%s) = %+v, want %s", tt.event, action, tt.want)
+		}
+	}
+	if len(explanations) != 1 || aiRepo.calls != 1 {
+		t.Errorf("explained %d times with %d AI calls, want once", len(explanations), aiRepo.calls)
+	}
+}
@@ -0,0 +1,281 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/quiz"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// GetQuizInput は何切る問題の取得の入力
+type GetQuizInput struct {
+	// QuizID は出題済みの問題ID（空の場合は Daily に応じて日替わりか新しい問題）
+	QuizID string
+	// Daily は日替わりの問題を返すか
+	Daily bool
+	// RuleSet は新しい問題のルールセット名（空の場合はデフォルト）
+	RuleSet string
+	// UserID は成績を返すユーザーID（空の場合は返さない）
+	UserID string
+}
+
+// GetQuizOutput は何切る問題の取得の結果
+type GetQuizOutput struct {
+	Quiz *quiz.Quiz
+	// Stats はユーザーの成績（UserID を指定しない場合は nil）
+	Stats *quiz.Stats
+}
+
+// SubmitQuizAnswerInput は何切る問題の回答の入力
+type SubmitQuizAnswerInput struct {
+	QuizID string
+	// UserID は回答したユーザーID（空の場合は成績に記録しない）
+	UserID string
+	// Discard は打牌（1枚のMPSZ表記）
+	Discard string
+	// Persona は解説に使うペルソナ名（空の場合はデフォルトのペルソナ）
+	Persona string
+}
+
+// SubmitQuizAnswerOutput は何切る問題の回答の結果
+type SubmitQuizAnswerOutput struct {
+	Quiz  *quiz.Quiz
+	Grade *quiz.Grade
+	// Explanation はAIによる解説（作成に失敗した場合は空）
+	Explanation string
+	// Recorded は成績に記録したか（同じ問題の2回目以降の回答は記録しない）
+	Recorded bool
+	// Stats は回答を記録した後のユーザーの成績（UserID を指定しない場合は nil）
+	Stats *quiz.Stats
+}
+
+// QuizUsecase は何切る問題の出題・採点・成績を管理する
+// 問題は配牌からのシミュレーションで作り、正解と採点はエンジンの評価で決める
+type QuizUsecase struct {
+	quizRepo  repository.QuizRepository
+	aiUsecase *AIUsecase
+	logger    *logrus.Logger
+
+	// dailyMu は同じ日替わりの問題を並行して作らないよう作成を直列にする
+	dailyMu sync.Mutex
+
+	mu             sync.RWMutex
+	defaultRuleSet mahjong.RuleSet
+}
+
+// NewQuizUsecase は新しいQuizUsecaseを作成する
+func NewQuizUsecase(quizRepo repository.QuizRepository, aiUsecase *AIUsecase, defaultRuleSet mahjong.RuleSet, logger *logrus.Logger) *QuizUsecase {
+	return &QuizUsecase{
+		quizRepo:       quizRepo,
+		aiUsecase:      aiUsecase,
+		logger:         logger,
+		defaultRuleSet: defaultRuleSet,
+	}
+}
+
+// SetDefaultRuleSet はデフォルトのルールセットを差し替える
+func (u *QuizUsecase) SetDefaultRuleSet(rules mahjong.RuleSet) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.defaultRuleSet = rules
+}
+
+// GetQuiz は出題済み・日替わり・新しい何切る問題のいずれかを返す
+func (u *QuizUsecase) GetQuiz(ctx context.Context, input GetQuizInput) (*GetQuizOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"quiz_id":  input.QuizID,
+		"daily":    input.Daily,
+		"rule_set": input.RuleSet,
+		"user_id":  input.UserID,
+	}).Info("GetQuiz request received")
+
+	var (
+		q   *quiz.Quiz
+		err error
+	)
+	switch {
+	case input.QuizID != "" && input.Daily:
+		return nil, fmt.Errorf("%w: quiz_id and daily cannot be combined", entity.ErrInvalidRequest)
+	case input.QuizID != "":
+		q, err = u.load(ctx, input.QuizID)
+	default:
+		rules, rulesErr := u.ruleSet(input.RuleSet)
+		if rulesErr != nil {
+			return nil, rulesErr
+		}
+		if input.Daily {
+			id := quiz.DailyID(time.Now().Format(time.DateOnly), rules.Name)
+			q, err = u.load(ctx, id)
+		} else {
+			q, err = u.generate(ctx, uuid.New().String(), rand.Uint64(), "", rules)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetQuizOutput{Quiz: q}
+	if input.UserID != "" {
+		if output.Stats, err = u.Stats(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return output, nil
+}
+
+// SubmitQuizAnswer は回答をエンジンの評価で採点し、ユーザーの成績に記録してAIに解説させる
+// 成績には同じ問題への最初の回答だけを記録する。解説の作成に失敗しても採点結果は返す
+func (u *QuizUsecase) SubmitQuizAnswer(ctx context.Context, input SubmitQuizAnswerInput) (*SubmitQuizAnswerOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"quiz_id": input.QuizID,
+		"user_id": input.UserID,
+		"discard": input.Discard,
+	}).Info("SubmitQuizAnswer request received")
+
+	if input.QuizID == "" {
+		return nil, fmt.Errorf("%w: quiz id is required", entity.ErrInvalidRequest)
+	}
+	if input.Discard == "" {
+		return nil, fmt.Errorf("%w: discard is required", entity.ErrInvalidRequest)
+	}
+	discard, err := mahjong.ParseTile(input.Discard)
+	if err != nil {
+		return nil, err
+	}
+	q, err := u.load(ctx, input.QuizID)
+	if err != nil {
+		return nil, err
+	}
+	grade, err := q.Grade(discard)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &SubmitQuizAnswerOutput{Quiz: q, Grade: grade}
+	if input.UserID != "" {
+		output.Recorded, err = u.quizRepo.SaveAttempt(ctx, quiz.Attempt{
+			UserID:     input.UserID,
+			QuizID:     q.ID,
+			Discard:    discard,
+			Correct:    grade.Correct,
+			Score:      grade.Score,
+			AnsweredAt: time.Now(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if output.Stats, err = u.Stats(ctx, input.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	explanation, err := u.explain(ctx, q, grade, input.Persona)
+	if err != nil {
+		u.logger.WithError(err).WithField("quiz_id", q.ID).Warn("Failed to explain quiz answer")
+	}
+	output.Explanation = explanation
+	return output, nil
+}
+
+// Stats はユーザーの成績を集計する
+func (u *QuizUsecase) Stats(ctx context.Context, userID string) (*quiz.Stats, error) {
+	attempts, err := u.quizRepo.Attempts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return quiz.Summarize(userID, attempts, time.Now()), nil
+}
+
+// load は問題を取得する
+// 期限切れなどで保存されていない日替わりの問題は、今日の前後1日のものに限りIDから同じ問題を作り直す
+// （任意の日付の問題を作らせて作成を直列にする dailyMu を占有させないため）
+func (u *QuizUsecase) load(ctx context.Context, id string) (*quiz.Quiz, error) {
+	q, err := u.quizRepo.Get(ctx, id)
+	if err != nil || q != nil {
+		return q, err
+	}
+	date, ruleSet, ok := quiz.ParseDailyID(id)
+	rules, known := mahjong.LookupRuleSet(ruleSet)
+	if !ok || !known || !quiz.IsCurrentDaily(date, time.Now()) {
+		return nil, fmt.Errorf("%w: %s", entity.ErrQuizNotFound, id)
+	}
+
+	u.dailyMu.Lock()
+	defer u.dailyMu.Unlock()
+	// 待っている間に他のリクエストが作った問題を使う
+	if q, err := u.quizRepo.Get(ctx, id); err != nil || q != nil {
+		return q, err
+	}
+	return u.generate(ctx, id, quiz.DailySeed(id), date, rules)
+}
+
+// generate は新しい問題を作って保存する
+func (u *QuizUsecase) generate(ctx context.Context, id string, seed uint64, date string, rules mahjong.RuleSet) (*quiz.Quiz, error) {
+	start := time.Now()
+	q, err := quiz.Generate(seed, rules)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate quiz: %w", err)
+	}
+	q.ID = id
+	q.Date = date
+	if err := u.quizRepo.Save(ctx, q); err != nil {
+		return nil, err
+	}
+	u.logger.WithFields(logrus.Fields{
+		"quiz_id":  q.ID,
+		"rule_set": q.RuleSet,
+		"hand":     q.Hand,
+		"duration": time.Since(start),
+	}).Info("Quiz generated")
+	return q, nil
+}
+
+// explain は回答した打牌と正解の違いをAIに解説させる
+func (u *QuizUsecase) explain(ctx context.Context, q *quiz.Quiz, grade *quiz.Grade, persona string) (string, error) {
+	result := "不正解"
+	if grade.Correct {
+		result = "正解"
+	}
+	accepted := make([]string, len(q.Accepted))
+	for i, t := range q.Accepted {
+		accepted[i] = "打" + t.String()
+	}
+	prompt := fmt.Sprintf("何切る問題の解説です。%d巡目のツモ後の手牌 %s から、回答者は打%sを選びました（%s、%d点）。\n"+
+		"エンジンの評価: %s\n"+
+		"エンジンが正解とする打牌: %s\n"+
+		"正解の打牌が優れている理由と、回答した打牌との違いを局面に触れながら簡潔に解説してください。",
+		q.Turn, q.Hand, grade.Discard.Tile, result, grade.Score, grade.Evaluation, strings.Join(accepted, "・"))
+	response, err := u.aiUsecase.AskMahjongAI(ctx, AskInput{
+		Prompt:  prompt,
+		Persona: persona,
+		RuleSet: q.RuleSet,
+		state:   q.State,
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.Response, nil
+}
+
+// ruleSet は名前からルールセットを返す（空の場合はデフォルト）
+func (u *QuizUsecase) ruleSet(name string) (mahjong.RuleSet, error) {
+	if name == "" {
+		u.mu.RLock()
+		defer u.mu.RUnlock()
+		return u.defaultRuleSet, nil
+	}
+	rules, ok := mahjong.LookupRuleSet(name)
+	if !ok {
+		return mahjong.RuleSet{}, fmt.Errorf("%w: %q (use one of %s)", entity.ErrUnknownRuleSet, name, strings.Join(mahjong.RuleSetNames(), ", "))
+	}
+	return rules, nil
+}
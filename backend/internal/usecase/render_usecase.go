@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/diagram"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// 図の大きさの制限
+const (
+	// maxRenderScale は図の最大の拡大率
+	maxRenderScale = 4
+	// maxRenderHandTiles は門前の牌とツモ牌の最大枚数
+	maxRenderHandTiles = 14
+	// maxRenderMelds は副露の最大数
+	maxRenderMelds = 4
+	// maxRenderDiscards は河の最大枚数
+	maxRenderDiscards = 30
+	// renderRowGap は河と手牌の間隔
+	renderRowGap = 12
+)
+
+// DiagramFormat は図の形式
+type DiagramFormat int
+
+const (
+	// DiagramFormatSVG は SVG
+	DiagramFormatSVG DiagramFormat = iota
+	// DiagramFormatPNG は PNG
+	DiagramFormatPNG
+)
+
+// RenderInput は図の作成の入力
+// HandInput・Drawn・Discards で手牌と河を、GameState または GameLogPosition で卓全体を描く
+type RenderInput struct {
+	HandInput
+	Drawn    string // ツモ牌（1枚のMPSZ表記）
+	Discards []DiscardedTileInput
+
+	GameState       *GameStateInput
+	GameLogPosition *GameLogPosition
+	RuleSet         string
+
+	Format DiagramFormat
+	// Scale は拡大率（0 の場合は1倍）
+	Scale float64
+}
+
+// RenderOutput は作成した図
+type RenderOutput struct {
+	Data     []byte
+	MIMEType string
+	// Width と Height は拡大後のピクセル数
+	Width  int
+	Height int
+}
+
+// RenderUsecase は手牌・河・局面の図の作成を管理する
+type RenderUsecase struct {
+	gameLogRepo repository.GameLogRepository
+	logger      *logrus.Logger
+
+	mu             sync.RWMutex
+	defaultRuleSet mahjong.RuleSet
+}
+
+// NewRenderUsecase は新しいRenderUsecaseを作成する
+func NewRenderUsecase(gameLogRepo repository.GameLogRepository, defaultRuleSet mahjong.RuleSet, logger *logrus.Logger) *RenderUsecase {
+	return &RenderUsecase{
+		gameLogRepo:    gameLogRepo,
+		logger:         logger,
+		defaultRuleSet: defaultRuleSet,
+	}
+}
+
+// SetDefaultRuleSet はデフォルトのルールセットを差し替える
+func (u *RenderUsecase) SetDefaultRuleSet(rules mahjong.RuleSet) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.defaultRuleSet = rules
+}
+
+// RenderHand は手牌と河、または卓全体の図を作成する
+func (u *RenderUsecase) RenderHand(ctx context.Context, input RenderInput) (*RenderOutput, error) {
+	u.logger.WithFields(logrus.Fields{
+		"hand":     input.Hand,
+		"melds":    len(input.Melds),
+		"discards": len(input.Discards),
+		"board":    input.GameState != nil || input.GameLogPosition != nil,
+		"format":   input.Format,
+	}).Debug("Rendering diagram")
+
+	if input.Scale < 0 || input.Scale > maxRenderScale {
+		return nil, fmt.Errorf("%w: scale %g is out of range (0-%d)", entity.ErrInvalidRequest, input.Scale, maxRenderScale)
+	}
+	scale := input.Scale
+	if scale == 0 {
+		scale = 1
+	}
+
+	board := input.GameState != nil || input.GameLogPosition != nil
+	hand := input.Hand != "" || len(input.Melds) > 0 || input.Drawn != "" || len(input.Discards) > 0
+	var (
+		canvas *diagram.Canvas
+		err    error
+	)
+	switch {
+	case board && hand:
+		return nil, fmt.Errorf("%w: a game state cannot be combined with a hand or discards", entity.ErrInvalidRequest)
+	case board:
+		canvas, err = u.boardCanvas(ctx, input)
+	case hand:
+		canvas, err = handCanvas(input)
+	default:
+		return nil, fmt.Errorf("%w: nothing to render (give a hand, discards or a game state)", entity.ErrInvalidRequest)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := &RenderOutput{
+		Width:  int(float64(canvas.Width())*scale + 0.999),
+		Height: int(float64(canvas.Height())*scale + 0.999),
+	}
+	switch input.Format {
+	case DiagramFormatPNG:
+		output.MIMEType = "image/png"
+		if output.Data, err = canvas.PNG(scale); err != nil {
+			return nil, err
+		}
+	default:
+		output.MIMEType = "image/svg+xml"
+		output.Data = canvas.SVG(scale)
+	}
+	return output, nil
+}
+
+// boardCanvas は局面または牌譜の局面を卓の図にする
+func (u *RenderUsecase) boardCanvas(ctx context.Context, input RenderInput) (*diagram.Canvas, error) {
+	if input.GameState != nil && input.GameLogPosition != nil {
+		return nil, fmt.Errorf("%w: game_state and game_log_position cannot be combined", entity.ErrInvalidRequest)
+	}
+	rules, err := u.ruleSet(input.RuleSet)
+	if err != nil {
+		return nil, err
+	}
+	if input.GameLogPosition != nil {
+		game, step, err := loadGameLogStep(ctx, u.gameLogRepo, input.GameLogPosition)
+		if err != nil {
+			return nil, err
+		}
+		// ルールセットの指定がない場合は牌譜の対局のルールに合わせる
+		if input.RuleSet == "" {
+			rules = game.RuleSet()
+		}
+		return diagram.Board(step.State, rules.Players), nil
+	}
+	state, err := parseGameState(input.GameState, rules)
+	if err != nil {
+		return nil, err
+	}
+	return diagram.Board(state, rules.Players), nil
+}
+
+// ruleSet は名前からルールセットを返す（空の場合はデフォルト）
+func (u *RenderUsecase) ruleSet(name string) (mahjong.RuleSet, error) {
+	if name == "" {
+		u.mu.RLock()
+		defer u.mu.RUnlock()
+		return u.defaultRuleSet, nil
+	}
+	rules, ok := mahjong.LookupRuleSet(name)
+	if !ok {
+		return mahjong.RuleSet{}, fmt.Errorf("%w: %q (use one of %s)", entity.ErrUnknownRuleSet, name, strings.Join(mahjong.RuleSetNames(), ", "))
+	}
+	return rules, nil
+}
+
+// handCanvas は手牌と河を、河を上にして縦に並べた図にする
+// 手牌は枚数を揃える必要はないが、同じ牌は副露とツモ牌を含めて4枚まで
+func handCanvas(input RenderInput) (*diagram.Canvas, error) {
+	concealed, err := diagram.ParseFaces(input.Hand)
+	if err != nil {
+		return nil, err
+	}
+	var drawn *diagram.Face
+	if input.Drawn != "" {
+		faces, err := diagram.ParseFaces(input.Drawn)
+		if err != nil {
+			return nil, err
+		}
+		if len(faces) != 1 {
+			return nil, fmt.Errorf("%w: drawn must be a single tile, got %q", entity.ErrInvalidTileNotation, input.Drawn)
+		}
+		drawn = &faces[0]
+	}
+	if n := len(concealed) + len(drawnFaces(drawn)); n > maxRenderHandTiles {
+		return nil, fmt.Errorf("%w: %d tiles exceed the limit of %d", entity.ErrInvalidHand, n, maxRenderHandTiles)
+	}
+	if len(input.Melds) > maxRenderMelds {
+		return nil, fmt.Errorf("%w: %d melds exceed the limit of %d", entity.ErrInvalidHand, len(input.Melds), maxRenderMelds)
+	}
+	if len(input.Discards) > maxRenderDiscards {
+		return nil, fmt.Errorf("%w: %d discards exceed the limit of %d", entity.ErrInvalidRequest, len(input.Discards), maxRenderDiscards)
+	}
+
+	var counts mahjong.Counts
+	for _, f := range concealed {
+		counts[f.Tile]++
+	}
+	for _, f := range drawnFaces(drawn) {
+		counts[f.Tile]++
+	}
+	melds := make([]diagram.Meld, 0, len(input.Melds))
+	for _, m := range input.Melds {
+		faces, err := diagram.ParseFaces(m.Tiles)
+		if err != nil {
+			return nil, err
+		}
+		tiles := make([]mahjong.Tile, len(faces))
+		for i, f := range faces {
+			tiles[i] = f.Tile
+			counts[f.Tile]++
+		}
+		if _, err := mahjong.NewMeld(m.Type, tiles); err != nil {
+			return nil, err
+		}
+		melds = append(melds, diagram.Meld{Type: m.Type, Faces: faces})
+	}
+	for t, n := range counts {
+		if n > 4 {
+			return nil, fmt.Errorf("%w: %s appears %d times", entity.ErrInvalidHand, mahjong.Tile(t), n)
+		}
+	}
+
+	discards := make([]diagram.Discard, 0, len(input.Discards))
+	for _, d := range input.Discards {
+		faces, err := diagram.ParseFaces(d.Tile)
+		if err != nil {
+			return nil, err
+		}
+		if len(faces) != 1 {
+			return nil, fmt.Errorf("%w: discard must be a single tile, got %q", entity.ErrInvalidTileNotation, d.Tile)
+		}
+		discards = append(discards, diagram.Discard{Face: faces[0], Tsumogiri: d.Tsumogiri, Called: d.Called, Riichi: d.Riichi})
+	}
+
+	var pond, hand *diagram.Canvas
+	if len(discards) > 0 {
+		pond = diagram.Pond(discards)
+	}
+	if len(concealed) > 0 || drawn != nil || len(melds) > 0 {
+		hand = diagram.Hand(concealed, drawn, melds)
+	}
+	return diagram.Column(renderRowGap, pond, hand), nil
+}
+
+// drawnFaces はツモ牌を枚数を数えられるよう0枚か1枚のスライスにする
+func drawnFaces(drawn *diagram.Face) []diagram.Face {
+	if drawn == nil {
+		return nil
+	}
+	return []diagram.Face{*drawn}
+}
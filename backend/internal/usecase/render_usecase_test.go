@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image/png"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+)
+
+// newTestRenderUsecase は天鳳形式の牌譜を保存したRenderUsecaseを作成する
+func newTestRenderUsecase(t *testing.T) (*RenderUsecase, string) {
+	t.Helper()
+	data, err := os.ReadFile("../domain/gamelog/testdata/tenhou.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	game, err := gamelog.ParseTenhouJSON(data)
+	if err != nil {
+		t.Fatalf("ParseTenhouJSON: %v", err)
+	}
+	game.ID = "game-1"
+	gameLogRepo := infrastructure.NewMemoryGameLogRepository(time.Hour)
+	if err := gameLogRepo.Save(context.Background(), game); err != nil {
+		t.Fatal(err)
+	}
+	return NewRenderUsecase(gameLogRepo, mahjong.RuleSetTenhou, newTestLogger()), game.ID
+}
+
+// testRenderGameState は東1局の4人分の局面の入力を返す
+func testRenderGameState() *GameStateInput {
+	return &GameStateInput{
+		RoundWind:      mahjong.East,
+		Kyoku:          1,
+		DoraIndicators: "3p",
+		Players: []PlayerStateInput{
+			{Seat: mahjong.East, Score: 25000, HandInput: HandInput{Hand: "123m406p789s1122z"}},
+			{Seat: mahjong.South, Score: 25000, HandInput: HandInput{Melds: []MeldInput{{Type: mahjong.MeldPon, Tiles: "777z"}}}},
+			{Seat: mahjong.West, Score: 25000, Discards: []DiscardedTileInput{{Tile: "1m", Tsumogiri: true}}},
+			{Seat: mahjong.North, Score: 25000, Discards: []DiscardedTileInput{{Tile: "9s", Riichi: true}}},
+		},
+		Self:          mahjong.East,
+		WallRemaining: 60,
+	}
+}
+
+func TestRenderHand(t *testing.T) {
+	u, gameID := newTestRenderUsecase(t)
+	const handHeight = 44 // 牌の高さと厚み
+	tests := []struct {
+		name       string
+		input      RenderInput
+		wantWidth  int
+		wantHeight int
+	}{
+		{name: "手牌", input: RenderInput{HandInput: HandInput{Hand: "123m456p789s1122z"}}, wantWidth: 13 * 30, wantHeight: handHeight},
+		{name: "ツモ牌と副露", input: RenderInput{
+			HandInput: HandInput{Hand: "123m456p1122z", Melds: []MeldInput{{Type: mahjong.MeldChi, Tiles: "406s"}}},
+			Drawn:     "0m",
+		}, wantWidth: 11*30 + 8 + 10 + 40 + 2*30, wantHeight: handHeight},
+		{name: "河だけ", input: RenderInput{Discards: []DiscardedTileInput{{Tile: "1z"}, {Tile: "9m", Riichi: true}}}, wantWidth: 30 + 40, wantHeight: handHeight},
+		{name: "河と手牌を縦に並べる", input: RenderInput{
+			HandInput: HandInput{Hand: "123m"},
+			Discards:  []DiscardedTileInput{{Tile: "1z"}, {Tile: "2z"}, {Tile: "3z"}, {Tile: "4z"}, {Tile: "5z"}, {Tile: "6z"}, {Tile: "7z"}},
+		}, wantWidth: 6 * 30, wantHeight: 2*40 + 4 + renderRowGap + handHeight},
+		{name: "拡大", input: RenderInput{HandInput: HandInput{Hand: "1z"}, Scale: 2.5}, wantWidth: 75, wantHeight: 110},
+		// 卓の図は大きいため縮小して描く
+		{name: "局面", input: RenderInput{GameState: testRenderGameState(), Scale: 0.25}, wantWidth: 200, wantHeight: 200},
+		{name: "牌譜の局面", input: RenderInput{GameLogPosition: &GameLogPosition{GameID: gameID}, Scale: 0.25}, wantWidth: 200, wantHeight: 200},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, format := range []DiagramFormat{DiagramFormatSVG, DiagramFormatPNG} {
+				input := tt.input
+				input.Format = format
+				output, err := u.RenderHand(context.Background(), input)
+				if err != nil {
+					t.Fatalf("RenderHand(%v): %v", format, err)
+				}
+				if output.Width != tt.wantWidth || output.Height != tt.wantHeight {
+					t.Errorf("RenderHand(%v) = %dx%d, want %dx%d", format, output.Width, output.Height, tt.wantWidth, tt.wantHeight)
+				}
+
+				switch format {
+				case DiagramFormatPNG:
+					img, err := png.Decode(bytes.NewReader(output.Data))
+					if err != nil {
+						t.Fatalf("png.Decode: %v", err)
+					}
+					if size := img.Bounds().Size(); output.MIMEType != "image/png" || size.X != output.Width || size.Y != output.Height {
+						t.Errorf("PNG %s is %v, want %dx%d", output.MIMEType, size, output.Width, output.Height)
+					}
+				default:
+					svg := string(output.Data)
+					if output.MIMEType != "image/svg+xml" || !strings.HasPrefix(svg, "<svg ") || !strings.HasSuffix(svg, "</svg>") {
+						t.Errorf("SVG %s = %.60q...", output.MIMEType, svg)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRenderHandError(t *testing.T) {
+	u, gameID := newTestRenderUsecase(t)
+	tests := []struct {
+		name    string
+		input   RenderInput
+		wantErr error
+	}{
+		{name: "何も描かない", input: RenderInput{}, wantErr: entity.ErrInvalidRequest},
+		{name: "負の拡大率", input: RenderInput{HandInput: HandInput{Hand: "1z"}, Scale: -1}, wantErr: entity.ErrInvalidRequest},
+		{name: "大きすぎる拡大率", input: RenderInput{HandInput: HandInput{Hand: "1z"}, Scale: maxRenderScale + 0.5}, wantErr: entity.ErrInvalidRequest},
+		{name: "局面と手牌", input: RenderInput{HandInput: HandInput{Hand: "1z"}, GameState: testRenderGameState()}, wantErr: entity.ErrInvalidRequest},
+		{name: "局面と牌譜", input: RenderInput{GameState: testRenderGameState(), GameLogPosition: &GameLogPosition{GameID: gameID}}, wantErr: entity.ErrInvalidRequest},
+		{name: "不正な表記", input: RenderInput{HandInput: HandInput{Hand: "123x"}}, wantErr: entity.ErrInvalidTileNotation},
+		{name: "ツモ牌が2枚", input: RenderInput{HandInput: HandInput{Hand: "1z"}, Drawn: "12m"}, wantErr: entity.ErrInvalidTileNotation},
+		{name: "河の1枚に2枚", input: RenderInput{Discards: []DiscardedTileInput{{Tile: "11z"}}}, wantErr: entity.ErrInvalidTileNotation},
+		{name: "ツモ牌を含めて14枚", input: RenderInput{HandInput: HandInput{Hand: "123m456p789s1122z"}, Drawn: "3z"}},
+		{name: "ツモ牌を含めて15枚", input: RenderInput{HandInput: HandInput{Hand: "123m456p789s11223z"}, Drawn: "3z"}, wantErr: entity.ErrInvalidHand},
+		{name: "副露が多すぎる", input: RenderInput{HandInput: HandInput{Melds: []MeldInput{
+			{Type: mahjong.MeldPon, Tiles: "111z"}, {Type: mahjong.MeldPon, Tiles: "222z"}, {Type: mahjong.MeldPon, Tiles: "333z"},
+			{Type: mahjong.MeldPon, Tiles: "444z"}, {Type: mahjong.MeldPon, Tiles: "555z"},
+		}}}, wantErr: entity.ErrInvalidHand},
+		{name: "副露の組み合わせが不正", input: RenderInput{HandInput: HandInput{Melds: []MeldInput{{Type: mahjong.MeldPon, Tiles: "123m"}}}}, wantErr: entity.ErrInvalidHand},
+		{name: "5枚目の牌", input: RenderInput{HandInput: HandInput{Hand: "1111m", Melds: []MeldInput{{Type: mahjong.MeldChi, Tiles: "123m"}}}}, wantErr: entity.ErrInvalidHand},
+		{name: "河が多すぎる", input: RenderInput{Discards: make([]DiscardedTileInput, maxRenderDiscards+1)}, wantErr: entity.ErrInvalidRequest},
+		{name: "不明なルールセット", input: RenderInput{GameState: testRenderGameState(), RuleSet: "unknown"}, wantErr: entity.ErrUnknownRuleSet},
+		{name: "ルールに合わない局面", input: RenderInput{GameState: testRenderGameState(), RuleSet: "sanma"}, wantErr: entity.ErrInvalidGameState},
+		{name: "牌譜がない", input: RenderInput{GameLogPosition: &GameLogPosition{GameID: "missing"}}, wantErr: entity.ErrGameLogNotFound},
+		{name: "牌譜のIDがない", input: RenderInput{GameLogPosition: &GameLogPosition{}}, wantErr: entity.ErrInvalidRequest},
+		{name: "牌譜の局が範囲外", input: RenderInput{GameLogPosition: &GameLogPosition{GameID: gameID, Round: 99}}, wantErr: entity.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, format := range []DiagramFormat{DiagramFormatSVG, DiagramFormatPNG} {
+				input := tt.input
+				input.Format = format
+				output, err := u.RenderHand(context.Background(), input)
+				if tt.wantErr == nil {
+					if err != nil {
+						t.Errorf("RenderHand(%v): %v", format, err)
+					}
+					continue
+				}
+				if !errors.Is(err, tt.wantErr) || output != nil {
+					t.Errorf("RenderHand(%v) = %v, %v, want %v", format, output, err, tt.wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderHandRuleSet(t *testing.T) {
+	u, _ := newTestRenderUsecase(t)
+	state := testRenderGameState()
+	state.Players = state.Players[:3]
+	input := RenderInput{GameState: state}
+
+	// 赤ドラのないデフォルトのルールでは赤5を含む局面を描けない
+	u.SetDefaultRuleSet(mahjong.RuleSetWRC)
+	if _, err := u.RenderHand(context.Background(), input); !errors.Is(err, entity.ErrInvalidGameState) {
+		t.Errorf("RenderHand() with WRC error = %v, want ErrInvalidGameState", err)
+	}
+	u.SetDefaultRuleSet(mahjong.RuleSetSanma)
+	if _, err := u.RenderHand(context.Background(), input); err != nil {
+		t.Errorf("RenderHand() with the sanma default: %v", err)
+	}
+	// 指定したルールセットはデフォルトより優先する
+	input.RuleSet = "tenhou"
+	if _, err := u.RenderHand(context.Background(), input); err != nil {
+		t.Errorf("RenderHand() with tenhou: %v", err)
+	}
+}
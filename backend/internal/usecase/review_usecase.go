@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/sirupsen/logrus"
+)
+
+// 検討で解説する悪手の数
+const (
+	defaultReviewMistakes = 5
+	maxReviewMistakes     = 20
+)
+
+// 検討ジョブの制限
+const (
+	// reviewTimeout は1つの検討の評価と解説の作成にかけられる時間の上限
+	reviewTimeout = 10 * time.Minute
+	// maxConcurrentReviews は同時に実行する検討の最大数（超えた分は開始前のまま順番を待つ）
+	maxConcurrentReviews = 4
+)
+
+// ReviewGameInput は牌譜の検討の入力
+type ReviewGameInput struct {
+	GameID string
+	// Player は検討するプレイヤー（起家からの席順）
+	Player int
+	// TopN は解説する悪手の数（0 の場合は既定値）
+	TopN int
+	// Persona は解説に使うペルソナ名（空の場合はデフォルトのペルソナ）
+	Persona string
+}
+
+// ReviewUsecase は牌譜の検討ジョブを管理する
+// エンジンで全打牌を評価して損失の大きい悪手を選び、AIに解説させる
+type ReviewUsecase struct {
+	gameLogRepo repository.GameLogRepository
+	reviewRepo  repository.GameReviewRepository
+	aiUsecase   *AIUsecase
+	logger      *logrus.Logger
+
+	timeout time.Duration
+	// slots は実行中の検討の数を制限するセマフォ
+	slots chan struct{}
+
+	mu sync.Mutex
+	// updates は実行中の検討ごとの更新の通知（更新のたびに close して差し替える）
+	updates map[string]chan struct{}
+}
+
+// NewReviewUsecase は新しいReviewUsecaseを作成する
+func NewReviewUsecase(gameLogRepo repository.GameLogRepository, reviewRepo repository.GameReviewRepository, aiUsecase *AIUsecase, logger *logrus.Logger) *ReviewUsecase {
+	return &ReviewUsecase{
+		gameLogRepo: gameLogRepo,
+		reviewRepo:  reviewRepo,
+		aiUsecase:   aiUsecase,
+		logger:      logger,
+		timeout:     reviewTimeout,
+		slots:       make(chan struct{}, maxConcurrentReviews),
+		updates:     make(map[string]chan struct{}),
+	}
+}
+
+// ReviewGame は牌譜の検討をバックグラウンドで開始し、開始前の検討結果を返す
+func (u *ReviewUsecase) ReviewGame(ctx context.Context, input ReviewGameInput) (*gamelog.Review, error) {
+	u.logger.WithFields(logrus.Fields{
+		"game_id": input.GameID,
+		"player":  input.Player,
+		"top_n":   input.TopN,
+	}).Info("ReviewGame request received")
+
+	if input.GameID == "" {
+		return nil, fmt.Errorf("%w: game id is required", entity.ErrInvalidRequest)
+	}
+	game, err := u.gameLogRepo.Get(ctx, input.GameID)
+	if err != nil {
+		return nil, err
+	}
+	if game == nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrGameLogNotFound, input.GameID)
+	}
+	players := game.RuleSet().Players
+	if input.Player < 0 || input.Player >= players {
+		return nil, fmt.Errorf("%w: player %d is out of range (0-%d)", entity.ErrInvalidRequest, input.Player, players-1)
+	}
+	if input.TopN < 0 || input.TopN > maxReviewMistakes {
+		return nil, fmt.Errorf("%w: top_n must be between 0 and %d", entity.ErrInvalidRequest, maxReviewMistakes)
+	}
+	if input.TopN == 0 {
+		input.TopN = defaultReviewMistakes
+	}
+
+	var positions []GameLogPosition
+	for r, round := range game.Rounds {
+		for s, step := range round.Steps {
+			if step.Player == input.Player {
+				positions = append(positions, GameLogPosition{GameID: game.ID, Round: r, Step: s})
+			}
+		}
+	}
+	now := time.Now()
+	review := &gamelog.Review{
+		ID:        uuid.New().String(),
+		GameID:    game.ID,
+		Player:    input.Player,
+		Status:    gamelog.ReviewPending,
+		Total:     len(positions),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if input.Player < len(game.Players) {
+		review.PlayerName = game.Players[input.Player]
+	}
+
+	u.mu.Lock()
+	u.updates[review.ID] = make(chan struct{})
+	u.mu.Unlock()
+	if err := u.save(ctx, review); err != nil {
+		u.mu.Lock()
+		delete(u.updates, review.ID)
+		u.mu.Unlock()
+		return nil, err
+	}
+
+	// 検討はバックグラウンドで review を更新するため、開始前の状態の複製を返す
+	started := *review
+	// リクエストの終了後も検討を続ける
+	go u.run(context.WithoutCancel(ctx), game, review, positions, input)
+	return &started, nil
+}
+
+// run は検討を実行する
+func (u *ReviewUsecase) run(ctx context.Context, game *gamelog.Game, review *gamelog.Review, positions []GameLogPosition, input ReviewGameInput) {
+	logger := u.logger.WithFields(logrus.Fields{"review_id": review.ID, "game_id": review.GameID})
+	start := time.Now()
+	fail := func(err error) {
+		logger.WithError(err).Error("Game review failed")
+		review.Status = gamelog.ReviewFailed
+		review.Error = err.Error()
+		u.finish(ctx, review)
+	}
+	// エンジンや解説の作成で panic しても検討を失敗として終え、プロセスを落とさない
+	defer func() {
+		if r := recover(); r != nil {
+			logger.WithField("stack", string(debug.Stack())).Error("Game review panicked")
+			fail(fmt.Errorf("review panicked: %v", r))
+		}
+	}()
+
+	// 実行中の検討が上限に達している場合は開始前のまま待つ
+	u.slots <- struct{}{}
+	defer func() { <-u.slots }()
+
+	// 保存は時間切れの後も行えるよう、評価と解説の作成にのみ時間の上限を設ける
+	jobCtx, cancel := context.WithTimeout(ctx, u.timeout)
+	defer cancel()
+
+	review.Status = gamelog.ReviewRunning
+	if err := u.save(ctx, review); err != nil {
+		fail(err)
+		return
+	}
+
+	var mistakes []gamelog.Mistake
+	for i, p := range positions {
+		if err := jobCtx.Err(); err != nil {
+			fail(fmt.Errorf("review timed out after %s: %w", u.timeout, err))
+			return
+		}
+		decision, err := game.EvaluateStep(p.Round, p.Step)
+		if err != nil {
+			fail(err)
+			return
+		}
+		if decision != nil && decision.IsMistake() {
+			mistakes = append(mistakes, gamelog.Mistake{Decision: *decision})
+		}
+		review.Evaluated = i + 1
+		if err := u.save(ctx, review); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	sort.SliceStable(mistakes, func(i, j int) bool {
+		return mistakes[i].Loss > mistakes[j].Loss
+	})
+	if len(mistakes) > input.TopN {
+		mistakes = mistakes[:input.TopN]
+	}
+	review.Mistakes = mistakes
+	if err := u.save(ctx, review); err != nil {
+		fail(err)
+		return
+	}
+
+	// 解説を作成できなかった悪手もエンジンの評価とともに結果に残す
+	for i := range review.Mistakes {
+		if err := jobCtx.Err(); err != nil {
+			fail(fmt.Errorf("review timed out after %s: %w", u.timeout, err))
+			return
+		}
+		commentary, err := u.explain(jobCtx, game, &review.Mistakes[i], input.Persona)
+		if err != nil {
+			logger.WithError(err).WithField("round", review.Mistakes[i].Round).Warn("Failed to explain mistake")
+			continue
+		}
+		review.Mistakes[i].Commentary = commentary
+		if err := u.save(ctx, review); err != nil {
+			fail(err)
+			return
+		}
+	}
+
+	review.Status = gamelog.ReviewCompleted
+	u.finish(ctx, review)
+	logger.WithFields(logrus.Fields{
+		"evaluated": review.Evaluated,
+		"mistakes":  len(review.Mistakes),
+		"duration":  time.Since(start),
+	}).Info("Game review completed")
+}
+
+// explain は悪手の局面についてAIに解説させる
+func (u *ReviewUsecase) explain(ctx context.Context, game *gamelog.Game, mistake *gamelog.Mistake, persona string) (string, error) {
+	prompt := fmt.Sprintf("牌譜の検討です。%s %d巡目の局面で、実際には打%sを選びました。\n"+
+		"エンジンの評価（%s、重大度: %s）: %s\n"+
+		"この打牌の問題点と、より良い選択とその理由を簡潔に解説してください。",
+		mistake.RoundName, mistake.Turn, mistake.Actual, mistake.Kind, mistake.Severity, mistake.Evaluation)
+	response, err := u.aiUsecase.AskMahjongAI(ctx, AskInput{
+		Prompt:  prompt,
+		Persona: persona,
+		GameLogPosition: &GameLogPosition{
+			GameID: game.ID,
+			Round:  mistake.Round,
+			Step:   mistake.Step,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return response.Response, nil
+}
+
+// save は検討結果を保存し、更新を待っている購読者に通知する
+func (u *ReviewUsecase) save(ctx context.Context, review *gamelog.Review) error {
+	if err := u.reviewRepo.Save(ctx, review); err != nil {
+		return err
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if ch, ok := u.updates[review.ID]; ok {
+		close(ch)
+		u.updates[review.ID] = make(chan struct{})
+	}
+	return nil
+}
+
+// finish は終了した検討結果を保存し、更新の通知を終える
+func (u *ReviewUsecase) finish(ctx context.Context, review *gamelog.Review) {
+	if err := u.reviewRepo.Save(ctx, review); err != nil {
+		u.logger.WithError(err).WithField("review_id", review.ID).Error("Failed to save game review")
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if ch, ok := u.updates[review.ID]; ok {
+		close(ch)
+		delete(u.updates, review.ID)
+	}
+}
+
+// GetReview は検討結果を返す
+func (u *ReviewUsecase) GetReview(ctx context.Context, id string) (*gamelog.Review, error) {
+	if id == "" {
+		return nil, fmt.Errorf("%w: review id is required", entity.ErrInvalidRequest)
+	}
+	review, err := u.reviewRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if review == nil {
+		return nil, fmt.Errorf("%w: %s", entity.ErrGameReviewNotFound, id)
+	}
+	return review, nil
+}
+
+// WatchReview は検討結果を更新のたびに送り、検討が終わるとチャネルを閉じる
+// 更新が続いた場合は途中の状態を省略し、最新の状態を送る
+func (u *ReviewUsecase) WatchReview(ctx context.Context, id string) (<-chan *gamelog.Review, <-chan error) {
+	reviewChan := make(chan *gamelog.Review)
+	errChan := make(chan error, 1)
+
+	go func() {
+		defer close(reviewChan)
+		defer close(errChan)
+
+		for {
+			// 検討結果を読む前に通知のチャネルを取得し、読んだ後の更新を取りこぼさない
+			u.mu.Lock()
+			updated := u.updates[id]
+			u.mu.Unlock()
+
+			review, err := u.GetReview(ctx, id)
+			if err != nil {
+				errChan <- err
+				return
+			}
+			select {
+			case reviewChan <- review:
+			case <-ctx.Done():
+				errChan <- ctx.Err()
+				return
+			}
+			if review.Done() || updated == nil {
+				return
+			}
+			select {
+			case <-updated:
+			case <-ctx.Done():
+				errChan <- ctx.Err()
+				return
+			}
+		}
+	}()
+
+	return reviewChan, errChan
+}
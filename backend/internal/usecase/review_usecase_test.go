@@ -0,0 +1,225 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/gamelog"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/repository"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+)
+
+// panickingReviewRepository は実行中の検討結果を最初に保存するときに panic する
+type panickingReviewRepository struct {
+	repository.GameReviewRepository
+	once sync.Once
+}
+
+func (r *panickingReviewRepository) Save(ctx context.Context, review *gamelog.Review) error {
+	if review.Status == gamelog.ReviewRunning {
+		r.once.Do(func() { panic("storage is broken") })
+	}
+	return r.GameReviewRepository.Save(ctx, review)
+}
+
+// failingReviewRepository は保存に失敗する
+type failingReviewRepository struct {
+	repository.GameReviewRepository
+}
+
+func (r *failingReviewRepository) Save(ctx context.Context, review *gamelog.Review) error {
+	return errors.New("storage is unavailable")
+}
+
+// newTestReviewUsecase は天鳳形式の牌譜を保存したReviewUsecaseを作成する
+func newTestReviewUsecase(t *testing.T, aiRepo *stubAIRepository, reviewRepo repository.GameReviewRepository) (*ReviewUsecase, string) {
+	t.Helper()
+	data, err := os.ReadFile("../domain/gamelog/testdata/tenhou.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	game, err := gamelog.ParseTenhouJSON(data)
+	if err != nil {
+		t.Fatalf("ParseTenhouJSON: %v", err)
+	}
+	game.ID = "game-1"
+	gameLogRepo := infrastructure.NewMemoryGameLogRepository(time.Hour)
+	if err := gameLogRepo.Save(context.Background(), game); err != nil {
+		t.Fatal(err)
+	}
+
+	aiUsecase := newTestAIUsecase(t, aiRepo, gameLogRepo)
+	return NewReviewUsecase(gameLogRepo, reviewRepo, aiUsecase, newTestLogger()), game.ID
+}
+
+// watchUntilDone は検討が終わるまでの更新をすべて受け取る
+func watchUntilDone(t *testing.T, u *ReviewUsecase, id string, onUpdate func(*gamelog.Review)) []*gamelog.Review {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	reviewChan, errChan := u.WatchReview(ctx, id)
+	var updates []*gamelog.Review
+	for review := range reviewChan {
+		updates = append(updates, review)
+		if onUpdate != nil {
+			onUpdate(review)
+		}
+	}
+	if err := <-errChan; err != nil {
+		t.Fatalf("WatchReview: %v", err)
+	}
+	if len(updates) == 0 || !updates[len(updates)-1].Done() {
+		t.Fatalf("WatchReview ended before the review finished (%d updates)", len(updates))
+	}
+	return updates
+}
+
+func TestReviewGameProgress(t *testing.T) {
+	release := make(chan struct{})
+	aiRepo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+		// 悪手を選んだ後の途中経過を確認するまで解説を止める
+		<-release
+		return entity.NewAIResponse("押し引きを見直しましょう。"), nil
+	}}
+	u, gameID := newTestReviewUsecase(t, aiRepo, infrastructure.NewMemoryGameReviewRepository(time.Hour))
+
+	// 見本の牌譜では席2に悪手がある
+	started, err := u.ReviewGame(context.Background(), ReviewGameInput{GameID: gameID, Player: 2, TopN: maxReviewMistakes})
+	if err != nil {
+		t.Fatalf("ReviewGame: %v", err)
+	}
+	if started.Status != gamelog.ReviewPending || started.Total == 0 {
+		t.Fatalf("ReviewGame() = status %v total %d, want a pending review with positions", started.Status, started.Total)
+	}
+
+	var releaseOnce sync.Once
+	updates := watchUntilDone(t, u, started.ID, func(r *gamelog.Review) {
+		if r.Evaluated == r.Total || r.Done() {
+			releaseOnce.Do(func() { close(release) })
+		}
+	})
+	releaseOnce.Do(func() { close(release) })
+
+	for i := 1; i < len(updates); i++ {
+		if updates[i].Evaluated < updates[i-1].Evaluated {
+			t.Errorf("Evaluated went back from %d to %d", updates[i-1].Evaluated, updates[i].Evaluated)
+		}
+	}
+	final := updates[len(updates)-1]
+	if final.Status != gamelog.ReviewCompleted || final.Evaluated != final.Total {
+		t.Fatalf("final review = status %v evaluated %d/%d (%s), want completed", final.Status, final.Evaluated, final.Total, final.Error)
+	}
+	if len(updates) < 2 {
+		t.Errorf("WatchReview sent %d updates, want the progress before completion", len(updates))
+	}
+	if len(final.Mistakes) == 0 {
+		t.Fatal("review found no mistakes in the sample game")
+	}
+	for _, m := range final.Mistakes {
+		if m.Commentary == "" {
+			t.Errorf("mistake in %s has no commentary", m.RoundName)
+		}
+	}
+}
+
+func TestReviewGameRecoversFromPanic(t *testing.T) {
+	reviewRepo := &panickingReviewRepository{GameReviewRepository: infrastructure.NewMemoryGameReviewRepository(time.Hour)}
+	u, gameID := newTestReviewUsecase(t, &stubAIRepository{}, reviewRepo)
+
+	started, err := u.ReviewGame(context.Background(), ReviewGameInput{GameID: gameID, Player: 0})
+	if err != nil {
+		t.Fatalf("ReviewGame: %v", err)
+	}
+	updates := watchUntilDone(t, u, started.ID, nil)
+	final := updates[len(updates)-1]
+	if final.Status != gamelog.ReviewFailed || !strings.Contains(final.Error, "storage is broken") {
+		t.Errorf("final review = status %v error %q, want failed by the panic", final.Status, final.Error)
+	}
+
+	// panic した検討の実行枠も返している
+	if len(u.slots) != 0 {
+		t.Errorf("%d slots are still in use", len(u.slots))
+	}
+}
+
+func TestReviewGameTimeout(t *testing.T) {
+	u, gameID := newTestReviewUsecase(t, &stubAIRepository{}, infrastructure.NewMemoryGameReviewRepository(time.Hour))
+	u.timeout = time.Nanosecond
+
+	started, err := u.ReviewGame(context.Background(), ReviewGameInput{GameID: gameID, Player: 0})
+	if err != nil {
+		t.Fatalf("ReviewGame: %v", err)
+	}
+	updates := watchUntilDone(t, u, started.ID, nil)
+	final := updates[len(updates)-1]
+	if final.Status != gamelog.ReviewFailed || !strings.Contains(final.Error, "timed out") {
+		t.Errorf("final review = status %v error %q, want failed by the timeout", final.Status, final.Error)
+	}
+}
+
+func TestReviewGameConcurrencyLimit(t *testing.T) {
+	u, gameID := newTestReviewUsecase(t, &stubAIRepository{}, infrastructure.NewMemoryGameReviewRepository(time.Hour))
+	u.slots = make(chan struct{}, 1)
+	// 実行枠を埋めておく
+	u.slots <- struct{}{}
+
+	started, err := u.ReviewGame(context.Background(), ReviewGameInput{GameID: gameID, Player: 0})
+	if err != nil {
+		t.Fatalf("ReviewGame: %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	review, err := u.GetReview(context.Background(), started.ID)
+	if err != nil {
+		t.Fatalf("GetReview: %v", err)
+	}
+	if review.Status != gamelog.ReviewPending {
+		t.Fatalf("status while all slots are in use = %v, want pending", review.Status)
+	}
+
+	<-u.slots
+	updates := watchUntilDone(t, u, started.ID, nil)
+	if final := updates[len(updates)-1]; final.Status != gamelog.ReviewCompleted {
+		t.Errorf("final review = status %v (%s), want completed", final.Status, final.Error)
+	}
+}
+
+func TestReviewGameSaveFailure(t *testing.T) {
+	reviewRepo := &failingReviewRepository{GameReviewRepository: infrastructure.NewMemoryGameReviewRepository(time.Hour)}
+	u, gameID := newTestReviewUsecase(t, &stubAIRepository{}, reviewRepo)
+
+	if _, err := u.ReviewGame(context.Background(), ReviewGameInput{GameID: gameID, Player: 0}); err == nil {
+		t.Fatal("ReviewGame() = nil, want the save error")
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if len(u.updates) != 0 {
+		t.Errorf("updates has %d entries after the failed save, want 0", len(u.updates))
+	}
+}
+
+func TestReviewGameInvalid(t *testing.T) {
+	u, gameID := newTestReviewUsecase(t, &stubAIRepository{}, infrastructure.NewMemoryGameReviewRepository(time.Hour))
+	tests := []struct {
+		name  string
+		input ReviewGameInput
+		want  error
+	}{
+		{name: "牌譜IDなし", input: ReviewGameInput{}, want: entity.ErrInvalidRequest},
+		{name: "存在しない牌譜", input: ReviewGameInput{GameID: "unknown"}, want: entity.ErrGameLogNotFound},
+		{name: "席が範囲外", input: ReviewGameInput{GameID: gameID, Player: 4}, want: entity.ErrInvalidRequest},
+		{name: "悪手の数が範囲外", input: ReviewGameInput{GameID: gameID, TopN: maxReviewMistakes + 1}, want: entity.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := u.ReviewGame(context.Background(), tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("ReviewGame() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
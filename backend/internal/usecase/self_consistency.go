@@ -0,0 +1,152 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/mahjong"
+	"github.com/sirupsen/logrus"
+)
+
+// maxSamples は自己一貫性のために生成する回答の最大数
+const maxSamples = 5
+
+// validateSamples は生成する回答の数を検証する（0・1 は自己一貫性を使わない）
+func validateSamples(samples int32) error {
+	if samples < 0 || samples > maxSamples {
+		return fmt.Errorf("%w: samples must be between 0 and %d", entity.ErrInvalidRequest, maxSamples)
+	}
+	return nil
+}
+
+// askSamples は同じリクエストで samples 個の回答を並列に生成し、結論の多数派の回答と結論の分布を返す
+//
+// samples が1以下の場合は1度だけ問い合わせ、分布は返さない。生成に失敗した回答は比べる対象から外し、
+// すべて失敗した場合は最初のエラーを返す。多数派の回答には最も早く生成を依頼した回答を使い、
+// 使用トークン数はすべての回答の合計とする。キャッシュした回答とみなすのはすべての回答がキャッシュから返された場合のみ。
+func (u *AIUsecase) askSamples(ctx context.Context, request *entity.AIRequest, samples int32) (*entity.AIResponse, *entity.SelfConsistency, error) {
+	if samples <= 1 {
+		response, err := u.ask(ctx, request)
+		return response, nil, err
+	}
+
+	responses := make([]*entity.AIResponse, samples)
+	errs := make([]error, samples)
+	var wg sync.WaitGroup
+	for i := range responses {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sample := *request
+			sample.Sample = i
+			responses[i], errs[i] = u.ask(ctx, &sample)
+		}(i)
+	}
+	wg.Wait()
+
+	// 結論ごとに回答をまとめる
+	var (
+		tokens     int32
+		cached     = true
+		order      []string
+		groups     = map[string][]*entity.AIResponse{}
+		firstError error
+	)
+	for i, response := range responses {
+		if errs[i] != nil {
+			u.logger.WithError(errs[i]).Warn("Failed to get AI response sample")
+			if firstError == nil {
+				firstError = errs[i]
+			}
+			continue
+		}
+		tokens += response.TokensUsed
+		cached = cached && response.Cached
+		conclusion := answerConclusion(response)
+		if _, ok := groups[conclusion]; !ok {
+			order = append(order, conclusion)
+		}
+		groups[conclusion] = append(groups[conclusion], response)
+	}
+	if len(order) == 0 {
+		return nil, nil, firstError
+	}
+
+	consistency := &entity.SelfConsistency{}
+	for _, conclusion := range order {
+		consistency.Samples += int32(len(groups[conclusion]))
+		consistency.Votes = append(consistency.Votes, entity.ConclusionVote{Conclusion: conclusion, Count: int32(len(groups[conclusion]))})
+	}
+	// 同数の場合は先に現れた結論を優先し、結論を取り出せなかった回答は最後に並べる
+	sort.SliceStable(consistency.Votes, func(i, j int) bool {
+		a, b := consistency.Votes[i], consistency.Votes[j]
+		if (a.Conclusion == "") != (b.Conclusion == "") {
+			return b.Conclusion == ""
+		}
+		return a.Count > b.Count
+	})
+
+	majority := groups[consistency.Votes[0].Conclusion][0]
+	if top := consistency.Votes[0]; top.Conclusion != "" {
+		consistency.Conclusion = top.Conclusion
+		consistency.Agreement = float32(top.Count) / float32(consistency.Samples)
+	}
+	response := *majority
+	response.TokensUsed = tokens
+	response.Cached = cached
+
+	u.logger.WithFields(logrus.Fields{
+		"samples":    consistency.Samples,
+		"conclusion": consistency.Conclusion,
+		"agreement":  consistency.Agreement,
+	}).Debug("Answer samples compared")
+	return &response, consistency, nil
+}
+
+// applyConsistency は自己一貫性の結果を回答に加え、信頼度を結論の一致率から求める
+// 結論を取り出せた回答がない場合は検証結果から求めた信頼度のままにし、
+// 検証で誤りが見つかった場合は一致率と検証結果の信頼度の低い方とする
+func applyConsistency(response *entity.AIResponse, consistency *entity.SelfConsistency) {
+	if consistency == nil {
+		return
+	}
+	response.Consistency = consistency
+	if consistency.Conclusion == "" {
+		return
+	}
+	confidence := consistency.Agreement
+	if v := response.Verification; v != nil && (v.Regenerated || hasWrongCheck(v.Checks)) && response.Confidence < confidence {
+		confidence = response.Confidence
+	}
+	response.Confidence = confidence
+}
+
+// hasWrongCheck は誤っていた主張があるかを返す
+func hasWrongCheck(checks []entity.ClaimCheck) bool {
+	for _, c := range checks {
+		if !c.Correct {
+			return true
+		}
+	}
+	return false
+}
+
+// answerConclusion は回答の結論を返す
+// JSONで出力させた回答は discard（なければ points）の値を、それ以外は文章から取り出した結論を使う
+func answerConclusion(response *entity.AIResponse) string {
+	if response.Structured == nil {
+		return mahjong.ExtractConclusion(response.Response).String()
+	}
+	c := mahjong.Conclusion{Discard: -1}
+	if discard, ok := response.Structured["discard"].(string); ok {
+		if t, err := mahjong.ParseTile(discard); err == nil {
+			c.Discard = t
+		}
+	} else if points, ok := response.Structured["points"].(float64); ok {
+		c.Points = int(points)
+	}
+	return c.String()
+}
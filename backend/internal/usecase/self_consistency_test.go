@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+)
+
+// sampleAnswer は回答の番号ごとの応答
+type sampleAnswer struct {
+	text   string
+	cached bool
+	err    error
+}
+
+// newSamplingAIUsecase は回答の番号ごとに決まった応答を返すAIUsecaseを作成する
+func newSamplingAIUsecase(t *testing.T, answers []sampleAnswer) (*AIUsecase, *stubAIRepository) {
+	t.Helper()
+	repo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+		a := answers[request.Sample]
+		if a.err != nil {
+			return nil, a.err
+		}
+		response := entity.NewAIResponseWithMetrics(a.text, 10, 0.5, 1)
+		response.Cached = a.cached
+		return response, nil
+	}}
+	return newTestAIUsecase(t, repo, infrastructure.NewMemoryGameLogRepository(time.Hour)), repo
+}
+
+func TestAskSamples(t *testing.T) {
+	errFirst := errors.New("first sample failed")
+	tests := []struct {
+		name           string
+		answers        []sampleAnswer
+		wantResponse   string
+		wantConclusion string
+		wantVotes      []entity.ConclusionVote
+		wantAgreement  float32
+		wantTokens     int32
+		wantCached     bool
+	}{
+		{
+			name:           "多数決",
+			answers:        []sampleAnswer{{text: "結論は打1m。"}, {text: "5sを切るのがベストです。"}, {text: "推奨は打5s。"}},
+			wantResponse:   "5sを切るのがベストです。",
+			wantConclusion: "打5s",
+			wantVotes:      []entity.ConclusionVote{{Conclusion: "打5s", Count: 2}, {Conclusion: "打1m", Count: 1}},
+			wantAgreement:  2.0 / 3,
+			wantTokens:     30,
+		},
+		{
+			name:           "同数は先に現れた結論",
+			answers:        []sampleAnswer{{text: "打9p"}, {text: "打1m"}, {text: "打1m"}, {text: "打9p"}},
+			wantResponse:   "打9p",
+			wantConclusion: "打9p",
+			wantVotes:      []entity.ConclusionVote{{Conclusion: "打9p", Count: 2}, {Conclusion: "打1m", Count: 2}},
+			wantAgreement:  0.5,
+			wantTokens:     40,
+		},
+		{
+			name:           "結論のない回答は最後",
+			answers:        []sampleAnswer{{text: "状況によります。"}, {text: "難しい局面です。"}, {text: "満貫の8000点です。"}},
+			wantResponse:   "満貫の8000点です。",
+			wantConclusion: "8000点",
+			wantVotes:      []entity.ConclusionVote{{Conclusion: "8000点", Count: 1}, {Conclusion: "", Count: 2}},
+			wantAgreement:  1.0 / 3,
+			wantTokens:     30,
+		},
+		{
+			name:          "どの回答にも結論がない",
+			answers:       []sampleAnswer{{text: "状況によります。"}, {text: "難しい局面です。"}},
+			wantResponse:  "状況によります。",
+			wantVotes:     []entity.ConclusionVote{{Conclusion: "", Count: 2}},
+			wantAgreement: 0,
+			wantTokens:    20,
+		},
+		{
+			name:           "失敗した回答を除く",
+			answers:        []sampleAnswer{{err: errFirst}, {text: "打5s"}, {err: errors.New("timeout")}, {text: "打1m"}, {text: "打5s"}},
+			wantResponse:   "打5s",
+			wantConclusion: "打5s",
+			wantVotes:      []entity.ConclusionVote{{Conclusion: "打5s", Count: 2}, {Conclusion: "打1m", Count: 1}},
+			wantAgreement:  2.0 / 3,
+			wantTokens:     30,
+		},
+		{
+			name:           "すべてキャッシュ",
+			answers:        []sampleAnswer{{text: "打5s", cached: true}, {text: "打5s", cached: true}},
+			wantResponse:   "打5s",
+			wantConclusion: "打5s",
+			wantVotes:      []entity.ConclusionVote{{Conclusion: "打5s", Count: 2}},
+			wantAgreement:  1,
+			wantTokens:     20,
+			wantCached:     true,
+		},
+		{
+			name:           "一部だけキャッシュ",
+			answers:        []sampleAnswer{{text: "打5s", cached: true}, {text: "打5s"}},
+			wantResponse:   "打5s",
+			wantConclusion: "打5s",
+			wantVotes:      []entity.ConclusionVote{{Conclusion: "打5s", Count: 2}},
+			wantAgreement:  1,
+			wantTokens:     20,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u, _ := newSamplingAIUsecase(t, tt.answers)
+			response, consistency, err := u.askSamples(context.Background(), entity.NewAIRequest("質問"), int32(len(tt.answers)))
+			if err != nil {
+				t.Fatalf("askSamples: %v", err)
+			}
+			if response.Response != tt.wantResponse || response.TokensUsed != tt.wantTokens || response.Cached != tt.wantCached {
+				t.Errorf("response = %q tokens %d cached %v, want %q tokens %d cached %v",
+					response.Response, response.TokensUsed, response.Cached, tt.wantResponse, tt.wantTokens, tt.wantCached)
+			}
+			if consistency.Conclusion != tt.wantConclusion || consistency.Agreement != tt.wantAgreement {
+				t.Errorf("consistency = %q agreement %g, want %q agreement %g", consistency.Conclusion, consistency.Agreement, tt.wantConclusion, tt.wantAgreement)
+			}
+			if !reflect.DeepEqual(consistency.Votes, tt.wantVotes) {
+				t.Errorf("Votes = %+v, want %+v", consistency.Votes, tt.wantVotes)
+			}
+			var samples int32
+			for _, v := range tt.wantVotes {
+				samples += v.Count
+			}
+			if consistency.Samples != samples {
+				t.Errorf("Samples = %d, want %d", consistency.Samples, samples)
+			}
+		})
+	}
+}
+
+func TestAskSamplesAllFail(t *testing.T) {
+	errFirst := errors.New("first sample failed")
+	u, _ := newSamplingAIUsecase(t, []sampleAnswer{{err: errFirst}, {err: errors.New("second sample failed")}})
+	_, consistency, err := u.askSamples(context.Background(), entity.NewAIRequest("質問"), 2)
+	if !errors.Is(err, errFirst) || consistency != nil {
+		t.Errorf("askSamples() = %+v, %v, want the first error", consistency, err)
+	}
+}
+
+func TestAskSamplesSingle(t *testing.T) {
+	for _, samples := range []int32{0, 1} {
+		u, repo := newSamplingAIUsecase(t, []sampleAnswer{{text: "打5s"}})
+		response, consistency, err := u.askSamples(context.Background(), entity.NewAIRequest("質問"), samples)
+		if err != nil {
+			t.Fatalf("askSamples(%d): %v", samples, err)
+		}
+		if response.Response != "打5s" || consistency != nil || repo.calls != 1 {
+			t.Errorf("askSamples(%d) = %q, %+v with %d calls, want one answer without consistency", samples, response.Response, consistency, repo.calls)
+		}
+	}
+}
+
+func TestAnswerConclusion(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *entity.AIResponse
+		want     string
+	}{
+		{name: "文章の打牌", response: &entity.AIResponse{Response: "ここは打5sがおすすめです。"}, want: "打5s"},
+		{name: "文章の点数", response: &entity.AIResponse{Response: "この手は7700点です。"}, want: "7700点"},
+		{name: "文章に結論なし", response: &entity.AIResponse{Response: "様子を見ましょう。"}, want: ""},
+		{name: "JSONの打牌", response: &entity.AIResponse{Structured: map[string]any{"discard": "1z", "points": 1000.0}}, want: "打1z"},
+		{name: "JSONの点数", response: &entity.AIResponse{Structured: map[string]any{"points": 12000.0}}, want: "12000点"},
+		{name: "JSONの不正な牌", response: &entity.AIResponse{Structured: map[string]any{"discard": "x"}}, want: ""},
+		{name: "JSONは文章より優先", response: &entity.AIResponse{Response: "打9m", Structured: map[string]any{"discard": "2p"}}, want: "打2p"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := answerConclusion(tt.response); got != tt.want {
+				t.Errorf("answerConclusion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyConsistency(t *testing.T) {
+	consistency := &entity.SelfConsistency{Samples: 4, Conclusion: "打5s", Agreement: 0.75}
+	tests := []struct {
+		name         string
+		consistency  *entity.SelfConsistency
+		verification *entity.AnswerVerification
+		want         float32
+	}{
+		{name: "一致率", consistency: consistency, want: 0.75},
+		{name: "自己一貫性なし", want: 0.4},
+		{name: "結論なし", consistency: &entity.SelfConsistency{Samples: 2}, want: 0.4},
+		{name: "正しい検証結果", consistency: consistency, verification: &entity.AnswerVerification{Checks: []entity.ClaimCheck{{Correct: true}}}, want: 0.75},
+		{name: "誤りのある検証結果", consistency: consistency, verification: &entity.AnswerVerification{Checks: []entity.ClaimCheck{{Correct: false}}}, want: 0.4},
+		{name: "生成し直した回答", consistency: consistency, verification: &entity.AnswerVerification{Regenerated: true}, want: 0.4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := &entity.AIResponse{Confidence: 0.4, Verification: tt.verification}
+			applyConsistency(response, tt.consistency)
+			if response.Confidence != tt.want || response.Consistency != tt.consistency {
+				t.Errorf("Confidence = %g consistency %+v, want %g", response.Confidence, response.Consistency, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/sirupsen/logrus"
+)
+
+// maxStructuredAttempts は形式に合う出力が得られるまで問い合わせる最大回数（最初の問い合わせを含む）
+const maxStructuredAttempts = 2
+
+// resolveResponseSchema は名前から構造化出力の形式を返す（空の場合は nil）
+func resolveResponseSchema(name string) (*entity.ResponseSchema, error) {
+	if name == "" {
+		return nil, nil
+	}
+	schema, ok := entity.LookupResponseSchema(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (use one of %s)", entity.ErrUnknownResponseSchema, name, strings.Join(entity.ResponseSchemaNames(), ", "))
+	}
+	return &schema, nil
+}
+
+// ask はAIに問い合わせる。形式を指定したリクエストでは出力を解析して検証し、
+// 形式に合わなければ食い違いを伝えて修正させる
+func (u *AIUsecase) ask(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+	if request.ResponseSchema == nil {
+		return u.aiRepo.AskAI(ctx, request)
+	}
+
+	var (
+		retry      = *request
+		tokensUsed int32
+		lastErr    error
+	)
+	for attempt := 0; attempt < maxStructuredAttempts; attempt++ {
+		response, err := u.aiRepo.AskAI(ctx, &retry)
+		if err != nil {
+			return nil, err
+		}
+		tokensUsed += response.TokensUsed
+		structured, err := parseStructured(response.Response, request.ResponseSchema)
+		if err == nil {
+			response.Structured = structured
+			response.TokensUsed = tokensUsed
+			return response, nil
+		}
+		u.logger.WithError(err).WithFields(logrus.Fields{
+			"schema":  request.ResponseSchema.Name,
+			"attempt": attempt + 1,
+		}).Warn("Structured output does not match schema")
+		lastErr = err
+		retry.Context = append(append([]string{}, request.Context...), repairContext(response.Response, err))
+	}
+	return nil, lastErr
+}
+
+// parseStructured は出力をJSONとして解析し、形式に合うかを検証する
+// 出力がコードブロックで囲まれている場合は中身を解析する
+func parseStructured(text string, schema *entity.ResponseSchema) (map[string]any, error) {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "```") {
+		text = strings.TrimPrefix(text, "```json")
+		text = strings.TrimPrefix(text, "```")
+		text = strings.TrimSuffix(strings.TrimSpace(text), "```")
+	}
+	var value any
+	if err := json.Unmarshal([]byte(text), &value); err != nil {
+		return nil, fmt.Errorf("%w: %v", entity.ErrStructuredOutputMismatch, err)
+	}
+	if err := schema.Root.Validate(value); err != nil {
+		return nil, err
+	}
+	return value.(map[string]any), nil
+}
+
+// repairContext は形式に合わなかった出力を修正させるときに伝える内容
+func repairContext(output string, err error) string {
+	return fmt.Sprintf("【出力形式】同じ質問への先の出力は、指定した形式に合いませんでした（%v）。\n先の出力:\n%s\n形式に合うJSONのみを出力し直してください。", err, output)
+}
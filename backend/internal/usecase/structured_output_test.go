@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rendaman0215/simple_ai_agent/internal/domain/entity"
+	"github.com/rendaman0215/simple_ai_agent/internal/infrastructure"
+)
+
+func TestResolveResponseSchema(t *testing.T) {
+	if schema, err := resolveResponseSchema(""); schema != nil || err != nil {
+		t.Errorf("resolveResponseSchema(\"\") = %v, %v, want nil", schema, err)
+	}
+	if schema, err := resolveResponseSchema("score"); err != nil || schema.Name != "score" {
+		t.Errorf("resolveResponseSchema(score) = %v, %v", schema, err)
+	}
+	if _, err := resolveResponseSchema("hand_transcription"); !errors.Is(err, entity.ErrUnknownResponseSchema) {
+		t.Errorf("resolveResponseSchema(hand_transcription) error = %v, want ErrUnknownResponseSchema", err)
+	}
+}
+
+func TestParseStructured(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr bool
+	}{
+		{name: "JSON", text: `{"decision":"push","discard":"5s","reasoning":"満貫の一向聴"}`},
+		{name: "コードブロック", text: "```json\n{\"decision\":\"fold\",\"discard\":\"1z\",\"reasoning\":\"現物\"}\n```"},
+		{name: "言語なしのコードブロック", text: "  ```\n{\"decision\":\"mawashi\",\"discard\":\"9m\",\"reasoning\":\"筋\"}```  "},
+		{name: "JSONでない", text: "押しです。", wantErr: true},
+		{name: "形式に合わない", text: `{"decision":"push","discard":"5s"}`, wantErr: true},
+		{name: "オブジェクトでない", text: `"push"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := parseStructured(tt.text, &entity.ResponseSchemaPushFold)
+			if tt.wantErr {
+				if !errors.Is(err, entity.ErrStructuredOutputMismatch) {
+					t.Errorf("parseStructured() error = %v, want ErrStructuredOutputMismatch", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseStructured: %v", err)
+			}
+			if _, ok := value["decision"].(string); !ok {
+				t.Errorf("parseStructured() = %v, want the decision", value)
+			}
+		})
+	}
+}
+
+func TestAskStructuredOutput(t *testing.T) {
+	valid := `{"decision":"fold","discard":"1z","reasoning":"現物"}`
+	tests := []struct {
+		name      string
+		outputs   []string
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "1回目で形式に合う", outputs: []string{valid}, wantCalls: 1},
+		{name: "修正させて形式に合う", outputs: []string{`{"decision":"降り","discard":"1z","reasoning":"現物"}`, valid}, wantCalls: 2},
+		{name: "修正しても形式に合わない", outputs: []string{"降りです。", `{"decision":"fold"}`}, wantCalls: maxStructuredAttempts, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				requests []*entity.AIRequest
+			)
+			repo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				// 修正の依頼では同じリクエストを書き換えて使うため、複製を残す
+				sent := *request
+				requests = append(requests, &sent)
+				return entity.NewAIResponseWithMetrics(tt.outputs[len(requests)-1], 100, 0.5, 1), nil
+			}}
+			u := newTestAIUsecase(t, repo, infrastructure.NewMemoryGameLogRepository(time.Hour))
+			request := entity.NewAIRequestWithOptions("押し引きは？", 1000, 0.7, []string{"局面"})
+			request.ResponseSchema = &entity.ResponseSchemaPushFold
+
+			response, err := u.ask(context.Background(), request)
+			if len(requests) != tt.wantCalls {
+				t.Fatalf("AskAI called %d times, want %d", len(requests), tt.wantCalls)
+			}
+			// 修正の依頼には元の文脈と、先の出力と食い違いを加える
+			for i, r := range requests[1:] {
+				if len(r.Context) != 2 || r.Context[0] != "局面" || !strings.Contains(r.Context[1], tt.outputs[i]) || !strings.Contains(r.Context[1], "structured output") {
+					t.Errorf("retry %d context = %q, want the original context and the repair instruction", i+1, r.Context)
+				}
+			}
+			if len(request.Context) != 1 {
+				t.Errorf("original request context was modified: %q", request.Context)
+			}
+			if tt.wantErr {
+				if !errors.Is(err, entity.ErrStructuredOutputMismatch) {
+					t.Errorf("ask() error = %v, want ErrStructuredOutputMismatch", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ask: %v", err)
+			}
+			if response.Structured["decision"] != "fold" || response.TokensUsed != int32(100*tt.wantCalls) {
+				t.Errorf("response = %v tokens %d, want fold with %d tokens", response.Structured, response.TokensUsed, 100*tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestAskStructuredOutputError(t *testing.T) {
+	errUnavailable := errors.New("unavailable")
+	repo := &stubAIRepository{ask: func(ctx context.Context, request *entity.AIRequest) (*entity.AIResponse, error) {
+		return nil, errUnavailable
+	}}
+	u := newTestAIUsecase(t, repo, infrastructure.NewMemoryGameLogRepository(time.Hour))
+	request := entity.NewAIRequest("押し引きは？")
+	request.ResponseSchema = &entity.ResponseSchemaPushFold
+
+	// AIのエラーは修正を依頼せずにそのまま返す
+	if _, err := u.ask(context.Background(), request); !errors.Is(err, errUnavailable) || repo.calls != 1 {
+		t.Errorf("ask() error = %v with %d calls, want the AI error without retry", err, repo.calls)
+	}
+}
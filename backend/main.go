@@ -15,6 +15,10 @@ import (
 	"github.com/rendaman0215/simple_ai_agent/internal/interface/config"
 	connectHandler "github.com/rendaman0215/simple_ai_agent/internal/interface/connect"
 	grpcHandler "github.com/rendaman0215/simple_ai_agent/internal/interface/grpc"
+	healthHandler "github.com/rendaman0215/simple_ai_agent/internal/interface/health"
+	"github.com/rendaman0215/simple_ai_agent/internal/interface/middleware"
+	mjaiHandler "github.com/rendaman0215/simple_ai_agent/internal/interface/mjai"
+	renderHandler "github.com/rendaman0215/simple_ai_agent/internal/interface/render"
 	"github.com/rendaman0215/simple_ai_agent/internal/usecase"
 	aiv1 "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1"
 	aiv1connect "github.com/rendaman0215/simple_ai_agent/proto/gen/go/mahjong/ai/v1/aiv1connect"
@@ -22,32 +26,29 @@ import (
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
 func main() {
-	// 設定を読み込み
-	cfg := config.LoadConfig()
-
-	// ロガーを設定
 	logger := logrus.New()
-	level, err := logrus.ParseLevel(cfg.LogLevel)
+
+	// 設定を読み込み
+	cfg, err := config.LoadConfig(os.Args[1:])
 	if err != nil {
-		logger.SetLevel(logrus.InfoLevel)
-	} else {
-		logger.SetLevel(level)
+		logger.WithError(err).Fatal("Failed to load configuration")
 	}
+	cfgManager := config.NewManager(cfg, os.Args[1:], logger)
 
-	logger.Info("Starting Mahjong AI Server (gRPC + Connect)...")
+	// ロガーを設定（検証済みのためエラーにならない）
+	level, _ := logrus.ParseLevel(cfg.LogLevel)
+	logger.SetLevel(level)
 
-	// Gemini APIキーの確認
-	if cfg.GeminiAPIKey == "" {
-		logger.Fatal("GEMINI_API_KEY environment variable is required")
-	}
+	logger.WithField("config_file", cfg.ConfigFile).Info("Starting Mahjong AI Server (gRPC + Connect)...")
 
 	// 依存関係を構築
 	// Infrastructure層
-	geminiClient, err := infrastructure.NewGeminiClient(cfg.GeminiAPIKey, logger)
+	geminiClient, err := infrastructure.NewGeminiClient(cfg.GeminiAPIKey, cfg.GeminiContextCacheTTL, logger)
 	if err != nil {
 		logger.WithError(err).Fatal("Failed to create Gemini client")
 	}
@@ -59,15 +60,126 @@ func main() {
 		}
 	}()
 
+	// 資料のベクトル検索と回答のキャッシュの意味的な一致に使う埋め込みモデル
+	embeddingClient, err := infrastructure.NewEmbeddingClient(cfg.EmbeddingProvider, cfg.GeminiAPIKey, logger)
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to create embedding client")
+	}
+	defer func() {
+		if closer, ok := embeddingClient.(interface{ Close() error }); ok {
+			if err := closer.Close(); err != nil {
+				logger.WithError(err).Error("Failed to close embedding client")
+			}
+		}
+	}()
+	// 同じ質問にはAIに問い合わせずキャッシュした回答を返す
+	aiRepo := geminiClient
+	if cfg.ResponseCache.TTL > 0 {
+		aiRepo = infrastructure.NewCachedAIRepository(geminiClient, embeddingClient, infrastructure.ResponseCacheOptions{
+			TTL:               cfg.ResponseCache.TTL,
+			MaxEntries:        cfg.ResponseCache.MaxEntries,
+			SemanticThreshold: cfg.ResponseCache.SemanticThreshold,
+		}, logger)
+	}
+
 	// Usecase層
-	aiUsecase := usecase.NewAIUsecase(geminiClient, logger)
+	personas, err := cfg.BuildPersonas()
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to build personas")
+	}
+	conversationRepo := infrastructure.NewMemoryConversationRepository(24 * time.Hour)
+	gameLogRepo := infrastructure.NewMemoryGameLogRepository(24 * time.Hour)
+	reviewRepo := infrastructure.NewMemoryGameReviewRepository(24 * time.Hour)
+	quizRepo := infrastructure.NewMemoryQuizRepository(7*24*time.Hour, 10000, 1000)
+	aiUsecase := usecase.NewAIUsecase(aiRepo, conversationRepo, gameLogRepo, usecase.PromptSettings{
+		Personas:       personas,
+		DefaultPersona: cfg.DefaultPersona,
+		DefaultRuleSet: cfg.RuleSet(),
+		Verification:   cfg.VerificationMode(),
+		KnowledgeTopK:  cfg.KnowledgeTopK,
+	}, logger)
+	aiUsecase.SetAttachmentLimits(usecase.AttachmentLimits{
+		MaxBytes: cfg.Attachments.MaxBytes,
+		MaxCount: cfg.Attachments.MaxCount,
+	})
+	analysisUsecase := usecase.NewAnalysisUsecase(cfg.RuleSet(), logger)
+	gameLogUsecase := usecase.NewGameLogUsecase(gameLogRepo, logger)
+	reviewUsecase := usecase.NewReviewUsecase(gameLogRepo, reviewRepo, aiUsecase, logger)
+	mjaiUsecase := usecase.NewMjaiUsecase(aiUsecase, logger)
+	coachUsecase := usecase.NewCoachUsecase(mjaiUsecase, logger)
+	renderUsecase := usecase.NewRenderUsecase(gameLogRepo, cfg.RuleSet(), logger)
+	quizUsecase := usecase.NewQuizUsecase(quizRepo, aiUsecase, cfg.RuleSet(), logger)
+	// 麻雀エンジンをAIが回答の根拠として呼び出せるツールとして登録
+	aiUsecase.RegisterTools(analysisUsecase.Tools()...)
+	// ルールの資料を索引にし、質問に関係する抜粋を回答の根拠として渡す
+	knowledgeUsecase := usecase.NewKnowledgeUsecase(
+		infrastructure.NewMarkdownKnowledgeRepository(cfg.KnowledgeDir),
+		infrastructure.NewFileVectorIndexRepository(cfg.KnowledgeIndexPath),
+		embeddingClient,
+		logger,
+	)
+	knowledgeIndex, err := knowledgeUsecase.Load(context.Background())
+	if err != nil {
+		logger.WithError(err).Fatal("Failed to load knowledge base")
+	}
+	aiUsecase.SetKnowledgeBase(knowledgeUsecase)
+	logger.WithFields(logrus.Fields{
+		"passages": knowledgeIndex.Passages,
+		"vectors":  knowledgeIndex.Reused,
+		"model":    knowledgeIndex.Model,
+	}).Info("Knowledge base indexed")
+	if embeddingClient != nil && knowledgeIndex.Reused < knowledgeIndex.Passages {
+		logger.WithField("index_path", cfg.KnowledgeIndexPath).Warn("Some passages have no embeddings, run `go run ./cmd/kbindex` or the ReindexKnowledge RPC to enable semantic search for them")
+	}
+	healthUsecase := usecase.NewHealthUsecase(geminiClient, cfg.HealthProbeInterval, cfg.HealthProbeTimeout, logger)
+
+	// ヘルスチェックをバックグラウンドで実行
+	ctxHealth, cancelHealth := context.WithCancel(context.Background())
+	defer cancelHealth()
+	go healthUsecase.Run(ctxHealth)
 
 	// Interface層
-	handler := grpcHandler.NewMahjongAIHandler(aiUsecase, logger)
+	handler := grpcHandler.NewMahjongAIHandler(aiUsecase, analysisUsecase, gameLogUsecase, reviewUsecase, coachUsecase, knowledgeUsecase, renderUsecase, quizUsecase, healthUsecase, logger)
+	healthServer := healthHandler.NewGRPCHealthServer(healthUsecase, logger)
+	mjaiServer := mjaiHandler.NewServer(mjaiUsecase, logger)
+
+	// 実行時に差し替え可能なミドルウェア
+	rateLimiter := middleware.NewRateLimiter(cfg.RateLimit.RequestsPerSecond, cfg.RateLimit.Burst)
+	cors := middleware.NewCORS(cfg.AllowedOrigins())
+
+	// 設定のホットリロード（SIGHUP または設定ファイルの変更）
+	cfgManager.OnReload(func(c *config.Config) {
+		if level, err := logrus.ParseLevel(c.LogLevel); err == nil {
+			logger.SetLevel(level)
+		}
+		// 検証済みのためエラーにならない
+		if personas, err := c.BuildPersonas(); err == nil {
+			aiUsecase.SetPromptSettings(usecase.PromptSettings{
+				Personas:       personas,
+				DefaultPersona: c.DefaultPersona,
+				DefaultRuleSet: c.RuleSet(),
+				Verification:   c.VerificationMode(),
+				KnowledgeTopK:  c.KnowledgeTopK,
+			})
+		}
+		analysisUsecase.SetDefaultRuleSet(c.RuleSet())
+		renderUsecase.SetDefaultRuleSet(c.RuleSet())
+		quizUsecase.SetDefaultRuleSet(c.RuleSet())
+		rateLimiter.SetLimit(c.RateLimit.RequestsPerSecond, c.RateLimit.Burst)
+		cors.SetOrigins(c.AllowedOrigins())
+	})
+	ctxConfig, cancelConfig := context.WithCancel(context.Background())
+	defer cancelConfig()
+	go cfgManager.Watch(ctxConfig)
 
-	// gRPCサーバーを作成
-	server := grpc.NewServer()
+	// gRPCサーバーを作成（添付した画像を含むリクエストを受け付けられるよう最大の大きさを広げる）
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(cfg.MaxMessageBytes()),
+		grpc.ChainUnaryInterceptor(rateLimiter.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(rateLimiter.StreamServerInterceptor()),
+	)
 	aiv1.RegisterMahjongAIServiceServer(server, handler)
+	healthpb.RegisterHealthServer(server, healthServer)
 
 	// リフレクションを有効にする（開発用）
 	reflection.Register(server)
@@ -87,33 +199,22 @@ func main() {
 	}()
 
 	// Connect ハンドラを作成
-	connectSvc := connectHandler.NewMahjongAIConnectHandler(aiUsecase, logger)
+	connectSvc := connectHandler.NewMahjongAIConnectHandler(aiUsecase, analysisUsecase, gameLogUsecase, reviewUsecase, coachUsecase, knowledgeUsecase, renderUsecase, quizUsecase, healthUsecase, logger)
 	path, connectHTTPHandler := aiv1connect.NewMahjongAIServiceHandler(connectSvc,
 		connect.WithCompressMinBytes(1024),
-		connect.WithReadMaxBytes(10*1024*1024),
+		connect.WithReadMaxBytes(cfg.MaxMessageBytes()),
 	)
 
 	// HTTPサーバ (h2c) を起動
 	mux := http.NewServeMux()
-	mux.Handle(path, connectHTTPHandler)
-	// CORS: シンプルにワイルドカード対応（必要に応じて強化）
-	cors := func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Access-Control-Allow-Origin", cfg.CORSAllowOrigins)
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Connect-Protocol, Authorization")
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-			w.Header().Set("Access-Control-Expose-Headers", "Connect-Content-Encoding, Connect-Accept-Encoding")
-			if r.Method == http.MethodOptions {
-				w.WriteHeader(http.StatusNoContent)
-				return
-			}
-			next.ServeHTTP(w, r)
-		})
-	}
+	mux.Handle(path, rateLimiter.Handler(connectHTTPHandler))
+	healthHandler.RegisterHTTP(mux, healthUsecase)
+	mux.Handle(renderHandler.Path, rateLimiter.Handler(renderHandler.NewHandler(renderUsecase, logger)))
+	mux.Handle("/mjai", rateLimiter.Handler(mjaiServer.WebSocketHandler(cors.Allowed)))
 
 	httpServer := &http.Server{
 		Addr:    fmt.Sprintf(":%s", cfg.HTTPPort),
-		Handler: h2c.NewHandler(cors(mux), &http2.Server{}),
+		Handler: h2c.NewHandler(cors.Handler(mux), &http2.Server{}),
 	}
 
 	go func() {
@@ -123,12 +224,32 @@ func main() {
 		}
 	}()
 
+	// mjai の TCP サーバーを起動
+	ctxMjai, cancelMjai := context.WithCancel(context.Background())
+	defer cancelMjai()
+	if cfg.MjaiPort != "" {
+		mjaiLis, err := net.Listen("tcp", fmt.Sprintf(":%s", cfg.MjaiPort))
+		if err != nil {
+			logger.WithError(err).Fatal("Failed to listen mjai")
+		}
+		go func() {
+			logger.WithField("port", cfg.MjaiPort).Info("mjai TCP server started")
+			if err := mjaiServer.ServeTCP(ctxMjai, mjaiLis); err != nil {
+				logger.WithError(err).Fatal("Failed to serve mjai TCP server")
+			}
+		}()
+	}
+
 	// シグナルを待機
 	quit := make(chan os.Signal, 1)
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
 	logger.Info("Shutting down servers...")
+	cancelConfig()
+	cancelHealth()
+	cancelMjai()
+	healthServer.Shutdown()
 	server.GracefulStop()
 	ctxShutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -9,6 +9,7 @@ package aiv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,56 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// 図の形式
+type DiagramFormat int32
+
+const (
+	DiagramFormat_DIAGRAM_FORMAT_UNSPECIFIED DiagramFormat = 0 // 未指定（SVG として扱う）
+	DiagramFormat_DIAGRAM_FORMAT_SVG         DiagramFormat = 1 // SVG
+	DiagramFormat_DIAGRAM_FORMAT_PNG         DiagramFormat = 2 // PNG
+)
+
+// Enum value maps for DiagramFormat.
+var (
+	DiagramFormat_name = map[int32]string{
+		0: "DIAGRAM_FORMAT_UNSPECIFIED",
+		1: "DIAGRAM_FORMAT_SVG",
+		2: "DIAGRAM_FORMAT_PNG",
+	}
+	DiagramFormat_value = map[string]int32{
+		"DIAGRAM_FORMAT_UNSPECIFIED": 0,
+		"DIAGRAM_FORMAT_SVG":         1,
+		"DIAGRAM_FORMAT_PNG":         2,
+	}
+)
+
+func (x DiagramFormat) Enum() *DiagramFormat {
+	p := new(DiagramFormat)
+	*p = x
+	return p
+}
+
+func (x DiagramFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiagramFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_ai_proto_enumTypes[0].Descriptor()
+}
+
+func (DiagramFormat) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_ai_proto_enumTypes[0]
+}
+
+func (x DiagramFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiagramFormat.Descriptor instead.
+func (DiagramFormat) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{0}
+}
+
 type HealthCheckResponse_ServingStatus int32
 
 const (
@@ -57,11 +108,11 @@ func (x HealthCheckResponse_ServingStatus) String() string {
 }
 
 func (HealthCheckResponse_ServingStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_mahjong_ai_v1_ai_proto_enumTypes[0].Descriptor()
+	return file_mahjong_ai_v1_ai_proto_enumTypes[1].Descriptor()
 }
 
 func (HealthCheckResponse_ServingStatus) Type() protoreflect.EnumType {
-	return &file_mahjong_ai_v1_ai_proto_enumTypes[0]
+	return &file_mahjong_ai_v1_ai_proto_enumTypes[1]
 }
 
 func (x HealthCheckResponse_ServingStatus) Number() protoreflect.EnumNumber {
@@ -70,7 +121,7 @@ func (x HealthCheckResponse_ServingStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use HealthCheckResponse_ServingStatus.Descriptor instead.
 func (HealthCheckResponse_ServingStatus) EnumDescriptor() ([]byte, []int) {
-	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{7, 0}
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{47, 0}
 }
 
 // エラー情報
@@ -219,6 +270,7 @@ type ResponseMetadata struct {
 	Timestamp        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`                                          // レスポンス時刻
 	ProcessingTimeMs int64                  `protobuf:"varint,3,opt,name=processing_time_ms,json=processingTimeMs,proto3" json:"processing_time_ms,omitempty"` // 処理時間（ミリ秒）
 	ServerVersion    string                 `protobuf:"bytes,4,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`             // サーバーバージョン
+	CacheHit         bool                   `protobuf:"varint,5,opt,name=cache_hit,json=cacheHit,proto3" json:"cache_hit,omitempty"`                           // AIに問い合わせず、キャッシュした回答を返したか
 }
 
 func (x *ResponseMetadata) Reset() {
@@ -281,17 +333,35 @@ func (x *ResponseMetadata) GetServerVersion() string {
 	return ""
 }
 
+func (x *ResponseMetadata) GetCacheHit() bool {
+	if x != nil {
+		return x.CacheHit
+	}
+	return false
+}
+
 // 麻雀AIのリクエスト
 type AskMahjongAIRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Prompt      string           `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`                         // 麻雀AIへの質問
-	Metadata    *RequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`                     // リクエストメタデータ
-	MaxTokens   int32            `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"` // 最大トークン数
-	Temperature float32          `protobuf:"fixed32,4,opt,name=temperature,proto3" json:"temperature,omitempty"`             // 温度パラメータ (0.0-2.0)
-	Context     []string         `protobuf:"bytes,5,rep,name=context,proto3" json:"context,omitempty"`                       // コンテキスト情報
+	Prompt          string           `protobuf:"bytes,1,opt,name=prompt,proto3" json:"prompt,omitempty"`                                             // 麻雀AIへの質問
+	Metadata        *RequestMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`                                         // リクエストメタデータ
+	MaxTokens       int32            `protobuf:"varint,3,opt,name=max_tokens,json=maxTokens,proto3" json:"max_tokens,omitempty"`                     // 最大トークン数
+	Temperature     float32          `protobuf:"fixed32,4,opt,name=temperature,proto3" json:"temperature,omitempty"`                                 // 温度パラメータ (0.0-2.0)
+	Context         []string         `protobuf:"bytes,5,rep,name=context,proto3" json:"context,omitempty"`                                           // コンテキスト情報
+	Persona         string           `protobuf:"bytes,6,opt,name=persona,proto3" json:"persona,omitempty"`                                           // ペルソナ名（空の場合はデフォルト）
+	UserLevel       string           `protobuf:"bytes,7,opt,name=user_level,json=userLevel,proto3" json:"user_level,omitempty"`                      // ユーザーのレベル (beginner, intermediate, advanced など)
+	Language        string           `protobuf:"bytes,8,opt,name=language,proto3" json:"language,omitempty"`                                         // 回答言語 (ja, en など)
+	RuleSet         string           `protobuf:"bytes,9,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                            // ルールセット名 (tenhou, mleague, wrc, ema, mahjongsoul, sanma)
+	ConversationId  string           `protobuf:"bytes,10,opt,name=conversation_id,json=conversationId,proto3" json:"conversation_id,omitempty"`      // 会話ID（指定したルールセットを同じ会話の以降のリクエストに引き継ぐ）
+	GameState       *GameState       `protobuf:"bytes,11,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`                     // 局面（プロンプトに整形して渡し、手牌が分かる場合はエンジンで解析する）
+	GameLogPosition *GameLogPosition `protobuf:"bytes,12,opt,name=game_log_position,json=gameLogPosition,proto3" json:"game_log_position,omitempty"` // 取り込んだ牌譜の局面（game_state とは同時に指定できない）
+	Samples         int32            `protobuf:"varint,13,opt,name=samples,proto3" json:"samples,omitempty"`                                         // 自己一貫性のために生成して比べる回答の数（0・1 は比べない、最大5、ストリーミングでは指定できない）
+	ResponseSchema  string           `protobuf:"bytes,14,opt,name=response_schema,json=responseSchema,proto3" json:"response_schema,omitempty"`      // 回答をJSONで出力させる形式 (discard, score, push_fold、空の場合は自由な文章、ストリーミングでは指定できない)
+	Attachments     []*Attachment    `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`                                  // 質問に添付する画像（雀魂のスクリーンショットや牌の写真など）
+	TranscribeHand  bool             `protobuf:"varint,16,opt,name=transcribe_hand,json=transcribeHand,proto3" json:"transcribe_hand,omitempty"`     // 回答の前に画像の手牌をMPSZ表記に書き起こし、牌の表記として検証してから質問に添える（attachments が必要）
 }
 
 func (x *AskMahjongAIRequest) Reset() {
@@ -361,6 +431,219 @@ func (x *AskMahjongAIRequest) GetContext() []string {
 	return nil
 }
 
+func (x *AskMahjongAIRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+func (x *AskMahjongAIRequest) GetUserLevel() string {
+	if x != nil {
+		return x.UserLevel
+	}
+	return ""
+}
+
+func (x *AskMahjongAIRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *AskMahjongAIRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *AskMahjongAIRequest) GetConversationId() string {
+	if x != nil {
+		return x.ConversationId
+	}
+	return ""
+}
+
+func (x *AskMahjongAIRequest) GetGameState() *GameState {
+	if x != nil {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *AskMahjongAIRequest) GetGameLogPosition() *GameLogPosition {
+	if x != nil {
+		return x.GameLogPosition
+	}
+	return nil
+}
+
+func (x *AskMahjongAIRequest) GetSamples() int32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *AskMahjongAIRequest) GetResponseSchema() string {
+	if x != nil {
+		return x.ResponseSchema
+	}
+	return ""
+}
+
+func (x *AskMahjongAIRequest) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *AskMahjongAIRequest) GetTranscribeHand() bool {
+	if x != nil {
+		return x.TranscribeHand
+	}
+	return false
+}
+
+// 質問に添付するファイル
+type Attachment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                         // ファイルの内容
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // MIMEタイプ (image/png, image/jpeg, image/webp, image/heic, image/heif)
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Attachment) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Attachment) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+// 画像の手牌を書き起こした結果
+type HandTranscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hand     string  `protobuf:"bytes,1,opt,name=hand,proto3" json:"hand,omitempty"`          // 門前の牌（MPSZ表記）
+	Melds    []*Meld `protobuf:"bytes,2,rep,name=melds,proto3" json:"melds,omitempty"`        // 副露
+	Valid    bool    `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`       // 牌の表記と枚数の検証に通ったか（通らなかった場合は質問に添えていない）
+	Error    string  `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`        // 検証に通らなかった理由
+	Attempts int32   `protobuf:"varint,5,opt,name=attempts,proto3" json:"attempts,omitempty"` // 書き起こしを問い合わせた回数
+}
+
+func (x *HandTranscription) Reset() {
+	*x = HandTranscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandTranscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandTranscription) ProtoMessage() {}
+
+func (x *HandTranscription) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandTranscription.ProtoReflect.Descriptor instead.
+func (*HandTranscription) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HandTranscription) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *HandTranscription) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *HandTranscription) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *HandTranscription) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *HandTranscription) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
 // 麻雀AIのレスポンス
 type AskMahjongAIResponse struct {
 	state         protoimpl.MessageState
@@ -371,16 +654,22 @@ type AskMahjongAIResponse struct {
 	//
 	//	*AskMahjongAIResponse_Response
 	//	*AskMahjongAIResponse_Error
-	Result     isAskMahjongAIResponse_Result `protobuf_oneof:"result"`
-	Metadata   *ResponseMetadata             `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`                        // レスポンスメタデータ
-	TokensUsed int32                         `protobuf:"varint,4,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"` // 使用トークン数
-	Confidence float32                       `protobuf:"fixed32,5,opt,name=confidence,proto3" json:"confidence,omitempty"`                  // 信頼度スコア (0.0-1.0)
+	Result          isAskMahjongAIResponse_Result `protobuf_oneof:"result"`
+	Metadata        *ResponseMetadata             `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`                                      // レスポンスメタデータ
+	TokensUsed      int32                         `protobuf:"varint,4,opt,name=tokens_used,json=tokensUsed,proto3" json:"tokens_used,omitempty"`               // 使用トークン数
+	Confidence      float32                       `protobuf:"fixed32,5,opt,name=confidence,proto3" json:"confidence,omitempty"`                                // 回答の検証結果と複数の回答の結論の一致率から求めた信頼度 (0.0-1.0、0 は検証していない)
+	ToolCalls       []*ToolCallInfo               `protobuf:"bytes,6,rep,name=tool_calls,json=toolCalls,proto3" json:"tool_calls,omitempty"`                   // 回答の根拠としてAIが呼び出したツール
+	Verification    *AnswerVerification           `protobuf:"bytes,7,opt,name=verification,proto3" json:"verification,omitempty"`                              // 回答の向聴数・待ち・点数をエンジンで検証した結果（検証していない場合は未設定）
+	SelfConsistency *SelfConsistency              `protobuf:"bytes,8,opt,name=self_consistency,json=selfConsistency,proto3" json:"self_consistency,omitempty"` // 複数の回答の結論を比べた結果（samples が2以上の場合のみ）
+	Structured      *structpb.Struct              `protobuf:"bytes,9,opt,name=structured,proto3" json:"structured,omitempty"`                                  // response_schema を指定した場合に回答のJSONを解析した値（response には同じJSONの文字列）
+	Citations       []*Citation                   `protobuf:"bytes,10,rep,name=citations,proto3" json:"citations,omitempty"`                                   // 回答の根拠としてAIに渡したルールの資料の抜粋
+	Transcription   *HandTranscription            `protobuf:"bytes,11,opt,name=transcription,proto3" json:"transcription,omitempty"`                           // transcribe_hand を指定した場合に画像の手牌を書き起こした結果
 }
 
 func (x *AskMahjongAIResponse) Reset() {
 	*x = AskMahjongAIResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[4]
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -393,7 +682,7 @@ func (x *AskMahjongAIResponse) String() string {
 func (*AskMahjongAIResponse) ProtoMessage() {}
 
 func (x *AskMahjongAIResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[4]
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +695,7 @@ func (x *AskMahjongAIResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AskMahjongAIResponse.ProtoReflect.Descriptor instead.
 func (*AskMahjongAIResponse) Descriptor() ([]byte, []int) {
-	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{4}
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *AskMahjongAIResponse) GetResult() isAskMahjongAIResponse_Result {
@@ -451,6 +740,48 @@ func (x *AskMahjongAIResponse) GetConfidence() float32 {
 	return 0
 }
 
+func (x *AskMahjongAIResponse) GetToolCalls() []*ToolCallInfo {
+	if x != nil {
+		return x.ToolCalls
+	}
+	return nil
+}
+
+func (x *AskMahjongAIResponse) GetVerification() *AnswerVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *AskMahjongAIResponse) GetSelfConsistency() *SelfConsistency {
+	if x != nil {
+		return x.SelfConsistency
+	}
+	return nil
+}
+
+func (x *AskMahjongAIResponse) GetStructured() *structpb.Struct {
+	if x != nil {
+		return x.Structured
+	}
+	return nil
+}
+
+func (x *AskMahjongAIResponse) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *AskMahjongAIResponse) GetTranscription() *HandTranscription {
+	if x != nil {
+		return x.Transcription
+	}
+	return nil
+}
+
 type isAskMahjongAIResponse_Result interface {
 	isAskMahjongAIResponse_Result()
 }
@@ -467,38 +798,37 @@ func (*AskMahjongAIResponse_Response) isAskMahjongAIResponse_Result() {}
 
 func (*AskMahjongAIResponse_Error) isAskMahjongAIResponse_Result() {}
 
-// ストリーミングレスポンス
-type AskMahjongAIStreamResponse struct {
+// 回答の根拠としてAIに渡したルールの資料の抜粋
+type Citation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Chunk:
-	//
-	//	*AskMahjongAIStreamResponse_TextChunk
-	//	*AskMahjongAIStreamResponse_Error
-	//	*AskMahjongAIStreamResponse_Metadata
-	Chunk   isAskMahjongAIStreamResponse_Chunk `protobuf_oneof:"chunk"`
-	IsFinal bool                               `protobuf:"varint,4,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"` // 最終チャンクかどうか
+	Document string  `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"` // 文書のパス
+	Title    string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`       // 文書の題名
+	Section  string  `protobuf:"bytes,3,opt,name=section,proto3" json:"section,omitempty"`   // 節の見出し（文書の冒頭は空）
+	Snippet  string  `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`   // 抜粋の本文の冒頭
+	Score    float32 `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`     // 質問との関連度（検索の得点）
+	Cited    bool    `protobuf:"varint,6,opt,name=cited,proto3" json:"cited,omitempty"`      // 回答が抜粋の番号（[1] など）を示して引用したか
 }
 
-func (x *AskMahjongAIStreamResponse) Reset() {
-	*x = AskMahjongAIStreamResponse{}
+func (x *Citation) Reset() {
+	*x = Citation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[5]
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AskMahjongAIStreamResponse) String() string {
+func (x *Citation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AskMahjongAIStreamResponse) ProtoMessage() {}
+func (*Citation) ProtoMessage() {}
 
-func (x *AskMahjongAIStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[5]
+func (x *Citation) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -509,94 +839,208 @@ func (x *AskMahjongAIStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AskMahjongAIStreamResponse.ProtoReflect.Descriptor instead.
-func (*AskMahjongAIStreamResponse) Descriptor() ([]byte, []int) {
-	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use Citation.ProtoReflect.Descriptor instead.
+func (*Citation) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{7}
 }
 
-func (m *AskMahjongAIStreamResponse) GetChunk() isAskMahjongAIStreamResponse_Chunk {
-	if m != nil {
-		return m.Chunk
+func (x *Citation) GetDocument() string {
+	if x != nil {
+		return x.Document
 	}
-	return nil
+	return ""
 }
 
-func (x *AskMahjongAIStreamResponse) GetTextChunk() string {
-	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_TextChunk); ok {
-		return x.TextChunk
+func (x *Citation) GetTitle() string {
+	if x != nil {
+		return x.Title
 	}
 	return ""
 }
 
-func (x *AskMahjongAIStreamResponse) GetError() *ErrorInfo {
-	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Error); ok {
-		return x.Error
+func (x *Citation) GetSection() string {
+	if x != nil {
+		return x.Section
 	}
-	return nil
+	return ""
 }
 
-func (x *AskMahjongAIStreamResponse) GetMetadata() *ResponseMetadata {
-	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Metadata); ok {
-		return x.Metadata
+func (x *Citation) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
 	}
-	return nil
+	return ""
 }
 
-func (x *AskMahjongAIStreamResponse) GetIsFinal() bool {
+func (x *Citation) GetScore() float32 {
 	if x != nil {
-		return x.IsFinal
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Citation) GetCited() bool {
+	if x != nil {
+		return x.Cited
 	}
 	return false
 }
 
-type isAskMahjongAIStreamResponse_Chunk interface {
-	isAskMahjongAIStreamResponse_Chunk()
+// ストリーミングで送る引用の一覧
+type CitationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Citations []*Citation `protobuf:"bytes,1,rep,name=citations,proto3" json:"citations,omitempty"`
 }
 
-type AskMahjongAIStreamResponse_TextChunk struct {
-	TextChunk string `protobuf:"bytes,1,opt,name=text_chunk,json=textChunk,proto3,oneof"` // テキストチャンク
+func (x *CitationList) Reset() {
+	*x = CitationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type AskMahjongAIStreamResponse_Error struct {
-	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+func (x *CitationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type AskMahjongAIStreamResponse_Metadata struct {
-	Metadata *ResponseMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof"` // 最終メタデータ（ストリーム終了時）
+func (*CitationList) ProtoMessage() {}
+
+func (x *CitationList) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (*AskMahjongAIStreamResponse_TextChunk) isAskMahjongAIStreamResponse_Chunk() {}
+// Deprecated: Use CitationList.ProtoReflect.Descriptor instead.
+func (*CitationList) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{8}
+}
 
-func (*AskMahjongAIStreamResponse_Error) isAskMahjongAIStreamResponse_Chunk() {}
+func (x *CitationList) GetCitations() []*Citation {
+	if x != nil {
+		return x.Citations
+	}
+	return nil
+}
 
-func (*AskMahjongAIStreamResponse_Metadata) isAskMahjongAIStreamResponse_Chunk() {}
+// ルールの資料の索引の情報
+type KnowledgeIndexInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-// ヘルスチェックリクエスト
-type HealthCheckRequest struct {
+	Passages   int32  `protobuf:"varint,1,opt,name=passages,proto3" json:"passages,omitempty"`                       // 索引に含まれる抜粋の数
+	Embedded   int32  `protobuf:"varint,2,opt,name=embedded,proto3" json:"embedded,omitempty"`                       // 埋め込みベクトルを新たに求めた抜粋の数
+	Reused     int32  `protobuf:"varint,3,opt,name=reused,proto3" json:"reused,omitempty"`                           // 本文が変わっておらず、保存済みのベクトルを使った抜粋の数
+	Model      string `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`                              // 埋め込みモデルの名前（埋め込みを使わない場合は空）
+	DurationMs int64  `protobuf:"varint,5,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"` // 索引の作成にかかった時間（ミリ秒）
+}
+
+func (x *KnowledgeIndexInfo) Reset() {
+	*x = KnowledgeIndexInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KnowledgeIndexInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KnowledgeIndexInfo) ProtoMessage() {}
+
+func (x *KnowledgeIndexInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KnowledgeIndexInfo.ProtoReflect.Descriptor instead.
+func (*KnowledgeIndexInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KnowledgeIndexInfo) GetPassages() int32 {
+	if x != nil {
+		return x.Passages
+	}
+	return 0
+}
+
+func (x *KnowledgeIndexInfo) GetEmbedded() int32 {
+	if x != nil {
+		return x.Embedded
+	}
+	return 0
+}
+
+func (x *KnowledgeIndexInfo) GetReused() int32 {
+	if x != nil {
+		return x.Reused
+	}
+	return 0
+}
+
+func (x *KnowledgeIndexInfo) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *KnowledgeIndexInfo) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// 結論ごとの回答の数
+type ConclusionVote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"` // サービス名（オプション）
+	Conclusion string `protobuf:"bytes,1,opt,name=conclusion,proto3" json:"conclusion,omitempty"` // 回答の結論（「打5s」「7700点」など、取り出せなかった場合は空）
+	Count      int32  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`          // この結論になった回答の数
 }
 
-func (x *HealthCheckRequest) Reset() {
-	*x = HealthCheckRequest{}
+func (x *ConclusionVote) Reset() {
+	*x = ConclusionVote{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[6]
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthCheckRequest) String() string {
+func (x *ConclusionVote) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckRequest) ProtoMessage() {}
+func (*ConclusionVote) ProtoMessage() {}
 
-func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[6]
+func (x *ConclusionVote) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,46 +1051,54 @@ func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
-func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
-	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ConclusionVote.ProtoReflect.Descriptor instead.
+func (*ConclusionVote) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *HealthCheckRequest) GetService() string {
+func (x *ConclusionVote) GetConclusion() string {
 	if x != nil {
-		return x.Service
+		return x.Conclusion
 	}
 	return ""
 }
 
-// ヘルスチェックレスポンス
-type HealthCheckResponse struct {
+func (x *ConclusionVote) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// 同じ質問に対する複数の回答の結論を比べた結果
+type SelfConsistency struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status    HealthCheckResponse_ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=mahjong.ai.v1.HealthCheckResponse_ServingStatus" json:"status,omitempty"`
-	Message   string                            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`     // ステータスメッセージ
-	Timestamp *timestamppb.Timestamp            `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // チェック時刻
+	Samples    int32             `protobuf:"varint,1,opt,name=samples,proto3" json:"samples,omitempty"`      // 比べた回答の数（生成に失敗した回答は含まない）
+	Conclusion string            `protobuf:"bytes,2,opt,name=conclusion,proto3" json:"conclusion,omitempty"` // 多数派の結論（結論を取り出せた回答がない場合は空）
+	Votes      []*ConclusionVote `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`           // 結論ごとの回答の数（多い順）
+	Agreement  float32           `protobuf:"fixed32,4,opt,name=agreement,proto3" json:"agreement,omitempty"` // 多数派の結論になった回答の割合 (0.0-1.0)
 }
 
-func (x *HealthCheckResponse) Reset() {
-	*x = HealthCheckResponse{}
+func (x *SelfConsistency) Reset() {
+	*x = SelfConsistency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[7]
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HealthCheckResponse) String() string {
+func (x *SelfConsistency) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HealthCheckResponse) ProtoMessage() {}
+func (*SelfConsistency) ProtoMessage() {}
 
-func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[7]
+func (x *SelfConsistency) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -657,242 +1109,4729 @@ func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
-func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use SelfConsistency.ProtoReflect.Descriptor instead.
+func (*SelfConsistency) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_ServingStatus {
+func (x *SelfConsistency) GetSamples() int32 {
 	if x != nil {
-		return x.Status
+		return x.Samples
 	}
-	return HealthCheckResponse_UNKNOWN
+	return 0
 }
 
-func (x *HealthCheckResponse) GetMessage() string {
+func (x *SelfConsistency) GetConclusion() string {
 	if x != nil {
-		return x.Message
+		return x.Conclusion
 	}
 	return ""
 }
 
-func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
+func (x *SelfConsistency) GetVotes() []*ConclusionVote {
 	if x != nil {
-		return x.Timestamp
+		return x.Votes
 	}
 	return nil
 }
 
-var File_mahjong_ai_v1_ai_proto protoreflect.FileDescriptor
+func (x *SelfConsistency) GetAgreement() float32 {
+	if x != nil {
+		return x.Agreement
+	}
+	return 0
+}
 
-var file_mahjong_ai_v1_ai_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x2f,
-	0x61, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
-	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x94, 0x02,
-	0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x45, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xc0, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10,
-	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
-	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xc4, 0x01, 0x0a, 0x13, 0x41, 0x73, 0x6b, 0x4d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
-	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0xee,
-	0x01, 0x0a, 0x14, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
-	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
-	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75,
-	0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65,
-	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x64, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
-	0xd2, 0x01, 0x0a, 0x1a, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
-	0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
-	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x3d, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x63,
-	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x2e, 0x0a, 0x12, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x22, 0x84, 0x02, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x4f, 0x0a, 0x0d, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56,
-	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52,
-	0x56, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43,
-	0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x03, 0x32, 0xa8, 0x02, 0x0a, 0x10,
-	0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x57, 0x0a, 0x0c, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49,
-	0x12, 0x22, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
-	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41,
-	0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x41, 0x73, 0x6b,
-	0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x22, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
-	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x12, 0x54, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
-	0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
-	0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbb, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
-	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x41, 0x69,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x6e, 0x64, 0x61, 0x6d, 0x61, 0x6e, 0x30, 0x32, 0x31, 0x35,
-	0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x69, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x61,
-	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x69, 0x76, 0x31,
-	0xa2, 0x02, 0x03, 0x4d, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
-	0x2e, 0x41, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
-	0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
-	0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x0f, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x3a, 0x3a, 0x41, 0x69,
-	0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+// 回答に含まれる主張をエンジンで検証した結果
+type ClaimCheckInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind       string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`             // 主張の種類（shanten / waits / score）
+	Claim      string `protobuf:"bytes,2,opt,name=claim,proto3" json:"claim,omitempty"`           // 回答中の主張の表現
+	Correct    bool   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`      // エンジンの計算と一致したか
+	Correction string `protobuf:"bytes,4,opt,name=correction,proto3" json:"correction,omitempty"` // 誤っていた場合の訂正文
 }
 
-var (
-	file_mahjong_ai_v1_ai_proto_rawDescOnce sync.Once
-	file_mahjong_ai_v1_ai_proto_rawDescData = file_mahjong_ai_v1_ai_proto_rawDesc
-)
+func (x *ClaimCheckInfo) Reset() {
+	*x = ClaimCheckInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_mahjong_ai_v1_ai_proto_rawDescGZIP() []byte {
-	file_mahjong_ai_v1_ai_proto_rawDescOnce.Do(func() {
-		file_mahjong_ai_v1_ai_proto_rawDescData = protoimpl.X.CompressGZIP(file_mahjong_ai_v1_ai_proto_rawDescData)
-	})
-	return file_mahjong_ai_v1_ai_proto_rawDescData
+func (x *ClaimCheckInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_mahjong_ai_v1_ai_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mahjong_ai_v1_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_mahjong_ai_v1_ai_proto_goTypes = []interface{}{
-	(HealthCheckResponse_ServingStatus)(0), // 0: mahjong.ai.v1.HealthCheckResponse.ServingStatus
-	(*ErrorInfo)(nil),                      // 1: mahjong.ai.v1.ErrorInfo
-	(*RequestMetadata)(nil),                // 2: mahjong.ai.v1.RequestMetadata
-	(*ResponseMetadata)(nil),               // 3: mahjong.ai.v1.ResponseMetadata
-	(*AskMahjongAIRequest)(nil),            // 4: mahjong.ai.v1.AskMahjongAIRequest
-	(*AskMahjongAIResponse)(nil),           // 5: mahjong.ai.v1.AskMahjongAIResponse
-	(*AskMahjongAIStreamResponse)(nil),     // 6: mahjong.ai.v1.AskMahjongAIStreamResponse
-	(*HealthCheckRequest)(nil),             // 7: mahjong.ai.v1.HealthCheckRequest
-	(*HealthCheckResponse)(nil),            // 8: mahjong.ai.v1.HealthCheckResponse
-	nil,                                    // 9: mahjong.ai.v1.RequestMetadata.HeadersEntry
-	(*timestamppb.Timestamp)(nil),          // 10: google.protobuf.Timestamp
+func (*ClaimCheckInfo) ProtoMessage() {}
+
+func (x *ClaimCheckInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_mahjong_ai_v1_ai_proto_depIdxs = []int32{
-	10, // 0: mahjong.ai.v1.RequestMetadata.timestamp:type_name -> google.protobuf.Timestamp
-	9,  // 1: mahjong.ai.v1.RequestMetadata.headers:type_name -> mahjong.ai.v1.RequestMetadata.HeadersEntry
-	10, // 2: mahjong.ai.v1.ResponseMetadata.timestamp:type_name -> google.protobuf.Timestamp
-	2,  // 3: mahjong.ai.v1.AskMahjongAIRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
-	1,  // 4: mahjong.ai.v1.AskMahjongAIResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
-	3,  // 5: mahjong.ai.v1.AskMahjongAIResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
-	1,  // 6: mahjong.ai.v1.AskMahjongAIStreamResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
-	3,  // 7: mahjong.ai.v1.AskMahjongAIStreamResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
-	0,  // 8: mahjong.ai.v1.HealthCheckResponse.status:type_name -> mahjong.ai.v1.HealthCheckResponse.ServingStatus
-	10, // 9: mahjong.ai.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
-	4,  // 10: mahjong.ai.v1.MahjongAIService.AskMahjongAI:input_type -> mahjong.ai.v1.AskMahjongAIRequest
-	4,  // 11: mahjong.ai.v1.MahjongAIService.AskMahjongAIStream:input_type -> mahjong.ai.v1.AskMahjongAIRequest
-	7,  // 12: mahjong.ai.v1.MahjongAIService.HealthCheck:input_type -> mahjong.ai.v1.HealthCheckRequest
-	5,  // 13: mahjong.ai.v1.MahjongAIService.AskMahjongAI:output_type -> mahjong.ai.v1.AskMahjongAIResponse
-	6,  // 14: mahjong.ai.v1.MahjongAIService.AskMahjongAIStream:output_type -> mahjong.ai.v1.AskMahjongAIStreamResponse
-	8,  // 15: mahjong.ai.v1.MahjongAIService.HealthCheck:output_type -> mahjong.ai.v1.HealthCheckResponse
-	13, // [13:16] is the sub-list for method output_type
-	10, // [10:13] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+
+// Deprecated: Use ClaimCheckInfo.ProtoReflect.Descriptor instead.
+func (*ClaimCheckInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{12}
 }
 
-func init() { file_mahjong_ai_v1_ai_proto_init() }
-func file_mahjong_ai_v1_ai_proto_init() {
-	if File_mahjong_ai_v1_ai_proto != nil {
-		return
+func (x *ClaimCheckInfo) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_mahjong_ai_v1_ai_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ErrorInfo); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return ""
+}
+
+func (x *ClaimCheckInfo) GetClaim() string {
+	if x != nil {
+		return x.Claim
+	}
+	return ""
+}
+
+func (x *ClaimCheckInfo) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *ClaimCheckInfo) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+// 回答の検証結果
+type AnswerVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checks      []*ClaimCheckInfo `protobuf:"bytes,1,rep,name=checks,proto3" json:"checks,omitempty"`            // 検証した主張
+	Regenerated bool              `protobuf:"varint,2,opt,name=regenerated,proto3" json:"regenerated,omitempty"` // 誤りを伝えて回答を生成し直したか
+	Annotated   bool              `protobuf:"varint,3,opt,name=annotated,proto3" json:"annotated,omitempty"`     // 訂正を回答の末尾に追記したか
+	Confidence  float32           `protobuf:"fixed32,4,opt,name=confidence,proto3" json:"confidence,omitempty"`  // 検証結果から求めた信頼度 (0.0-1.0)
+}
+
+func (x *AnswerVerification) Reset() {
+	*x = AnswerVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnswerVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerVerification) ProtoMessage() {}
+
+func (x *AnswerVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RequestMetadata); i {
-			case 0:
-				return &v.state
-			case 1:
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerVerification.ProtoReflect.Descriptor instead.
+func (*AnswerVerification) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *AnswerVerification) GetChecks() []*ClaimCheckInfo {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+func (x *AnswerVerification) GetRegenerated() bool {
+	if x != nil {
+		return x.Regenerated
+	}
+	return false
+}
+
+func (x *AnswerVerification) GetAnnotated() bool {
+	if x != nil {
+		return x.Annotated
+	}
+	return false
+}
+
+func (x *AnswerVerification) GetConfidence() float32 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// AIによるツールの呼び出し
+type ToolCallInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`           // ツール名
+	Arguments string `protobuf:"bytes,2,opt,name=arguments,proto3" json:"arguments,omitempty"` // 引数（JSON）
+	Result    string `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`       // 結果（JSON）
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`         // ツールの実行に失敗した場合のエラー
+}
+
+func (x *ToolCallInfo) Reset() {
+	*x = ToolCallInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolCallInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolCallInfo) ProtoMessage() {}
+
+func (x *ToolCallInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolCallInfo.ProtoReflect.Descriptor instead.
+func (*ToolCallInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ToolCallInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetArguments() string {
+	if x != nil {
+		return x.Arguments
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+func (x *ToolCallInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// ストリーミングレスポンス
+type AskMahjongAIStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Chunk:
+	//
+	//	*AskMahjongAIStreamResponse_TextChunk
+	//	*AskMahjongAIStreamResponse_Error
+	//	*AskMahjongAIStreamResponse_Metadata
+	//	*AskMahjongAIStreamResponse_ToolCall
+	//	*AskMahjongAIStreamResponse_Verification
+	//	*AskMahjongAIStreamResponse_Citations
+	//	*AskMahjongAIStreamResponse_Transcription
+	Chunk   isAskMahjongAIStreamResponse_Chunk `protobuf_oneof:"chunk"`
+	IsFinal bool                               `protobuf:"varint,4,opt,name=is_final,json=isFinal,proto3" json:"is_final,omitempty"` // 最終チャンクかどうか
+}
+
+func (x *AskMahjongAIStreamResponse) Reset() {
+	*x = AskMahjongAIStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AskMahjongAIStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskMahjongAIStreamResponse) ProtoMessage() {}
+
+func (x *AskMahjongAIStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskMahjongAIStreamResponse.ProtoReflect.Descriptor instead.
+func (*AskMahjongAIStreamResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{15}
+}
+
+func (m *AskMahjongAIStreamResponse) GetChunk() isAskMahjongAIStreamResponse_Chunk {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetTextChunk() string {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_TextChunk); ok {
+		return x.TextChunk
+	}
+	return ""
+}
+
+func (x *AskMahjongAIStreamResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetMetadata() *ResponseMetadata {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetToolCall() *ToolCallInfo {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_ToolCall); ok {
+		return x.ToolCall
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetVerification() *AnswerVerification {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Verification); ok {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetCitations() *CitationList {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Citations); ok {
+		return x.Citations
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetTranscription() *HandTranscription {
+	if x, ok := x.GetChunk().(*AskMahjongAIStreamResponse_Transcription); ok {
+		return x.Transcription
+	}
+	return nil
+}
+
+func (x *AskMahjongAIStreamResponse) GetIsFinal() bool {
+	if x != nil {
+		return x.IsFinal
+	}
+	return false
+}
+
+type isAskMahjongAIStreamResponse_Chunk interface {
+	isAskMahjongAIStreamResponse_Chunk()
+}
+
+type AskMahjongAIStreamResponse_TextChunk struct {
+	TextChunk string `protobuf:"bytes,1,opt,name=text_chunk,json=textChunk,proto3,oneof"` // テキストチャンク
+}
+
+type AskMahjongAIStreamResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+type AskMahjongAIStreamResponse_Metadata struct {
+	Metadata *ResponseMetadata `protobuf:"bytes,3,opt,name=metadata,proto3,oneof"` // 最終メタデータ（ストリーム終了時）
+}
+
+type AskMahjongAIStreamResponse_ToolCall struct {
+	ToolCall *ToolCallInfo `protobuf:"bytes,5,opt,name=tool_call,json=toolCall,proto3,oneof"` // AIが呼び出したツール
+}
+
+type AskMahjongAIStreamResponse_Verification struct {
+	Verification *AnswerVerification `protobuf:"bytes,6,opt,name=verification,proto3,oneof"` // 回答の検証結果（最終メタデータの直前）
+}
+
+type AskMahjongAIStreamResponse_Citations struct {
+	Citations *CitationList `protobuf:"bytes,7,opt,name=citations,proto3,oneof"` // 回答の根拠としてAIに渡したルールの資料の抜粋（テキストの後）
+}
+
+type AskMahjongAIStreamResponse_Transcription struct {
+	Transcription *HandTranscription `protobuf:"bytes,8,opt,name=transcription,proto3,oneof"` // 画像の手牌を書き起こした結果（transcribe_hand を指定した場合、テキストの前）
+}
+
+func (*AskMahjongAIStreamResponse_TextChunk) isAskMahjongAIStreamResponse_Chunk() {}
+
+func (*AskMahjongAIStreamResponse_Error) isAskMahjongAIStreamResponse_Chunk() {}
+
+func (*AskMahjongAIStreamResponse_Metadata) isAskMahjongAIStreamResponse_Chunk() {}
+
+func (*AskMahjongAIStreamResponse_ToolCall) isAskMahjongAIStreamResponse_Chunk() {}
+
+func (*AskMahjongAIStreamResponse_Verification) isAskMahjongAIStreamResponse_Chunk() {}
+
+func (*AskMahjongAIStreamResponse_Citations) isAskMahjongAIStreamResponse_Chunk() {}
+
+func (*AskMahjongAIStreamResponse_Transcription) isAskMahjongAIStreamResponse_Chunk() {}
+
+// 待ち判定のリクエスト
+type GetWaitsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata               *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                                                // リクエストメタデータ
+	Hand                   string           `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`                                                                        // 門前の牌（副露1つにつき3枚少ない13枚）
+	Melds                  []*Meld          `protobuf:"bytes,3,rep,name=melds,proto3" json:"melds,omitempty"`                                                                      // 副露
+	RuleSet                string           `protobuf:"bytes,4,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                                                   // ルールセット名（空の場合はデフォルト）
+	SeatWind               Wind             `protobuf:"varint,5,opt,name=seat_wind,json=seatWind,proto3,enum=mahjong.ai.v1.Wind" json:"seat_wind,omitempty"`                       // 自風（未指定の場合は南家）
+	RoundWind              Wind             `protobuf:"varint,6,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"`                    // 場風（未指定の場合は東場）
+	Riichi                 bool             `protobuf:"varint,7,opt,name=riichi,proto3" json:"riichi,omitempty"`                                                                   // 立直しているか
+	DoraIndicators         string           `protobuf:"bytes,8,opt,name=dora_indicators,json=doraIndicators,proto3" json:"dora_indicators,omitempty"`                              // ドラ表示牌
+	Discards               string           `protobuf:"bytes,9,opt,name=discards,proto3" json:"discards,omitempty"`                                                                // 自分の捨て牌
+	PassedSinceLastDiscard string           `protobuf:"bytes,10,opt,name=passed_since_last_discard,json=passedSinceLastDiscard,proto3" json:"passed_since_last_discard,omitempty"` // 自分の最後の打牌以降に見逃した牌
+	PassedAfterRiichi      string           `protobuf:"bytes,11,opt,name=passed_after_riichi,json=passedAfterRiichi,proto3" json:"passed_after_riichi,omitempty"`                  // 立直後に見逃した牌
+	VisibleTiles           string           `protobuf:"bytes,12,opt,name=visible_tiles,json=visibleTiles,proto3" json:"visible_tiles,omitempty"`                                   // 手牌以外で見えている牌（残り枚数の計算用）
+}
+
+func (x *GetWaitsRequest) Reset() {
+	*x = GetWaitsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitsRequest) ProtoMessage() {}
+
+func (x *GetWaitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitsRequest.ProtoReflect.Descriptor instead.
+func (*GetWaitsRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetWaitsRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetWaitsRequest) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *GetWaitsRequest) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *GetWaitsRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *GetWaitsRequest) GetSeatWind() Wind {
+	if x != nil {
+		return x.SeatWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *GetWaitsRequest) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *GetWaitsRequest) GetRiichi() bool {
+	if x != nil {
+		return x.Riichi
+	}
+	return false
+}
+
+func (x *GetWaitsRequest) GetDoraIndicators() string {
+	if x != nil {
+		return x.DoraIndicators
+	}
+	return ""
+}
+
+func (x *GetWaitsRequest) GetDiscards() string {
+	if x != nil {
+		return x.Discards
+	}
+	return ""
+}
+
+func (x *GetWaitsRequest) GetPassedSinceLastDiscard() string {
+	if x != nil {
+		return x.PassedSinceLastDiscard
+	}
+	return ""
+}
+
+func (x *GetWaitsRequest) GetPassedAfterRiichi() string {
+	if x != nil {
+		return x.PassedAfterRiichi
+	}
+	return ""
+}
+
+func (x *GetWaitsRequest) GetVisibleTiles() string {
+	if x != nil {
+		return x.VisibleTiles
+	}
+	return ""
+}
+
+// 待ち判定のレスポンス
+type GetWaitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*GetWaitsResponse_Waits
+	//	*GetWaitsResponse_Error
+	Result   isGetWaitsResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata         `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *GetWaitsResponse) Reset() {
+	*x = GetWaitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWaitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWaitsResponse) ProtoMessage() {}
+
+func (x *GetWaitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWaitsResponse.ProtoReflect.Descriptor instead.
+func (*GetWaitsResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *GetWaitsResponse) GetResult() isGetWaitsResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetWaitsResponse) GetWaits() *WaitsResult {
+	if x, ok := x.GetResult().(*GetWaitsResponse_Waits); ok {
+		return x.Waits
+	}
+	return nil
+}
+
+func (x *GetWaitsResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*GetWaitsResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetWaitsResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isGetWaitsResponse_Result interface {
+	isGetWaitsResponse_Result()
+}
+
+type GetWaitsResponse_Waits struct {
+	Waits *WaitsResult `protobuf:"bytes,1,opt,name=waits,proto3,oneof"` // 成功時の結果
+}
+
+type GetWaitsResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*GetWaitsResponse_Waits) isGetWaitsResponse_Result() {}
+
+func (*GetWaitsResponse_Error) isGetWaitsResponse_Result() {}
+
+// 打牌推奨のリクエスト
+type RecommendDiscardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata       *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                             // リクエストメタデータ
+	Hand           string           `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`                                                     // 門前の牌（副露1つにつき3枚少ない14枚）
+	Melds          []*Meld          `protobuf:"bytes,3,rep,name=melds,proto3" json:"melds,omitempty"`                                                   // 副露
+	RuleSet        string           `protobuf:"bytes,4,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                                // ルールセット名（空の場合はデフォルト）
+	SeatWind       Wind             `protobuf:"varint,5,opt,name=seat_wind,json=seatWind,proto3,enum=mahjong.ai.v1.Wind" json:"seat_wind,omitempty"`    // 自風（未指定の場合は南家）
+	RoundWind      Wind             `protobuf:"varint,6,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"` // 場風（未指定の場合は東場）
+	DoraIndicators string           `protobuf:"bytes,7,opt,name=dora_indicators,json=doraIndicators,proto3" json:"dora_indicators,omitempty"`           // ドラ表示牌
+	Discards       string           `protobuf:"bytes,8,opt,name=discards,proto3" json:"discards,omitempty"`                                             // 自分の捨て牌
+	VisibleTiles   string           `protobuf:"bytes,9,opt,name=visible_tiles,json=visibleTiles,proto3" json:"visible_tiles,omitempty"`                 // 手牌以外で見えている牌（残り枚数の計算用）
+	Simulations    int32            `protobuf:"varint,10,opt,name=simulations,proto3" json:"simulations,omitempty"`                                     // モンテカルロ法の試行回数（0 以下の場合は行わない）
+	Turns          int32            `protobuf:"varint,11,opt,name=turns,proto3" json:"turns,omitempty"`                                                 // 残りのツモ回数（0 の場合は既定値）
+	Seed           uint64           `protobuf:"varint,12,opt,name=seed,proto3" json:"seed,omitempty"`                                                   // 乱数の種（同じ種と入力では同じ結果になる）
+}
+
+func (x *RecommendDiscardRequest) Reset() {
+	*x = RecommendDiscardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendDiscardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendDiscardRequest) ProtoMessage() {}
+
+func (x *RecommendDiscardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendDiscardRequest.ProtoReflect.Descriptor instead.
+func (*RecommendDiscardRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RecommendDiscardRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RecommendDiscardRequest) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *RecommendDiscardRequest) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *RecommendDiscardRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *RecommendDiscardRequest) GetSeatWind() Wind {
+	if x != nil {
+		return x.SeatWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *RecommendDiscardRequest) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *RecommendDiscardRequest) GetDoraIndicators() string {
+	if x != nil {
+		return x.DoraIndicators
+	}
+	return ""
+}
+
+func (x *RecommendDiscardRequest) GetDiscards() string {
+	if x != nil {
+		return x.Discards
+	}
+	return ""
+}
+
+func (x *RecommendDiscardRequest) GetVisibleTiles() string {
+	if x != nil {
+		return x.VisibleTiles
+	}
+	return ""
+}
+
+func (x *RecommendDiscardRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *RecommendDiscardRequest) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *RecommendDiscardRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// 打牌推奨のレスポンス
+type RecommendDiscardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*RecommendDiscardResponse_Discard
+	//	*RecommendDiscardResponse_Error
+	Result   isRecommendDiscardResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata                 `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *RecommendDiscardResponse) Reset() {
+	*x = RecommendDiscardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecommendDiscardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecommendDiscardResponse) ProtoMessage() {}
+
+func (x *RecommendDiscardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecommendDiscardResponse.ProtoReflect.Descriptor instead.
+func (*RecommendDiscardResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{19}
+}
+
+func (m *RecommendDiscardResponse) GetResult() isRecommendDiscardResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *RecommendDiscardResponse) GetDiscard() *DiscardResult {
+	if x, ok := x.GetResult().(*RecommendDiscardResponse_Discard); ok {
+		return x.Discard
+	}
+	return nil
+}
+
+func (x *RecommendDiscardResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*RecommendDiscardResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RecommendDiscardResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isRecommendDiscardResponse_Result interface {
+	isRecommendDiscardResponse_Result()
+}
+
+type RecommendDiscardResponse_Discard struct {
+	Discard *DiscardResult `protobuf:"bytes,1,opt,name=discard,proto3,oneof"` // 成功時の結果
+}
+
+type RecommendDiscardResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*RecommendDiscardResponse_Discard) isRecommendDiscardResponse_Result() {}
+
+func (*RecommendDiscardResponse_Error) isRecommendDiscardResponse_Result() {}
+
+// 手牌のシミュレーションのリクエスト
+type SimulateHandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata       *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                             // リクエストメタデータ
+	Hand           string           `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`                                                     // 門前の牌（副露1つにつき3枚少ない13枚または14枚）
+	Melds          []*Meld          `protobuf:"bytes,3,rep,name=melds,proto3" json:"melds,omitempty"`                                                   // 副露
+	RuleSet        string           `protobuf:"bytes,4,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                                // ルールセット名（空の場合はデフォルト）
+	SeatWind       Wind             `protobuf:"varint,5,opt,name=seat_wind,json=seatWind,proto3,enum=mahjong.ai.v1.Wind" json:"seat_wind,omitempty"`    // 自風（未指定の場合は南家）
+	RoundWind      Wind             `protobuf:"varint,6,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"` // 場風（未指定の場合は東場）
+	DoraIndicators string           `protobuf:"bytes,7,opt,name=dora_indicators,json=doraIndicators,proto3" json:"dora_indicators,omitempty"`           // ドラ表示牌
+	Discards       string           `protobuf:"bytes,8,opt,name=discards,proto3" json:"discards,omitempty"`                                             // 自分の捨て牌
+	VisibleTiles   string           `protobuf:"bytes,9,opt,name=visible_tiles,json=visibleTiles,proto3" json:"visible_tiles,omitempty"`                 // 手牌以外で見えている牌（残り枚数の計算用）
+	Riichi         bool             `protobuf:"varint,10,opt,name=riichi,proto3" json:"riichi,omitempty"`                                               // 立直済みか（立直済みの場合はツモ切りのみ）
+	Simulations    int32            `protobuf:"varint,11,opt,name=simulations,proto3" json:"simulations,omitempty"`                                     // 試行回数（0 の場合は既定値）
+	Turns          int32            `protobuf:"varint,12,opt,name=turns,proto3" json:"turns,omitempty"`                                                 // 残りのツモ回数（0 の場合は既定値）
+	Seed           uint64           `protobuf:"varint,13,opt,name=seed,proto3" json:"seed,omitempty"`                                                   // 乱数の種（同じ種と入力では同じ結果になる）
+	Policy         string           `protobuf:"bytes,14,opt,name=policy,proto3" json:"policy,omitempty"`                                                // 打牌の方針（efficiency / tsumogiri、空の場合は efficiency）
+}
+
+func (x *SimulateHandRequest) Reset() {
+	*x = SimulateHandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateHandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateHandRequest) ProtoMessage() {}
+
+func (x *SimulateHandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateHandRequest.ProtoReflect.Descriptor instead.
+func (*SimulateHandRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SimulateHandRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SimulateHandRequest) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *SimulateHandRequest) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *SimulateHandRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *SimulateHandRequest) GetSeatWind() Wind {
+	if x != nil {
+		return x.SeatWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *SimulateHandRequest) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *SimulateHandRequest) GetDoraIndicators() string {
+	if x != nil {
+		return x.DoraIndicators
+	}
+	return ""
+}
+
+func (x *SimulateHandRequest) GetDiscards() string {
+	if x != nil {
+		return x.Discards
+	}
+	return ""
+}
+
+func (x *SimulateHandRequest) GetVisibleTiles() string {
+	if x != nil {
+		return x.VisibleTiles
+	}
+	return ""
+}
+
+func (x *SimulateHandRequest) GetRiichi() bool {
+	if x != nil {
+		return x.Riichi
+	}
+	return false
+}
+
+func (x *SimulateHandRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *SimulateHandRequest) GetTurns() int32 {
+	if x != nil {
+		return x.Turns
+	}
+	return 0
+}
+
+func (x *SimulateHandRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+func (x *SimulateHandRequest) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+// 手牌のシミュレーションのレスポンス
+type SimulateHandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*SimulateHandResponse_Simulation
+	//	*SimulateHandResponse_Error
+	Result   isSimulateHandResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata             `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *SimulateHandResponse) Reset() {
+	*x = SimulateHandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulateHandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulateHandResponse) ProtoMessage() {}
+
+func (x *SimulateHandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulateHandResponse.ProtoReflect.Descriptor instead.
+func (*SimulateHandResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{21}
+}
+
+func (m *SimulateHandResponse) GetResult() isSimulateHandResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SimulateHandResponse) GetSimulation() *SimulationResult {
+	if x, ok := x.GetResult().(*SimulateHandResponse_Simulation); ok {
+		return x.Simulation
+	}
+	return nil
+}
+
+func (x *SimulateHandResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*SimulateHandResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SimulateHandResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isSimulateHandResponse_Result interface {
+	isSimulateHandResponse_Result()
+}
+
+type SimulateHandResponse_Simulation struct {
+	Simulation *SimulationResult `protobuf:"bytes,1,opt,name=simulation,proto3,oneof"` // 成功時の結果
+}
+
+type SimulateHandResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*SimulateHandResponse_Simulation) isSimulateHandResponse_Result() {}
+
+func (*SimulateHandResponse_Error) isSimulateHandResponse_Result() {}
+
+// 安全度評価のリクエスト
+type AssessSafetyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata       *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                   // リクエストメタデータ
+	Hand           string           `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`                                           // 門前の牌
+	Melds          []*Meld          `protobuf:"bytes,3,rep,name=melds,proto3" json:"melds,omitempty"`                                         // 副露
+	Opponents      []*OpponentInfo  `protobuf:"bytes,4,rep,name=opponents,proto3" json:"opponents,omitempty"`                                 // 他家（立直していない他家は捨て牌を見えている牌として数える）
+	VisibleTiles   string           `protobuf:"bytes,5,opt,name=visible_tiles,json=visibleTiles,proto3" json:"visible_tiles,omitempty"`       // 他家の副露など、手牌と他家の捨て牌以外で見えている牌
+	DoraIndicators string           `protobuf:"bytes,6,opt,name=dora_indicators,json=doraIndicators,proto3" json:"dora_indicators,omitempty"` // ドラ表示牌
+}
+
+func (x *AssessSafetyRequest) Reset() {
+	*x = AssessSafetyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssessSafetyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessSafetyRequest) ProtoMessage() {}
+
+func (x *AssessSafetyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessSafetyRequest.ProtoReflect.Descriptor instead.
+func (*AssessSafetyRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AssessSafetyRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *AssessSafetyRequest) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *AssessSafetyRequest) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *AssessSafetyRequest) GetOpponents() []*OpponentInfo {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *AssessSafetyRequest) GetVisibleTiles() string {
+	if x != nil {
+		return x.VisibleTiles
+	}
+	return ""
+}
+
+func (x *AssessSafetyRequest) GetDoraIndicators() string {
+	if x != nil {
+		return x.DoraIndicators
+	}
+	return ""
+}
+
+// 安全度評価のレスポンス
+type AssessSafetyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*AssessSafetyResponse_Safety
+	//	*AssessSafetyResponse_Error
+	Result   isAssessSafetyResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata             `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *AssessSafetyResponse) Reset() {
+	*x = AssessSafetyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AssessSafetyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssessSafetyResponse) ProtoMessage() {}
+
+func (x *AssessSafetyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssessSafetyResponse.ProtoReflect.Descriptor instead.
+func (*AssessSafetyResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *AssessSafetyResponse) GetResult() isAssessSafetyResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *AssessSafetyResponse) GetSafety() *SafetyResult {
+	if x, ok := x.GetResult().(*AssessSafetyResponse_Safety); ok {
+		return x.Safety
+	}
+	return nil
+}
+
+func (x *AssessSafetyResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*AssessSafetyResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *AssessSafetyResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isAssessSafetyResponse_Result interface {
+	isAssessSafetyResponse_Result()
+}
+
+type AssessSafetyResponse_Safety struct {
+	Safety *SafetyResult `protobuf:"bytes,1,opt,name=safety,proto3,oneof"` // 成功時の結果
+}
+
+type AssessSafetyResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*AssessSafetyResponse_Safety) isAssessSafetyResponse_Result() {}
+
+func (*AssessSafetyResponse_Error) isAssessSafetyResponse_Result() {}
+
+// 押し引き評価のリクエスト
+type EvaluatePushFoldRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata       *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                             // リクエストメタデータ
+	Hand           string           `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`                                                     // 門前の牌（副露1つにつき3枚少ない14枚）
+	Melds          []*Meld          `protobuf:"bytes,3,rep,name=melds,proto3" json:"melds,omitempty"`                                                   // 副露
+	RuleSet        string           `protobuf:"bytes,4,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                                // ルールセット名（空の場合はデフォルト）
+	SeatWind       Wind             `protobuf:"varint,5,opt,name=seat_wind,json=seatWind,proto3,enum=mahjong.ai.v1.Wind" json:"seat_wind,omitempty"`    // 自風（未指定の場合は南家）
+	RoundWind      Wind             `protobuf:"varint,6,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"` // 場風（未指定の場合は東場）
+	DoraIndicators string           `protobuf:"bytes,7,opt,name=dora_indicators,json=doraIndicators,proto3" json:"dora_indicators,omitempty"`           // ドラ表示牌
+	Discards       string           `protobuf:"bytes,8,opt,name=discards,proto3" json:"discards,omitempty"`                                             // 自分の捨て牌
+	VisibleTiles   string           `protobuf:"bytes,9,opt,name=visible_tiles,json=visibleTiles,proto3" json:"visible_tiles,omitempty"`                 // 他家の副露など、手牌と捨て牌以外で見えている牌
+	Opponents      []*OpponentInfo  `protobuf:"bytes,10,rep,name=opponents,proto3" json:"opponents,omitempty"`                                          // 他家（少なくとも1人は立直している必要がある）
+	Turn           int32            `protobuf:"varint,11,opt,name=turn,proto3" json:"turn,omitempty"`                                                   // 現在の巡目（0 の場合は自分の捨て牌の枚数から求める）
+	OwnScore       int32            `protobuf:"varint,12,opt,name=own_score,json=ownScore,proto3" json:"own_score,omitempty"`                           // 自分の持ち点
+	AllLast        bool             `protobuf:"varint,13,opt,name=all_last,json=allLast,proto3" json:"all_last,omitempty"`                              // オーラスか（順位点を含めて評価する）
+	RiichiSticks   int32            `protobuf:"varint,14,opt,name=riichi_sticks,json=riichiSticks,proto3" json:"riichi_sticks,omitempty"`               // 供託の立直棒の本数（今局の立直者の分を含む）
+	Honba          int32            `protobuf:"varint,15,opt,name=honba,proto3" json:"honba,omitempty"`                                                 // 積み棒の本数
+	Simulations    int32            `protobuf:"varint,16,opt,name=simulations,proto3" json:"simulations,omitempty"`                                     // 打点を見積もるモンテカルロ法の試行回数（0 の場合は既定値、負の場合は行わない）
+	Seed           uint64           `protobuf:"varint,17,opt,name=seed,proto3" json:"seed,omitempty"`                                                   // 乱数の種
+}
+
+func (x *EvaluatePushFoldRequest) Reset() {
+	*x = EvaluatePushFoldRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePushFoldRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePushFoldRequest) ProtoMessage() {}
+
+func (x *EvaluatePushFoldRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePushFoldRequest.ProtoReflect.Descriptor instead.
+func (*EvaluatePushFoldRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *EvaluatePushFoldRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *EvaluatePushFoldRequest) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *EvaluatePushFoldRequest) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *EvaluatePushFoldRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *EvaluatePushFoldRequest) GetSeatWind() Wind {
+	if x != nil {
+		return x.SeatWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *EvaluatePushFoldRequest) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *EvaluatePushFoldRequest) GetDoraIndicators() string {
+	if x != nil {
+		return x.DoraIndicators
+	}
+	return ""
+}
+
+func (x *EvaluatePushFoldRequest) GetDiscards() string {
+	if x != nil {
+		return x.Discards
+	}
+	return ""
+}
+
+func (x *EvaluatePushFoldRequest) GetVisibleTiles() string {
+	if x != nil {
+		return x.VisibleTiles
+	}
+	return ""
+}
+
+func (x *EvaluatePushFoldRequest) GetOpponents() []*OpponentInfo {
+	if x != nil {
+		return x.Opponents
+	}
+	return nil
+}
+
+func (x *EvaluatePushFoldRequest) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *EvaluatePushFoldRequest) GetOwnScore() int32 {
+	if x != nil {
+		return x.OwnScore
+	}
+	return 0
+}
+
+func (x *EvaluatePushFoldRequest) GetAllLast() bool {
+	if x != nil {
+		return x.AllLast
+	}
+	return false
+}
+
+func (x *EvaluatePushFoldRequest) GetRiichiSticks() int32 {
+	if x != nil {
+		return x.RiichiSticks
+	}
+	return 0
+}
+
+func (x *EvaluatePushFoldRequest) GetHonba() int32 {
+	if x != nil {
+		return x.Honba
+	}
+	return 0
+}
+
+func (x *EvaluatePushFoldRequest) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *EvaluatePushFoldRequest) GetSeed() uint64 {
+	if x != nil {
+		return x.Seed
+	}
+	return 0
+}
+
+// 押し引き評価のレスポンス
+type EvaluatePushFoldResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*EvaluatePushFoldResponse_PushFold
+	//	*EvaluatePushFoldResponse_Error
+	Result   isEvaluatePushFoldResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata                 `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *EvaluatePushFoldResponse) Reset() {
+	*x = EvaluatePushFoldResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvaluatePushFoldResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluatePushFoldResponse) ProtoMessage() {}
+
+func (x *EvaluatePushFoldResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluatePushFoldResponse.ProtoReflect.Descriptor instead.
+func (*EvaluatePushFoldResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{25}
+}
+
+func (m *EvaluatePushFoldResponse) GetResult() isEvaluatePushFoldResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *EvaluatePushFoldResponse) GetPushFold() *PushFoldResult {
+	if x, ok := x.GetResult().(*EvaluatePushFoldResponse_PushFold); ok {
+		return x.PushFold
+	}
+	return nil
+}
+
+func (x *EvaluatePushFoldResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*EvaluatePushFoldResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *EvaluatePushFoldResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isEvaluatePushFoldResponse_Result interface {
+	isEvaluatePushFoldResponse_Result()
+}
+
+type EvaluatePushFoldResponse_PushFold struct {
+	PushFold *PushFoldResult `protobuf:"bytes,1,opt,name=push_fold,json=pushFold,proto3,oneof"` // 成功時の結果
+}
+
+type EvaluatePushFoldResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*EvaluatePushFoldResponse_PushFold) isEvaluatePushFoldResponse_Result() {}
+
+func (*EvaluatePushFoldResponse_Error) isEvaluatePushFoldResponse_Result() {}
+
+// オーラスの点数状況の計算のリクエスト
+type CalculatePlacementRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata       *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                    // リクエストメタデータ
+	Scores         []int32          `protobuf:"varint,2,rep,packed,name=scores,proto3" json:"scores,omitempty"`                                // 持ち点（起家からの席順）
+	Dealer         int32            `protobuf:"varint,3,opt,name=dealer,proto3" json:"dealer,omitempty"`                                       // 親の席（起家からの席順、四麻のオーラスは通常 3）
+	Honba          int32            `protobuf:"varint,4,opt,name=honba,proto3" json:"honba,omitempty"`                                         // 積み棒の本数
+	RiichiSticks   int32            `protobuf:"varint,5,opt,name=riichi_sticks,json=riichiSticks,proto3" json:"riichi_sticks,omitempty"`       // 供託の立直棒の本数
+	RuleSet        string           `protobuf:"bytes,6,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                       // ルールセット名（空の場合はデフォルト）
+	Uma            []int32          `protobuf:"varint,7,rep,packed,name=uma,proto3" json:"uma,omitempty"`                                      // 順位ウマ（千点単位、1位から順に。空の場合はルールセットの値）
+	StartingPoints int32            `protobuf:"varint,8,opt,name=starting_points,json=startingPoints,proto3" json:"starting_points,omitempty"` // 配給原点（0 の場合はルールセットの値）
+	ReturnPoints   int32            `protobuf:"varint,9,opt,name=return_points,json=returnPoints,proto3" json:"return_points,omitempty"`       // 返し点（0 の場合はルールセットの値、オカは返し点と配給原点の差）
+}
+
+func (x *CalculatePlacementRequest) Reset() {
+	*x = CalculatePlacementRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculatePlacementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePlacementRequest) ProtoMessage() {}
+
+func (x *CalculatePlacementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePlacementRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePlacementRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CalculatePlacementRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *CalculatePlacementRequest) GetScores() []int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *CalculatePlacementRequest) GetDealer() int32 {
+	if x != nil {
+		return x.Dealer
+	}
+	return 0
+}
+
+func (x *CalculatePlacementRequest) GetHonba() int32 {
+	if x != nil {
+		return x.Honba
+	}
+	return 0
+}
+
+func (x *CalculatePlacementRequest) GetRiichiSticks() int32 {
+	if x != nil {
+		return x.RiichiSticks
+	}
+	return 0
+}
+
+func (x *CalculatePlacementRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *CalculatePlacementRequest) GetUma() []int32 {
+	if x != nil {
+		return x.Uma
+	}
+	return nil
+}
+
+func (x *CalculatePlacementRequest) GetStartingPoints() int32 {
+	if x != nil {
+		return x.StartingPoints
+	}
+	return 0
+}
+
+func (x *CalculatePlacementRequest) GetReturnPoints() int32 {
+	if x != nil {
+		return x.ReturnPoints
+	}
+	return 0
+}
+
+// オーラスの点数状況の計算のレスポンス
+type CalculatePlacementResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*CalculatePlacementResponse_Placement
+	//	*CalculatePlacementResponse_Error
+	Result   isCalculatePlacementResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata                   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *CalculatePlacementResponse) Reset() {
+	*x = CalculatePlacementResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CalculatePlacementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePlacementResponse) ProtoMessage() {}
+
+func (x *CalculatePlacementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePlacementResponse.ProtoReflect.Descriptor instead.
+func (*CalculatePlacementResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{27}
+}
+
+func (m *CalculatePlacementResponse) GetResult() isCalculatePlacementResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *CalculatePlacementResponse) GetPlacement() *PlacementResult {
+	if x, ok := x.GetResult().(*CalculatePlacementResponse_Placement); ok {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *CalculatePlacementResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*CalculatePlacementResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *CalculatePlacementResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isCalculatePlacementResponse_Result interface {
+	isCalculatePlacementResponse_Result()
+}
+
+type CalculatePlacementResponse_Placement struct {
+	Placement *PlacementResult `protobuf:"bytes,1,opt,name=placement,proto3,oneof"` // 成功時の結果
+}
+
+type CalculatePlacementResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*CalculatePlacementResponse_Placement) isCalculatePlacementResponse_Result() {}
+
+func (*CalculatePlacementResponse_Error) isCalculatePlacementResponse_Result() {}
+
+// 牌譜の取り込みのリクエスト
+type ImportGameLogRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                               // リクエストメタデータ
+	Format   GameLogFormat    `protobuf:"varint,2,opt,name=format,proto3,enum=mahjong.ai.v1.GameLogFormat" json:"format,omitempty"` // 牌譜の形式（未指定の場合は内容から判定する）
+	Data     string           `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`                                       // 牌譜の内容
+}
+
+func (x *ImportGameLogRequest) Reset() {
+	*x = ImportGameLogRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportGameLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameLogRequest) ProtoMessage() {}
+
+func (x *ImportGameLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameLogRequest.ProtoReflect.Descriptor instead.
+func (*ImportGameLogRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImportGameLogRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ImportGameLogRequest) GetFormat() GameLogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return GameLogFormat_GAME_LOG_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportGameLogRequest) GetData() string {
+	if x != nil {
+		return x.Data
+	}
+	return ""
+}
+
+// 牌譜の取り込みのレスポンス
+type ImportGameLogResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*ImportGameLogResponse_GameLog
+	//	*ImportGameLogResponse_Error
+	Result   isImportGameLogResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata              `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *ImportGameLogResponse) Reset() {
+	*x = ImportGameLogResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportGameLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportGameLogResponse) ProtoMessage() {}
+
+func (x *ImportGameLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportGameLogResponse.ProtoReflect.Descriptor instead.
+func (*ImportGameLogResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{29}
+}
+
+func (m *ImportGameLogResponse) GetResult() isImportGameLogResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ImportGameLogResponse) GetGameLog() *GameLog {
+	if x, ok := x.GetResult().(*ImportGameLogResponse_GameLog); ok {
+		return x.GameLog
+	}
+	return nil
+}
+
+func (x *ImportGameLogResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*ImportGameLogResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ImportGameLogResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isImportGameLogResponse_Result interface {
+	isImportGameLogResponse_Result()
+}
+
+type ImportGameLogResponse_GameLog struct {
+	GameLog *GameLog `protobuf:"bytes,1,opt,name=game_log,json=gameLog,proto3,oneof"` // 成功時の結果
+}
+
+type ImportGameLogResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*ImportGameLogResponse_GameLog) isImportGameLogResponse_Result() {}
+
+func (*ImportGameLogResponse_Error) isImportGameLogResponse_Result() {}
+
+// 牌譜の打牌の判断の取得のリクエスト
+type GetGameLogStepRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"` // リクエストメタデータ
+	Position *GameLogPosition `protobuf:"bytes,2,opt,name=position,proto3" json:"position,omitempty"` // 打牌の判断の位置
+}
+
+func (x *GetGameLogStepRequest) Reset() {
+	*x = GetGameLogStepRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameLogStepRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameLogStepRequest) ProtoMessage() {}
+
+func (x *GetGameLogStepRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameLogStepRequest.ProtoReflect.Descriptor instead.
+func (*GetGameLogStepRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetGameLogStepRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetGameLogStepRequest) GetPosition() *GameLogPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+// 牌譜の打牌の判断の取得のレスポンス
+type GetGameLogStepResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*GetGameLogStepResponse_Step
+	//	*GetGameLogStepResponse_Error
+	Result   isGetGameLogStepResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata               `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *GetGameLogStepResponse) Reset() {
+	*x = GetGameLogStepResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameLogStepResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameLogStepResponse) ProtoMessage() {}
+
+func (x *GetGameLogStepResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameLogStepResponse.ProtoReflect.Descriptor instead.
+func (*GetGameLogStepResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{31}
+}
+
+func (m *GetGameLogStepResponse) GetResult() isGetGameLogStepResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetGameLogStepResponse) GetStep() *GameLogStep {
+	if x, ok := x.GetResult().(*GetGameLogStepResponse_Step); ok {
+		return x.Step
+	}
+	return nil
+}
+
+func (x *GetGameLogStepResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*GetGameLogStepResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetGameLogStepResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isGetGameLogStepResponse_Result interface {
+	isGetGameLogStepResponse_Result()
+}
+
+type GetGameLogStepResponse_Step struct {
+	Step *GameLogStep `protobuf:"bytes,1,opt,name=step,proto3,oneof"` // 成功時の結果
+}
+
+type GetGameLogStepResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*GetGameLogStepResponse_Step) isGetGameLogStepResponse_Result() {}
+
+func (*GetGameLogStepResponse_Error) isGetGameLogStepResponse_Result() {}
+
+// 牌譜の検討のリクエスト
+type ReviewGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`           // リクエストメタデータ
+	GameId   string           `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // 牌譜ID（ImportGameLog の結果）
+	Player   int32            `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`              // 検討するプレイヤー（起家からの席順）
+	TopN     int32            `protobuf:"varint,4,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`      // AIに解説させる悪手の数（0 の場合は5、最大20）
+	Persona  string           `protobuf:"bytes,5,opt,name=persona,proto3" json:"persona,omitempty"`             // 解説に使うペルソナ名（空の場合はデフォルト）
+}
+
+func (x *ReviewGameRequest) Reset() {
+	*x = ReviewGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewGameRequest) ProtoMessage() {}
+
+func (x *ReviewGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewGameRequest.ProtoReflect.Descriptor instead.
+func (*ReviewGameRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReviewGameRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ReviewGameRequest) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *ReviewGameRequest) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *ReviewGameRequest) GetTopN() int32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+func (x *ReviewGameRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+// 牌譜の検討結果の取得のリクエスト
+type GetGameReviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                 // リクエストメタデータ
+	ReviewId string           `protobuf:"bytes,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"` // 検討ID
+}
+
+func (x *GetGameReviewRequest) Reset() {
+	*x = GetGameReviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameReviewRequest) ProtoMessage() {}
+
+func (x *GetGameReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetGameReviewRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetGameReviewRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetGameReviewRequest) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+// 牌譜の検討のレスポンス
+type GameReviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*GameReviewResponse_Review
+	//	*GameReviewResponse_Error
+	Result   isGameReviewResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata           `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *GameReviewResponse) Reset() {
+	*x = GameReviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameReviewResponse) ProtoMessage() {}
+
+func (x *GameReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameReviewResponse.ProtoReflect.Descriptor instead.
+func (*GameReviewResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{34}
+}
+
+func (m *GameReviewResponse) GetResult() isGameReviewResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GameReviewResponse) GetReview() *GameReview {
+	if x, ok := x.GetResult().(*GameReviewResponse_Review); ok {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *GameReviewResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*GameReviewResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GameReviewResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isGameReviewResponse_Result interface {
+	isGameReviewResponse_Result()
+}
+
+type GameReviewResponse_Review struct {
+	Review *GameReview `protobuf:"bytes,1,opt,name=review,proto3,oneof"` // 成功時の結果
+}
+
+type GameReviewResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*GameReviewResponse_Review) isGameReviewResponse_Result() {}
+
+func (*GameReviewResponse_Error) isGameReviewResponse_Result() {}
+
+// コーチングのリクエスト（ストリームで順に送る）
+type CoachRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"` // リクエストメタデータ（最初のリクエストのみ参照する）
+	// Types that are assignable to Payload:
+	//
+	//	*CoachRequest_Event
+	//	*CoachRequest_Explain
+	Payload isCoachRequest_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *CoachRequest) Reset() {
+	*x = CoachRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachRequest) ProtoMessage() {}
+
+func (x *CoachRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachRequest.ProtoReflect.Descriptor instead.
+func (*CoachRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CoachRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (m *CoachRequest) GetPayload() isCoachRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *CoachRequest) GetEvent() *CoachEvent {
+	if x, ok := x.GetPayload().(*CoachRequest_Event); ok {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *CoachRequest) GetExplain() *CoachExplainRequest {
+	if x, ok := x.GetPayload().(*CoachRequest_Explain); ok {
+		return x.Explain
+	}
+	return nil
+}
+
+type isCoachRequest_Payload interface {
+	isCoachRequest_Payload()
+}
+
+type CoachRequest_Event struct {
+	Event *CoachEvent `protobuf:"bytes,2,opt,name=event,proto3,oneof"` // 対局の出来事
+}
+
+type CoachRequest_Explain struct {
+	Explain *CoachExplainRequest `protobuf:"bytes,3,opt,name=explain,proto3,oneof"` // 解説の依頼
+}
+
+func (*CoachRequest_Event) isCoachRequest_Payload() {}
+
+func (*CoachRequest_Explain) isCoachRequest_Payload() {}
+
+// コーチングのレスポンス
+type CoachResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Payload:
+	//
+	//	*CoachResponse_Advice
+	//	*CoachResponse_Warning
+	//	*CoachResponse_Explanation
+	//	*CoachResponse_Error
+	Payload  isCoachResponse_Payload `protobuf_oneof:"payload"`
+	Metadata *ResponseMetadata       `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *CoachResponse) Reset() {
+	*x = CoachResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachResponse) ProtoMessage() {}
+
+func (x *CoachResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachResponse.ProtoReflect.Descriptor instead.
+func (*CoachResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{36}
+}
+
+func (m *CoachResponse) GetPayload() isCoachResponse_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *CoachResponse) GetAdvice() *CoachAdvice {
+	if x, ok := x.GetPayload().(*CoachResponse_Advice); ok {
+		return x.Advice
+	}
+	return nil
+}
+
+func (x *CoachResponse) GetWarning() *CoachWarning {
+	if x, ok := x.GetPayload().(*CoachResponse_Warning); ok {
+		return x.Warning
+	}
+	return nil
+}
+
+func (x *CoachResponse) GetExplanation() *CoachExplanation {
+	if x, ok := x.GetPayload().(*CoachResponse_Explanation); ok {
+		return x.Explanation
+	}
+	return nil
+}
+
+func (x *CoachResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetPayload().(*CoachResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *CoachResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isCoachResponse_Payload interface {
+	isCoachResponse_Payload()
+}
+
+type CoachResponse_Advice struct {
+	Advice *CoachAdvice `protobuf:"bytes,1,opt,name=advice,proto3,oneof"` // エンジンによる推奨
+}
+
+type CoachResponse_Warning struct {
+	Warning *CoachWarning `protobuf:"bytes,2,opt,name=warning,proto3,oneof"` // 状況の変化の警告
+}
+
+type CoachResponse_Explanation struct {
+	Explanation *CoachExplanation `protobuf:"bytes,3,opt,name=explanation,proto3,oneof"` // AIによる解説の一部
+}
+
+type CoachResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,4,opt,name=error,proto3,oneof"` // リクエストを処理できなかった場合のエラー情報（ストリームは続く）
+}
+
+func (*CoachResponse_Advice) isCoachResponse_Payload() {}
+
+func (*CoachResponse_Warning) isCoachResponse_Payload() {}
+
+func (*CoachResponse_Explanation) isCoachResponse_Payload() {}
+
+func (*CoachResponse_Error) isCoachResponse_Payload() {}
+
+// ルールの資料の索引の作り直しのリクエスト
+type ReindexKnowledgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"` // リクエストメタデータ
+	Full     bool             `protobuf:"varint,2,opt,name=full,proto3" json:"full,omitempty"`        // 本文が変わっていない抜粋も埋め込みベクトルを求め直すか
+}
+
+func (x *ReindexKnowledgeRequest) Reset() {
+	*x = ReindexKnowledgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexKnowledgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexKnowledgeRequest) ProtoMessage() {}
+
+func (x *ReindexKnowledgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexKnowledgeRequest.ProtoReflect.Descriptor instead.
+func (*ReindexKnowledgeRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReindexKnowledgeRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ReindexKnowledgeRequest) GetFull() bool {
+	if x != nil {
+		return x.Full
+	}
+	return false
+}
+
+// ルールの資料の索引の作り直しのレスポンス
+type ReindexKnowledgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*ReindexKnowledgeResponse_Index
+	//	*ReindexKnowledgeResponse_Error
+	Result   isReindexKnowledgeResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata                 `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *ReindexKnowledgeResponse) Reset() {
+	*x = ReindexKnowledgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReindexKnowledgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReindexKnowledgeResponse) ProtoMessage() {}
+
+func (x *ReindexKnowledgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReindexKnowledgeResponse.ProtoReflect.Descriptor instead.
+func (*ReindexKnowledgeResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{38}
+}
+
+func (m *ReindexKnowledgeResponse) GetResult() isReindexKnowledgeResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *ReindexKnowledgeResponse) GetIndex() *KnowledgeIndexInfo {
+	if x, ok := x.GetResult().(*ReindexKnowledgeResponse_Index); ok {
+		return x.Index
+	}
+	return nil
+}
+
+func (x *ReindexKnowledgeResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*ReindexKnowledgeResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ReindexKnowledgeResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isReindexKnowledgeResponse_Result interface {
+	isReindexKnowledgeResponse_Result()
+}
+
+type ReindexKnowledgeResponse_Index struct {
+	Index *KnowledgeIndexInfo `protobuf:"bytes,1,opt,name=index,proto3,oneof"` // 成功時の結果
+}
+
+type ReindexKnowledgeResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*ReindexKnowledgeResponse_Index) isReindexKnowledgeResponse_Result() {}
+
+func (*ReindexKnowledgeResponse_Error) isReindexKnowledgeResponse_Result() {}
+
+// 手牌・河・局面の図の作成のリクエスト
+// hand・melds・drawn・discards で手牌と河を、game_state または game_log_position で卓全体を描く
+type RenderHandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata        *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`                                        // リクエストメタデータ
+	Hand            string           `protobuf:"bytes,2,opt,name=hand,proto3" json:"hand,omitempty"`                                                // 門前の牌（MPSZ表記、並べた順に描く）
+	Melds           []*Meld          `protobuf:"bytes,3,rep,name=melds,proto3" json:"melds,omitempty"`                                              // 副露（手牌の右に描く）
+	Drawn           string           `protobuf:"bytes,4,opt,name=drawn,proto3" json:"drawn,omitempty"`                                              // ツモ牌（手牌から離して描く）
+	Discards        []*DiscardedTile `protobuf:"bytes,5,rep,name=discards,proto3" json:"discards,omitempty"`                                        // 河（手牌の上に6枚ずつ描く）
+	GameState       *GameState       `protobuf:"bytes,6,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"`                     // 卓全体を描く局面（手牌・河とは同時に指定できない）
+	GameLogPosition *GameLogPosition `protobuf:"bytes,7,opt,name=game_log_position,json=gameLogPosition,proto3" json:"game_log_position,omitempty"` // 卓全体を描く取り込んだ牌譜の局面（game_state とは同時に指定できない）
+	RuleSet         string           `protobuf:"bytes,8,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                           // 局面の検証に使うルールセット名（空の場合はデフォルト、牌譜は対局のルール）
+	Format          DiagramFormat    `protobuf:"varint,9,opt,name=format,proto3,enum=mahjong.ai.v1.DiagramFormat" json:"format,omitempty"`          // 図の形式
+	Scale           float32          `protobuf:"fixed32,10,opt,name=scale,proto3" json:"scale,omitempty"`                                           // 拡大率（0 は1倍、最大4倍）
+}
+
+func (x *RenderHandRequest) Reset() {
+	*x = RenderHandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderHandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderHandRequest) ProtoMessage() {}
+
+func (x *RenderHandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderHandRequest.ProtoReflect.Descriptor instead.
+func (*RenderHandRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RenderHandRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *RenderHandRequest) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *RenderHandRequest) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *RenderHandRequest) GetDrawn() string {
+	if x != nil {
+		return x.Drawn
+	}
+	return ""
+}
+
+func (x *RenderHandRequest) GetDiscards() []*DiscardedTile {
+	if x != nil {
+		return x.Discards
+	}
+	return nil
+}
+
+func (x *RenderHandRequest) GetGameState() *GameState {
+	if x != nil {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *RenderHandRequest) GetGameLogPosition() *GameLogPosition {
+	if x != nil {
+		return x.GameLogPosition
+	}
+	return nil
+}
+
+func (x *RenderHandRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *RenderHandRequest) GetFormat() DiagramFormat {
+	if x != nil {
+		return x.Format
+	}
+	return DiagramFormat_DIAGRAM_FORMAT_UNSPECIFIED
+}
+
+func (x *RenderHandRequest) GetScale() float32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+// 図
+type Diagram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data     []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`                         // 図の内容
+	MimeType string `protobuf:"bytes,2,opt,name=mime_type,json=mimeType,proto3" json:"mime_type,omitempty"` // MIMEタイプ (image/svg+xml, image/png)
+	Width    int32  `protobuf:"varint,3,opt,name=width,proto3" json:"width,omitempty"`                      // 幅（ピクセル）
+	Height   int32  `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`                    // 高さ（ピクセル）
+}
+
+func (x *Diagram) Reset() {
+	*x = Diagram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Diagram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagram) ProtoMessage() {}
+
+func (x *Diagram) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagram.ProtoReflect.Descriptor instead.
+func (*Diagram) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *Diagram) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Diagram) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *Diagram) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Diagram) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+// 手牌・河・局面の図の作成のレスポンス
+type RenderHandResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*RenderHandResponse_Diagram
+	//	*RenderHandResponse_Error
+	Result   isRenderHandResponse_Result `protobuf_oneof:"result"`
+	Metadata *ResponseMetadata           `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *RenderHandResponse) Reset() {
+	*x = RenderHandResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenderHandResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenderHandResponse) ProtoMessage() {}
+
+func (x *RenderHandResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenderHandResponse.ProtoReflect.Descriptor instead.
+func (*RenderHandResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{41}
+}
+
+func (m *RenderHandResponse) GetResult() isRenderHandResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *RenderHandResponse) GetDiagram() *Diagram {
+	if x, ok := x.GetResult().(*RenderHandResponse_Diagram); ok {
+		return x.Diagram
+	}
+	return nil
+}
+
+func (x *RenderHandResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*RenderHandResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *RenderHandResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isRenderHandResponse_Result interface {
+	isRenderHandResponse_Result()
+}
+
+type RenderHandResponse_Diagram struct {
+	Diagram *Diagram `protobuf:"bytes,1,opt,name=diagram,proto3,oneof"` // 成功時の図
+}
+
+type RenderHandResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*RenderHandResponse_Diagram) isRenderHandResponse_Result() {}
+
+func (*RenderHandResponse_Error) isRenderHandResponse_Result() {}
+
+// 何切る問題の取得のリクエスト
+// quiz_id を指定すると出題済みの問題を、daily では日替わりの問題を、どちらもなければ新しい問題を返す
+type GetQuizRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`              // リクエストメタデータ
+	QuizId   string           `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`    // 出題済みの問題ID
+	Daily    bool             `protobuf:"varint,3,opt,name=daily,proto3" json:"daily,omitempty"`                   // 日替わりの問題（同じ日・ルールセットでは誰にでも同じ問題）
+	RuleSet  string           `protobuf:"bytes,4,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // 新しい問題のルールセット名（空の場合はデフォルト）
+	UserId   string           `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`    // 成績を返すユーザーID（空の場合は返さない）
+}
+
+func (x *GetQuizRequest) Reset() {
+	*x = GetQuizRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuizRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizRequest) ProtoMessage() {}
+
+func (x *GetQuizRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizRequest.ProtoReflect.Descriptor instead.
+func (*GetQuizRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetQuizRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *GetQuizRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *GetQuizRequest) GetDaily() bool {
+	if x != nil {
+		return x.Daily
+	}
+	return false
+}
+
+func (x *GetQuizRequest) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *GetQuizRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// 何切る問題の取得のレスポンス
+type GetQuizResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*GetQuizResponse_Quiz
+	//	*GetQuizResponse_Error
+	Result   isGetQuizResponse_Result `protobuf_oneof:"result"`
+	Stats    *QuizStats               `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`       // ユーザーの成績（user_id を指定した場合）
+	Metadata *ResponseMetadata        `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *GetQuizResponse) Reset() {
+	*x = GetQuizResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetQuizResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetQuizResponse) ProtoMessage() {}
+
+func (x *GetQuizResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetQuizResponse.ProtoReflect.Descriptor instead.
+func (*GetQuizResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{43}
+}
+
+func (m *GetQuizResponse) GetResult() isGetQuizResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *GetQuizResponse) GetQuiz() *Quiz {
+	if x, ok := x.GetResult().(*GetQuizResponse_Quiz); ok {
+		return x.Quiz
+	}
+	return nil
+}
+
+func (x *GetQuizResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*GetQuizResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *GetQuizResponse) GetStats() *QuizStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GetQuizResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isGetQuizResponse_Result interface {
+	isGetQuizResponse_Result()
+}
+
+type GetQuizResponse_Quiz struct {
+	Quiz *Quiz `protobuf:"bytes,1,opt,name=quiz,proto3,oneof"` // 成功時の問題
+}
+
+type GetQuizResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*GetQuizResponse_Quiz) isGetQuizResponse_Result() {}
+
+func (*GetQuizResponse_Error) isGetQuizResponse_Result() {}
+
+// 何切る問題の回答のリクエスト
+type SubmitQuizAnswerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *RequestMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`           // リクエストメタデータ
+	QuizId   string           `protobuf:"bytes,2,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"` // 問題ID
+	UserId   string           `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 回答したユーザーID（空の場合は成績に記録しない）
+	Discard  string           `protobuf:"bytes,4,opt,name=discard,proto3" json:"discard,omitempty"`             // 打牌（1枚のMPSZ表記）
+	Persona  string           `protobuf:"bytes,5,opt,name=persona,proto3" json:"persona,omitempty"`             // 解説に使うペルソナ名（空の場合はデフォルト）
+}
+
+func (x *SubmitQuizAnswerRequest) Reset() {
+	*x = SubmitQuizAnswerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitQuizAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuizAnswerRequest) ProtoMessage() {}
+
+func (x *SubmitQuizAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuizAnswerRequest.ProtoReflect.Descriptor instead.
+func (*SubmitQuizAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SubmitQuizAnswerRequest) GetMetadata() *RequestMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *SubmitQuizAnswerRequest) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *SubmitQuizAnswerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SubmitQuizAnswerRequest) GetDiscard() string {
+	if x != nil {
+		return x.Discard
+	}
+	return ""
+}
+
+func (x *SubmitQuizAnswerRequest) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+// 何切る問題の回答のレスポンス
+type SubmitQuizAnswerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Result:
+	//
+	//	*SubmitQuizAnswerResponse_Grade
+	//	*SubmitQuizAnswerResponse_Error
+	Result   isSubmitQuizAnswerResponse_Result `protobuf_oneof:"result"`
+	Stats    *QuizStats                        `protobuf:"bytes,3,opt,name=stats,proto3" json:"stats,omitempty"`       // 回答を記録した後のユーザーの成績（user_id を指定した場合）
+	Metadata *ResponseMetadata                 `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"` // レスポンスメタデータ
+}
+
+func (x *SubmitQuizAnswerResponse) Reset() {
+	*x = SubmitQuizAnswerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubmitQuizAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitQuizAnswerResponse) ProtoMessage() {}
+
+func (x *SubmitQuizAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitQuizAnswerResponse.ProtoReflect.Descriptor instead.
+func (*SubmitQuizAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{45}
+}
+
+func (m *SubmitQuizAnswerResponse) GetResult() isSubmitQuizAnswerResponse_Result {
+	if m != nil {
+		return m.Result
+	}
+	return nil
+}
+
+func (x *SubmitQuizAnswerResponse) GetGrade() *QuizGrade {
+	if x, ok := x.GetResult().(*SubmitQuizAnswerResponse_Grade); ok {
+		return x.Grade
+	}
+	return nil
+}
+
+func (x *SubmitQuizAnswerResponse) GetError() *ErrorInfo {
+	if x, ok := x.GetResult().(*SubmitQuizAnswerResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *SubmitQuizAnswerResponse) GetStats() *QuizStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *SubmitQuizAnswerResponse) GetMetadata() *ResponseMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type isSubmitQuizAnswerResponse_Result interface {
+	isSubmitQuizAnswerResponse_Result()
+}
+
+type SubmitQuizAnswerResponse_Grade struct {
+	Grade *QuizGrade `protobuf:"bytes,1,opt,name=grade,proto3,oneof"` // 成功時の採点結果
+}
+
+type SubmitQuizAnswerResponse_Error struct {
+	Error *ErrorInfo `protobuf:"bytes,2,opt,name=error,proto3,oneof"` // エラー情報
+}
+
+func (*SubmitQuizAnswerResponse_Grade) isSubmitQuizAnswerResponse_Result() {}
+
+func (*SubmitQuizAnswerResponse_Error) isSubmitQuizAnswerResponse_Result() {}
+
+// ヘルスチェックリクエスト
+type HealthCheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Service string `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"` // サービス名（オプション）
+}
+
+func (x *HealthCheckRequest) Reset() {
+	*x = HealthCheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckRequest) ProtoMessage() {}
+
+func (x *HealthCheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckRequest.ProtoReflect.Descriptor instead.
+func (*HealthCheckRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *HealthCheckRequest) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+// ヘルスチェックレスポンス
+type HealthCheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    HealthCheckResponse_ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=mahjong.ai.v1.HealthCheckResponse_ServingStatus" json:"status,omitempty"`
+	Message   string                            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`     // ステータスメッセージ
+	Timestamp *timestamppb.Timestamp            `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"` // チェック時刻
+}
+
+func (x *HealthCheckResponse) Reset() {
+	*x = HealthCheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_ai_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheckResponse) ProtoMessage() {}
+
+func (x *HealthCheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_ai_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheckResponse.ProtoReflect.Descriptor instead.
+func (*HealthCheckResponse) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_ai_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *HealthCheckResponse) GetStatus() HealthCheckResponse_ServingStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HealthCheckResponse_UNKNOWN
+}
+
+func (x *HealthCheckResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *HealthCheckResponse) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+var File_mahjong_ai_v1_ai_proto protoreflect.FileDescriptor
+
+var file_mahjong_ai_v1_ai_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f,
+	0x61, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69,
+	0x2f, 0x76, 0x31, 0x2f, 0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53,
+	0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x10, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38,
+	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x12, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x68, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x48, 0x69, 0x74, 0x22, 0x8b, 0x05, 0x0a, 0x13, 0x41,
+	0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x78, 0x74, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x75, 0x73, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x67, 0x61,
+	0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x11, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
+	0x67, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x53, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x12, 0x3b, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x68, 0x61,
+	0x6e, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x22, 0x3d, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x9a, 0x01, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e,
+	0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x22, 0xf4, 0x04, 0x0a, 0x14, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x74,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x74, 0x6f,
+	0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x49,
+	0x0a, 0x10, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x0f, 0x73, 0x65, 0x6c, 0x66, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x0a, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x75, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x75, 0x72,
+	0x65, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x46, 0x0a, 0x0d, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x61, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x08,
+	0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69, 0x70, 0x70, 0x65, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x69, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x69, 0x74, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x0c, 0x43, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x63, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x9b, 0x01, 0x0a, 0x12, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1f,
+	0x0a, 0x0b, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22,
+	0x46, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x67,
+	0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x61,
+	0x67, 0x72, 0x65, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x0e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xab,
+	0x01, 0x0a, 0x12, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x6e, 0x0a, 0x0c,
+	0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xde, 0x03, 0x0a,
+	0x1a, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x74,
+	0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x30, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3d,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3a, 0x0a,
+	0x09, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x08, 0x74, 0x6f, 0x6f, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x47, 0x0a, 0x0c, 0x76, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x09, 0x63, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x48, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0xfa, 0x03,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e,
+	0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52,
+	0x08, 0x73, 0x65, 0x61, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72,
+	0x69, 0x69, 0x63, 0x68, 0x69, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x6f, 0x72, 0x61, 0x5f, 0x69, 0x6e,
+	0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x64, 0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x70, 0x61,
+	0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x4c, 0x61, 0x73, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52,
+	0x69, 0x69, 0x63, 0x68, 0x69, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xbf, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x32, 0x0a, 0x05, 0x77, 0x61, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x05, 0x77, 0x61,
+	0x69, 0x74, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xcb, 0x03, 0x0a,
+	0x17, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x30,
+	0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x57, 0x69, 0x6e, 0x64,
+	0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x57, 0x69, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x6f, 0x72, 0x61, 0x5f, 0x69, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64,
+	0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0xcd, 0x01, 0x0a, 0x18, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xf7, 0x03, 0x0a, 0x13, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
+	0x6e, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74,
+	0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57,
+	0x69, 0x6e, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x64, 0x6f, 0x72, 0x61, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x74,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x69, 0x69, 0x63,
+	0x68, 0x69, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69,
+	0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x22, 0xd2, 0x01, 0x0a, 0x14, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x0a, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42,
+	0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x99, 0x02, 0x0a, 0x13, 0x41, 0x73,
+	0x73, 0x65, 0x73, 0x73, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e,
+	0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x09,
+	0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x6f, 0x70,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x73, 0x69, 0x62,
+	0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x27, 0x0a, 0x0f,
+	0x64, 0x6f, 0x72, 0x61, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x14, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73,
+	0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35,
+	0x0a, 0x06, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xf7,
+	0x04, 0x0a, 0x17, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65,
+	0x6c, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05,
+	0x6d, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74,
+	0x12, 0x30, 0x0a, 0x09, 0x73, 0x65, 0x61, 0x74, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x08, 0x73, 0x65, 0x61, 0x74, 0x57, 0x69,
+	0x6e, 0x64, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x6f, 0x72, 0x61, 0x5f, 0x69,
+	0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x64, 0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x76, 0x69, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x09, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0a, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x09, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x75, 0x72, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x6c, 0x6c, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x61, 0x6c, 0x6c, 0x4c, 0x61, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x69, 0x69, 0x63, 0x68,
+	0x69, 0x5f, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x68, 0x6f, 0x6e, 0x62, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x6e,
+	0x62, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x61,
+	0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x66, 0x6f,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c,
+	0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x08, 0x70, 0x75, 0x73, 0x68, 0x46,
+	0x6f, 0x6c, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xbd, 0x02, 0x0a,
+	0x19, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x64, 0x65, 0x61, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x5f, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x6d, 0x61, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x03, 0x75, 0x6d, 0x61, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xd5, 0x01, 0x0a,
+	0x1a, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00,
+	0x52, 0x09, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f,
+	0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0xc5, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61,
+	0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x08, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x48, 0x00, 0x52, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x4c,
+	0x6f, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8f, 0x01, 0x0a, 0x15,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc3, 0x01,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x65, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x65, 0x70, 0x48, 0x00, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x6f, 0x70, 0x4e, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22, 0x6f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x49, 0x64, 0x22, 0xc2, 0x01, 0x0a, 0x12, 0x47, 0x61, 0x6d, 0x65, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a,
+	0x06, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x48, 0x00, 0x52, 0x06, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xc8, 0x01, 0x0a, 0x0c,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x31, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x48, 0x00, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xbd, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x61, 0x64, 0x76, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x64,
+	0x76, 0x69, 0x63, 0x65, 0x48, 0x00, 0x52, 0x06, 0x61, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x37,
+	0x0a, 0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x07,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x43, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b,
+	0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x69, 0x0a, 0x17, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c,
+	0x6c, 0x22, 0xce, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x6e, 0x6f,
+	0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39,
+	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x49, 0x6e, 0x66, 0x6f,
+	0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0xca, 0x03, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x61, 0x6e,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65,
+	0x6c, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x64, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63,
+	0x61, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4a, 0x0a, 0x11,
+	0x67, 0x61, 0x6d, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x50,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x67, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65,
+	0x53, 0x65, 0x74, 0x12, 0x34, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x22,
+	0x68, 0x0a, 0x07, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x77,
+	0x69, 0x64, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x07, 0x64, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x61,
+	0x67, 0x72, 0x61, 0x6d, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xaf, 0x01,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a, 0x07,
+	0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71,
+	0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xe5, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x48, 0x00, 0x52, 0x04, 0x71, 0x75, 0x69, 0x7a, 0x12, 0x30,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x08, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22, 0xf5, 0x01, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74,
+	0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x47, 0x72, 0x61, 0x64, 0x65, 0x48, 0x00, 0x52, 0x05, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x2e, 0x0a,
+	0x12, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x22, 0x84, 0x02,
+	0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x22, 0x4f, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x6e, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4e, 0x4f, 0x54, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x03, 0x2a, 0x5f, 0x0a, 0x0d, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1e, 0x0a, 0x1a, 0x44, 0x49, 0x41, 0x47, 0x52, 0x41, 0x4d,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x49, 0x41, 0x47, 0x52, 0x41, 0x4d,
+	0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x53, 0x56, 0x47, 0x10, 0x01, 0x12, 0x16, 0x0a,
+	0x12, 0x44, 0x49, 0x41, 0x47, 0x52, 0x41, 0x4d, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f,
+	0x50, 0x4e, 0x47, 0x10, 0x02, 0x32, 0xcf, 0x0d, 0x0a, 0x10, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x41, 0x49, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x41, 0x73,
+	0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x6b, 0x4d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x12, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x41, 0x49, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x6b, 0x4d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73,
+	0x6b, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x41, 0x49, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4b, 0x0a, 0x08, 0x47, 0x65,
+	0x74, 0x57, 0x61, 0x69, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c,
+	0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x22, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0c, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x53,
+	0x61, 0x66, 0x65, 0x74, 0x79, 0x12, 0x22, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73, 0x53, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x73, 0x73,
+	0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63,
+	0x0a, 0x10, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f,
+	0x6c, 0x64, 0x12, 0x26, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46,
+	0x6f, 0x6c, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x12, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x50, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x12,
+	0x23, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x65, 0x70, 0x12, 0x24, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x65,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a, 0x0a, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x23, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x23, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x30, 0x01, 0x12, 0x46, 0x0a, 0x05, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x12, 0x1b, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x10, 0x52, 0x65,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x12, 0x26,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x4b, 0x6e,
+	0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x51, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x12, 0x20, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x6e, 0x64, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x12, 0x1d, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x69, 0x7a, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x12, 0x26, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51, 0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x51,
+	0x75, 0x69, 0x7a, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x54, 0x0a, 0x0b, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0xbb, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x07, 0x41,
+	0x69, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x6e, 0x64, 0x61, 0x6d, 0x61, 0x6e, 0x30, 0x32, 0x31,
+	0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x69, 0x5f, 0x61, 0x67, 0x65, 0x6e,
+	0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x69, 0x76,
+	0x31, 0xa2, 0x02, 0x03, 0x4d, 0x41, 0x58, 0xaa, 0x02, 0x0d, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x41, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x3a, 0x3a, 0x41,
+	0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mahjong_ai_v1_ai_proto_rawDescOnce sync.Once
+	file_mahjong_ai_v1_ai_proto_rawDescData = file_mahjong_ai_v1_ai_proto_rawDesc
+)
+
+func file_mahjong_ai_v1_ai_proto_rawDescGZIP() []byte {
+	file_mahjong_ai_v1_ai_proto_rawDescOnce.Do(func() {
+		file_mahjong_ai_v1_ai_proto_rawDescData = protoimpl.X.CompressGZIP(file_mahjong_ai_v1_ai_proto_rawDescData)
+	})
+	return file_mahjong_ai_v1_ai_proto_rawDescData
+}
+
+var file_mahjong_ai_v1_ai_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mahjong_ai_v1_ai_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_mahjong_ai_v1_ai_proto_goTypes = []interface{}{
+	(DiagramFormat)(0),                     // 0: mahjong.ai.v1.DiagramFormat
+	(HealthCheckResponse_ServingStatus)(0), // 1: mahjong.ai.v1.HealthCheckResponse.ServingStatus
+	(*ErrorInfo)(nil),                      // 2: mahjong.ai.v1.ErrorInfo
+	(*RequestMetadata)(nil),                // 3: mahjong.ai.v1.RequestMetadata
+	(*ResponseMetadata)(nil),               // 4: mahjong.ai.v1.ResponseMetadata
+	(*AskMahjongAIRequest)(nil),            // 5: mahjong.ai.v1.AskMahjongAIRequest
+	(*Attachment)(nil),                     // 6: mahjong.ai.v1.Attachment
+	(*HandTranscription)(nil),              // 7: mahjong.ai.v1.HandTranscription
+	(*AskMahjongAIResponse)(nil),           // 8: mahjong.ai.v1.AskMahjongAIResponse
+	(*Citation)(nil),                       // 9: mahjong.ai.v1.Citation
+	(*CitationList)(nil),                   // 10: mahjong.ai.v1.CitationList
+	(*KnowledgeIndexInfo)(nil),             // 11: mahjong.ai.v1.KnowledgeIndexInfo
+	(*ConclusionVote)(nil),                 // 12: mahjong.ai.v1.ConclusionVote
+	(*SelfConsistency)(nil),                // 13: mahjong.ai.v1.SelfConsistency
+	(*ClaimCheckInfo)(nil),                 // 14: mahjong.ai.v1.ClaimCheckInfo
+	(*AnswerVerification)(nil),             // 15: mahjong.ai.v1.AnswerVerification
+	(*ToolCallInfo)(nil),                   // 16: mahjong.ai.v1.ToolCallInfo
+	(*AskMahjongAIStreamResponse)(nil),     // 17: mahjong.ai.v1.AskMahjongAIStreamResponse
+	(*GetWaitsRequest)(nil),                // 18: mahjong.ai.v1.GetWaitsRequest
+	(*GetWaitsResponse)(nil),               // 19: mahjong.ai.v1.GetWaitsResponse
+	(*RecommendDiscardRequest)(nil),        // 20: mahjong.ai.v1.RecommendDiscardRequest
+	(*RecommendDiscardResponse)(nil),       // 21: mahjong.ai.v1.RecommendDiscardResponse
+	(*SimulateHandRequest)(nil),            // 22: mahjong.ai.v1.SimulateHandRequest
+	(*SimulateHandResponse)(nil),           // 23: mahjong.ai.v1.SimulateHandResponse
+	(*AssessSafetyRequest)(nil),            // 24: mahjong.ai.v1.AssessSafetyRequest
+	(*AssessSafetyResponse)(nil),           // 25: mahjong.ai.v1.AssessSafetyResponse
+	(*EvaluatePushFoldRequest)(nil),        // 26: mahjong.ai.v1.EvaluatePushFoldRequest
+	(*EvaluatePushFoldResponse)(nil),       // 27: mahjong.ai.v1.EvaluatePushFoldResponse
+	(*CalculatePlacementRequest)(nil),      // 28: mahjong.ai.v1.CalculatePlacementRequest
+	(*CalculatePlacementResponse)(nil),     // 29: mahjong.ai.v1.CalculatePlacementResponse
+	(*ImportGameLogRequest)(nil),           // 30: mahjong.ai.v1.ImportGameLogRequest
+	(*ImportGameLogResponse)(nil),          // 31: mahjong.ai.v1.ImportGameLogResponse
+	(*GetGameLogStepRequest)(nil),          // 32: mahjong.ai.v1.GetGameLogStepRequest
+	(*GetGameLogStepResponse)(nil),         // 33: mahjong.ai.v1.GetGameLogStepResponse
+	(*ReviewGameRequest)(nil),              // 34: mahjong.ai.v1.ReviewGameRequest
+	(*GetGameReviewRequest)(nil),           // 35: mahjong.ai.v1.GetGameReviewRequest
+	(*GameReviewResponse)(nil),             // 36: mahjong.ai.v1.GameReviewResponse
+	(*CoachRequest)(nil),                   // 37: mahjong.ai.v1.CoachRequest
+	(*CoachResponse)(nil),                  // 38: mahjong.ai.v1.CoachResponse
+	(*ReindexKnowledgeRequest)(nil),        // 39: mahjong.ai.v1.ReindexKnowledgeRequest
+	(*ReindexKnowledgeResponse)(nil),       // 40: mahjong.ai.v1.ReindexKnowledgeResponse
+	(*RenderHandRequest)(nil),              // 41: mahjong.ai.v1.RenderHandRequest
+	(*Diagram)(nil),                        // 42: mahjong.ai.v1.Diagram
+	(*RenderHandResponse)(nil),             // 43: mahjong.ai.v1.RenderHandResponse
+	(*GetQuizRequest)(nil),                 // 44: mahjong.ai.v1.GetQuizRequest
+	(*GetQuizResponse)(nil),                // 45: mahjong.ai.v1.GetQuizResponse
+	(*SubmitQuizAnswerRequest)(nil),        // 46: mahjong.ai.v1.SubmitQuizAnswerRequest
+	(*SubmitQuizAnswerResponse)(nil),       // 47: mahjong.ai.v1.SubmitQuizAnswerResponse
+	(*HealthCheckRequest)(nil),             // 48: mahjong.ai.v1.HealthCheckRequest
+	(*HealthCheckResponse)(nil),            // 49: mahjong.ai.v1.HealthCheckResponse
+	nil,                                    // 50: mahjong.ai.v1.RequestMetadata.HeadersEntry
+	(*timestamppb.Timestamp)(nil),          // 51: google.protobuf.Timestamp
+	(*GameState)(nil),                      // 52: mahjong.ai.v1.GameState
+	(*GameLogPosition)(nil),                // 53: mahjong.ai.v1.GameLogPosition
+	(*Meld)(nil),                           // 54: mahjong.ai.v1.Meld
+	(*structpb.Struct)(nil),                // 55: google.protobuf.Struct
+	(Wind)(0),                              // 56: mahjong.ai.v1.Wind
+	(*WaitsResult)(nil),                    // 57: mahjong.ai.v1.WaitsResult
+	(*DiscardResult)(nil),                  // 58: mahjong.ai.v1.DiscardResult
+	(*SimulationResult)(nil),               // 59: mahjong.ai.v1.SimulationResult
+	(*OpponentInfo)(nil),                   // 60: mahjong.ai.v1.OpponentInfo
+	(*SafetyResult)(nil),                   // 61: mahjong.ai.v1.SafetyResult
+	(*PushFoldResult)(nil),                 // 62: mahjong.ai.v1.PushFoldResult
+	(*PlacementResult)(nil),                // 63: mahjong.ai.v1.PlacementResult
+	(GameLogFormat)(0),                     // 64: mahjong.ai.v1.GameLogFormat
+	(*GameLog)(nil),                        // 65: mahjong.ai.v1.GameLog
+	(*GameLogStep)(nil),                    // 66: mahjong.ai.v1.GameLogStep
+	(*GameReview)(nil),                     // 67: mahjong.ai.v1.GameReview
+	(*CoachEvent)(nil),                     // 68: mahjong.ai.v1.CoachEvent
+	(*CoachExplainRequest)(nil),            // 69: mahjong.ai.v1.CoachExplainRequest
+	(*CoachAdvice)(nil),                    // 70: mahjong.ai.v1.CoachAdvice
+	(*CoachWarning)(nil),                   // 71: mahjong.ai.v1.CoachWarning
+	(*CoachExplanation)(nil),               // 72: mahjong.ai.v1.CoachExplanation
+	(*DiscardedTile)(nil),                  // 73: mahjong.ai.v1.DiscardedTile
+	(*Quiz)(nil),                           // 74: mahjong.ai.v1.Quiz
+	(*QuizStats)(nil),                      // 75: mahjong.ai.v1.QuizStats
+	(*QuizGrade)(nil),                      // 76: mahjong.ai.v1.QuizGrade
+}
+var file_mahjong_ai_v1_ai_proto_depIdxs = []int32{
+	51,  // 0: mahjong.ai.v1.RequestMetadata.timestamp:type_name -> google.protobuf.Timestamp
+	50,  // 1: mahjong.ai.v1.RequestMetadata.headers:type_name -> mahjong.ai.v1.RequestMetadata.HeadersEntry
+	51,  // 2: mahjong.ai.v1.ResponseMetadata.timestamp:type_name -> google.protobuf.Timestamp
+	3,   // 3: mahjong.ai.v1.AskMahjongAIRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	52,  // 4: mahjong.ai.v1.AskMahjongAIRequest.game_state:type_name -> mahjong.ai.v1.GameState
+	53,  // 5: mahjong.ai.v1.AskMahjongAIRequest.game_log_position:type_name -> mahjong.ai.v1.GameLogPosition
+	6,   // 6: mahjong.ai.v1.AskMahjongAIRequest.attachments:type_name -> mahjong.ai.v1.Attachment
+	54,  // 7: mahjong.ai.v1.HandTranscription.melds:type_name -> mahjong.ai.v1.Meld
+	2,   // 8: mahjong.ai.v1.AskMahjongAIResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 9: mahjong.ai.v1.AskMahjongAIResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	16,  // 10: mahjong.ai.v1.AskMahjongAIResponse.tool_calls:type_name -> mahjong.ai.v1.ToolCallInfo
+	15,  // 11: mahjong.ai.v1.AskMahjongAIResponse.verification:type_name -> mahjong.ai.v1.AnswerVerification
+	13,  // 12: mahjong.ai.v1.AskMahjongAIResponse.self_consistency:type_name -> mahjong.ai.v1.SelfConsistency
+	55,  // 13: mahjong.ai.v1.AskMahjongAIResponse.structured:type_name -> google.protobuf.Struct
+	9,   // 14: mahjong.ai.v1.AskMahjongAIResponse.citations:type_name -> mahjong.ai.v1.Citation
+	7,   // 15: mahjong.ai.v1.AskMahjongAIResponse.transcription:type_name -> mahjong.ai.v1.HandTranscription
+	9,   // 16: mahjong.ai.v1.CitationList.citations:type_name -> mahjong.ai.v1.Citation
+	12,  // 17: mahjong.ai.v1.SelfConsistency.votes:type_name -> mahjong.ai.v1.ConclusionVote
+	14,  // 18: mahjong.ai.v1.AnswerVerification.checks:type_name -> mahjong.ai.v1.ClaimCheckInfo
+	2,   // 19: mahjong.ai.v1.AskMahjongAIStreamResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 20: mahjong.ai.v1.AskMahjongAIStreamResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	16,  // 21: mahjong.ai.v1.AskMahjongAIStreamResponse.tool_call:type_name -> mahjong.ai.v1.ToolCallInfo
+	15,  // 22: mahjong.ai.v1.AskMahjongAIStreamResponse.verification:type_name -> mahjong.ai.v1.AnswerVerification
+	10,  // 23: mahjong.ai.v1.AskMahjongAIStreamResponse.citations:type_name -> mahjong.ai.v1.CitationList
+	7,   // 24: mahjong.ai.v1.AskMahjongAIStreamResponse.transcription:type_name -> mahjong.ai.v1.HandTranscription
+	3,   // 25: mahjong.ai.v1.GetWaitsRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	54,  // 26: mahjong.ai.v1.GetWaitsRequest.melds:type_name -> mahjong.ai.v1.Meld
+	56,  // 27: mahjong.ai.v1.GetWaitsRequest.seat_wind:type_name -> mahjong.ai.v1.Wind
+	56,  // 28: mahjong.ai.v1.GetWaitsRequest.round_wind:type_name -> mahjong.ai.v1.Wind
+	57,  // 29: mahjong.ai.v1.GetWaitsResponse.waits:type_name -> mahjong.ai.v1.WaitsResult
+	2,   // 30: mahjong.ai.v1.GetWaitsResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 31: mahjong.ai.v1.GetWaitsResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 32: mahjong.ai.v1.RecommendDiscardRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	54,  // 33: mahjong.ai.v1.RecommendDiscardRequest.melds:type_name -> mahjong.ai.v1.Meld
+	56,  // 34: mahjong.ai.v1.RecommendDiscardRequest.seat_wind:type_name -> mahjong.ai.v1.Wind
+	56,  // 35: mahjong.ai.v1.RecommendDiscardRequest.round_wind:type_name -> mahjong.ai.v1.Wind
+	58,  // 36: mahjong.ai.v1.RecommendDiscardResponse.discard:type_name -> mahjong.ai.v1.DiscardResult
+	2,   // 37: mahjong.ai.v1.RecommendDiscardResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 38: mahjong.ai.v1.RecommendDiscardResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 39: mahjong.ai.v1.SimulateHandRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	54,  // 40: mahjong.ai.v1.SimulateHandRequest.melds:type_name -> mahjong.ai.v1.Meld
+	56,  // 41: mahjong.ai.v1.SimulateHandRequest.seat_wind:type_name -> mahjong.ai.v1.Wind
+	56,  // 42: mahjong.ai.v1.SimulateHandRequest.round_wind:type_name -> mahjong.ai.v1.Wind
+	59,  // 43: mahjong.ai.v1.SimulateHandResponse.simulation:type_name -> mahjong.ai.v1.SimulationResult
+	2,   // 44: mahjong.ai.v1.SimulateHandResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 45: mahjong.ai.v1.SimulateHandResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 46: mahjong.ai.v1.AssessSafetyRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	54,  // 47: mahjong.ai.v1.AssessSafetyRequest.melds:type_name -> mahjong.ai.v1.Meld
+	60,  // 48: mahjong.ai.v1.AssessSafetyRequest.opponents:type_name -> mahjong.ai.v1.OpponentInfo
+	61,  // 49: mahjong.ai.v1.AssessSafetyResponse.safety:type_name -> mahjong.ai.v1.SafetyResult
+	2,   // 50: mahjong.ai.v1.AssessSafetyResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 51: mahjong.ai.v1.AssessSafetyResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 52: mahjong.ai.v1.EvaluatePushFoldRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	54,  // 53: mahjong.ai.v1.EvaluatePushFoldRequest.melds:type_name -> mahjong.ai.v1.Meld
+	56,  // 54: mahjong.ai.v1.EvaluatePushFoldRequest.seat_wind:type_name -> mahjong.ai.v1.Wind
+	56,  // 55: mahjong.ai.v1.EvaluatePushFoldRequest.round_wind:type_name -> mahjong.ai.v1.Wind
+	60,  // 56: mahjong.ai.v1.EvaluatePushFoldRequest.opponents:type_name -> mahjong.ai.v1.OpponentInfo
+	62,  // 57: mahjong.ai.v1.EvaluatePushFoldResponse.push_fold:type_name -> mahjong.ai.v1.PushFoldResult
+	2,   // 58: mahjong.ai.v1.EvaluatePushFoldResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 59: mahjong.ai.v1.EvaluatePushFoldResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 60: mahjong.ai.v1.CalculatePlacementRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	63,  // 61: mahjong.ai.v1.CalculatePlacementResponse.placement:type_name -> mahjong.ai.v1.PlacementResult
+	2,   // 62: mahjong.ai.v1.CalculatePlacementResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 63: mahjong.ai.v1.CalculatePlacementResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 64: mahjong.ai.v1.ImportGameLogRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	64,  // 65: mahjong.ai.v1.ImportGameLogRequest.format:type_name -> mahjong.ai.v1.GameLogFormat
+	65,  // 66: mahjong.ai.v1.ImportGameLogResponse.game_log:type_name -> mahjong.ai.v1.GameLog
+	2,   // 67: mahjong.ai.v1.ImportGameLogResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 68: mahjong.ai.v1.ImportGameLogResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 69: mahjong.ai.v1.GetGameLogStepRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	53,  // 70: mahjong.ai.v1.GetGameLogStepRequest.position:type_name -> mahjong.ai.v1.GameLogPosition
+	66,  // 71: mahjong.ai.v1.GetGameLogStepResponse.step:type_name -> mahjong.ai.v1.GameLogStep
+	2,   // 72: mahjong.ai.v1.GetGameLogStepResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 73: mahjong.ai.v1.GetGameLogStepResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 74: mahjong.ai.v1.ReviewGameRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	3,   // 75: mahjong.ai.v1.GetGameReviewRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	67,  // 76: mahjong.ai.v1.GameReviewResponse.review:type_name -> mahjong.ai.v1.GameReview
+	2,   // 77: mahjong.ai.v1.GameReviewResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 78: mahjong.ai.v1.GameReviewResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 79: mahjong.ai.v1.CoachRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	68,  // 80: mahjong.ai.v1.CoachRequest.event:type_name -> mahjong.ai.v1.CoachEvent
+	69,  // 81: mahjong.ai.v1.CoachRequest.explain:type_name -> mahjong.ai.v1.CoachExplainRequest
+	70,  // 82: mahjong.ai.v1.CoachResponse.advice:type_name -> mahjong.ai.v1.CoachAdvice
+	71,  // 83: mahjong.ai.v1.CoachResponse.warning:type_name -> mahjong.ai.v1.CoachWarning
+	72,  // 84: mahjong.ai.v1.CoachResponse.explanation:type_name -> mahjong.ai.v1.CoachExplanation
+	2,   // 85: mahjong.ai.v1.CoachResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 86: mahjong.ai.v1.CoachResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 87: mahjong.ai.v1.ReindexKnowledgeRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	11,  // 88: mahjong.ai.v1.ReindexKnowledgeResponse.index:type_name -> mahjong.ai.v1.KnowledgeIndexInfo
+	2,   // 89: mahjong.ai.v1.ReindexKnowledgeResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 90: mahjong.ai.v1.ReindexKnowledgeResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 91: mahjong.ai.v1.RenderHandRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	54,  // 92: mahjong.ai.v1.RenderHandRequest.melds:type_name -> mahjong.ai.v1.Meld
+	73,  // 93: mahjong.ai.v1.RenderHandRequest.discards:type_name -> mahjong.ai.v1.DiscardedTile
+	52,  // 94: mahjong.ai.v1.RenderHandRequest.game_state:type_name -> mahjong.ai.v1.GameState
+	53,  // 95: mahjong.ai.v1.RenderHandRequest.game_log_position:type_name -> mahjong.ai.v1.GameLogPosition
+	0,   // 96: mahjong.ai.v1.RenderHandRequest.format:type_name -> mahjong.ai.v1.DiagramFormat
+	42,  // 97: mahjong.ai.v1.RenderHandResponse.diagram:type_name -> mahjong.ai.v1.Diagram
+	2,   // 98: mahjong.ai.v1.RenderHandResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	4,   // 99: mahjong.ai.v1.RenderHandResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 100: mahjong.ai.v1.GetQuizRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	74,  // 101: mahjong.ai.v1.GetQuizResponse.quiz:type_name -> mahjong.ai.v1.Quiz
+	2,   // 102: mahjong.ai.v1.GetQuizResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	75,  // 103: mahjong.ai.v1.GetQuizResponse.stats:type_name -> mahjong.ai.v1.QuizStats
+	4,   // 104: mahjong.ai.v1.GetQuizResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	3,   // 105: mahjong.ai.v1.SubmitQuizAnswerRequest.metadata:type_name -> mahjong.ai.v1.RequestMetadata
+	76,  // 106: mahjong.ai.v1.SubmitQuizAnswerResponse.grade:type_name -> mahjong.ai.v1.QuizGrade
+	2,   // 107: mahjong.ai.v1.SubmitQuizAnswerResponse.error:type_name -> mahjong.ai.v1.ErrorInfo
+	75,  // 108: mahjong.ai.v1.SubmitQuizAnswerResponse.stats:type_name -> mahjong.ai.v1.QuizStats
+	4,   // 109: mahjong.ai.v1.SubmitQuizAnswerResponse.metadata:type_name -> mahjong.ai.v1.ResponseMetadata
+	1,   // 110: mahjong.ai.v1.HealthCheckResponse.status:type_name -> mahjong.ai.v1.HealthCheckResponse.ServingStatus
+	51,  // 111: mahjong.ai.v1.HealthCheckResponse.timestamp:type_name -> google.protobuf.Timestamp
+	5,   // 112: mahjong.ai.v1.MahjongAIService.AskMahjongAI:input_type -> mahjong.ai.v1.AskMahjongAIRequest
+	5,   // 113: mahjong.ai.v1.MahjongAIService.AskMahjongAIStream:input_type -> mahjong.ai.v1.AskMahjongAIRequest
+	18,  // 114: mahjong.ai.v1.MahjongAIService.GetWaits:input_type -> mahjong.ai.v1.GetWaitsRequest
+	20,  // 115: mahjong.ai.v1.MahjongAIService.RecommendDiscard:input_type -> mahjong.ai.v1.RecommendDiscardRequest
+	22,  // 116: mahjong.ai.v1.MahjongAIService.SimulateHand:input_type -> mahjong.ai.v1.SimulateHandRequest
+	24,  // 117: mahjong.ai.v1.MahjongAIService.AssessSafety:input_type -> mahjong.ai.v1.AssessSafetyRequest
+	26,  // 118: mahjong.ai.v1.MahjongAIService.EvaluatePushFold:input_type -> mahjong.ai.v1.EvaluatePushFoldRequest
+	28,  // 119: mahjong.ai.v1.MahjongAIService.CalculatePlacement:input_type -> mahjong.ai.v1.CalculatePlacementRequest
+	30,  // 120: mahjong.ai.v1.MahjongAIService.ImportGameLog:input_type -> mahjong.ai.v1.ImportGameLogRequest
+	32,  // 121: mahjong.ai.v1.MahjongAIService.GetGameLogStep:input_type -> mahjong.ai.v1.GetGameLogStepRequest
+	34,  // 122: mahjong.ai.v1.MahjongAIService.ReviewGame:input_type -> mahjong.ai.v1.ReviewGameRequest
+	35,  // 123: mahjong.ai.v1.MahjongAIService.GetGameReview:input_type -> mahjong.ai.v1.GetGameReviewRequest
+	35,  // 124: mahjong.ai.v1.MahjongAIService.WatchGameReview:input_type -> mahjong.ai.v1.GetGameReviewRequest
+	37,  // 125: mahjong.ai.v1.MahjongAIService.Coach:input_type -> mahjong.ai.v1.CoachRequest
+	39,  // 126: mahjong.ai.v1.MahjongAIService.ReindexKnowledge:input_type -> mahjong.ai.v1.ReindexKnowledgeRequest
+	41,  // 127: mahjong.ai.v1.MahjongAIService.RenderHand:input_type -> mahjong.ai.v1.RenderHandRequest
+	44,  // 128: mahjong.ai.v1.MahjongAIService.GetQuiz:input_type -> mahjong.ai.v1.GetQuizRequest
+	46,  // 129: mahjong.ai.v1.MahjongAIService.SubmitQuizAnswer:input_type -> mahjong.ai.v1.SubmitQuizAnswerRequest
+	48,  // 130: mahjong.ai.v1.MahjongAIService.HealthCheck:input_type -> mahjong.ai.v1.HealthCheckRequest
+	8,   // 131: mahjong.ai.v1.MahjongAIService.AskMahjongAI:output_type -> mahjong.ai.v1.AskMahjongAIResponse
+	17,  // 132: mahjong.ai.v1.MahjongAIService.AskMahjongAIStream:output_type -> mahjong.ai.v1.AskMahjongAIStreamResponse
+	19,  // 133: mahjong.ai.v1.MahjongAIService.GetWaits:output_type -> mahjong.ai.v1.GetWaitsResponse
+	21,  // 134: mahjong.ai.v1.MahjongAIService.RecommendDiscard:output_type -> mahjong.ai.v1.RecommendDiscardResponse
+	23,  // 135: mahjong.ai.v1.MahjongAIService.SimulateHand:output_type -> mahjong.ai.v1.SimulateHandResponse
+	25,  // 136: mahjong.ai.v1.MahjongAIService.AssessSafety:output_type -> mahjong.ai.v1.AssessSafetyResponse
+	27,  // 137: mahjong.ai.v1.MahjongAIService.EvaluatePushFold:output_type -> mahjong.ai.v1.EvaluatePushFoldResponse
+	29,  // 138: mahjong.ai.v1.MahjongAIService.CalculatePlacement:output_type -> mahjong.ai.v1.CalculatePlacementResponse
+	31,  // 139: mahjong.ai.v1.MahjongAIService.ImportGameLog:output_type -> mahjong.ai.v1.ImportGameLogResponse
+	33,  // 140: mahjong.ai.v1.MahjongAIService.GetGameLogStep:output_type -> mahjong.ai.v1.GetGameLogStepResponse
+	36,  // 141: mahjong.ai.v1.MahjongAIService.ReviewGame:output_type -> mahjong.ai.v1.GameReviewResponse
+	36,  // 142: mahjong.ai.v1.MahjongAIService.GetGameReview:output_type -> mahjong.ai.v1.GameReviewResponse
+	36,  // 143: mahjong.ai.v1.MahjongAIService.WatchGameReview:output_type -> mahjong.ai.v1.GameReviewResponse
+	38,  // 144: mahjong.ai.v1.MahjongAIService.Coach:output_type -> mahjong.ai.v1.CoachResponse
+	40,  // 145: mahjong.ai.v1.MahjongAIService.ReindexKnowledge:output_type -> mahjong.ai.v1.ReindexKnowledgeResponse
+	43,  // 146: mahjong.ai.v1.MahjongAIService.RenderHand:output_type -> mahjong.ai.v1.RenderHandResponse
+	45,  // 147: mahjong.ai.v1.MahjongAIService.GetQuiz:output_type -> mahjong.ai.v1.GetQuizResponse
+	47,  // 148: mahjong.ai.v1.MahjongAIService.SubmitQuizAnswer:output_type -> mahjong.ai.v1.SubmitQuizAnswerResponse
+	49,  // 149: mahjong.ai.v1.MahjongAIService.HealthCheck:output_type -> mahjong.ai.v1.HealthCheckResponse
+	131, // [131:150] is the sub-list for method output_type
+	112, // [112:131] is the sub-list for method input_type
+	112, // [112:112] is the sub-list for extension type_name
+	112, // [112:112] is the sub-list for extension extendee
+	0,   // [0:112] is the sub-list for field type_name
+}
+
+func init() { file_mahjong_ai_v1_ai_proto_init() }
+func file_mahjong_ai_v1_ai_proto_init() {
+	if File_mahjong_ai_v1_ai_proto != nil {
+		return
+	}
+	file_mahjong_ai_v1_analysis_proto_init()
+	file_mahjong_ai_v1_game_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mahjong_ai_v1_ai_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResponseMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AskMahjongAIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Attachment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandTranscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AskMahjongAIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Citation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CitationList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KnowledgeIndexInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConclusionVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfConsistency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimCheckInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnswerVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolCallInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AskMahjongAIStreamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWaitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendDiscardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -900,8 +5839,8 @@ func file_mahjong_ai_v1_ai_proto_init() {
 				return nil
 			}
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResponseMetadata); i {
+		file_mahjong_ai_v1_ai_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecommendDiscardResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -912,8 +5851,8 @@ func file_mahjong_ai_v1_ai_proto_init() {
 				return nil
 			}
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AskMahjongAIRequest); i {
+		file_mahjong_ai_v1_ai_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateHandRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -924,8 +5863,8 @@ func file_mahjong_ai_v1_ai_proto_init() {
 				return nil
 			}
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AskMahjongAIResponse); i {
+		file_mahjong_ai_v1_ai_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulateHandResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -936,8 +5875,8 @@ func file_mahjong_ai_v1_ai_proto_init() {
 				return nil
 			}
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AskMahjongAIStreamResponse); i {
+		file_mahjong_ai_v1_ai_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssessSafetyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -948,7 +5887,283 @@ func file_mahjong_ai_v1_ai_proto_init() {
 				return nil
 			}
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_mahjong_ai_v1_ai_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AssessSafetyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePushFoldRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvaluatePushFoldResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculatePlacementRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CalculatePlacementResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportGameLogRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportGameLogResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameLogStepRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameLogStepResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGameReviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameReviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReindexKnowledgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReindexKnowledgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderHandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Diagram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderHandResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuizRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetQuizResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitQuizAnswerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubmitQuizAnswerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_ai_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthCheckRequest); i {
 			case 0:
 				return &v.state
@@ -960,7 +6175,7 @@ func file_mahjong_ai_v1_ai_proto_init() {
 				return nil
 			}
 		}
-		file_mahjong_ai_v1_ai_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_mahjong_ai_v1_ai_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HealthCheckResponse); i {
 			case 0:
 				return &v.state
@@ -973,22 +6188,88 @@ func file_mahjong_ai_v1_ai_proto_init() {
 			}
 		}
 	}
-	file_mahjong_ai_v1_ai_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_mahjong_ai_v1_ai_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*AskMahjongAIResponse_Response)(nil),
 		(*AskMahjongAIResponse_Error)(nil),
 	}
-	file_mahjong_ai_v1_ai_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_mahjong_ai_v1_ai_proto_msgTypes[15].OneofWrappers = []interface{}{
 		(*AskMahjongAIStreamResponse_TextChunk)(nil),
 		(*AskMahjongAIStreamResponse_Error)(nil),
 		(*AskMahjongAIStreamResponse_Metadata)(nil),
+		(*AskMahjongAIStreamResponse_ToolCall)(nil),
+		(*AskMahjongAIStreamResponse_Verification)(nil),
+		(*AskMahjongAIStreamResponse_Citations)(nil),
+		(*AskMahjongAIStreamResponse_Transcription)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*GetWaitsResponse_Waits)(nil),
+		(*GetWaitsResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[19].OneofWrappers = []interface{}{
+		(*RecommendDiscardResponse_Discard)(nil),
+		(*RecommendDiscardResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[21].OneofWrappers = []interface{}{
+		(*SimulateHandResponse_Simulation)(nil),
+		(*SimulateHandResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*AssessSafetyResponse_Safety)(nil),
+		(*AssessSafetyResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[25].OneofWrappers = []interface{}{
+		(*EvaluatePushFoldResponse_PushFold)(nil),
+		(*EvaluatePushFoldResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*CalculatePlacementResponse_Placement)(nil),
+		(*CalculatePlacementResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*ImportGameLogResponse_GameLog)(nil),
+		(*ImportGameLogResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[31].OneofWrappers = []interface{}{
+		(*GetGameLogStepResponse_Step)(nil),
+		(*GetGameLogStepResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[34].OneofWrappers = []interface{}{
+		(*GameReviewResponse_Review)(nil),
+		(*GameReviewResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*CoachRequest_Event)(nil),
+		(*CoachRequest_Explain)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[36].OneofWrappers = []interface{}{
+		(*CoachResponse_Advice)(nil),
+		(*CoachResponse_Warning)(nil),
+		(*CoachResponse_Explanation)(nil),
+		(*CoachResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[38].OneofWrappers = []interface{}{
+		(*ReindexKnowledgeResponse_Index)(nil),
+		(*ReindexKnowledgeResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*RenderHandResponse_Diagram)(nil),
+		(*RenderHandResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*GetQuizResponse_Quiz)(nil),
+		(*GetQuizResponse_Error)(nil),
+	}
+	file_mahjong_ai_v1_ai_proto_msgTypes[45].OneofWrappers = []interface{}{
+		(*SubmitQuizAnswerResponse_Grade)(nil),
+		(*SubmitQuizAnswerResponse_Error)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mahjong_ai_v1_ai_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   49,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -21,6 +21,22 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	MahjongAIService_AskMahjongAI_FullMethodName       = "/mahjong.ai.v1.MahjongAIService/AskMahjongAI"
 	MahjongAIService_AskMahjongAIStream_FullMethodName = "/mahjong.ai.v1.MahjongAIService/AskMahjongAIStream"
+	MahjongAIService_GetWaits_FullMethodName           = "/mahjong.ai.v1.MahjongAIService/GetWaits"
+	MahjongAIService_RecommendDiscard_FullMethodName   = "/mahjong.ai.v1.MahjongAIService/RecommendDiscard"
+	MahjongAIService_SimulateHand_FullMethodName       = "/mahjong.ai.v1.MahjongAIService/SimulateHand"
+	MahjongAIService_AssessSafety_FullMethodName       = "/mahjong.ai.v1.MahjongAIService/AssessSafety"
+	MahjongAIService_EvaluatePushFold_FullMethodName   = "/mahjong.ai.v1.MahjongAIService/EvaluatePushFold"
+	MahjongAIService_CalculatePlacement_FullMethodName = "/mahjong.ai.v1.MahjongAIService/CalculatePlacement"
+	MahjongAIService_ImportGameLog_FullMethodName      = "/mahjong.ai.v1.MahjongAIService/ImportGameLog"
+	MahjongAIService_GetGameLogStep_FullMethodName     = "/mahjong.ai.v1.MahjongAIService/GetGameLogStep"
+	MahjongAIService_ReviewGame_FullMethodName         = "/mahjong.ai.v1.MahjongAIService/ReviewGame"
+	MahjongAIService_GetGameReview_FullMethodName      = "/mahjong.ai.v1.MahjongAIService/GetGameReview"
+	MahjongAIService_WatchGameReview_FullMethodName    = "/mahjong.ai.v1.MahjongAIService/WatchGameReview"
+	MahjongAIService_Coach_FullMethodName              = "/mahjong.ai.v1.MahjongAIService/Coach"
+	MahjongAIService_ReindexKnowledge_FullMethodName   = "/mahjong.ai.v1.MahjongAIService/ReindexKnowledge"
+	MahjongAIService_RenderHand_FullMethodName         = "/mahjong.ai.v1.MahjongAIService/RenderHand"
+	MahjongAIService_GetQuiz_FullMethodName            = "/mahjong.ai.v1.MahjongAIService/GetQuiz"
+	MahjongAIService_SubmitQuizAnswer_FullMethodName   = "/mahjong.ai.v1.MahjongAIService/SubmitQuizAnswer"
 	MahjongAIService_HealthCheck_FullMethodName        = "/mahjong.ai.v1.MahjongAIService/HealthCheck"
 )
 
@@ -32,6 +48,38 @@ type MahjongAIServiceClient interface {
 	AskMahjongAI(ctx context.Context, in *AskMahjongAIRequest, opts ...grpc.CallOption) (*AskMahjongAIResponse, error)
 	// 麻雀AIに質問する（ストリーミング）
 	AskMahjongAIStream(ctx context.Context, in *AskMahjongAIRequest, opts ...grpc.CallOption) (MahjongAIService_AskMahjongAIStreamClient, error)
+	// 聴牌形の待ち・待ちの形・役の有無・フリテンを判定する
+	GetWaits(ctx context.Context, in *GetWaitsRequest, opts ...grpc.CallOption) (*GetWaitsResponse, error)
+	// 打牌候補を受け入れ・改良・和了率・打点期待値で評価して推奨順に返す
+	RecommendDiscard(ctx context.Context, in *RecommendDiscardRequest, opts ...grpc.CallOption) (*RecommendDiscardResponse, error)
+	// 手牌からの展開をモンテカルロ法でシミュレーションし、巡目ごとの聴牌率・和了率・打点期待値を返す
+	SimulateHand(ctx context.Context, in *SimulateHandRequest, opts ...grpc.CallOption) (*SimulateHandResponse, error)
+	// 手牌の牌ごとに立直者への安全度（現物・筋・壁・ワンチャンスなど）を評価して安全な順に返す
+	AssessSafety(ctx context.Context, in *AssessSafetyRequest, opts ...grpc.CallOption) (*AssessSafetyResponse, error)
+	// 手牌の価値・立直者の脅威・点数状況・巡目から押し・回し・降りを期待値で評価する
+	EvaluatePushFold(ctx context.Context, in *EvaluatePushFoldRequest, opts ...grpc.CallOption) (*EvaluatePushFoldResponse, error)
+	// オーラスで各順位に届くために必要な和了を計算する
+	CalculatePlacement(ctx context.Context, in *CalculatePlacementRequest, opts ...grpc.CallOption) (*CalculatePlacementResponse, error)
+	// 天鳳の牌譜（JSON・mjlog）を取り込み、各打牌の判断の直前の局面を再構成して保存する
+	ImportGameLog(ctx context.Context, in *ImportGameLogRequest, opts ...grpc.CallOption) (*ImportGameLogResponse, error)
+	// 取り込んだ牌譜の打牌の判断とその直前の局面を返す
+	GetGameLogStep(ctx context.Context, in *GetGameLogStepRequest, opts ...grpc.CallOption) (*GetGameLogStepResponse, error)
+	// 牌譜のプレイヤーの全打牌をエンジンで評価し、損失の大きい悪手をAIに解説させる検討をバックグラウンドで開始する
+	ReviewGame(ctx context.Context, in *ReviewGameRequest, opts ...grpc.CallOption) (*GameReviewResponse, error)
+	// 牌譜の検討結果を返す
+	GetGameReview(ctx context.Context, in *GetGameReviewRequest, opts ...grpc.CallOption) (*GameReviewResponse, error)
+	// 牌譜の検討結果を更新のたびに返し、検討が終わると終了する
+	WatchGameReview(ctx context.Context, in *GetGameReviewRequest, opts ...grpc.CallOption) (MahjongAIService_WatchGameReviewClient, error)
+	// 対局の出来事を受け取りながら、推奨・警告・解説を送る（双方向ストリーミング）
+	Coach(ctx context.Context, opts ...grpc.CallOption) (MahjongAIService_CoachClient, error)
+	// ルールの資料を読み込み直し、全文検索と埋め込みベクトルの索引を作り直す（管理用）
+	ReindexKnowledge(ctx context.Context, in *ReindexKnowledgeRequest, opts ...grpc.CallOption) (*ReindexKnowledgeResponse, error)
+	// 手牌・副露・河・局面を牌の図（SVG・PNG）にする
+	RenderHand(ctx context.Context, in *RenderHandRequest, opts ...grpc.CallOption) (*RenderHandResponse, error)
+	// 何切る問題を出題する
+	GetQuiz(ctx context.Context, in *GetQuizRequest, opts ...grpc.CallOption) (*GetQuizResponse, error)
+	// 何切る問題の回答をエンジンで採点し、AIの解説を付けて成績に記録する
+	SubmitQuizAnswer(ctx context.Context, in *SubmitQuizAnswerRequest, opts ...grpc.CallOption) (*SubmitQuizAnswerResponse, error)
 	// ヘルスチェック
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -85,6 +133,195 @@ func (x *mahjongAIServiceAskMahjongAIStreamClient) Recv() (*AskMahjongAIStreamRe
 	return m, nil
 }
 
+func (c *mahjongAIServiceClient) GetWaits(ctx context.Context, in *GetWaitsRequest, opts ...grpc.CallOption) (*GetWaitsResponse, error) {
+	out := new(GetWaitsResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_GetWaits_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) RecommendDiscard(ctx context.Context, in *RecommendDiscardRequest, opts ...grpc.CallOption) (*RecommendDiscardResponse, error) {
+	out := new(RecommendDiscardResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_RecommendDiscard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) SimulateHand(ctx context.Context, in *SimulateHandRequest, opts ...grpc.CallOption) (*SimulateHandResponse, error) {
+	out := new(SimulateHandResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_SimulateHand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) AssessSafety(ctx context.Context, in *AssessSafetyRequest, opts ...grpc.CallOption) (*AssessSafetyResponse, error) {
+	out := new(AssessSafetyResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_AssessSafety_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) EvaluatePushFold(ctx context.Context, in *EvaluatePushFoldRequest, opts ...grpc.CallOption) (*EvaluatePushFoldResponse, error) {
+	out := new(EvaluatePushFoldResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_EvaluatePushFold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) CalculatePlacement(ctx context.Context, in *CalculatePlacementRequest, opts ...grpc.CallOption) (*CalculatePlacementResponse, error) {
+	out := new(CalculatePlacementResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_CalculatePlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) ImportGameLog(ctx context.Context, in *ImportGameLogRequest, opts ...grpc.CallOption) (*ImportGameLogResponse, error) {
+	out := new(ImportGameLogResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_ImportGameLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) GetGameLogStep(ctx context.Context, in *GetGameLogStepRequest, opts ...grpc.CallOption) (*GetGameLogStepResponse, error) {
+	out := new(GetGameLogStepResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_GetGameLogStep_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) ReviewGame(ctx context.Context, in *ReviewGameRequest, opts ...grpc.CallOption) (*GameReviewResponse, error) {
+	out := new(GameReviewResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_ReviewGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) GetGameReview(ctx context.Context, in *GetGameReviewRequest, opts ...grpc.CallOption) (*GameReviewResponse, error) {
+	out := new(GameReviewResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_GetGameReview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) WatchGameReview(ctx context.Context, in *GetGameReviewRequest, opts ...grpc.CallOption) (MahjongAIService_WatchGameReviewClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MahjongAIService_ServiceDesc.Streams[1], MahjongAIService_WatchGameReview_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mahjongAIServiceWatchGameReviewClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MahjongAIService_WatchGameReviewClient interface {
+	Recv() (*GameReviewResponse, error)
+	grpc.ClientStream
+}
+
+type mahjongAIServiceWatchGameReviewClient struct {
+	grpc.ClientStream
+}
+
+func (x *mahjongAIServiceWatchGameReviewClient) Recv() (*GameReviewResponse, error) {
+	m := new(GameReviewResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mahjongAIServiceClient) Coach(ctx context.Context, opts ...grpc.CallOption) (MahjongAIService_CoachClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MahjongAIService_ServiceDesc.Streams[2], MahjongAIService_Coach_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mahjongAIServiceCoachClient{stream}
+	return x, nil
+}
+
+type MahjongAIService_CoachClient interface {
+	Send(*CoachRequest) error
+	Recv() (*CoachResponse, error)
+	grpc.ClientStream
+}
+
+type mahjongAIServiceCoachClient struct {
+	grpc.ClientStream
+}
+
+func (x *mahjongAIServiceCoachClient) Send(m *CoachRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *mahjongAIServiceCoachClient) Recv() (*CoachResponse, error) {
+	m := new(CoachResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mahjongAIServiceClient) ReindexKnowledge(ctx context.Context, in *ReindexKnowledgeRequest, opts ...grpc.CallOption) (*ReindexKnowledgeResponse, error) {
+	out := new(ReindexKnowledgeResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_ReindexKnowledge_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) RenderHand(ctx context.Context, in *RenderHandRequest, opts ...grpc.CallOption) (*RenderHandResponse, error) {
+	out := new(RenderHandResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_RenderHand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) GetQuiz(ctx context.Context, in *GetQuizRequest, opts ...grpc.CallOption) (*GetQuizResponse, error) {
+	out := new(GetQuizResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_GetQuiz_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mahjongAIServiceClient) SubmitQuizAnswer(ctx context.Context, in *SubmitQuizAnswerRequest, opts ...grpc.CallOption) (*SubmitQuizAnswerResponse, error) {
+	out := new(SubmitQuizAnswerResponse)
+	err := c.cc.Invoke(ctx, MahjongAIService_SubmitQuizAnswer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mahjongAIServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	out := new(HealthCheckResponse)
 	err := c.cc.Invoke(ctx, MahjongAIService_HealthCheck_FullMethodName, in, out, opts...)
@@ -102,6 +339,38 @@ type MahjongAIServiceServer interface {
 	AskMahjongAI(context.Context, *AskMahjongAIRequest) (*AskMahjongAIResponse, error)
 	// 麻雀AIに質問する（ストリーミング）
 	AskMahjongAIStream(*AskMahjongAIRequest, MahjongAIService_AskMahjongAIStreamServer) error
+	// 聴牌形の待ち・待ちの形・役の有無・フリテンを判定する
+	GetWaits(context.Context, *GetWaitsRequest) (*GetWaitsResponse, error)
+	// 打牌候補を受け入れ・改良・和了率・打点期待値で評価して推奨順に返す
+	RecommendDiscard(context.Context, *RecommendDiscardRequest) (*RecommendDiscardResponse, error)
+	// 手牌からの展開をモンテカルロ法でシミュレーションし、巡目ごとの聴牌率・和了率・打点期待値を返す
+	SimulateHand(context.Context, *SimulateHandRequest) (*SimulateHandResponse, error)
+	// 手牌の牌ごとに立直者への安全度（現物・筋・壁・ワンチャンスなど）を評価して安全な順に返す
+	AssessSafety(context.Context, *AssessSafetyRequest) (*AssessSafetyResponse, error)
+	// 手牌の価値・立直者の脅威・点数状況・巡目から押し・回し・降りを期待値で評価する
+	EvaluatePushFold(context.Context, *EvaluatePushFoldRequest) (*EvaluatePushFoldResponse, error)
+	// オーラスで各順位に届くために必要な和了を計算する
+	CalculatePlacement(context.Context, *CalculatePlacementRequest) (*CalculatePlacementResponse, error)
+	// 天鳳の牌譜（JSON・mjlog）を取り込み、各打牌の判断の直前の局面を再構成して保存する
+	ImportGameLog(context.Context, *ImportGameLogRequest) (*ImportGameLogResponse, error)
+	// 取り込んだ牌譜の打牌の判断とその直前の局面を返す
+	GetGameLogStep(context.Context, *GetGameLogStepRequest) (*GetGameLogStepResponse, error)
+	// 牌譜のプレイヤーの全打牌をエンジンで評価し、損失の大きい悪手をAIに解説させる検討をバックグラウンドで開始する
+	ReviewGame(context.Context, *ReviewGameRequest) (*GameReviewResponse, error)
+	// 牌譜の検討結果を返す
+	GetGameReview(context.Context, *GetGameReviewRequest) (*GameReviewResponse, error)
+	// 牌譜の検討結果を更新のたびに返し、検討が終わると終了する
+	WatchGameReview(*GetGameReviewRequest, MahjongAIService_WatchGameReviewServer) error
+	// 対局の出来事を受け取りながら、推奨・警告・解説を送る（双方向ストリーミング）
+	Coach(MahjongAIService_CoachServer) error
+	// ルールの資料を読み込み直し、全文検索と埋め込みベクトルの索引を作り直す（管理用）
+	ReindexKnowledge(context.Context, *ReindexKnowledgeRequest) (*ReindexKnowledgeResponse, error)
+	// 手牌・副露・河・局面を牌の図（SVG・PNG）にする
+	RenderHand(context.Context, *RenderHandRequest) (*RenderHandResponse, error)
+	// 何切る問題を出題する
+	GetQuiz(context.Context, *GetQuizRequest) (*GetQuizResponse, error)
+	// 何切る問題の回答をエンジンで採点し、AIの解説を付けて成績に記録する
+	SubmitQuizAnswer(context.Context, *SubmitQuizAnswerRequest) (*SubmitQuizAnswerResponse, error)
 	// ヘルスチェック
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedMahjongAIServiceServer()
@@ -117,6 +386,54 @@ func (UnimplementedMahjongAIServiceServer) AskMahjongAI(context.Context, *AskMah
 func (UnimplementedMahjongAIServiceServer) AskMahjongAIStream(*AskMahjongAIRequest, MahjongAIService_AskMahjongAIStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method AskMahjongAIStream not implemented")
 }
+func (UnimplementedMahjongAIServiceServer) GetWaits(context.Context, *GetWaitsRequest) (*GetWaitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWaits not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) RecommendDiscard(context.Context, *RecommendDiscardRequest) (*RecommendDiscardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecommendDiscard not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) SimulateHand(context.Context, *SimulateHandRequest) (*SimulateHandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateHand not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) AssessSafety(context.Context, *AssessSafetyRequest) (*AssessSafetyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssessSafety not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) EvaluatePushFold(context.Context, *EvaluatePushFoldRequest) (*EvaluatePushFoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvaluatePushFold not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) CalculatePlacement(context.Context, *CalculatePlacementRequest) (*CalculatePlacementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculatePlacement not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) ImportGameLog(context.Context, *ImportGameLogRequest) (*ImportGameLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportGameLog not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) GetGameLogStep(context.Context, *GetGameLogStepRequest) (*GetGameLogStepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameLogStep not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) ReviewGame(context.Context, *ReviewGameRequest) (*GameReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewGame not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) GetGameReview(context.Context, *GetGameReviewRequest) (*GameReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGameReview not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) WatchGameReview(*GetGameReviewRequest, MahjongAIService_WatchGameReviewServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGameReview not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) Coach(MahjongAIService_CoachServer) error {
+	return status.Errorf(codes.Unimplemented, "method Coach not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) ReindexKnowledge(context.Context, *ReindexKnowledgeRequest) (*ReindexKnowledgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReindexKnowledge not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) RenderHand(context.Context, *RenderHandRequest) (*RenderHandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderHand not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) GetQuiz(context.Context, *GetQuizRequest) (*GetQuizResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuiz not implemented")
+}
+func (UnimplementedMahjongAIServiceServer) SubmitQuizAnswer(context.Context, *SubmitQuizAnswerRequest) (*SubmitQuizAnswerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuizAnswer not implemented")
+}
 func (UnimplementedMahjongAIServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -172,6 +489,305 @@ func (x *mahjongAIServiceAskMahjongAIStreamServer) Send(m *AskMahjongAIStreamRes
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MahjongAIService_GetWaits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWaitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).GetWaits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_GetWaits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).GetWaits(ctx, req.(*GetWaitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_RecommendDiscard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecommendDiscardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).RecommendDiscard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_RecommendDiscard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).RecommendDiscard(ctx, req.(*RecommendDiscardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_SimulateHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateHandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).SimulateHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_SimulateHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).SimulateHand(ctx, req.(*SimulateHandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_AssessSafety_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssessSafetyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).AssessSafety(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_AssessSafety_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).AssessSafety(ctx, req.(*AssessSafetyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_EvaluatePushFold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvaluatePushFoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).EvaluatePushFold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_EvaluatePushFold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).EvaluatePushFold(ctx, req.(*EvaluatePushFoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_CalculatePlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculatePlacementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).CalculatePlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_CalculatePlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).CalculatePlacement(ctx, req.(*CalculatePlacementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_ImportGameLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportGameLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).ImportGameLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_ImportGameLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).ImportGameLog(ctx, req.(*ImportGameLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_GetGameLogStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameLogStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).GetGameLogStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_GetGameLogStep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).GetGameLogStep(ctx, req.(*GetGameLogStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_ReviewGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).ReviewGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_ReviewGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).ReviewGame(ctx, req.(*ReviewGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_GetGameReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).GetGameReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_GetGameReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).GetGameReview(ctx, req.(*GetGameReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_WatchGameReview_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetGameReviewRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MahjongAIServiceServer).WatchGameReview(m, &mahjongAIServiceWatchGameReviewServer{stream})
+}
+
+type MahjongAIService_WatchGameReviewServer interface {
+	Send(*GameReviewResponse) error
+	grpc.ServerStream
+}
+
+type mahjongAIServiceWatchGameReviewServer struct {
+	grpc.ServerStream
+}
+
+func (x *mahjongAIServiceWatchGameReviewServer) Send(m *GameReviewResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MahjongAIService_Coach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MahjongAIServiceServer).Coach(&mahjongAIServiceCoachServer{stream})
+}
+
+type MahjongAIService_CoachServer interface {
+	Send(*CoachResponse) error
+	Recv() (*CoachRequest, error)
+	grpc.ServerStream
+}
+
+type mahjongAIServiceCoachServer struct {
+	grpc.ServerStream
+}
+
+func (x *mahjongAIServiceCoachServer) Send(m *CoachResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *mahjongAIServiceCoachServer) Recv() (*CoachRequest, error) {
+	m := new(CoachRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MahjongAIService_ReindexKnowledge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReindexKnowledgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).ReindexKnowledge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_ReindexKnowledge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).ReindexKnowledge(ctx, req.(*ReindexKnowledgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_RenderHand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderHandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).RenderHand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_RenderHand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).RenderHand(ctx, req.(*RenderHandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_GetQuiz_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuizRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).GetQuiz(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_GetQuiz_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).GetQuiz(ctx, req.(*GetQuizRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MahjongAIService_SubmitQuizAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitQuizAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MahjongAIServiceServer).SubmitQuizAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MahjongAIService_SubmitQuizAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MahjongAIServiceServer).SubmitQuizAnswer(ctx, req.(*SubmitQuizAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MahjongAIService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -201,6 +817,62 @@ var MahjongAIService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AskMahjongAI",
 			Handler:    _MahjongAIService_AskMahjongAI_Handler,
 		},
+		{
+			MethodName: "GetWaits",
+			Handler:    _MahjongAIService_GetWaits_Handler,
+		},
+		{
+			MethodName: "RecommendDiscard",
+			Handler:    _MahjongAIService_RecommendDiscard_Handler,
+		},
+		{
+			MethodName: "SimulateHand",
+			Handler:    _MahjongAIService_SimulateHand_Handler,
+		},
+		{
+			MethodName: "AssessSafety",
+			Handler:    _MahjongAIService_AssessSafety_Handler,
+		},
+		{
+			MethodName: "EvaluatePushFold",
+			Handler:    _MahjongAIService_EvaluatePushFold_Handler,
+		},
+		{
+			MethodName: "CalculatePlacement",
+			Handler:    _MahjongAIService_CalculatePlacement_Handler,
+		},
+		{
+			MethodName: "ImportGameLog",
+			Handler:    _MahjongAIService_ImportGameLog_Handler,
+		},
+		{
+			MethodName: "GetGameLogStep",
+			Handler:    _MahjongAIService_GetGameLogStep_Handler,
+		},
+		{
+			MethodName: "ReviewGame",
+			Handler:    _MahjongAIService_ReviewGame_Handler,
+		},
+		{
+			MethodName: "GetGameReview",
+			Handler:    _MahjongAIService_GetGameReview_Handler,
+		},
+		{
+			MethodName: "ReindexKnowledge",
+			Handler:    _MahjongAIService_ReindexKnowledge_Handler,
+		},
+		{
+			MethodName: "RenderHand",
+			Handler:    _MahjongAIService_RenderHand_Handler,
+		},
+		{
+			MethodName: "GetQuiz",
+			Handler:    _MahjongAIService_GetQuiz_Handler,
+		},
+		{
+			MethodName: "SubmitQuizAnswer",
+			Handler:    _MahjongAIService_SubmitQuizAnswer_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _MahjongAIService_HealthCheck_Handler,
@@ -212,6 +884,17 @@ var MahjongAIService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MahjongAIService_AskMahjongAIStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchGameReview",
+			Handler:       _MahjongAIService_WatchGameReview_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Coach",
+			Handler:       _MahjongAIService_Coach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "mahjong/ai/v1/ai.proto",
 }
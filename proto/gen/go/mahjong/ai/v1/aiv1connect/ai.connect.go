@@ -39,6 +39,53 @@ const (
 	// MahjongAIServiceAskMahjongAIStreamProcedure is the fully-qualified name of the MahjongAIService's
 	// AskMahjongAIStream RPC.
 	MahjongAIServiceAskMahjongAIStreamProcedure = "/mahjong.ai.v1.MahjongAIService/AskMahjongAIStream"
+	// MahjongAIServiceGetWaitsProcedure is the fully-qualified name of the MahjongAIService's GetWaits
+	// RPC.
+	MahjongAIServiceGetWaitsProcedure = "/mahjong.ai.v1.MahjongAIService/GetWaits"
+	// MahjongAIServiceRecommendDiscardProcedure is the fully-qualified name of the MahjongAIService's
+	// RecommendDiscard RPC.
+	MahjongAIServiceRecommendDiscardProcedure = "/mahjong.ai.v1.MahjongAIService/RecommendDiscard"
+	// MahjongAIServiceSimulateHandProcedure is the fully-qualified name of the MahjongAIService's
+	// SimulateHand RPC.
+	MahjongAIServiceSimulateHandProcedure = "/mahjong.ai.v1.MahjongAIService/SimulateHand"
+	// MahjongAIServiceAssessSafetyProcedure is the fully-qualified name of the MahjongAIService's
+	// AssessSafety RPC.
+	MahjongAIServiceAssessSafetyProcedure = "/mahjong.ai.v1.MahjongAIService/AssessSafety"
+	// MahjongAIServiceEvaluatePushFoldProcedure is the fully-qualified name of the MahjongAIService's
+	// EvaluatePushFold RPC.
+	MahjongAIServiceEvaluatePushFoldProcedure = "/mahjong.ai.v1.MahjongAIService/EvaluatePushFold"
+	// MahjongAIServiceCalculatePlacementProcedure is the fully-qualified name of the MahjongAIService's
+	// CalculatePlacement RPC.
+	MahjongAIServiceCalculatePlacementProcedure = "/mahjong.ai.v1.MahjongAIService/CalculatePlacement"
+	// MahjongAIServiceImportGameLogProcedure is the fully-qualified name of the MahjongAIService's
+	// ImportGameLog RPC.
+	MahjongAIServiceImportGameLogProcedure = "/mahjong.ai.v1.MahjongAIService/ImportGameLog"
+	// MahjongAIServiceGetGameLogStepProcedure is the fully-qualified name of the MahjongAIService's
+	// GetGameLogStep RPC.
+	MahjongAIServiceGetGameLogStepProcedure = "/mahjong.ai.v1.MahjongAIService/GetGameLogStep"
+	// MahjongAIServiceReviewGameProcedure is the fully-qualified name of the MahjongAIService's
+	// ReviewGame RPC.
+	MahjongAIServiceReviewGameProcedure = "/mahjong.ai.v1.MahjongAIService/ReviewGame"
+	// MahjongAIServiceGetGameReviewProcedure is the fully-qualified name of the MahjongAIService's
+	// GetGameReview RPC.
+	MahjongAIServiceGetGameReviewProcedure = "/mahjong.ai.v1.MahjongAIService/GetGameReview"
+	// MahjongAIServiceWatchGameReviewProcedure is the fully-qualified name of the MahjongAIService's
+	// WatchGameReview RPC.
+	MahjongAIServiceWatchGameReviewProcedure = "/mahjong.ai.v1.MahjongAIService/WatchGameReview"
+	// MahjongAIServiceCoachProcedure is the fully-qualified name of the MahjongAIService's Coach RPC.
+	MahjongAIServiceCoachProcedure = "/mahjong.ai.v1.MahjongAIService/Coach"
+	// MahjongAIServiceReindexKnowledgeProcedure is the fully-qualified name of the MahjongAIService's
+	// ReindexKnowledge RPC.
+	MahjongAIServiceReindexKnowledgeProcedure = "/mahjong.ai.v1.MahjongAIService/ReindexKnowledge"
+	// MahjongAIServiceRenderHandProcedure is the fully-qualified name of the MahjongAIService's
+	// RenderHand RPC.
+	MahjongAIServiceRenderHandProcedure = "/mahjong.ai.v1.MahjongAIService/RenderHand"
+	// MahjongAIServiceGetQuizProcedure is the fully-qualified name of the MahjongAIService's GetQuiz
+	// RPC.
+	MahjongAIServiceGetQuizProcedure = "/mahjong.ai.v1.MahjongAIService/GetQuiz"
+	// MahjongAIServiceSubmitQuizAnswerProcedure is the fully-qualified name of the MahjongAIService's
+	// SubmitQuizAnswer RPC.
+	MahjongAIServiceSubmitQuizAnswerProcedure = "/mahjong.ai.v1.MahjongAIService/SubmitQuizAnswer"
 	// MahjongAIServiceHealthCheckProcedure is the fully-qualified name of the MahjongAIService's
 	// HealthCheck RPC.
 	MahjongAIServiceHealthCheckProcedure = "/mahjong.ai.v1.MahjongAIService/HealthCheck"
@@ -50,6 +97,38 @@ type MahjongAIServiceClient interface {
 	AskMahjongAI(context.Context, *connect.Request[v1.AskMahjongAIRequest]) (*connect.Response[v1.AskMahjongAIResponse], error)
 	// 麻雀AIに質問する（ストリーミング）
 	AskMahjongAIStream(context.Context, *connect.Request[v1.AskMahjongAIRequest]) (*connect.ServerStreamForClient[v1.AskMahjongAIStreamResponse], error)
+	// 聴牌形の待ち・待ちの形・役の有無・フリテンを判定する
+	GetWaits(context.Context, *connect.Request[v1.GetWaitsRequest]) (*connect.Response[v1.GetWaitsResponse], error)
+	// 打牌候補を受け入れ・改良・和了率・打点期待値で評価して推奨順に返す
+	RecommendDiscard(context.Context, *connect.Request[v1.RecommendDiscardRequest]) (*connect.Response[v1.RecommendDiscardResponse], error)
+	// 手牌からの展開をモンテカルロ法でシミュレーションし、巡目ごとの聴牌率・和了率・打点期待値を返す
+	SimulateHand(context.Context, *connect.Request[v1.SimulateHandRequest]) (*connect.Response[v1.SimulateHandResponse], error)
+	// 手牌の牌ごとに立直者への安全度（現物・筋・壁・ワンチャンスなど）を評価して安全な順に返す
+	AssessSafety(context.Context, *connect.Request[v1.AssessSafetyRequest]) (*connect.Response[v1.AssessSafetyResponse], error)
+	// 手牌の価値・立直者の脅威・点数状況・巡目から押し・回し・降りを期待値で評価する
+	EvaluatePushFold(context.Context, *connect.Request[v1.EvaluatePushFoldRequest]) (*connect.Response[v1.EvaluatePushFoldResponse], error)
+	// オーラスで各順位に届くために必要な和了を計算する
+	CalculatePlacement(context.Context, *connect.Request[v1.CalculatePlacementRequest]) (*connect.Response[v1.CalculatePlacementResponse], error)
+	// 天鳳の牌譜（JSON・mjlog）を取り込み、各打牌の判断の直前の局面を再構成して保存する
+	ImportGameLog(context.Context, *connect.Request[v1.ImportGameLogRequest]) (*connect.Response[v1.ImportGameLogResponse], error)
+	// 取り込んだ牌譜の打牌の判断とその直前の局面を返す
+	GetGameLogStep(context.Context, *connect.Request[v1.GetGameLogStepRequest]) (*connect.Response[v1.GetGameLogStepResponse], error)
+	// 牌譜のプレイヤーの全打牌をエンジンで評価し、損失の大きい悪手をAIに解説させる検討をバックグラウンドで開始する
+	ReviewGame(context.Context, *connect.Request[v1.ReviewGameRequest]) (*connect.Response[v1.GameReviewResponse], error)
+	// 牌譜の検討結果を返す
+	GetGameReview(context.Context, *connect.Request[v1.GetGameReviewRequest]) (*connect.Response[v1.GameReviewResponse], error)
+	// 牌譜の検討結果を更新のたびに返し、検討が終わると終了する
+	WatchGameReview(context.Context, *connect.Request[v1.GetGameReviewRequest]) (*connect.ServerStreamForClient[v1.GameReviewResponse], error)
+	// 対局の出来事を受け取りながら、推奨・警告・解説を送る（双方向ストリーミング）
+	Coach(context.Context) *connect.BidiStreamForClient[v1.CoachRequest, v1.CoachResponse]
+	// ルールの資料を読み込み直し、全文検索と埋め込みベクトルの索引を作り直す（管理用）
+	ReindexKnowledge(context.Context, *connect.Request[v1.ReindexKnowledgeRequest]) (*connect.Response[v1.ReindexKnowledgeResponse], error)
+	// 手牌・副露・河・局面を牌の図（SVG・PNG）にする
+	RenderHand(context.Context, *connect.Request[v1.RenderHandRequest]) (*connect.Response[v1.RenderHandResponse], error)
+	// 何切る問題を出題する
+	GetQuiz(context.Context, *connect.Request[v1.GetQuizRequest]) (*connect.Response[v1.GetQuizResponse], error)
+	// 何切る問題の回答をエンジンで採点し、AIの解説を付けて成績に記録する
+	SubmitQuizAnswer(context.Context, *connect.Request[v1.SubmitQuizAnswerRequest]) (*connect.Response[v1.SubmitQuizAnswerResponse], error)
 	// ヘルスチェック
 	HealthCheck(context.Context, *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error)
 }
@@ -77,6 +156,102 @@ func NewMahjongAIServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(mahjongAIServiceMethods.ByName("AskMahjongAIStream")),
 			connect.WithClientOptions(opts...),
 		),
+		getWaits: connect.NewClient[v1.GetWaitsRequest, v1.GetWaitsResponse](
+			httpClient,
+			baseURL+MahjongAIServiceGetWaitsProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("GetWaits")),
+			connect.WithClientOptions(opts...),
+		),
+		recommendDiscard: connect.NewClient[v1.RecommendDiscardRequest, v1.RecommendDiscardResponse](
+			httpClient,
+			baseURL+MahjongAIServiceRecommendDiscardProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("RecommendDiscard")),
+			connect.WithClientOptions(opts...),
+		),
+		simulateHand: connect.NewClient[v1.SimulateHandRequest, v1.SimulateHandResponse](
+			httpClient,
+			baseURL+MahjongAIServiceSimulateHandProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("SimulateHand")),
+			connect.WithClientOptions(opts...),
+		),
+		assessSafety: connect.NewClient[v1.AssessSafetyRequest, v1.AssessSafetyResponse](
+			httpClient,
+			baseURL+MahjongAIServiceAssessSafetyProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("AssessSafety")),
+			connect.WithClientOptions(opts...),
+		),
+		evaluatePushFold: connect.NewClient[v1.EvaluatePushFoldRequest, v1.EvaluatePushFoldResponse](
+			httpClient,
+			baseURL+MahjongAIServiceEvaluatePushFoldProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("EvaluatePushFold")),
+			connect.WithClientOptions(opts...),
+		),
+		calculatePlacement: connect.NewClient[v1.CalculatePlacementRequest, v1.CalculatePlacementResponse](
+			httpClient,
+			baseURL+MahjongAIServiceCalculatePlacementProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("CalculatePlacement")),
+			connect.WithClientOptions(opts...),
+		),
+		importGameLog: connect.NewClient[v1.ImportGameLogRequest, v1.ImportGameLogResponse](
+			httpClient,
+			baseURL+MahjongAIServiceImportGameLogProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("ImportGameLog")),
+			connect.WithClientOptions(opts...),
+		),
+		getGameLogStep: connect.NewClient[v1.GetGameLogStepRequest, v1.GetGameLogStepResponse](
+			httpClient,
+			baseURL+MahjongAIServiceGetGameLogStepProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("GetGameLogStep")),
+			connect.WithClientOptions(opts...),
+		),
+		reviewGame: connect.NewClient[v1.ReviewGameRequest, v1.GameReviewResponse](
+			httpClient,
+			baseURL+MahjongAIServiceReviewGameProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("ReviewGame")),
+			connect.WithClientOptions(opts...),
+		),
+		getGameReview: connect.NewClient[v1.GetGameReviewRequest, v1.GameReviewResponse](
+			httpClient,
+			baseURL+MahjongAIServiceGetGameReviewProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("GetGameReview")),
+			connect.WithClientOptions(opts...),
+		),
+		watchGameReview: connect.NewClient[v1.GetGameReviewRequest, v1.GameReviewResponse](
+			httpClient,
+			baseURL+MahjongAIServiceWatchGameReviewProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("WatchGameReview")),
+			connect.WithClientOptions(opts...),
+		),
+		coach: connect.NewClient[v1.CoachRequest, v1.CoachResponse](
+			httpClient,
+			baseURL+MahjongAIServiceCoachProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("Coach")),
+			connect.WithClientOptions(opts...),
+		),
+		reindexKnowledge: connect.NewClient[v1.ReindexKnowledgeRequest, v1.ReindexKnowledgeResponse](
+			httpClient,
+			baseURL+MahjongAIServiceReindexKnowledgeProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("ReindexKnowledge")),
+			connect.WithClientOptions(opts...),
+		),
+		renderHand: connect.NewClient[v1.RenderHandRequest, v1.RenderHandResponse](
+			httpClient,
+			baseURL+MahjongAIServiceRenderHandProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("RenderHand")),
+			connect.WithClientOptions(opts...),
+		),
+		getQuiz: connect.NewClient[v1.GetQuizRequest, v1.GetQuizResponse](
+			httpClient,
+			baseURL+MahjongAIServiceGetQuizProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("GetQuiz")),
+			connect.WithClientOptions(opts...),
+		),
+		submitQuizAnswer: connect.NewClient[v1.SubmitQuizAnswerRequest, v1.SubmitQuizAnswerResponse](
+			httpClient,
+			baseURL+MahjongAIServiceSubmitQuizAnswerProcedure,
+			connect.WithSchema(mahjongAIServiceMethods.ByName("SubmitQuizAnswer")),
+			connect.WithClientOptions(opts...),
+		),
 		healthCheck: connect.NewClient[v1.HealthCheckRequest, v1.HealthCheckResponse](
 			httpClient,
 			baseURL+MahjongAIServiceHealthCheckProcedure,
@@ -90,6 +265,22 @@ func NewMahjongAIServiceClient(httpClient connect.HTTPClient, baseURL string, op
 type mahjongAIServiceClient struct {
 	askMahjongAI       *connect.Client[v1.AskMahjongAIRequest, v1.AskMahjongAIResponse]
 	askMahjongAIStream *connect.Client[v1.AskMahjongAIRequest, v1.AskMahjongAIStreamResponse]
+	getWaits           *connect.Client[v1.GetWaitsRequest, v1.GetWaitsResponse]
+	recommendDiscard   *connect.Client[v1.RecommendDiscardRequest, v1.RecommendDiscardResponse]
+	simulateHand       *connect.Client[v1.SimulateHandRequest, v1.SimulateHandResponse]
+	assessSafety       *connect.Client[v1.AssessSafetyRequest, v1.AssessSafetyResponse]
+	evaluatePushFold   *connect.Client[v1.EvaluatePushFoldRequest, v1.EvaluatePushFoldResponse]
+	calculatePlacement *connect.Client[v1.CalculatePlacementRequest, v1.CalculatePlacementResponse]
+	importGameLog      *connect.Client[v1.ImportGameLogRequest, v1.ImportGameLogResponse]
+	getGameLogStep     *connect.Client[v1.GetGameLogStepRequest, v1.GetGameLogStepResponse]
+	reviewGame         *connect.Client[v1.ReviewGameRequest, v1.GameReviewResponse]
+	getGameReview      *connect.Client[v1.GetGameReviewRequest, v1.GameReviewResponse]
+	watchGameReview    *connect.Client[v1.GetGameReviewRequest, v1.GameReviewResponse]
+	coach              *connect.Client[v1.CoachRequest, v1.CoachResponse]
+	reindexKnowledge   *connect.Client[v1.ReindexKnowledgeRequest, v1.ReindexKnowledgeResponse]
+	renderHand         *connect.Client[v1.RenderHandRequest, v1.RenderHandResponse]
+	getQuiz            *connect.Client[v1.GetQuizRequest, v1.GetQuizResponse]
+	submitQuizAnswer   *connect.Client[v1.SubmitQuizAnswerRequest, v1.SubmitQuizAnswerResponse]
 	healthCheck        *connect.Client[v1.HealthCheckRequest, v1.HealthCheckResponse]
 }
 
@@ -103,6 +294,86 @@ func (c *mahjongAIServiceClient) AskMahjongAIStream(ctx context.Context, req *co
 	return c.askMahjongAIStream.CallServerStream(ctx, req)
 }
 
+// GetWaits calls mahjong.ai.v1.MahjongAIService.GetWaits.
+func (c *mahjongAIServiceClient) GetWaits(ctx context.Context, req *connect.Request[v1.GetWaitsRequest]) (*connect.Response[v1.GetWaitsResponse], error) {
+	return c.getWaits.CallUnary(ctx, req)
+}
+
+// RecommendDiscard calls mahjong.ai.v1.MahjongAIService.RecommendDiscard.
+func (c *mahjongAIServiceClient) RecommendDiscard(ctx context.Context, req *connect.Request[v1.RecommendDiscardRequest]) (*connect.Response[v1.RecommendDiscardResponse], error) {
+	return c.recommendDiscard.CallUnary(ctx, req)
+}
+
+// SimulateHand calls mahjong.ai.v1.MahjongAIService.SimulateHand.
+func (c *mahjongAIServiceClient) SimulateHand(ctx context.Context, req *connect.Request[v1.SimulateHandRequest]) (*connect.Response[v1.SimulateHandResponse], error) {
+	return c.simulateHand.CallUnary(ctx, req)
+}
+
+// AssessSafety calls mahjong.ai.v1.MahjongAIService.AssessSafety.
+func (c *mahjongAIServiceClient) AssessSafety(ctx context.Context, req *connect.Request[v1.AssessSafetyRequest]) (*connect.Response[v1.AssessSafetyResponse], error) {
+	return c.assessSafety.CallUnary(ctx, req)
+}
+
+// EvaluatePushFold calls mahjong.ai.v1.MahjongAIService.EvaluatePushFold.
+func (c *mahjongAIServiceClient) EvaluatePushFold(ctx context.Context, req *connect.Request[v1.EvaluatePushFoldRequest]) (*connect.Response[v1.EvaluatePushFoldResponse], error) {
+	return c.evaluatePushFold.CallUnary(ctx, req)
+}
+
+// CalculatePlacement calls mahjong.ai.v1.MahjongAIService.CalculatePlacement.
+func (c *mahjongAIServiceClient) CalculatePlacement(ctx context.Context, req *connect.Request[v1.CalculatePlacementRequest]) (*connect.Response[v1.CalculatePlacementResponse], error) {
+	return c.calculatePlacement.CallUnary(ctx, req)
+}
+
+// ImportGameLog calls mahjong.ai.v1.MahjongAIService.ImportGameLog.
+func (c *mahjongAIServiceClient) ImportGameLog(ctx context.Context, req *connect.Request[v1.ImportGameLogRequest]) (*connect.Response[v1.ImportGameLogResponse], error) {
+	return c.importGameLog.CallUnary(ctx, req)
+}
+
+// GetGameLogStep calls mahjong.ai.v1.MahjongAIService.GetGameLogStep.
+func (c *mahjongAIServiceClient) GetGameLogStep(ctx context.Context, req *connect.Request[v1.GetGameLogStepRequest]) (*connect.Response[v1.GetGameLogStepResponse], error) {
+	return c.getGameLogStep.CallUnary(ctx, req)
+}
+
+// ReviewGame calls mahjong.ai.v1.MahjongAIService.ReviewGame.
+func (c *mahjongAIServiceClient) ReviewGame(ctx context.Context, req *connect.Request[v1.ReviewGameRequest]) (*connect.Response[v1.GameReviewResponse], error) {
+	return c.reviewGame.CallUnary(ctx, req)
+}
+
+// GetGameReview calls mahjong.ai.v1.MahjongAIService.GetGameReview.
+func (c *mahjongAIServiceClient) GetGameReview(ctx context.Context, req *connect.Request[v1.GetGameReviewRequest]) (*connect.Response[v1.GameReviewResponse], error) {
+	return c.getGameReview.CallUnary(ctx, req)
+}
+
+// WatchGameReview calls mahjong.ai.v1.MahjongAIService.WatchGameReview.
+func (c *mahjongAIServiceClient) WatchGameReview(ctx context.Context, req *connect.Request[v1.GetGameReviewRequest]) (*connect.ServerStreamForClient[v1.GameReviewResponse], error) {
+	return c.watchGameReview.CallServerStream(ctx, req)
+}
+
+// Coach calls mahjong.ai.v1.MahjongAIService.Coach.
+func (c *mahjongAIServiceClient) Coach(ctx context.Context) *connect.BidiStreamForClient[v1.CoachRequest, v1.CoachResponse] {
+	return c.coach.CallBidiStream(ctx)
+}
+
+// ReindexKnowledge calls mahjong.ai.v1.MahjongAIService.ReindexKnowledge.
+func (c *mahjongAIServiceClient) ReindexKnowledge(ctx context.Context, req *connect.Request[v1.ReindexKnowledgeRequest]) (*connect.Response[v1.ReindexKnowledgeResponse], error) {
+	return c.reindexKnowledge.CallUnary(ctx, req)
+}
+
+// RenderHand calls mahjong.ai.v1.MahjongAIService.RenderHand.
+func (c *mahjongAIServiceClient) RenderHand(ctx context.Context, req *connect.Request[v1.RenderHandRequest]) (*connect.Response[v1.RenderHandResponse], error) {
+	return c.renderHand.CallUnary(ctx, req)
+}
+
+// GetQuiz calls mahjong.ai.v1.MahjongAIService.GetQuiz.
+func (c *mahjongAIServiceClient) GetQuiz(ctx context.Context, req *connect.Request[v1.GetQuizRequest]) (*connect.Response[v1.GetQuizResponse], error) {
+	return c.getQuiz.CallUnary(ctx, req)
+}
+
+// SubmitQuizAnswer calls mahjong.ai.v1.MahjongAIService.SubmitQuizAnswer.
+func (c *mahjongAIServiceClient) SubmitQuizAnswer(ctx context.Context, req *connect.Request[v1.SubmitQuizAnswerRequest]) (*connect.Response[v1.SubmitQuizAnswerResponse], error) {
+	return c.submitQuizAnswer.CallUnary(ctx, req)
+}
+
 // HealthCheck calls mahjong.ai.v1.MahjongAIService.HealthCheck.
 func (c *mahjongAIServiceClient) HealthCheck(ctx context.Context, req *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error) {
 	return c.healthCheck.CallUnary(ctx, req)
@@ -114,6 +385,38 @@ type MahjongAIServiceHandler interface {
 	AskMahjongAI(context.Context, *connect.Request[v1.AskMahjongAIRequest]) (*connect.Response[v1.AskMahjongAIResponse], error)
 	// 麻雀AIに質問する（ストリーミング）
 	AskMahjongAIStream(context.Context, *connect.Request[v1.AskMahjongAIRequest], *connect.ServerStream[v1.AskMahjongAIStreamResponse]) error
+	// 聴牌形の待ち・待ちの形・役の有無・フリテンを判定する
+	GetWaits(context.Context, *connect.Request[v1.GetWaitsRequest]) (*connect.Response[v1.GetWaitsResponse], error)
+	// 打牌候補を受け入れ・改良・和了率・打点期待値で評価して推奨順に返す
+	RecommendDiscard(context.Context, *connect.Request[v1.RecommendDiscardRequest]) (*connect.Response[v1.RecommendDiscardResponse], error)
+	// 手牌からの展開をモンテカルロ法でシミュレーションし、巡目ごとの聴牌率・和了率・打点期待値を返す
+	SimulateHand(context.Context, *connect.Request[v1.SimulateHandRequest]) (*connect.Response[v1.SimulateHandResponse], error)
+	// 手牌の牌ごとに立直者への安全度（現物・筋・壁・ワンチャンスなど）を評価して安全な順に返す
+	AssessSafety(context.Context, *connect.Request[v1.AssessSafetyRequest]) (*connect.Response[v1.AssessSafetyResponse], error)
+	// 手牌の価値・立直者の脅威・点数状況・巡目から押し・回し・降りを期待値で評価する
+	EvaluatePushFold(context.Context, *connect.Request[v1.EvaluatePushFoldRequest]) (*connect.Response[v1.EvaluatePushFoldResponse], error)
+	// オーラスで各順位に届くために必要な和了を計算する
+	CalculatePlacement(context.Context, *connect.Request[v1.CalculatePlacementRequest]) (*connect.Response[v1.CalculatePlacementResponse], error)
+	// 天鳳の牌譜（JSON・mjlog）を取り込み、各打牌の判断の直前の局面を再構成して保存する
+	ImportGameLog(context.Context, *connect.Request[v1.ImportGameLogRequest]) (*connect.Response[v1.ImportGameLogResponse], error)
+	// 取り込んだ牌譜の打牌の判断とその直前の局面を返す
+	GetGameLogStep(context.Context, *connect.Request[v1.GetGameLogStepRequest]) (*connect.Response[v1.GetGameLogStepResponse], error)
+	// 牌譜のプレイヤーの全打牌をエンジンで評価し、損失の大きい悪手をAIに解説させる検討をバックグラウンドで開始する
+	ReviewGame(context.Context, *connect.Request[v1.ReviewGameRequest]) (*connect.Response[v1.GameReviewResponse], error)
+	// 牌譜の検討結果を返す
+	GetGameReview(context.Context, *connect.Request[v1.GetGameReviewRequest]) (*connect.Response[v1.GameReviewResponse], error)
+	// 牌譜の検討結果を更新のたびに返し、検討が終わると終了する
+	WatchGameReview(context.Context, *connect.Request[v1.GetGameReviewRequest], *connect.ServerStream[v1.GameReviewResponse]) error
+	// 対局の出来事を受け取りながら、推奨・警告・解説を送る（双方向ストリーミング）
+	Coach(context.Context, *connect.BidiStream[v1.CoachRequest, v1.CoachResponse]) error
+	// ルールの資料を読み込み直し、全文検索と埋め込みベクトルの索引を作り直す（管理用）
+	ReindexKnowledge(context.Context, *connect.Request[v1.ReindexKnowledgeRequest]) (*connect.Response[v1.ReindexKnowledgeResponse], error)
+	// 手牌・副露・河・局面を牌の図（SVG・PNG）にする
+	RenderHand(context.Context, *connect.Request[v1.RenderHandRequest]) (*connect.Response[v1.RenderHandResponse], error)
+	// 何切る問題を出題する
+	GetQuiz(context.Context, *connect.Request[v1.GetQuizRequest]) (*connect.Response[v1.GetQuizResponse], error)
+	// 何切る問題の回答をエンジンで採点し、AIの解説を付けて成績に記録する
+	SubmitQuizAnswer(context.Context, *connect.Request[v1.SubmitQuizAnswerRequest]) (*connect.Response[v1.SubmitQuizAnswerResponse], error)
 	// ヘルスチェック
 	HealthCheck(context.Context, *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error)
 }
@@ -137,6 +440,102 @@ func NewMahjongAIServiceHandler(svc MahjongAIServiceHandler, opts ...connect.Han
 		connect.WithSchema(mahjongAIServiceMethods.ByName("AskMahjongAIStream")),
 		connect.WithHandlerOptions(opts...),
 	)
+	mahjongAIServiceGetWaitsHandler := connect.NewUnaryHandler(
+		MahjongAIServiceGetWaitsProcedure,
+		svc.GetWaits,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("GetWaits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceRecommendDiscardHandler := connect.NewUnaryHandler(
+		MahjongAIServiceRecommendDiscardProcedure,
+		svc.RecommendDiscard,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("RecommendDiscard")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceSimulateHandHandler := connect.NewUnaryHandler(
+		MahjongAIServiceSimulateHandProcedure,
+		svc.SimulateHand,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("SimulateHand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceAssessSafetyHandler := connect.NewUnaryHandler(
+		MahjongAIServiceAssessSafetyProcedure,
+		svc.AssessSafety,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("AssessSafety")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceEvaluatePushFoldHandler := connect.NewUnaryHandler(
+		MahjongAIServiceEvaluatePushFoldProcedure,
+		svc.EvaluatePushFold,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("EvaluatePushFold")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceCalculatePlacementHandler := connect.NewUnaryHandler(
+		MahjongAIServiceCalculatePlacementProcedure,
+		svc.CalculatePlacement,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("CalculatePlacement")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceImportGameLogHandler := connect.NewUnaryHandler(
+		MahjongAIServiceImportGameLogProcedure,
+		svc.ImportGameLog,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("ImportGameLog")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceGetGameLogStepHandler := connect.NewUnaryHandler(
+		MahjongAIServiceGetGameLogStepProcedure,
+		svc.GetGameLogStep,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("GetGameLogStep")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceReviewGameHandler := connect.NewUnaryHandler(
+		MahjongAIServiceReviewGameProcedure,
+		svc.ReviewGame,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("ReviewGame")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceGetGameReviewHandler := connect.NewUnaryHandler(
+		MahjongAIServiceGetGameReviewProcedure,
+		svc.GetGameReview,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("GetGameReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceWatchGameReviewHandler := connect.NewServerStreamHandler(
+		MahjongAIServiceWatchGameReviewProcedure,
+		svc.WatchGameReview,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("WatchGameReview")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceCoachHandler := connect.NewBidiStreamHandler(
+		MahjongAIServiceCoachProcedure,
+		svc.Coach,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("Coach")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceReindexKnowledgeHandler := connect.NewUnaryHandler(
+		MahjongAIServiceReindexKnowledgeProcedure,
+		svc.ReindexKnowledge,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("ReindexKnowledge")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceRenderHandHandler := connect.NewUnaryHandler(
+		MahjongAIServiceRenderHandProcedure,
+		svc.RenderHand,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("RenderHand")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceGetQuizHandler := connect.NewUnaryHandler(
+		MahjongAIServiceGetQuizProcedure,
+		svc.GetQuiz,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("GetQuiz")),
+		connect.WithHandlerOptions(opts...),
+	)
+	mahjongAIServiceSubmitQuizAnswerHandler := connect.NewUnaryHandler(
+		MahjongAIServiceSubmitQuizAnswerProcedure,
+		svc.SubmitQuizAnswer,
+		connect.WithSchema(mahjongAIServiceMethods.ByName("SubmitQuizAnswer")),
+		connect.WithHandlerOptions(opts...),
+	)
 	mahjongAIServiceHealthCheckHandler := connect.NewUnaryHandler(
 		MahjongAIServiceHealthCheckProcedure,
 		svc.HealthCheck,
@@ -149,6 +548,38 @@ func NewMahjongAIServiceHandler(svc MahjongAIServiceHandler, opts ...connect.Han
 			mahjongAIServiceAskMahjongAIHandler.ServeHTTP(w, r)
 		case MahjongAIServiceAskMahjongAIStreamProcedure:
 			mahjongAIServiceAskMahjongAIStreamHandler.ServeHTTP(w, r)
+		case MahjongAIServiceGetWaitsProcedure:
+			mahjongAIServiceGetWaitsHandler.ServeHTTP(w, r)
+		case MahjongAIServiceRecommendDiscardProcedure:
+			mahjongAIServiceRecommendDiscardHandler.ServeHTTP(w, r)
+		case MahjongAIServiceSimulateHandProcedure:
+			mahjongAIServiceSimulateHandHandler.ServeHTTP(w, r)
+		case MahjongAIServiceAssessSafetyProcedure:
+			mahjongAIServiceAssessSafetyHandler.ServeHTTP(w, r)
+		case MahjongAIServiceEvaluatePushFoldProcedure:
+			mahjongAIServiceEvaluatePushFoldHandler.ServeHTTP(w, r)
+		case MahjongAIServiceCalculatePlacementProcedure:
+			mahjongAIServiceCalculatePlacementHandler.ServeHTTP(w, r)
+		case MahjongAIServiceImportGameLogProcedure:
+			mahjongAIServiceImportGameLogHandler.ServeHTTP(w, r)
+		case MahjongAIServiceGetGameLogStepProcedure:
+			mahjongAIServiceGetGameLogStepHandler.ServeHTTP(w, r)
+		case MahjongAIServiceReviewGameProcedure:
+			mahjongAIServiceReviewGameHandler.ServeHTTP(w, r)
+		case MahjongAIServiceGetGameReviewProcedure:
+			mahjongAIServiceGetGameReviewHandler.ServeHTTP(w, r)
+		case MahjongAIServiceWatchGameReviewProcedure:
+			mahjongAIServiceWatchGameReviewHandler.ServeHTTP(w, r)
+		case MahjongAIServiceCoachProcedure:
+			mahjongAIServiceCoachHandler.ServeHTTP(w, r)
+		case MahjongAIServiceReindexKnowledgeProcedure:
+			mahjongAIServiceReindexKnowledgeHandler.ServeHTTP(w, r)
+		case MahjongAIServiceRenderHandProcedure:
+			mahjongAIServiceRenderHandHandler.ServeHTTP(w, r)
+		case MahjongAIServiceGetQuizProcedure:
+			mahjongAIServiceGetQuizHandler.ServeHTTP(w, r)
+		case MahjongAIServiceSubmitQuizAnswerProcedure:
+			mahjongAIServiceSubmitQuizAnswerHandler.ServeHTTP(w, r)
 		case MahjongAIServiceHealthCheckProcedure:
 			mahjongAIServiceHealthCheckHandler.ServeHTTP(w, r)
 		default:
@@ -168,6 +599,70 @@ func (UnimplementedMahjongAIServiceHandler) AskMahjongAIStream(context.Context,
 	return connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.AskMahjongAIStream is not implemented"))
 }
 
+func (UnimplementedMahjongAIServiceHandler) GetWaits(context.Context, *connect.Request[v1.GetWaitsRequest]) (*connect.Response[v1.GetWaitsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.GetWaits is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) RecommendDiscard(context.Context, *connect.Request[v1.RecommendDiscardRequest]) (*connect.Response[v1.RecommendDiscardResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.RecommendDiscard is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) SimulateHand(context.Context, *connect.Request[v1.SimulateHandRequest]) (*connect.Response[v1.SimulateHandResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.SimulateHand is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) AssessSafety(context.Context, *connect.Request[v1.AssessSafetyRequest]) (*connect.Response[v1.AssessSafetyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.AssessSafety is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) EvaluatePushFold(context.Context, *connect.Request[v1.EvaluatePushFoldRequest]) (*connect.Response[v1.EvaluatePushFoldResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.EvaluatePushFold is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) CalculatePlacement(context.Context, *connect.Request[v1.CalculatePlacementRequest]) (*connect.Response[v1.CalculatePlacementResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.CalculatePlacement is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) ImportGameLog(context.Context, *connect.Request[v1.ImportGameLogRequest]) (*connect.Response[v1.ImportGameLogResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.ImportGameLog is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) GetGameLogStep(context.Context, *connect.Request[v1.GetGameLogStepRequest]) (*connect.Response[v1.GetGameLogStepResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.GetGameLogStep is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) ReviewGame(context.Context, *connect.Request[v1.ReviewGameRequest]) (*connect.Response[v1.GameReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.ReviewGame is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) GetGameReview(context.Context, *connect.Request[v1.GetGameReviewRequest]) (*connect.Response[v1.GameReviewResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.GetGameReview is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) WatchGameReview(context.Context, *connect.Request[v1.GetGameReviewRequest], *connect.ServerStream[v1.GameReviewResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.WatchGameReview is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) Coach(context.Context, *connect.BidiStream[v1.CoachRequest, v1.CoachResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.Coach is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) ReindexKnowledge(context.Context, *connect.Request[v1.ReindexKnowledgeRequest]) (*connect.Response[v1.ReindexKnowledgeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.ReindexKnowledge is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) RenderHand(context.Context, *connect.Request[v1.RenderHandRequest]) (*connect.Response[v1.RenderHandResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.RenderHand is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) GetQuiz(context.Context, *connect.Request[v1.GetQuizRequest]) (*connect.Response[v1.GetQuizResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.GetQuiz is not implemented"))
+}
+
+func (UnimplementedMahjongAIServiceHandler) SubmitQuizAnswer(context.Context, *connect.Request[v1.SubmitQuizAnswerRequest]) (*connect.Response[v1.SubmitQuizAnswerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.SubmitQuizAnswer is not implemented"))
+}
+
 func (UnimplementedMahjongAIServiceHandler) HealthCheck(context.Context, *connect.Request[v1.HealthCheckRequest]) (*connect.Response[v1.HealthCheckResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("mahjong.ai.v1.MahjongAIService.HealthCheck is not implemented"))
 }
@@ -0,0 +1,2712 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: mahjong/ai/v1/analysis.proto
+
+package aiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 副露の種類
+type MeldType int32
+
+const (
+	MeldType_MELD_TYPE_UNSPECIFIED MeldType = 0
+	MeldType_MELD_TYPE_CHI         MeldType = 1 // チー
+	MeldType_MELD_TYPE_PON         MeldType = 2 // ポン
+	MeldType_MELD_TYPE_MINKAN      MeldType = 3 // 大明槓
+	MeldType_MELD_TYPE_ANKAN       MeldType = 4 // 暗槓
+	MeldType_MELD_TYPE_KAKAN       MeldType = 5 // 加槓
+)
+
+// Enum value maps for MeldType.
+var (
+	MeldType_name = map[int32]string{
+		0: "MELD_TYPE_UNSPECIFIED",
+		1: "MELD_TYPE_CHI",
+		2: "MELD_TYPE_PON",
+		3: "MELD_TYPE_MINKAN",
+		4: "MELD_TYPE_ANKAN",
+		5: "MELD_TYPE_KAKAN",
+	}
+	MeldType_value = map[string]int32{
+		"MELD_TYPE_UNSPECIFIED": 0,
+		"MELD_TYPE_CHI":         1,
+		"MELD_TYPE_PON":         2,
+		"MELD_TYPE_MINKAN":      3,
+		"MELD_TYPE_ANKAN":       4,
+		"MELD_TYPE_KAKAN":       5,
+	}
+)
+
+func (x MeldType) Enum() *MeldType {
+	p := new(MeldType)
+	*p = x
+	return p
+}
+
+func (x MeldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MeldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_analysis_proto_enumTypes[0].Descriptor()
+}
+
+func (MeldType) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_analysis_proto_enumTypes[0]
+}
+
+func (x MeldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MeldType.Descriptor instead.
+func (MeldType) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+// 風
+type Wind int32
+
+const (
+	Wind_WIND_UNSPECIFIED Wind = 0
+	Wind_WIND_EAST        Wind = 1
+	Wind_WIND_SOUTH       Wind = 2
+	Wind_WIND_WEST        Wind = 3
+	Wind_WIND_NORTH       Wind = 4
+)
+
+// Enum value maps for Wind.
+var (
+	Wind_name = map[int32]string{
+		0: "WIND_UNSPECIFIED",
+		1: "WIND_EAST",
+		2: "WIND_SOUTH",
+		3: "WIND_WEST",
+		4: "WIND_NORTH",
+	}
+	Wind_value = map[string]int32{
+		"WIND_UNSPECIFIED": 0,
+		"WIND_EAST":        1,
+		"WIND_SOUTH":       2,
+		"WIND_WEST":        3,
+		"WIND_NORTH":       4,
+	}
+)
+
+func (x Wind) Enum() *Wind {
+	p := new(Wind)
+	*p = x
+	return p
+}
+
+func (x Wind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Wind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_analysis_proto_enumTypes[1].Descriptor()
+}
+
+func (Wind) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_analysis_proto_enumTypes[1]
+}
+
+func (x Wind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Wind.Descriptor instead.
+func (Wind) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{1}
+}
+
+// 待ちの形
+type WaitShape int32
+
+const (
+	WaitShape_WAIT_SHAPE_UNSPECIFIED WaitShape = 0
+	WaitShape_WAIT_SHAPE_RYANMEN     WaitShape = 1 // 両面
+	WaitShape_WAIT_SHAPE_KANCHAN     WaitShape = 2 // 嵌張
+	WaitShape_WAIT_SHAPE_PENCHAN     WaitShape = 3 // 辺張
+	WaitShape_WAIT_SHAPE_SHANPON     WaitShape = 4 // 双碰
+	WaitShape_WAIT_SHAPE_TANKI       WaitShape = 5 // 単騎
+	WaitShape_WAIT_SHAPE_NOBETAN     WaitShape = 6 // 延べ単
+	WaitShape_WAIT_SHAPE_MULTI_SIDED WaitShape = 7 // 多面張
+)
+
+// Enum value maps for WaitShape.
+var (
+	WaitShape_name = map[int32]string{
+		0: "WAIT_SHAPE_UNSPECIFIED",
+		1: "WAIT_SHAPE_RYANMEN",
+		2: "WAIT_SHAPE_KANCHAN",
+		3: "WAIT_SHAPE_PENCHAN",
+		4: "WAIT_SHAPE_SHANPON",
+		5: "WAIT_SHAPE_TANKI",
+		6: "WAIT_SHAPE_NOBETAN",
+		7: "WAIT_SHAPE_MULTI_SIDED",
+	}
+	WaitShape_value = map[string]int32{
+		"WAIT_SHAPE_UNSPECIFIED": 0,
+		"WAIT_SHAPE_RYANMEN":     1,
+		"WAIT_SHAPE_KANCHAN":     2,
+		"WAIT_SHAPE_PENCHAN":     3,
+		"WAIT_SHAPE_SHANPON":     4,
+		"WAIT_SHAPE_TANKI":       5,
+		"WAIT_SHAPE_NOBETAN":     6,
+		"WAIT_SHAPE_MULTI_SIDED": 7,
+	}
+)
+
+func (x WaitShape) Enum() *WaitShape {
+	p := new(WaitShape)
+	*p = x
+	return p
+}
+
+func (x WaitShape) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WaitShape) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_analysis_proto_enumTypes[2].Descriptor()
+}
+
+func (WaitShape) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_analysis_proto_enumTypes[2]
+}
+
+func (x WaitShape) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WaitShape.Descriptor instead.
+func (WaitShape) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{2}
+}
+
+// 立直者に対する牌の安全度の分類
+type SafetyClass int32
+
+const (
+	SafetyClass_SAFETY_CLASS_UNSPECIFIED SafetyClass = 0
+	SafetyClass_SAFETY_CLASS_GENBUTSU    SafetyClass = 1 // 現物
+	SafetyClass_SAFETY_CLASS_NO_CHANCE   SafetyClass = 2 // 壁・字牌の残りなしによるノーチャンス
+	SafetyClass_SAFETY_CLASS_SUJI        SafetyClass = 3 // 筋
+	SafetyClass_SAFETY_CLASS_HONOR       SafetyClass = 4 // 字牌（残りあり）
+	SafetyClass_SAFETY_CLASS_ONE_CHANCE  SafetyClass = 5 // ワンチャンス
+	SafetyClass_SAFETY_CLASS_HALF_SUJI   SafetyClass = 6 // 片筋
+	SafetyClass_SAFETY_CLASS_MUSUJI      SafetyClass = 7 // 無筋
+)
+
+// Enum value maps for SafetyClass.
+var (
+	SafetyClass_name = map[int32]string{
+		0: "SAFETY_CLASS_UNSPECIFIED",
+		1: "SAFETY_CLASS_GENBUTSU",
+		2: "SAFETY_CLASS_NO_CHANCE",
+		3: "SAFETY_CLASS_SUJI",
+		4: "SAFETY_CLASS_HONOR",
+		5: "SAFETY_CLASS_ONE_CHANCE",
+		6: "SAFETY_CLASS_HALF_SUJI",
+		7: "SAFETY_CLASS_MUSUJI",
+	}
+	SafetyClass_value = map[string]int32{
+		"SAFETY_CLASS_UNSPECIFIED": 0,
+		"SAFETY_CLASS_GENBUTSU":    1,
+		"SAFETY_CLASS_NO_CHANCE":   2,
+		"SAFETY_CLASS_SUJI":        3,
+		"SAFETY_CLASS_HONOR":       4,
+		"SAFETY_CLASS_ONE_CHANCE":  5,
+		"SAFETY_CLASS_HALF_SUJI":   6,
+		"SAFETY_CLASS_MUSUJI":      7,
+	}
+)
+
+func (x SafetyClass) Enum() *SafetyClass {
+	p := new(SafetyClass)
+	*p = x
+	return p
+}
+
+func (x SafetyClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SafetyClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_analysis_proto_enumTypes[3].Descriptor()
+}
+
+func (SafetyClass) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_analysis_proto_enumTypes[3]
+}
+
+func (x SafetyClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SafetyClass.Descriptor instead.
+func (SafetyClass) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{3}
+}
+
+// 押し引きの選択肢
+type PushFoldAction int32
+
+const (
+	PushFoldAction_PUSH_FOLD_ACTION_UNSPECIFIED PushFoldAction = 0
+	PushFoldAction_PUSH_FOLD_ACTION_PUSH        PushFoldAction = 1 // 押し
+	PushFoldAction_PUSH_FOLD_ACTION_MAWASHI     PushFoldAction = 2 // 回し
+	PushFoldAction_PUSH_FOLD_ACTION_FOLD        PushFoldAction = 3 // 降り
+)
+
+// Enum value maps for PushFoldAction.
+var (
+	PushFoldAction_name = map[int32]string{
+		0: "PUSH_FOLD_ACTION_UNSPECIFIED",
+		1: "PUSH_FOLD_ACTION_PUSH",
+		2: "PUSH_FOLD_ACTION_MAWASHI",
+		3: "PUSH_FOLD_ACTION_FOLD",
+	}
+	PushFoldAction_value = map[string]int32{
+		"PUSH_FOLD_ACTION_UNSPECIFIED": 0,
+		"PUSH_FOLD_ACTION_PUSH":        1,
+		"PUSH_FOLD_ACTION_MAWASHI":     2,
+		"PUSH_FOLD_ACTION_FOLD":        3,
+	}
+)
+
+func (x PushFoldAction) Enum() *PushFoldAction {
+	p := new(PushFoldAction)
+	*p = x
+	return p
+}
+
+func (x PushFoldAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PushFoldAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_analysis_proto_enumTypes[4].Descriptor()
+}
+
+func (PushFoldAction) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_analysis_proto_enumTypes[4]
+}
+
+func (x PushFoldAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PushFoldAction.Descriptor instead.
+func (PushFoldAction) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{4}
+}
+
+// 副露
+type Meld struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  MeldType `protobuf:"varint,1,opt,name=type,proto3,enum=mahjong.ai.v1.MeldType" json:"type,omitempty"` // 副露の種類
+	Tiles string   `protobuf:"bytes,2,opt,name=tiles,proto3" json:"tiles,omitempty"`                            // 副露した牌（例: "555z"）
+}
+
+func (x *Meld) Reset() {
+	*x = Meld{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Meld) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meld) ProtoMessage() {}
+
+func (x *Meld) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meld.ProtoReflect.Descriptor instead.
+func (*Meld) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Meld) GetType() MeldType {
+	if x != nil {
+		return x.Type
+	}
+	return MeldType_MELD_TYPE_UNSPECIFIED
+}
+
+func (x *Meld) GetTiles() string {
+	if x != nil {
+		return x.Tiles
+	}
+	return ""
+}
+
+// 役
+type YakuInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`        // 役名
+	Han     int32  `protobuf:"varint,2,opt,name=han,proto3" json:"han,omitempty"`         // 翻数
+	Yakuman int32  `protobuf:"varint,3,opt,name=yakuman,proto3" json:"yakuman,omitempty"` // 役満の倍数（通常役は0）
+}
+
+func (x *YakuInfo) Reset() {
+	*x = YakuInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *YakuInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*YakuInfo) ProtoMessage() {}
+
+func (x *YakuInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use YakuInfo.ProtoReflect.Descriptor instead.
+func (*YakuInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *YakuInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *YakuInfo) GetHan() int32 {
+	if x != nil {
+		return x.Han
+	}
+	return 0
+}
+
+func (x *YakuInfo) GetYakuman() int32 {
+	if x != nil {
+		return x.Yakuman
+	}
+	return 0
+}
+
+// 和了の評価
+type AgariInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Yaku    []*YakuInfo `protobuf:"bytes,1,rep,name=yaku,proto3" json:"yaku,omitempty"`                       // 成立した役（空の場合は役なし）
+	Han     int32       `protobuf:"varint,2,opt,name=han,proto3" json:"han,omitempty"`                        // ドラを含む翻数
+	Fu      int32       `protobuf:"varint,3,opt,name=fu,proto3" json:"fu,omitempty"`                          // 符
+	Dora    int32       `protobuf:"varint,4,opt,name=dora,proto3" json:"dora,omitempty"`                      // ドラ（赤ドラ・裏ドラを除く）
+	AkaDora int32       `protobuf:"varint,5,opt,name=aka_dora,json=akaDora,proto3" json:"aka_dora,omitempty"` // 赤ドラ
+	UraDora int32       `protobuf:"varint,6,opt,name=ura_dora,json=uraDora,proto3" json:"ura_dora,omitempty"` // 裏ドラ
+	Points  int32       `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`                  // 受け取る点数（ツモは合計）
+	Score   string      `protobuf:"bytes,8,opt,name=score,proto3" json:"score,omitempty"`                     // 点数の表記（例: "30符4翻 ロン7700点 / ツモ2000-3900"）
+}
+
+func (x *AgariInfo) Reset() {
+	*x = AgariInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AgariInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AgariInfo) ProtoMessage() {}
+
+func (x *AgariInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AgariInfo.ProtoReflect.Descriptor instead.
+func (*AgariInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AgariInfo) GetYaku() []*YakuInfo {
+	if x != nil {
+		return x.Yaku
+	}
+	return nil
+}
+
+func (x *AgariInfo) GetHan() int32 {
+	if x != nil {
+		return x.Han
+	}
+	return 0
+}
+
+func (x *AgariInfo) GetFu() int32 {
+	if x != nil {
+		return x.Fu
+	}
+	return 0
+}
+
+func (x *AgariInfo) GetDora() int32 {
+	if x != nil {
+		return x.Dora
+	}
+	return 0
+}
+
+func (x *AgariInfo) GetAkaDora() int32 {
+	if x != nil {
+		return x.AkaDora
+	}
+	return 0
+}
+
+func (x *AgariInfo) GetUraDora() int32 {
+	if x != nil {
+		return x.UraDora
+	}
+	return 0
+}
+
+func (x *AgariInfo) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+func (x *AgariInfo) GetScore() string {
+	if x != nil {
+		return x.Score
+	}
+	return ""
+}
+
+// 和了牌ごとの待ち
+type WaitInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tile        string      `protobuf:"bytes,1,opt,name=tile,proto3" json:"tile,omitempty"`                                          // 和了牌
+	Shapes      []WaitShape `protobuf:"varint,2,rep,packed,name=shapes,proto3,enum=mahjong.ai.v1.WaitShape" json:"shapes,omitempty"` // この牌で和了したときにとりうる待ちの形
+	Remaining   int32       `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`                               // 見えていない残り枚数
+	NoYakuRon   bool        `protobuf:"varint,4,opt,name=no_yaku_ron,json=noYakuRon,proto3" json:"no_yaku_ron,omitempty"`            // ロンでは役がなく和了できない
+	NoYakuTsumo bool        `protobuf:"varint,5,opt,name=no_yaku_tsumo,json=noYakuTsumo,proto3" json:"no_yaku_tsumo,omitempty"`      // ツモでも役がなく和了できない
+	Ron         *AgariInfo  `protobuf:"bytes,6,opt,name=ron,proto3" json:"ron,omitempty"`                                            // ロン和了時の評価
+	Tsumo       *AgariInfo  `protobuf:"bytes,7,opt,name=tsumo,proto3" json:"tsumo,omitempty"`                                        // ツモ和了時の評価
+}
+
+func (x *WaitInfo) Reset() {
+	*x = WaitInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitInfo) ProtoMessage() {}
+
+func (x *WaitInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitInfo.ProtoReflect.Descriptor instead.
+func (*WaitInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *WaitInfo) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *WaitInfo) GetShapes() []WaitShape {
+	if x != nil {
+		return x.Shapes
+	}
+	return nil
+}
+
+func (x *WaitInfo) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *WaitInfo) GetNoYakuRon() bool {
+	if x != nil {
+		return x.NoYakuRon
+	}
+	return false
+}
+
+func (x *WaitInfo) GetNoYakuTsumo() bool {
+	if x != nil {
+		return x.NoYakuTsumo
+	}
+	return false
+}
+
+func (x *WaitInfo) GetRon() *AgariInfo {
+	if x != nil {
+		return x.Ron
+	}
+	return nil
+}
+
+func (x *WaitInfo) GetTsumo() *AgariInfo {
+	if x != nil {
+		return x.Tsumo
+	}
+	return nil
+}
+
+// フリテンの判定
+type FuritenInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Permanent bool     `protobuf:"varint,1,opt,name=permanent,proto3" json:"permanent,omitempty"` // 捨て牌フリテン
+	Temporary bool     `protobuf:"varint,2,opt,name=temporary,proto3" json:"temporary,omitempty"` // 同巡内フリテン
+	Riichi    bool     `protobuf:"varint,3,opt,name=riichi,proto3" json:"riichi,omitempty"`       // 立直後の見逃しによるフリテン
+	Tiles     []string `protobuf:"bytes,4,rep,name=tiles,proto3" json:"tiles,omitempty"`          // フリテンの原因になった和了牌
+}
+
+func (x *FuritenInfo) Reset() {
+	*x = FuritenInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FuritenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FuritenInfo) ProtoMessage() {}
+
+func (x *FuritenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FuritenInfo.ProtoReflect.Descriptor instead.
+func (*FuritenInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FuritenInfo) GetPermanent() bool {
+	if x != nil {
+		return x.Permanent
+	}
+	return false
+}
+
+func (x *FuritenInfo) GetTemporary() bool {
+	if x != nil {
+		return x.Temporary
+	}
+	return false
+}
+
+func (x *FuritenInfo) GetRiichi() bool {
+	if x != nil {
+		return x.Riichi
+	}
+	return false
+}
+
+func (x *FuritenInfo) GetTiles() []string {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+// 待ち判定の結果
+type WaitsResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tenpai  bool         `protobuf:"varint,1,opt,name=tenpai,proto3" json:"tenpai,omitempty"`                            // 聴牌しているか
+	Shape   WaitShape    `protobuf:"varint,2,opt,name=shape,proto3,enum=mahjong.ai.v1.WaitShape" json:"shape,omitempty"` // 手牌全体としての待ちの形
+	Waits   []*WaitInfo  `protobuf:"bytes,3,rep,name=waits,proto3" json:"waits,omitempty"`                               // 和了牌ごとの待ち
+	Furiten *FuritenInfo `protobuf:"bytes,4,opt,name=furiten,proto3" json:"furiten,omitempty"`                           // フリテンの判定
+	CanRon  bool         `protobuf:"varint,5,opt,name=can_ron,json=canRon,proto3" json:"can_ron,omitempty"`              // フリテンでなく、役のあるロン和了牌があるか
+	RuleSet string       `protobuf:"bytes,6,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`            // 適用したルールセット名
+}
+
+func (x *WaitsResult) Reset() {
+	*x = WaitsResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WaitsResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WaitsResult) ProtoMessage() {}
+
+func (x *WaitsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WaitsResult.ProtoReflect.Descriptor instead.
+func (*WaitsResult) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *WaitsResult) GetTenpai() bool {
+	if x != nil {
+		return x.Tenpai
+	}
+	return false
+}
+
+func (x *WaitsResult) GetShape() WaitShape {
+	if x != nil {
+		return x.Shape
+	}
+	return WaitShape_WAIT_SHAPE_UNSPECIFIED
+}
+
+func (x *WaitsResult) GetWaits() []*WaitInfo {
+	if x != nil {
+		return x.Waits
+	}
+	return nil
+}
+
+func (x *WaitsResult) GetFuriten() *FuritenInfo {
+	if x != nil {
+		return x.Furiten
+	}
+	return nil
+}
+
+func (x *WaitsResult) GetCanRon() bool {
+	if x != nil {
+		return x.CanRon
+	}
+	return false
+}
+
+func (x *WaitsResult) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+// 受け入れの牌
+type UkeireTileInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tile      string `protobuf:"bytes,1,opt,name=tile,proto3" json:"tile,omitempty"`            // 向聴数を進める牌
+	Remaining int32  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"` // 見えていない残り枚数
+}
+
+func (x *UkeireTileInfo) Reset() {
+	*x = UkeireTileInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UkeireTileInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UkeireTileInfo) ProtoMessage() {}
+
+func (x *UkeireTileInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UkeireTileInfo.ProtoReflect.Descriptor instead.
+func (*UkeireTileInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UkeireTileInfo) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *UkeireTileInfo) GetRemaining() int32 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+// モンテカルロ法による評価
+type SimulationInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Simulations      int32                 `protobuf:"varint,1,opt,name=simulations,proto3" json:"simulations,omitempty"`                                      // 試行回数
+	WinRate          float32               `protobuf:"fixed32,2,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`                              // 和了率
+	TsumoRate        float32               `protobuf:"fixed32,3,opt,name=tsumo_rate,json=tsumoRate,proto3" json:"tsumo_rate,omitempty"`                        // ツモ和了率
+	TenpaiRate       float32               `protobuf:"fixed32,4,opt,name=tenpai_rate,json=tenpaiRate,proto3" json:"tenpai_rate,omitempty"`                     // 聴牌率（流局時点を含む）
+	AverageWinPoints float32               `protobuf:"fixed32,5,opt,name=average_win_points,json=averageWinPoints,proto3" json:"average_win_points,omitempty"` // 和了時の平均打点
+	ExpectedValue    float32               `protobuf:"fixed32,6,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`            // 打点期待値（和了率 × 平均打点）
+	ByTurn           []*SimulationTurnInfo `protobuf:"bytes,7,rep,name=by_turn,json=byTurn,proto3" json:"by_turn,omitempty"`                                   // 自分のツモ回数ごとの累積の集計
+}
+
+func (x *SimulationInfo) Reset() {
+	*x = SimulationInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationInfo) ProtoMessage() {}
+
+func (x *SimulationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationInfo.ProtoReflect.Descriptor instead.
+func (*SimulationInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SimulationInfo) GetSimulations() int32 {
+	if x != nil {
+		return x.Simulations
+	}
+	return 0
+}
+
+func (x *SimulationInfo) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *SimulationInfo) GetTsumoRate() float32 {
+	if x != nil {
+		return x.TsumoRate
+	}
+	return 0
+}
+
+func (x *SimulationInfo) GetTenpaiRate() float32 {
+	if x != nil {
+		return x.TenpaiRate
+	}
+	return 0
+}
+
+func (x *SimulationInfo) GetAverageWinPoints() float32 {
+	if x != nil {
+		return x.AverageWinPoints
+	}
+	return 0
+}
+
+func (x *SimulationInfo) GetExpectedValue() float32 {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return 0
+}
+
+func (x *SimulationInfo) GetByTurn() []*SimulationTurnInfo {
+	if x != nil {
+		return x.ByTurn
+	}
+	return nil
+}
+
+// 自分の N 回目のツモを終えた時点までの累積の集計
+type SimulationTurnInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Turn          int32   `protobuf:"varint,1,opt,name=turn,proto3" json:"turn,omitempty"`                                         // 自分のツモ回数（1始まり）
+	TenpaiRate    float32 `protobuf:"fixed32,2,opt,name=tenpai_rate,json=tenpaiRate,proto3" json:"tenpai_rate,omitempty"`          // 聴牌率
+	WinRate       float32 `protobuf:"fixed32,3,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`                   // 和了率
+	ExpectedValue float32 `protobuf:"fixed32,4,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"` // 打点期待値
+}
+
+func (x *SimulationTurnInfo) Reset() {
+	*x = SimulationTurnInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationTurnInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationTurnInfo) ProtoMessage() {}
+
+func (x *SimulationTurnInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationTurnInfo.ProtoReflect.Descriptor instead.
+func (*SimulationTurnInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SimulationTurnInfo) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *SimulationTurnInfo) GetTenpaiRate() float32 {
+	if x != nil {
+		return x.TenpaiRate
+	}
+	return 0
+}
+
+func (x *SimulationTurnInfo) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *SimulationTurnInfo) GetExpectedValue() float32 {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return 0
+}
+
+// 手牌のシミュレーション結果
+type SimulationResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Simulation *SimulationInfo `protobuf:"bytes,1,opt,name=simulation,proto3" json:"simulation,omitempty"`          // 集計結果
+	Shanten    int32           `protobuf:"varint,2,opt,name=shanten,proto3" json:"shanten,omitempty"`               // 開始時点の向聴数（14枚の場合は打牌前）
+	Policy     string          `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy,omitempty"`                  // 適用した打牌の方針
+	RuleSet    string          `protobuf:"bytes,4,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // 適用したルールセット名
+}
+
+func (x *SimulationResult) Reset() {
+	*x = SimulationResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationResult) ProtoMessage() {}
+
+func (x *SimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationResult.ProtoReflect.Descriptor instead.
+func (*SimulationResult) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SimulationResult) GetSimulation() *SimulationInfo {
+	if x != nil {
+		return x.Simulation
+	}
+	return nil
+}
+
+func (x *SimulationResult) GetShanten() int32 {
+	if x != nil {
+		return x.Shanten
+	}
+	return 0
+}
+
+func (x *SimulationResult) GetPolicy() string {
+	if x != nil {
+		return x.Policy
+	}
+	return ""
+}
+
+func (x *SimulationResult) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+// 打牌候補の評価
+type DiscardCandidateInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tile             string            `protobuf:"bytes,1,opt,name=tile,proto3" json:"tile,omitempty"`                                                  // 打牌
+	Shanten          int32             `protobuf:"varint,2,opt,name=shanten,proto3" json:"shanten,omitempty"`                                           // 打牌後の向聴数
+	Ukeire           []*UkeireTileInfo `protobuf:"bytes,3,rep,name=ukeire,proto3" json:"ukeire,omitempty"`                                              // 受け入れの牌
+	UkeireCount      int32             `protobuf:"varint,4,opt,name=ukeire_count,json=ukeireCount,proto3" json:"ukeire_count,omitempty"`                // 受け入れの残り枚数の合計
+	ImprovementCount int32             `protobuf:"varint,5,opt,name=improvement_count,json=improvementCount,proto3" json:"improvement_count,omitempty"` // 改良牌の残り枚数
+	GoodShapeRate    float32           `protobuf:"fixed32,6,opt,name=good_shape_rate,json=goodShapeRate,proto3" json:"good_shape_rate,omitempty"`       // 1向聴以内のとき、良形（2種以上の待ち）で聴牌する割合
+	Simulation       *SimulationInfo   `protobuf:"bytes,7,opt,name=simulation,proto3" json:"simulation,omitempty"`                                      // モンテカルロ法による評価（評価していない候補は未設定）
+}
+
+func (x *DiscardCandidateInfo) Reset() {
+	*x = DiscardCandidateInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardCandidateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardCandidateInfo) ProtoMessage() {}
+
+func (x *DiscardCandidateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardCandidateInfo.ProtoReflect.Descriptor instead.
+func (*DiscardCandidateInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DiscardCandidateInfo) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *DiscardCandidateInfo) GetShanten() int32 {
+	if x != nil {
+		return x.Shanten
+	}
+	return 0
+}
+
+func (x *DiscardCandidateInfo) GetUkeire() []*UkeireTileInfo {
+	if x != nil {
+		return x.Ukeire
+	}
+	return nil
+}
+
+func (x *DiscardCandidateInfo) GetUkeireCount() int32 {
+	if x != nil {
+		return x.UkeireCount
+	}
+	return 0
+}
+
+func (x *DiscardCandidateInfo) GetImprovementCount() int32 {
+	if x != nil {
+		return x.ImprovementCount
+	}
+	return 0
+}
+
+func (x *DiscardCandidateInfo) GetGoodShapeRate() float32 {
+	if x != nil {
+		return x.GoodShapeRate
+	}
+	return 0
+}
+
+func (x *DiscardCandidateInfo) GetSimulation() *SimulationInfo {
+	if x != nil {
+		return x.Simulation
+	}
+	return nil
+}
+
+// 打牌候補の評価結果
+type DiscardResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shanten    int32                   `protobuf:"varint,1,opt,name=shanten,proto3" json:"shanten,omitempty"`               // 打牌前の向聴数（-1 の場合はツモ和了できる）
+	Candidates []*DiscardCandidateInfo `protobuf:"bytes,2,rep,name=candidates,proto3" json:"candidates,omitempty"`          // 推奨順の打牌候補
+	RuleSet    string                  `protobuf:"bytes,3,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // 適用したルールセット名
+}
+
+func (x *DiscardResult) Reset() {
+	*x = DiscardResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardResult) ProtoMessage() {}
+
+func (x *DiscardResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardResult.ProtoReflect.Descriptor instead.
+func (*DiscardResult) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiscardResult) GetShanten() int32 {
+	if x != nil {
+		return x.Shanten
+	}
+	return 0
+}
+
+func (x *DiscardResult) GetCandidates() []*DiscardCandidateInfo {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *DiscardResult) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+// 他家の捨て牌と立直の状況
+type OpponentInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat              Wind   `protobuf:"varint,1,opt,name=seat,proto3,enum=mahjong.ai.v1.Wind" json:"seat,omitempty"`                             // 他家の自風（結果の表示用）
+	Discards          string `protobuf:"bytes,2,opt,name=discards,proto3" json:"discards,omitempty"`                                              // 捨て牌（捨てた順）
+	RiichiTurn        int32  `protobuf:"varint,3,opt,name=riichi_turn,json=riichiTurn,proto3" json:"riichi_turn,omitempty"`                       // 立直宣言牌が何枚目の捨て牌か（1始まり、0 は立直していない）
+	PassedAfterRiichi string `protobuf:"bytes,4,opt,name=passed_after_riichi,json=passedAfterRiichi,proto3" json:"passed_after_riichi,omitempty"` // 立直後に他家が捨て、この他家が和了しなかった牌
+	Score             int32  `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`                                                   // 持ち点（押し引きの評価でオーラスの順位を考慮する場合に使う）
+}
+
+func (x *OpponentInfo) Reset() {
+	*x = OpponentInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpponentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentInfo) ProtoMessage() {}
+
+func (x *OpponentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentInfo.ProtoReflect.Descriptor instead.
+func (*OpponentInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OpponentInfo) GetSeat() Wind {
+	if x != nil {
+		return x.Seat
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *OpponentInfo) GetDiscards() string {
+	if x != nil {
+		return x.Discards
+	}
+	return ""
+}
+
+func (x *OpponentInfo) GetRiichiTurn() int32 {
+	if x != nil {
+		return x.RiichiTurn
+	}
+	return 0
+}
+
+func (x *OpponentInfo) GetPassedAfterRiichi() string {
+	if x != nil {
+		return x.PassedAfterRiichi
+	}
+	return ""
+}
+
+func (x *OpponentInfo) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// 1人の立直者に対する牌の安全度
+type OpponentSafetyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat        Wind        `protobuf:"varint,1,opt,name=seat,proto3,enum=mahjong.ai.v1.Wind" json:"seat,omitempty"`                                         // 立直者の自風
+	SafetyClass SafetyClass `protobuf:"varint,2,opt,name=safety_class,json=safetyClass,proto3,enum=mahjong.ai.v1.SafetyClass" json:"safety_class,omitempty"` // 分類
+	Risk        float32     `protobuf:"fixed32,3,opt,name=risk,proto3" json:"risk,omitempty"`                                                                // 放銃率の目安
+	Reasons     []string    `protobuf:"bytes,4,rep,name=reasons,proto3" json:"reasons,omitempty"`                                                            // 判断の理由（例: "筋（4p が現物）"）
+}
+
+func (x *OpponentSafetyInfo) Reset() {
+	*x = OpponentSafetyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpponentSafetyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpponentSafetyInfo) ProtoMessage() {}
+
+func (x *OpponentSafetyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpponentSafetyInfo.ProtoReflect.Descriptor instead.
+func (*OpponentSafetyInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OpponentSafetyInfo) GetSeat() Wind {
+	if x != nil {
+		return x.Seat
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *OpponentSafetyInfo) GetSafetyClass() SafetyClass {
+	if x != nil {
+		return x.SafetyClass
+	}
+	return SafetyClass_SAFETY_CLASS_UNSPECIFIED
+}
+
+func (x *OpponentSafetyInfo) GetRisk() float32 {
+	if x != nil {
+		return x.Risk
+	}
+	return 0
+}
+
+func (x *OpponentSafetyInfo) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// 手牌の1種類の牌の安全度
+type TileSafetyInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tile    string                `protobuf:"bytes,1,opt,name=tile,proto3" json:"tile,omitempty"`       // 牌
+	Count   int32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`    // 手牌にある枚数
+	Risk    float32               `protobuf:"fixed32,3,opt,name=risk,proto3" json:"risk,omitempty"`     // いずれかの立直者に放銃する確率の目安
+	Against []*OpponentSafetyInfo `protobuf:"bytes,4,rep,name=against,proto3" json:"against,omitempty"` // 立直者ごとの安全度
+}
+
+func (x *TileSafetyInfo) Reset() {
+	*x = TileSafetyInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TileSafetyInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TileSafetyInfo) ProtoMessage() {}
+
+func (x *TileSafetyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TileSafetyInfo.ProtoReflect.Descriptor instead.
+func (*TileSafetyInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TileSafetyInfo) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *TileSafetyInfo) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *TileSafetyInfo) GetRisk() float32 {
+	if x != nil {
+		return x.Risk
+	}
+	return 0
+}
+
+func (x *TileSafetyInfo) GetAgainst() []*OpponentSafetyInfo {
+	if x != nil {
+		return x.Against
+	}
+	return nil
+}
+
+// 安全度の評価結果
+type SafetyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tiles []*TileSafetyInfo `protobuf:"bytes,1,rep,name=tiles,proto3" json:"tiles,omitempty"` // 安全な順の手牌の牌
+}
+
+func (x *SafetyResult) Reset() {
+	*x = SafetyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SafetyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SafetyResult) ProtoMessage() {}
+
+func (x *SafetyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SafetyResult.ProtoReflect.Descriptor instead.
+func (*SafetyResult) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SafetyResult) GetTiles() []*TileSafetyInfo {
+	if x != nil {
+		return x.Tiles
+	}
+	return nil
+}
+
+// 押し引きの選択肢ごとの評価
+type PushFoldOptionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action           PushFoldAction `protobuf:"varint,1,opt,name=action,proto3,enum=mahjong.ai.v1.PushFoldAction" json:"action,omitempty"`              // 選択肢
+	Discard          string         `protobuf:"bytes,2,opt,name=discard,proto3" json:"discard,omitempty"`                                               // 打牌
+	Shanten          int32          `protobuf:"varint,3,opt,name=shanten,proto3" json:"shanten,omitempty"`                                              // 打牌後の向聴数
+	UkeireCount      int32          `protobuf:"varint,4,opt,name=ukeire_count,json=ukeireCount,proto3" json:"ukeire_count,omitempty"`                   // 打牌後の受け入れ枚数
+	DiscardRisk      float32        `protobuf:"fixed32,5,opt,name=discard_risk,json=discardRisk,proto3" json:"discard_risk,omitempty"`                  // この打牌で立直者に放銃する確率の目安
+	WinRate          float32        `protobuf:"fixed32,6,opt,name=win_rate,json=winRate,proto3" json:"win_rate,omitempty"`                              // 局が終わるまでに和了する確率
+	DealInRate       float32        `protobuf:"fixed32,7,opt,name=deal_in_rate,json=dealInRate,proto3" json:"deal_in_rate,omitempty"`                   // 局が終わるまでに立直者に放銃する確率
+	AverageWinPoints float32        `protobuf:"fixed32,8,opt,name=average_win_points,json=averageWinPoints,proto3" json:"average_win_points,omitempty"` // 和了したときの平均打点（供託・積み棒を含む）
+	ExpectedValue    float32        `protobuf:"fixed32,9,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"`            // 局の収支の期待値（オーラスでは順位点の増減を含む）
+}
+
+func (x *PushFoldOptionInfo) Reset() {
+	*x = PushFoldOptionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFoldOptionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFoldOptionInfo) ProtoMessage() {}
+
+func (x *PushFoldOptionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFoldOptionInfo.ProtoReflect.Descriptor instead.
+func (*PushFoldOptionInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PushFoldOptionInfo) GetAction() PushFoldAction {
+	if x != nil {
+		return x.Action
+	}
+	return PushFoldAction_PUSH_FOLD_ACTION_UNSPECIFIED
+}
+
+func (x *PushFoldOptionInfo) GetDiscard() string {
+	if x != nil {
+		return x.Discard
+	}
+	return ""
+}
+
+func (x *PushFoldOptionInfo) GetShanten() int32 {
+	if x != nil {
+		return x.Shanten
+	}
+	return 0
+}
+
+func (x *PushFoldOptionInfo) GetUkeireCount() int32 {
+	if x != nil {
+		return x.UkeireCount
+	}
+	return 0
+}
+
+func (x *PushFoldOptionInfo) GetDiscardRisk() float32 {
+	if x != nil {
+		return x.DiscardRisk
+	}
+	return 0
+}
+
+func (x *PushFoldOptionInfo) GetWinRate() float32 {
+	if x != nil {
+		return x.WinRate
+	}
+	return 0
+}
+
+func (x *PushFoldOptionInfo) GetDealInRate() float32 {
+	if x != nil {
+		return x.DealInRate
+	}
+	return 0
+}
+
+func (x *PushFoldOptionInfo) GetAverageWinPoints() float32 {
+	if x != nil {
+		return x.AverageWinPoints
+	}
+	return 0
+}
+
+func (x *PushFoldOptionInfo) GetExpectedValue() float32 {
+	if x != nil {
+		return x.ExpectedValue
+	}
+	return 0
+}
+
+// 立直者の脅威の見積もり
+type ThreatInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat           Wind    `protobuf:"varint,1,opt,name=seat,proto3,enum=mahjong.ai.v1.Wind" json:"seat,omitempty"`                        // 立直者の自風
+	ExpectedLoss   int32   `protobuf:"varint,2,opt,name=expected_loss,json=expectedLoss,proto3" json:"expected_loss,omitempty"`            // 放銃したときの平均失点
+	WinRatePerTurn float32 `protobuf:"fixed32,3,opt,name=win_rate_per_turn,json=winRatePerTurn,proto3" json:"win_rate_per_turn,omitempty"` // 自分以外から1巡あたりに和了する確率
+}
+
+func (x *ThreatInfo) Reset() {
+	*x = ThreatInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThreatInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThreatInfo) ProtoMessage() {}
+
+func (x *ThreatInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThreatInfo.ProtoReflect.Descriptor instead.
+func (*ThreatInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ThreatInfo) GetSeat() Wind {
+	if x != nil {
+		return x.Seat
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *ThreatInfo) GetExpectedLoss() int32 {
+	if x != nil {
+		return x.ExpectedLoss
+	}
+	return 0
+}
+
+func (x *ThreatInfo) GetWinRatePerTurn() float32 {
+	if x != nil {
+		return x.WinRatePerTurn
+	}
+	return 0
+}
+
+// 押し引きの評価結果
+type PushFoldResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recommendation PushFoldAction        `protobuf:"varint,1,opt,name=recommendation,proto3,enum=mahjong.ai.v1.PushFoldAction" json:"recommendation,omitempty"` // 期待値が最も高い選択肢
+	Options        []*PushFoldOptionInfo `protobuf:"bytes,2,rep,name=options,proto3" json:"options,omitempty"`                                                  // 押し・回し・降りの評価（回しが押しより安全にならない場合は含めない）
+	Threats        []*ThreatInfo         `protobuf:"bytes,3,rep,name=threats,proto3" json:"threats,omitempty"`                                                  // 立直者の脅威
+	RemainingTurns int32                 `protobuf:"varint,4,opt,name=remaining_turns,json=remainingTurns,proto3" json:"remaining_turns,omitempty"`             // 流局までの残りの巡目
+	DrawRisk       float32               `protobuf:"fixed32,5,opt,name=draw_risk,json=drawRisk,proto3" json:"draw_risk,omitempty"`                              // 押した場合に以降ツモ切りする牌の放銃率の平均
+	RuleSet        string                `protobuf:"bytes,6,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`                                   // 適用したルールセット名
+}
+
+func (x *PushFoldResult) Reset() {
+	*x = PushFoldResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushFoldResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushFoldResult) ProtoMessage() {}
+
+func (x *PushFoldResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushFoldResult.ProtoReflect.Descriptor instead.
+func (*PushFoldResult) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PushFoldResult) GetRecommendation() PushFoldAction {
+	if x != nil {
+		return x.Recommendation
+	}
+	return PushFoldAction_PUSH_FOLD_ACTION_UNSPECIFIED
+}
+
+func (x *PushFoldResult) GetOptions() []*PushFoldOptionInfo {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *PushFoldResult) GetThreats() []*ThreatInfo {
+	if x != nil {
+		return x.Threats
+	}
+	return nil
+}
+
+func (x *PushFoldResult) GetRemainingTurns() int32 {
+	if x != nil {
+		return x.RemainingTurns
+	}
+	return 0
+}
+
+func (x *PushFoldResult) GetDrawRisk() float32 {
+	if x != nil {
+		return x.DrawRisk
+	}
+	return 0
+}
+
+func (x *PushFoldResult) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+// オーラスのプレイヤーの現在の順位
+type PlacementStandingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player      int32   `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`                               // プレイヤー（起家からの席順）
+	Score       int32   `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`                                 // 持ち点
+	Rank        int32   `protobuf:"varint,3,opt,name=rank,proto3" json:"rank,omitempty"`                                   // 順位（1始まり、同点の場合は起家に近い方が上位）
+	FinalPoints float32 `protobuf:"fixed32,4,opt,name=final_points,json=finalPoints,proto3" json:"final_points,omitempty"` // このまま終局した場合のウマ・オカを含む最終ポイント（千点単位）
+}
+
+func (x *PlacementStandingInfo) Reset() {
+	*x = PlacementStandingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementStandingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementStandingInfo) ProtoMessage() {}
+
+func (x *PlacementStandingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementStandingInfo.ProtoReflect.Descriptor instead.
+func (*PlacementStandingInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlacementStandingInfo) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *PlacementStandingInfo) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlacementStandingInfo) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlacementStandingInfo) GetFinalPoints() float32 {
+	if x != nil {
+		return x.FinalPoints
+	}
+	return 0
+}
+
+// 目標の順位に届く最小の和了
+type PlacementRequirementInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player     int32  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`                           // 和了するプレイヤー（起家からの席順）
+	TargetRank int32  `protobuf:"varint,2,opt,name=target_rank,json=targetRank,proto3" json:"target_rank,omitempty"` // 目標の順位（1始まり）
+	Tsumo      bool   `protobuf:"varint,3,opt,name=tsumo,proto3" json:"tsumo,omitempty"`                             // ツモ和了の条件か
+	From       int32  `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`                               // ロンの放銃者（ツモの場合は -1）
+	Possible   bool   `protobuf:"varint,5,opt,name=possible,proto3" json:"possible,omitempty"`                       // 役満以下の和了で届くか
+	Hand       string `protobuf:"bytes,6,opt,name=hand,proto3" json:"hand,omitempty"`                                // 必要な最小の和了（例: 30符2翻 ロン2000点）
+	Points     int32  `protobuf:"varint,7,opt,name=points,proto3" json:"points,omitempty"`                           // 和了で受け取る点数（積み棒・供託を含む）
+}
+
+func (x *PlacementRequirementInfo) Reset() {
+	*x = PlacementRequirementInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementRequirementInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementRequirementInfo) ProtoMessage() {}
+
+func (x *PlacementRequirementInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementRequirementInfo.ProtoReflect.Descriptor instead.
+func (*PlacementRequirementInfo) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PlacementRequirementInfo) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *PlacementRequirementInfo) GetTargetRank() int32 {
+	if x != nil {
+		return x.TargetRank
+	}
+	return 0
+}
+
+func (x *PlacementRequirementInfo) GetTsumo() bool {
+	if x != nil {
+		return x.Tsumo
+	}
+	return false
+}
+
+func (x *PlacementRequirementInfo) GetFrom() int32 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *PlacementRequirementInfo) GetPossible() bool {
+	if x != nil {
+		return x.Possible
+	}
+	return false
+}
+
+func (x *PlacementRequirementInfo) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *PlacementRequirementInfo) GetPoints() int32 {
+	if x != nil {
+		return x.Points
+	}
+	return 0
+}
+
+// オーラスの点数状況の計算結果
+type PlacementResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Standings    []*PlacementStandingInfo    `protobuf:"bytes,1,rep,name=standings,proto3" json:"standings,omitempty"`            // 起家からの席順の現在の順位
+	Requirements []*PlacementRequirementInfo `protobuf:"bytes,2,rep,name=requirements,proto3" json:"requirements,omitempty"`      // 現在より上の順位に届く和了（プレイヤー・目標の順位・放銃者の順）
+	RuleSet      string                      `protobuf:"bytes,3,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // 適用したルールセット名
+}
+
+func (x *PlacementResult) Reset() {
+	*x = PlacementResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlacementResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlacementResult) ProtoMessage() {}
+
+func (x *PlacementResult) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_analysis_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlacementResult.ProtoReflect.Descriptor instead.
+func (*PlacementResult) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_analysis_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlacementResult) GetStandings() []*PlacementStandingInfo {
+	if x != nil {
+		return x.Standings
+	}
+	return nil
+}
+
+func (x *PlacementResult) GetRequirements() []*PlacementRequirementInfo {
+	if x != nil {
+		return x.Requirements
+	}
+	return nil
+}
+
+func (x *PlacementResult) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+var File_mahjong_ai_v1_analysis_proto protoreflect.FileDescriptor
+
+var file_mahjong_ai_v1_analysis_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x22, 0x49, 0x0a,
+	0x04, 0x4d, 0x65, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x4a, 0x0a, 0x08, 0x59, 0x61, 0x6b, 0x75,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x61, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x68, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x79, 0x61,
+	0x6b, 0x75, 0x6d, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x79, 0x61, 0x6b,
+	0x75, 0x6d, 0x61, 0x6e, 0x22, 0xd2, 0x01, 0x0a, 0x09, 0x41, 0x67, 0x61, 0x72, 0x69, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x2b, 0x0a, 0x04, 0x79, 0x61, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x59, 0x61, 0x6b, 0x75, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x79, 0x61, 0x6b, 0x75, 0x12,
+	0x10, 0x0a, 0x03, 0x68, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x68, 0x61,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x66,
+	0x75, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x6f, 0x72, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x64, 0x6f, 0x72, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6b, 0x61, 0x5f, 0x64, 0x6f, 0x72,
+	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x6b, 0x61, 0x44, 0x6f, 0x72, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x75, 0x72, 0x61, 0x5f, 0x64, 0x6f, 0x72, 0x61, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x75, 0x72, 0x61, 0x44, 0x6f, 0x72, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x8e, 0x02, 0x0a, 0x08, 0x57, 0x61,
+	0x69, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x68,
+	0x61, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x53,
+	0x68, 0x61, 0x70, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x70, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0b, 0x6e, 0x6f,
+	0x5f, 0x79, 0x61, 0x6b, 0x75, 0x5f, 0x72, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x6e, 0x6f, 0x59, 0x61, 0x6b, 0x75, 0x52, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x6f,
+	0x5f, 0x79, 0x61, 0x6b, 0x75, 0x5f, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6e, 0x6f, 0x59, 0x61, 0x6b, 0x75, 0x54, 0x73, 0x75, 0x6d, 0x6f, 0x12, 0x2a,
+	0x0a, 0x03, 0x72, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x61, 0x72,
+	0x69, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x03, 0x72, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x74, 0x73,
+	0x75, 0x6d, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x61, 0x72, 0x69, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x22, 0x77, 0x0a, 0x0b, 0x46, 0x75,
+	0x72, 0x69, 0x74, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x65, 0x72,
+	0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x70, 0x65,
+	0x72, 0x6d, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x65, 0x6d, 0x70, 0x6f,
+	0x72, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x65, 0x6d, 0x70,
+	0x6f, 0x72, 0x61, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x0b, 0x57, 0x61, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x65, 0x6e, 0x70, 0x61, 0x69, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x65, 0x6e, 0x70, 0x61, 0x69, 0x12, 0x2e, 0x0a, 0x05, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x53,
+	0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x05, 0x77,
+	0x61, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x69, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x05, 0x77, 0x61, 0x69, 0x74, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x66, 0x75,
+	0x72, 0x69, 0x74, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x75, 0x72, 0x69,
+	0x74, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x66, 0x75, 0x72, 0x69, 0x74, 0x65, 0x6e,
+	0x12, 0x17, 0x0a, 0x07, 0x63, 0x61, 0x6e, 0x5f, 0x72, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x52, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c,
+	0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c,
+	0x65, 0x53, 0x65, 0x74, 0x22, 0x42, 0x0a, 0x0e, 0x55, 0x6b, 0x65, 0x69, 0x72, 0x65, 0x54, 0x69,
+	0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
+	0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x22, 0x9e, 0x02, 0x0a, 0x0e, 0x53, 0x69, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0b, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x73, 0x75, 0x6d,
+	0x6f, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x74, 0x73,
+	0x75, 0x6d, 0x6f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6e, 0x70, 0x61,
+	0x69, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x65,
+	0x6e, 0x70, 0x61, 0x69, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x57, 0x69, 0x6e,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x3a, 0x0a,
+	0x07, 0x62, 0x79, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x06, 0x62, 0x79, 0x54, 0x75, 0x72, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x12, 0x53, 0x69,
+	0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x75, 0x72, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x74, 0x75, 0x72, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6e, 0x70, 0x61, 0x69, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x65, 0x6e, 0x70, 0x61,
+	0x69, 0x52, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x3d, 0x0a, 0x0a,
+	0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0a, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68,
+	0x61, 0x6e, 0x74, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x19, 0x0a,
+	0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x22, 0xb2, 0x02, 0x0a, 0x14, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x12,
+	0x35, 0x0a, 0x06, 0x75, 0x6b, 0x65, 0x69, 0x72, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e,
+	0x55, 0x6b, 0x65, 0x69, 0x72, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06,
+	0x75, 0x6b, 0x65, 0x69, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6b, 0x65, 0x69, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x75, 0x6b,
+	0x65, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6d, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6d, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x67, 0x6f, 0x6f, 0x64, 0x5f, 0x73,
+	0x68, 0x61, 0x70, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0d, 0x67, 0x6f, 0x6f, 0x64, 0x53, 0x68, 0x61, 0x70, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3d,
+	0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x89, 0x01,
+	0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x73, 0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x19,
+	0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x4f, 0x70,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x65,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x54, 0x75, 0x72, 0x6e,
+	0x12, 0x2e, 0x0a, 0x13, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
+	0x5f, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70,
+	0x61, 0x73, 0x73, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a,
+	0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x66,
+	0x65, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x0b, 0x73, 0x61, 0x66, 0x65, 0x74, 0x79,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x6c, 0x65, 0x53, 0x61, 0x66, 0x65,
+	0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04,
+	0x72, 0x69, 0x73, 0x6b, 0x12, 0x3b, 0x0a, 0x07, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e,
+	0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x53, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x61, 0x67, 0x61, 0x69, 0x6e, 0x73,
+	0x74, 0x22, 0x43, 0x0a, 0x0c, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x33, 0x0a, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x69, 0x6c, 0x65, 0x53, 0x61, 0x66, 0x65, 0x74, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x05, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xd7, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x73, 0x68, 0x46,
+	0x6f, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x35, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75,
+	0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x68, 0x61, 0x6e, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6b, 0x65, 0x69,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x75, 0x6b, 0x65, 0x69, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x19,
+	0x0a, 0x08, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x07, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0c, 0x64, 0x65, 0x61,
+	0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0a, 0x64, 0x65, 0x61, 0x6c, 0x49, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x10, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x57, 0x69, 0x6e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x22, 0x85, 0x01, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x27, 0x0a, 0x04, 0x73, 0x65, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x73, 0x73, 0x12, 0x29, 0x0a,
+	0x11, 0x77, 0x69, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x74, 0x75,
+	0x72, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0e, 0x77, 0x69, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x50, 0x65, 0x72, 0x54, 0x75, 0x72, 0x6e, 0x22, 0xaa, 0x02, 0x0a, 0x0e, 0x50, 0x75, 0x73,
+	0x68, 0x46, 0x6f, 0x6c, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x45, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x33, 0x0a, 0x07, 0x74, 0x68, 0x72, 0x65, 0x61, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x74, 0x68, 0x72,
+	0x65, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x72,
+	0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x1b, 0x0a,
+	0x09, 0x64, 0x72, 0x61, 0x77, 0x5f, 0x72, 0x69, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x08, 0x64, 0x72, 0x61, 0x77, 0x52, 0x69, 0x73, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75,
+	0x6c, 0x65, 0x53, 0x65, 0x74, 0x22, 0x7c, 0x0a, 0x15, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x72, 0x61, 0x6e, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x22, 0xc5, 0x01, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x73, 0x75,
+	0x6d, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x12,
+	0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x61, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x0f,
+	0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x42, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x4b, 0x0a, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0c, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x2a, 0x8b, 0x01, 0x0a, 0x08,
+	0x4d, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x4c, 0x44,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x43, 0x48, 0x49, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x45, 0x4c, 0x44, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x50, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x4c,
+	0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x4b, 0x41, 0x4e, 0x10, 0x03, 0x12,
+	0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4e, 0x4b,
+	0x41, 0x4e, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x4c, 0x44, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x4b, 0x41, 0x4b, 0x41, 0x4e, 0x10, 0x05, 0x2a, 0x5a, 0x0a, 0x04, 0x57, 0x69, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x10, 0x57, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x49, 0x4e, 0x44, 0x5f,
+	0x45, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x49, 0x4e, 0x44, 0x5f, 0x53,
+	0x4f, 0x55, 0x54, 0x48, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x49, 0x4e, 0x44, 0x5f, 0x57,
+	0x45, 0x53, 0x54, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x49, 0x4e, 0x44, 0x5f, 0x4e, 0x4f,
+	0x52, 0x54, 0x48, 0x10, 0x04, 0x2a, 0xd1, 0x01, 0x0a, 0x09, 0x57, 0x61, 0x69, 0x74, 0x53, 0x68,
+	0x61, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x59,
+	0x41, 0x4e, 0x4d, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x4b, 0x41, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x10, 0x02, 0x12,
+	0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x50, 0x45,
+	0x4e, 0x43, 0x48, 0x41, 0x4e, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x53, 0x48, 0x41, 0x4e, 0x50, 0x4f, 0x4e, 0x10, 0x04, 0x12,
+	0x14, 0x0a, 0x10, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x54, 0x41,
+	0x4e, 0x4b, 0x49, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x48,
+	0x41, 0x50, 0x45, 0x5f, 0x4e, 0x4f, 0x42, 0x45, 0x54, 0x41, 0x4e, 0x10, 0x06, 0x12, 0x1a, 0x0a,
+	0x16, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x4d, 0x55, 0x4c, 0x54,
+	0x49, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x44, 0x10, 0x07, 0x2a, 0xe3, 0x01, 0x0a, 0x0b, 0x53, 0x61,
+	0x66, 0x65, 0x74, 0x79, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x53, 0x41, 0x46,
+	0x45, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x41, 0x46, 0x45, 0x54,
+	0x59, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x47, 0x45, 0x4e, 0x42, 0x55, 0x54, 0x53, 0x55,
+	0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x41, 0x46, 0x45, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x41,
+	0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x41, 0x46, 0x45, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x53,
+	0x55, 0x4a, 0x49, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x41, 0x46, 0x45, 0x54, 0x59, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x48, 0x4f, 0x4e, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x1b, 0x0a,
+	0x17, 0x53, 0x41, 0x46, 0x45, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4f, 0x4e,
+	0x45, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x41,
+	0x46, 0x45, 0x54, 0x59, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f,
+	0x53, 0x55, 0x4a, 0x49, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x41, 0x46, 0x45, 0x54, 0x59,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4d, 0x55, 0x53, 0x55, 0x4a, 0x49, 0x10, 0x07, 0x2a,
+	0x86, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x46, 0x6f, 0x6c, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x46, 0x4f, 0x4c,
+	0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x10, 0x01, 0x12,
+	0x1c, 0x0a, 0x18, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x41, 0x57, 0x41, 0x53, 0x48, 0x49, 0x10, 0x02, 0x12, 0x19, 0x0a,
+	0x15, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x10, 0x03, 0x42, 0xc1, 0x01, 0x0a, 0x11, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x42, 0x0d,
+	0x41, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
+	0x47, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x6e, 0x64,
+	0x61, 0x6d, 0x61, 0x6e, 0x30, 0x32, 0x31, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f,
+	0x61, 0x69, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69,
+	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x41, 0x58, 0xaa, 0x02,
+	0x0d, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0d, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02,
+	0x19, 0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x3a, 0x3a, 0x41, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mahjong_ai_v1_analysis_proto_rawDescOnce sync.Once
+	file_mahjong_ai_v1_analysis_proto_rawDescData = file_mahjong_ai_v1_analysis_proto_rawDesc
+)
+
+func file_mahjong_ai_v1_analysis_proto_rawDescGZIP() []byte {
+	file_mahjong_ai_v1_analysis_proto_rawDescOnce.Do(func() {
+		file_mahjong_ai_v1_analysis_proto_rawDescData = protoimpl.X.CompressGZIP(file_mahjong_ai_v1_analysis_proto_rawDescData)
+	})
+	return file_mahjong_ai_v1_analysis_proto_rawDescData
+}
+
+var file_mahjong_ai_v1_analysis_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_mahjong_ai_v1_analysis_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_mahjong_ai_v1_analysis_proto_goTypes = []interface{}{
+	(MeldType)(0),                    // 0: mahjong.ai.v1.MeldType
+	(Wind)(0),                        // 1: mahjong.ai.v1.Wind
+	(WaitShape)(0),                   // 2: mahjong.ai.v1.WaitShape
+	(SafetyClass)(0),                 // 3: mahjong.ai.v1.SafetyClass
+	(PushFoldAction)(0),              // 4: mahjong.ai.v1.PushFoldAction
+	(*Meld)(nil),                     // 5: mahjong.ai.v1.Meld
+	(*YakuInfo)(nil),                 // 6: mahjong.ai.v1.YakuInfo
+	(*AgariInfo)(nil),                // 7: mahjong.ai.v1.AgariInfo
+	(*WaitInfo)(nil),                 // 8: mahjong.ai.v1.WaitInfo
+	(*FuritenInfo)(nil),              // 9: mahjong.ai.v1.FuritenInfo
+	(*WaitsResult)(nil),              // 10: mahjong.ai.v1.WaitsResult
+	(*UkeireTileInfo)(nil),           // 11: mahjong.ai.v1.UkeireTileInfo
+	(*SimulationInfo)(nil),           // 12: mahjong.ai.v1.SimulationInfo
+	(*SimulationTurnInfo)(nil),       // 13: mahjong.ai.v1.SimulationTurnInfo
+	(*SimulationResult)(nil),         // 14: mahjong.ai.v1.SimulationResult
+	(*DiscardCandidateInfo)(nil),     // 15: mahjong.ai.v1.DiscardCandidateInfo
+	(*DiscardResult)(nil),            // 16: mahjong.ai.v1.DiscardResult
+	(*OpponentInfo)(nil),             // 17: mahjong.ai.v1.OpponentInfo
+	(*OpponentSafetyInfo)(nil),       // 18: mahjong.ai.v1.OpponentSafetyInfo
+	(*TileSafetyInfo)(nil),           // 19: mahjong.ai.v1.TileSafetyInfo
+	(*SafetyResult)(nil),             // 20: mahjong.ai.v1.SafetyResult
+	(*PushFoldOptionInfo)(nil),       // 21: mahjong.ai.v1.PushFoldOptionInfo
+	(*ThreatInfo)(nil),               // 22: mahjong.ai.v1.ThreatInfo
+	(*PushFoldResult)(nil),           // 23: mahjong.ai.v1.PushFoldResult
+	(*PlacementStandingInfo)(nil),    // 24: mahjong.ai.v1.PlacementStandingInfo
+	(*PlacementRequirementInfo)(nil), // 25: mahjong.ai.v1.PlacementRequirementInfo
+	(*PlacementResult)(nil),          // 26: mahjong.ai.v1.PlacementResult
+}
+var file_mahjong_ai_v1_analysis_proto_depIdxs = []int32{
+	0,  // 0: mahjong.ai.v1.Meld.type:type_name -> mahjong.ai.v1.MeldType
+	6,  // 1: mahjong.ai.v1.AgariInfo.yaku:type_name -> mahjong.ai.v1.YakuInfo
+	2,  // 2: mahjong.ai.v1.WaitInfo.shapes:type_name -> mahjong.ai.v1.WaitShape
+	7,  // 3: mahjong.ai.v1.WaitInfo.ron:type_name -> mahjong.ai.v1.AgariInfo
+	7,  // 4: mahjong.ai.v1.WaitInfo.tsumo:type_name -> mahjong.ai.v1.AgariInfo
+	2,  // 5: mahjong.ai.v1.WaitsResult.shape:type_name -> mahjong.ai.v1.WaitShape
+	8,  // 6: mahjong.ai.v1.WaitsResult.waits:type_name -> mahjong.ai.v1.WaitInfo
+	9,  // 7: mahjong.ai.v1.WaitsResult.furiten:type_name -> mahjong.ai.v1.FuritenInfo
+	13, // 8: mahjong.ai.v1.SimulationInfo.by_turn:type_name -> mahjong.ai.v1.SimulationTurnInfo
+	12, // 9: mahjong.ai.v1.SimulationResult.simulation:type_name -> mahjong.ai.v1.SimulationInfo
+	11, // 10: mahjong.ai.v1.DiscardCandidateInfo.ukeire:type_name -> mahjong.ai.v1.UkeireTileInfo
+	12, // 11: mahjong.ai.v1.DiscardCandidateInfo.simulation:type_name -> mahjong.ai.v1.SimulationInfo
+	15, // 12: mahjong.ai.v1.DiscardResult.candidates:type_name -> mahjong.ai.v1.DiscardCandidateInfo
+	1,  // 13: mahjong.ai.v1.OpponentInfo.seat:type_name -> mahjong.ai.v1.Wind
+	1,  // 14: mahjong.ai.v1.OpponentSafetyInfo.seat:type_name -> mahjong.ai.v1.Wind
+	3,  // 15: mahjong.ai.v1.OpponentSafetyInfo.safety_class:type_name -> mahjong.ai.v1.SafetyClass
+	18, // 16: mahjong.ai.v1.TileSafetyInfo.against:type_name -> mahjong.ai.v1.OpponentSafetyInfo
+	19, // 17: mahjong.ai.v1.SafetyResult.tiles:type_name -> mahjong.ai.v1.TileSafetyInfo
+	4,  // 18: mahjong.ai.v1.PushFoldOptionInfo.action:type_name -> mahjong.ai.v1.PushFoldAction
+	1,  // 19: mahjong.ai.v1.ThreatInfo.seat:type_name -> mahjong.ai.v1.Wind
+	4,  // 20: mahjong.ai.v1.PushFoldResult.recommendation:type_name -> mahjong.ai.v1.PushFoldAction
+	21, // 21: mahjong.ai.v1.PushFoldResult.options:type_name -> mahjong.ai.v1.PushFoldOptionInfo
+	22, // 22: mahjong.ai.v1.PushFoldResult.threats:type_name -> mahjong.ai.v1.ThreatInfo
+	24, // 23: mahjong.ai.v1.PlacementResult.standings:type_name -> mahjong.ai.v1.PlacementStandingInfo
+	25, // 24: mahjong.ai.v1.PlacementResult.requirements:type_name -> mahjong.ai.v1.PlacementRequirementInfo
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
+}
+
+func init() { file_mahjong_ai_v1_analysis_proto_init() }
+func file_mahjong_ai_v1_analysis_proto_init() {
+	if File_mahjong_ai_v1_analysis_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_mahjong_ai_v1_analysis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Meld); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*YakuInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgariInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FuritenInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WaitsResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UkeireTileInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationTurnInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimulationResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardCandidateInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpponentInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpponentSafetyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TileSafetyInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SafetyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushFoldOptionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreatInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushFoldResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementStandingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementRequirementInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_analysis_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlacementResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mahjong_ai_v1_analysis_proto_rawDesc,
+			NumEnums:      5,
+			NumMessages:   22,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mahjong_ai_v1_analysis_proto_goTypes,
+		DependencyIndexes: file_mahjong_ai_v1_analysis_proto_depIdxs,
+		EnumInfos:         file_mahjong_ai_v1_analysis_proto_enumTypes,
+		MessageInfos:      file_mahjong_ai_v1_analysis_proto_msgTypes,
+	}.Build()
+	File_mahjong_ai_v1_analysis_proto = out.File
+	file_mahjong_ai_v1_analysis_proto_rawDesc = nil
+	file_mahjong_ai_v1_analysis_proto_goTypes = nil
+	file_mahjong_ai_v1_analysis_proto_depIdxs = nil
+}
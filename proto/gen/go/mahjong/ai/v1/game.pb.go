@@ -0,0 +1,3309 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: mahjong/ai/v1/game.proto
+
+package aiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 牌譜の形式
+type GameLogFormat int32
+
+const (
+	GameLogFormat_GAME_LOG_FORMAT_UNSPECIFIED GameLogFormat = 0 // 内容から判定する
+	GameLogFormat_GAME_LOG_FORMAT_TENHOU_JSON GameLogFormat = 1 // 天鳳のJSON形式（tenhou.net/6）
+	GameLogFormat_GAME_LOG_FORMAT_MJLOG       GameLogFormat = 2 // 天鳳のXML形式（mjlog）
+)
+
+// Enum value maps for GameLogFormat.
+var (
+	GameLogFormat_name = map[int32]string{
+		0: "GAME_LOG_FORMAT_UNSPECIFIED",
+		1: "GAME_LOG_FORMAT_TENHOU_JSON",
+		2: "GAME_LOG_FORMAT_MJLOG",
+	}
+	GameLogFormat_value = map[string]int32{
+		"GAME_LOG_FORMAT_UNSPECIFIED": 0,
+		"GAME_LOG_FORMAT_TENHOU_JSON": 1,
+		"GAME_LOG_FORMAT_MJLOG":       2,
+	}
+)
+
+func (x GameLogFormat) Enum() *GameLogFormat {
+	p := new(GameLogFormat)
+	*p = x
+	return p
+}
+
+func (x GameLogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameLogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_game_proto_enumTypes[0].Descriptor()
+}
+
+func (GameLogFormat) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_game_proto_enumTypes[0]
+}
+
+func (x GameLogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameLogFormat.Descriptor instead.
+func (GameLogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{0}
+}
+
+// 打牌の判断を評価した観点
+type DecisionKind int32
+
+const (
+	DecisionKind_DECISION_KIND_UNSPECIFIED DecisionKind = 0
+	DecisionKind_DECISION_KIND_EFFICIENCY  DecisionKind = 1 // 牌効率（立直者がいない場合）
+	DecisionKind_DECISION_KIND_PUSH_FOLD   DecisionKind = 2 // 押し引き・安全度（立直者がいる場合）
+)
+
+// Enum value maps for DecisionKind.
+var (
+	DecisionKind_name = map[int32]string{
+		0: "DECISION_KIND_UNSPECIFIED",
+		1: "DECISION_KIND_EFFICIENCY",
+		2: "DECISION_KIND_PUSH_FOLD",
+	}
+	DecisionKind_value = map[string]int32{
+		"DECISION_KIND_UNSPECIFIED": 0,
+		"DECISION_KIND_EFFICIENCY":  1,
+		"DECISION_KIND_PUSH_FOLD":   2,
+	}
+)
+
+func (x DecisionKind) Enum() *DecisionKind {
+	p := new(DecisionKind)
+	*p = x
+	return p
+}
+
+func (x DecisionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DecisionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_game_proto_enumTypes[1].Descriptor()
+}
+
+func (DecisionKind) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_game_proto_enumTypes[1]
+}
+
+func (x DecisionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DecisionKind.Descriptor instead.
+func (DecisionKind) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
+// 悪手の重大度
+type MistakeSeverity int32
+
+const (
+	MistakeSeverity_MISTAKE_SEVERITY_UNSPECIFIED MistakeSeverity = 0
+	MistakeSeverity_MISTAKE_SEVERITY_MINOR       MistakeSeverity = 1 // 軽微（損失の目安300点以上）
+	MistakeSeverity_MISTAKE_SEVERITY_MODERATE    MistakeSeverity = 2 // 中程度（800点以上）
+	MistakeSeverity_MISTAKE_SEVERITY_SEVERE      MistakeSeverity = 3 // 重大（2000点以上）
+)
+
+// Enum value maps for MistakeSeverity.
+var (
+	MistakeSeverity_name = map[int32]string{
+		0: "MISTAKE_SEVERITY_UNSPECIFIED",
+		1: "MISTAKE_SEVERITY_MINOR",
+		2: "MISTAKE_SEVERITY_MODERATE",
+		3: "MISTAKE_SEVERITY_SEVERE",
+	}
+	MistakeSeverity_value = map[string]int32{
+		"MISTAKE_SEVERITY_UNSPECIFIED": 0,
+		"MISTAKE_SEVERITY_MINOR":       1,
+		"MISTAKE_SEVERITY_MODERATE":    2,
+		"MISTAKE_SEVERITY_SEVERE":      3,
+	}
+)
+
+func (x MistakeSeverity) Enum() *MistakeSeverity {
+	p := new(MistakeSeverity)
+	*p = x
+	return p
+}
+
+func (x MistakeSeverity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MistakeSeverity) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_game_proto_enumTypes[2].Descriptor()
+}
+
+func (MistakeSeverity) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_game_proto_enumTypes[2]
+}
+
+func (x MistakeSeverity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MistakeSeverity.Descriptor instead.
+func (MistakeSeverity) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{2}
+}
+
+// 検討の進み具合
+type ReviewStatus int32
+
+const (
+	ReviewStatus_REVIEW_STATUS_UNSPECIFIED ReviewStatus = 0
+	ReviewStatus_REVIEW_STATUS_PENDING     ReviewStatus = 1 // 開始前
+	ReviewStatus_REVIEW_STATUS_RUNNING     ReviewStatus = 2 // エンジンによる評価・AIによる解説の作成中
+	ReviewStatus_REVIEW_STATUS_COMPLETED   ReviewStatus = 3 // 完了
+	ReviewStatus_REVIEW_STATUS_FAILED      ReviewStatus = 4 // 失敗
+)
+
+// Enum value maps for ReviewStatus.
+var (
+	ReviewStatus_name = map[int32]string{
+		0: "REVIEW_STATUS_UNSPECIFIED",
+		1: "REVIEW_STATUS_PENDING",
+		2: "REVIEW_STATUS_RUNNING",
+		3: "REVIEW_STATUS_COMPLETED",
+		4: "REVIEW_STATUS_FAILED",
+	}
+	ReviewStatus_value = map[string]int32{
+		"REVIEW_STATUS_UNSPECIFIED": 0,
+		"REVIEW_STATUS_PENDING":     1,
+		"REVIEW_STATUS_RUNNING":     2,
+		"REVIEW_STATUS_COMPLETED":   3,
+		"REVIEW_STATUS_FAILED":      4,
+	}
+)
+
+func (x ReviewStatus) Enum() *ReviewStatus {
+	p := new(ReviewStatus)
+	*p = x
+	return p
+}
+
+func (x ReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_game_proto_enumTypes[3].Descriptor()
+}
+
+func (ReviewStatus) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_game_proto_enumTypes[3]
+}
+
+func (x ReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReviewStatus.Descriptor instead.
+func (ReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+// 推奨する行動
+type CoachAction int32
+
+const (
+	CoachAction_COACH_ACTION_UNSPECIFIED CoachAction = 0
+	CoachAction_COACH_ACTION_DISCARD     CoachAction = 1 // 打牌
+	CoachAction_COACH_ACTION_RIICHI      CoachAction = 2 // 立直（tile は宣言牌）
+	CoachAction_COACH_ACTION_TSUMO       CoachAction = 3 // ツモ和了
+	CoachAction_COACH_ACTION_RON         CoachAction = 4 // ロン和了
+)
+
+// Enum value maps for CoachAction.
+var (
+	CoachAction_name = map[int32]string{
+		0: "COACH_ACTION_UNSPECIFIED",
+		1: "COACH_ACTION_DISCARD",
+		2: "COACH_ACTION_RIICHI",
+		3: "COACH_ACTION_TSUMO",
+		4: "COACH_ACTION_RON",
+	}
+	CoachAction_value = map[string]int32{
+		"COACH_ACTION_UNSPECIFIED": 0,
+		"COACH_ACTION_DISCARD":     1,
+		"COACH_ACTION_RIICHI":      2,
+		"COACH_ACTION_TSUMO":       3,
+		"COACH_ACTION_RON":         4,
+	}
+)
+
+func (x CoachAction) Enum() *CoachAction {
+	p := new(CoachAction)
+	*p = x
+	return p
+}
+
+func (x CoachAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoachAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_game_proto_enumTypes[4].Descriptor()
+}
+
+func (CoachAction) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_game_proto_enumTypes[4]
+}
+
+func (x CoachAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoachAction.Descriptor instead.
+func (CoachAction) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{4}
+}
+
+// 警告の種類
+type CoachWarningKind int32
+
+const (
+	CoachWarningKind_COACH_WARNING_KIND_UNSPECIFIED     CoachWarningKind = 0
+	CoachWarningKind_COACH_WARNING_KIND_OPPONENT_TENPAI CoachWarningKind = 1 // 他家が聴牌している可能性が高い
+	CoachWarningKind_COACH_WARNING_KIND_FURITEN         CoachWarningKind = 2 // 自分がフリテン
+	CoachWarningKind_COACH_WARNING_KIND_NO_YAKU         CoachWarningKind = 3 // 自分の聴牌にロンで和了できる役がない
+)
+
+// Enum value maps for CoachWarningKind.
+var (
+	CoachWarningKind_name = map[int32]string{
+		0: "COACH_WARNING_KIND_UNSPECIFIED",
+		1: "COACH_WARNING_KIND_OPPONENT_TENPAI",
+		2: "COACH_WARNING_KIND_FURITEN",
+		3: "COACH_WARNING_KIND_NO_YAKU",
+	}
+	CoachWarningKind_value = map[string]int32{
+		"COACH_WARNING_KIND_UNSPECIFIED":     0,
+		"COACH_WARNING_KIND_OPPONENT_TENPAI": 1,
+		"COACH_WARNING_KIND_FURITEN":         2,
+		"COACH_WARNING_KIND_NO_YAKU":         3,
+	}
+)
+
+func (x CoachWarningKind) Enum() *CoachWarningKind {
+	p := new(CoachWarningKind)
+	*p = x
+	return p
+}
+
+func (x CoachWarningKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CoachWarningKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_mahjong_ai_v1_game_proto_enumTypes[5].Descriptor()
+}
+
+func (CoachWarningKind) Type() protoreflect.EnumType {
+	return &file_mahjong_ai_v1_game_proto_enumTypes[5]
+}
+
+func (x CoachWarningKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CoachWarningKind.Descriptor instead.
+func (CoachWarningKind) EnumDescriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+// 河の1枚
+type DiscardedTile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tile      string `protobuf:"bytes,1,opt,name=tile,proto3" json:"tile,omitempty"`            // 牌（1枚のMPSZ表記）
+	Tsumogiri bool   `protobuf:"varint,2,opt,name=tsumogiri,proto3" json:"tsumogiri,omitempty"` // ツモ切りか
+	Called    bool   `protobuf:"varint,3,opt,name=called,proto3" json:"called,omitempty"`       // 他家に鳴かれたか
+	Riichi    bool   `protobuf:"varint,4,opt,name=riichi,proto3" json:"riichi,omitempty"`       // 立直宣言牌か
+}
+
+func (x *DiscardedTile) Reset() {
+	*x = DiscardedTile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiscardedTile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscardedTile) ProtoMessage() {}
+
+func (x *DiscardedTile) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscardedTile.ProtoReflect.Descriptor instead.
+func (*DiscardedTile) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiscardedTile) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *DiscardedTile) GetTsumogiri() bool {
+	if x != nil {
+		return x.Tsumogiri
+	}
+	return false
+}
+
+func (x *DiscardedTile) GetCalled() bool {
+	if x != nil {
+		return x.Called
+	}
+	return false
+}
+
+func (x *DiscardedTile) GetRiichi() bool {
+	if x != nil {
+		return x.Riichi
+	}
+	return false
+}
+
+// プレイヤーの状況
+type PlayerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seat     Wind             `protobuf:"varint,1,opt,name=seat,proto3,enum=mahjong.ai.v1.Wind" json:"seat,omitempty"` // 自風
+	Name     string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                          // プレイヤー名
+	Score    int32            `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`                       // 持ち点
+	Hand     string           `protobuf:"bytes,4,opt,name=hand,proto3" json:"hand,omitempty"`                          // 門前の牌（分かる場合のみ。通常は自分のみ）
+	Melds    []*Meld          `protobuf:"bytes,5,rep,name=melds,proto3" json:"melds,omitempty"`                        // 副露
+	Discards []*DiscardedTile `protobuf:"bytes,6,rep,name=discards,proto3" json:"discards,omitempty"`                  // 河（捨てた順）
+}
+
+func (x *PlayerState) Reset() {
+	*x = PlayerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerState) ProtoMessage() {}
+
+func (x *PlayerState) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerState.ProtoReflect.Descriptor instead.
+func (*PlayerState) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlayerState) GetSeat() Wind {
+	if x != nil {
+		return x.Seat
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *PlayerState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerState) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *PlayerState) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *PlayerState) GetMelds() []*Meld {
+	if x != nil {
+		return x.Melds
+	}
+	return nil
+}
+
+func (x *PlayerState) GetDiscards() []*DiscardedTile {
+	if x != nil {
+		return x.Discards
+	}
+	return nil
+}
+
+// 局の状況
+type GameState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundWind      Wind           `protobuf:"varint,1,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"` // 場風
+	Kyoku          int32          `protobuf:"varint,2,opt,name=kyoku,proto3" json:"kyoku,omitempty"`                                                  // 局（1始まり）
+	Honba          int32          `protobuf:"varint,3,opt,name=honba,proto3" json:"honba,omitempty"`                                                  // 積み棒の本数
+	RiichiSticks   int32          `protobuf:"varint,4,opt,name=riichi_sticks,json=riichiSticks,proto3" json:"riichi_sticks,omitempty"`                // 供託の立直棒の本数
+	DoraIndicators string         `protobuf:"bytes,5,opt,name=dora_indicators,json=doraIndicators,proto3" json:"dora_indicators,omitempty"`           // ドラ表示牌
+	Players        []*PlayerState `protobuf:"bytes,6,rep,name=players,proto3" json:"players,omitempty"`                                               // プレイヤー（四麻は4人、三麻は3人）
+	SelfSeat       Wind           `protobuf:"varint,7,opt,name=self_seat,json=selfSeat,proto3,enum=mahjong.ai.v1.Wind" json:"self_seat,omitempty"`    // 質問者の自風（未指定の場合は観戦者として扱う）
+	WallRemaining  int32          `protobuf:"varint,8,opt,name=wall_remaining,json=wallRemaining,proto3" json:"wall_remaining,omitempty"`             // 山の残り枚数
+}
+
+func (x *GameState) Reset() {
+	*x = GameState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameState) ProtoMessage() {}
+
+func (x *GameState) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameState.ProtoReflect.Descriptor instead.
+func (*GameState) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GameState) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *GameState) GetKyoku() int32 {
+	if x != nil {
+		return x.Kyoku
+	}
+	return 0
+}
+
+func (x *GameState) GetHonba() int32 {
+	if x != nil {
+		return x.Honba
+	}
+	return 0
+}
+
+func (x *GameState) GetRiichiSticks() int32 {
+	if x != nil {
+		return x.RiichiSticks
+	}
+	return 0
+}
+
+func (x *GameState) GetDoraIndicators() string {
+	if x != nil {
+		return x.DoraIndicators
+	}
+	return ""
+}
+
+func (x *GameState) GetPlayers() []*PlayerState {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameState) GetSelfSeat() Wind {
+	if x != nil {
+		return x.SelfSeat
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *GameState) GetWallRemaining() int32 {
+	if x != nil {
+		return x.WallRemaining
+	}
+	return 0
+}
+
+// 牌譜の1局
+type GameLogRound struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        int32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                                  // 局の番号（0始まり）
+	Name         string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                     // 局の名前（例: 東1局 0本場）
+	RoundWind    Wind    `protobuf:"varint,3,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"` // 場風
+	Kyoku        int32   `protobuf:"varint,4,opt,name=kyoku,proto3" json:"kyoku,omitempty"`                                                  // 局（1始まり）
+	Honba        int32   `protobuf:"varint,5,opt,name=honba,proto3" json:"honba,omitempty"`                                                  // 積み棒の本数
+	RiichiSticks int32   `protobuf:"varint,6,opt,name=riichi_sticks,json=riichiSticks,proto3" json:"riichi_sticks,omitempty"`                // 局の開始時の供託の立直棒の本数
+	Scores       []int32 `protobuf:"varint,7,rep,packed,name=scores,proto3" json:"scores,omitempty"`                                         // 局の開始時の持ち点（起家からの席順）
+	Steps        int32   `protobuf:"varint,8,opt,name=steps,proto3" json:"steps,omitempty"`                                                  // 打牌の判断の数
+	Result       string  `protobuf:"bytes,9,opt,name=result,proto3" json:"result,omitempty"`                                                 // 局の結果（例: 和了・流局）
+}
+
+func (x *GameLogRound) Reset() {
+	*x = GameLogRound{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameLogRound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameLogRound) ProtoMessage() {}
+
+func (x *GameLogRound) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameLogRound.ProtoReflect.Descriptor instead.
+func (*GameLogRound) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GameLogRound) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GameLogRound) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameLogRound) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *GameLogRound) GetKyoku() int32 {
+	if x != nil {
+		return x.Kyoku
+	}
+	return 0
+}
+
+func (x *GameLogRound) GetHonba() int32 {
+	if x != nil {
+		return x.Honba
+	}
+	return 0
+}
+
+func (x *GameLogRound) GetRiichiSticks() int32 {
+	if x != nil {
+		return x.RiichiSticks
+	}
+	return 0
+}
+
+func (x *GameLogRound) GetScores() []int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *GameLogRound) GetSteps() int32 {
+	if x != nil {
+		return x.Steps
+	}
+	return 0
+}
+
+func (x *GameLogRound) GetResult() string {
+	if x != nil {
+		return x.Result
+	}
+	return ""
+}
+
+// 取り込んだ牌譜
+type GameLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId  string          `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`    // 牌譜ID（GetGameLogStep・AskMahjongAI で局面を指定する）
+	Title   string          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`                    // 対局の名前
+	Players []string        `protobuf:"bytes,3,rep,name=players,proto3" json:"players,omitempty"`                // プレイヤー名（起家からの席順）
+	Sanma   bool            `protobuf:"varint,4,opt,name=sanma,proto3" json:"sanma,omitempty"`                   // 三人麻雀か
+	RuleSet string          `protobuf:"bytes,5,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"` // 対局のルールに最も近いルールセット名
+	Rounds  []*GameLogRound `protobuf:"bytes,6,rep,name=rounds,proto3" json:"rounds,omitempty"`                  // 局の一覧
+}
+
+func (x *GameLog) Reset() {
+	*x = GameLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameLog) ProtoMessage() {}
+
+func (x *GameLog) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameLog.ProtoReflect.Descriptor instead.
+func (*GameLog) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GameLog) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameLog) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *GameLog) GetPlayers() []string {
+	if x != nil {
+		return x.Players
+	}
+	return nil
+}
+
+func (x *GameLog) GetSanma() bool {
+	if x != nil {
+		return x.Sanma
+	}
+	return false
+}
+
+func (x *GameLog) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *GameLog) GetRounds() []*GameLogRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+// 牌譜の中の打牌の判断の位置
+type GameLogPosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GameId string `protobuf:"bytes,1,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"` // 牌譜ID
+	Round  int32  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`                // 局の番号（0始まり）
+	Step   int32  `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`                  // 局の中の打牌の番号（0始まり）
+}
+
+func (x *GameLogPosition) Reset() {
+	*x = GameLogPosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameLogPosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameLogPosition) ProtoMessage() {}
+
+func (x *GameLogPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameLogPosition.ProtoReflect.Descriptor instead.
+func (*GameLogPosition) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GameLogPosition) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameLogPosition) GetRound() int32 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *GameLogPosition) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+// 牌譜の打牌の判断
+type GameLogStep struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position  *GameLogPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`                  // 打牌の判断の位置
+	Player    int32            `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`                     // 打牌者（起家からの席順）
+	Seat      Wind             `protobuf:"varint,3,opt,name=seat,proto3,enum=mahjong.ai.v1.Wind" json:"seat,omitempty"` // 打牌者の自風
+	Turn      int32            `protobuf:"varint,4,opt,name=turn,proto3" json:"turn,omitempty"`                         // 打牌者の何巡目の打牌か（1始まり）
+	State     *GameState       `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`                        // 打牌の直前の局面（手牌は打牌者の分のみ）
+	Discard   string           `protobuf:"bytes,6,opt,name=discard,proto3" json:"discard,omitempty"`                    // 実際の打牌
+	Tsumogiri bool             `protobuf:"varint,7,opt,name=tsumogiri,proto3" json:"tsumogiri,omitempty"`               // 実際の打牌がツモ切りか
+	Riichi    bool             `protobuf:"varint,8,opt,name=riichi,proto3" json:"riichi,omitempty"`                     // 実際の打牌で立直を宣言したか
+}
+
+func (x *GameLogStep) Reset() {
+	*x = GameLogStep{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameLogStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameLogStep) ProtoMessage() {}
+
+func (x *GameLogStep) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameLogStep.ProtoReflect.Descriptor instead.
+func (*GameLogStep) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GameLogStep) GetPosition() *GameLogPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *GameLogStep) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *GameLogStep) GetSeat() Wind {
+	if x != nil {
+		return x.Seat
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *GameLogStep) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *GameLogStep) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GameLogStep) GetDiscard() string {
+	if x != nil {
+		return x.Discard
+	}
+	return ""
+}
+
+func (x *GameLogStep) GetTsumogiri() bool {
+	if x != nil {
+		return x.Tsumogiri
+	}
+	return false
+}
+
+func (x *GameLogStep) GetRiichi() bool {
+	if x != nil {
+		return x.Riichi
+	}
+	return false
+}
+
+// 検討で指摘した悪手
+type ReviewMistake struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position    *GameLogPosition `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`                                     // 打牌の判断の位置（GetGameLogStep・AskMahjongAI で参照できる）
+	RoundName   string           `protobuf:"bytes,2,opt,name=round_name,json=roundName,proto3" json:"round_name,omitempty"`                  // 局の名前（例: 東1局 0本場）
+	Turn        int32            `protobuf:"varint,3,opt,name=turn,proto3" json:"turn,omitempty"`                                            // 何巡目の打牌か（1始まり）
+	Kind        DecisionKind     `protobuf:"varint,4,opt,name=kind,proto3,enum=mahjong.ai.v1.DecisionKind" json:"kind,omitempty"`            // 評価した観点
+	Severity    MistakeSeverity  `protobuf:"varint,5,opt,name=severity,proto3,enum=mahjong.ai.v1.MistakeSeverity" json:"severity,omitempty"` // 重大度
+	Actual      string           `protobuf:"bytes,6,opt,name=actual,proto3" json:"actual,omitempty"`                                         // 実際の打牌
+	Recommended string           `protobuf:"bytes,7,opt,name=recommended,proto3" json:"recommended,omitempty"`                               // エンジンが推奨する打牌
+	Loss        int32            `protobuf:"varint,8,opt,name=loss,proto3" json:"loss,omitempty"`                                            // 推奨する打牌と比べた損失の目安（点）
+	Evaluation  string           `protobuf:"bytes,9,opt,name=evaluation,proto3" json:"evaluation,omitempty"`                                 // エンジンによる評価の要約
+	Commentary  string           `protobuf:"bytes,10,opt,name=commentary,proto3" json:"commentary,omitempty"`                                // AIによる解説（作成前や作成に失敗した場合は空）
+}
+
+func (x *ReviewMistake) Reset() {
+	*x = ReviewMistake{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewMistake) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewMistake) ProtoMessage() {}
+
+func (x *ReviewMistake) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewMistake.ProtoReflect.Descriptor instead.
+func (*ReviewMistake) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReviewMistake) GetPosition() *GameLogPosition {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *ReviewMistake) GetRoundName() string {
+	if x != nil {
+		return x.RoundName
+	}
+	return ""
+}
+
+func (x *ReviewMistake) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *ReviewMistake) GetKind() DecisionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DecisionKind_DECISION_KIND_UNSPECIFIED
+}
+
+func (x *ReviewMistake) GetSeverity() MistakeSeverity {
+	if x != nil {
+		return x.Severity
+	}
+	return MistakeSeverity_MISTAKE_SEVERITY_UNSPECIFIED
+}
+
+func (x *ReviewMistake) GetActual() string {
+	if x != nil {
+		return x.Actual
+	}
+	return ""
+}
+
+func (x *ReviewMistake) GetRecommended() string {
+	if x != nil {
+		return x.Recommended
+	}
+	return ""
+}
+
+func (x *ReviewMistake) GetLoss() int32 {
+	if x != nil {
+		return x.Loss
+	}
+	return 0
+}
+
+func (x *ReviewMistake) GetEvaluation() string {
+	if x != nil {
+		return x.Evaluation
+	}
+	return ""
+}
+
+func (x *ReviewMistake) GetCommentary() string {
+	if x != nil {
+		return x.Commentary
+	}
+	return ""
+}
+
+// 牌譜の検討結果
+type GameReview struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewId   string           `protobuf:"bytes,1,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`              // 検討ID
+	GameId     string           `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`                    // 牌譜ID
+	Player     int32            `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`                                 // 検討するプレイヤー（起家からの席順）
+	PlayerName string           `protobuf:"bytes,4,opt,name=player_name,json=playerName,proto3" json:"player_name,omitempty"`        // プレイヤー名
+	Status     ReviewStatus     `protobuf:"varint,5,opt,name=status,proto3,enum=mahjong.ai.v1.ReviewStatus" json:"status,omitempty"` // 進み具合
+	Error      string           `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                                    // 失敗した理由
+	Evaluated  int32            `protobuf:"varint,7,opt,name=evaluated,proto3" json:"evaluated,omitempty"`                           // エンジンで評価した打牌の判断の数
+	Total      int32            `protobuf:"varint,8,opt,name=total,proto3" json:"total,omitempty"`                                   // 打牌の判断の数
+	Mistakes   []*ReviewMistake `protobuf:"bytes,9,rep,name=mistakes,proto3" json:"mistakes,omitempty"`                              // 損失の大きい順の悪手
+}
+
+func (x *GameReview) Reset() {
+	*x = GameReview{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameReview) ProtoMessage() {}
+
+func (x *GameReview) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameReview.ProtoReflect.Descriptor instead.
+func (*GameReview) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GameReview) GetReviewId() string {
+	if x != nil {
+		return x.ReviewId
+	}
+	return ""
+}
+
+func (x *GameReview) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *GameReview) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *GameReview) GetPlayerName() string {
+	if x != nil {
+		return x.PlayerName
+	}
+	return ""
+}
+
+func (x *GameReview) GetStatus() ReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReviewStatus_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *GameReview) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *GameReview) GetEvaluated() int32 {
+	if x != nil {
+		return x.Evaluated
+	}
+	return 0
+}
+
+func (x *GameReview) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GameReview) GetMistakes() []*ReviewMistake {
+	if x != nil {
+		return x.Mistakes
+	}
+	return nil
+}
+
+// コーチングの対局の開始
+type CoachGameStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Self        int32    `protobuf:"varint,1,opt,name=self,proto3" json:"self,omitempty"`                                 // 自分の席（起家からの席順）
+	PlayerNames []string `protobuf:"bytes,2,rep,name=player_names,json=playerNames,proto3" json:"player_names,omitempty"` // プレイヤー名（起家からの席順）
+	Explain     bool     `protobuf:"varint,3,opt,name=explain,proto3" json:"explain,omitempty"`                           // 推奨のたびにAIに解説させるか
+	Persona     string   `protobuf:"bytes,4,opt,name=persona,proto3" json:"persona,omitempty"`                            // 解説に使うペルソナ名（空の場合はデフォルト）
+}
+
+func (x *CoachGameStart) Reset() {
+	*x = CoachGameStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachGameStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachGameStart) ProtoMessage() {}
+
+func (x *CoachGameStart) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachGameStart.ProtoReflect.Descriptor instead.
+func (*CoachGameStart) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CoachGameStart) GetSelf() int32 {
+	if x != nil {
+		return x.Self
+	}
+	return 0
+}
+
+func (x *CoachGameStart) GetPlayerNames() []string {
+	if x != nil {
+		return x.PlayerNames
+	}
+	return nil
+}
+
+func (x *CoachGameStart) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+func (x *CoachGameStart) GetPersona() string {
+	if x != nil {
+		return x.Persona
+	}
+	return ""
+}
+
+// コーチングの局の開始
+type CoachRoundStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoundWind     Wind    `protobuf:"varint,1,opt,name=round_wind,json=roundWind,proto3,enum=mahjong.ai.v1.Wind" json:"round_wind,omitempty"` // 場風
+	Kyoku         int32   `protobuf:"varint,2,opt,name=kyoku,proto3" json:"kyoku,omitempty"`                                                  // 局（1始まり）
+	Honba         int32   `protobuf:"varint,3,opt,name=honba,proto3" json:"honba,omitempty"`                                                  // 積み棒の本数
+	RiichiSticks  int32   `protobuf:"varint,4,opt,name=riichi_sticks,json=riichiSticks,proto3" json:"riichi_sticks,omitempty"`                // 供託の立直棒の本数
+	Scores        []int32 `protobuf:"varint,5,rep,packed,name=scores,proto3" json:"scores,omitempty"`                                         // 持ち点（起家からの席順）
+	DoraIndicator string  `protobuf:"bytes,6,opt,name=dora_indicator,json=doraIndicator,proto3" json:"dora_indicator,omitempty"`              // ドラ表示牌
+	Hand          string  `protobuf:"bytes,7,opt,name=hand,proto3" json:"hand,omitempty"`                                                     // 自分の配牌（13枚、0 は赤5）
+}
+
+func (x *CoachRoundStart) Reset() {
+	*x = CoachRoundStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachRoundStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachRoundStart) ProtoMessage() {}
+
+func (x *CoachRoundStart) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachRoundStart.ProtoReflect.Descriptor instead.
+func (*CoachRoundStart) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CoachRoundStart) GetRoundWind() Wind {
+	if x != nil {
+		return x.RoundWind
+	}
+	return Wind_WIND_UNSPECIFIED
+}
+
+func (x *CoachRoundStart) GetKyoku() int32 {
+	if x != nil {
+		return x.Kyoku
+	}
+	return 0
+}
+
+func (x *CoachRoundStart) GetHonba() int32 {
+	if x != nil {
+		return x.Honba
+	}
+	return 0
+}
+
+func (x *CoachRoundStart) GetRiichiSticks() int32 {
+	if x != nil {
+		return x.RiichiSticks
+	}
+	return 0
+}
+
+func (x *CoachRoundStart) GetScores() []int32 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *CoachRoundStart) GetDoraIndicator() string {
+	if x != nil {
+		return x.DoraIndicator
+	}
+	return ""
+}
+
+func (x *CoachRoundStart) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+// ツモ（嶺上牌を含む）
+type CoachDraw struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player int32  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"` // ツモしたプレイヤー（起家からの席順）
+	Tile   string `protobuf:"bytes,2,opt,name=tile,proto3" json:"tile,omitempty"`      // ツモ牌（自分のツモのみ、他家の場合は空）
+}
+
+func (x *CoachDraw) Reset() {
+	*x = CoachDraw{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachDraw) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachDraw) ProtoMessage() {}
+
+func (x *CoachDraw) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachDraw.ProtoReflect.Descriptor instead.
+func (*CoachDraw) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CoachDraw) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *CoachDraw) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+// 打牌
+type CoachDiscard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player    int32  `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`       // 打牌したプレイヤー（起家からの席順）
+	Tile      string `protobuf:"bytes,2,opt,name=tile,proto3" json:"tile,omitempty"`            // 打牌（0 は赤5）
+	Tsumogiri bool   `protobuf:"varint,3,opt,name=tsumogiri,proto3" json:"tsumogiri,omitempty"` // ツモ切りか
+}
+
+func (x *CoachDiscard) Reset() {
+	*x = CoachDiscard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachDiscard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachDiscard) ProtoMessage() {}
+
+func (x *CoachDiscard) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachDiscard.ProtoReflect.Descriptor instead.
+func (*CoachDiscard) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CoachDiscard) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *CoachDiscard) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *CoachDiscard) GetTsumogiri() bool {
+	if x != nil {
+		return x.Tsumogiri
+	}
+	return false
+}
+
+// 副露（チー・ポン・大明槓は直前の打牌を鳴く）
+type CoachCall struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player int32    `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"`                         // 副露したプレイヤー（起家からの席順）
+	Type   MeldType `protobuf:"varint,2,opt,name=type,proto3,enum=mahjong.ai.v1.MeldType" json:"type,omitempty"` // 副露の種類
+	Tiles  string   `protobuf:"bytes,3,opt,name=tiles,proto3" json:"tiles,omitempty"`                            // 副露の牌（鳴いた牌を含む。加槓は加えた1枚）
+}
+
+func (x *CoachCall) Reset() {
+	*x = CoachCall{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachCall) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachCall) ProtoMessage() {}
+
+func (x *CoachCall) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachCall.ProtoReflect.Descriptor instead.
+func (*CoachCall) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CoachCall) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *CoachCall) GetType() MeldType {
+	if x != nil {
+		return x.Type
+	}
+	return MeldType_MELD_TYPE_UNSPECIFIED
+}
+
+func (x *CoachCall) GetTiles() string {
+	if x != nil {
+		return x.Tiles
+	}
+	return ""
+}
+
+// 立直の宣言（続く打牌が宣言牌になる）
+type CoachRiichi struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player int32 `protobuf:"varint,1,opt,name=player,proto3" json:"player,omitempty"` // 立直したプレイヤー（起家からの席順）
+}
+
+func (x *CoachRiichi) Reset() {
+	*x = CoachRiichi{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachRiichi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachRiichi) ProtoMessage() {}
+
+func (x *CoachRiichi) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachRiichi.ProtoReflect.Descriptor instead.
+func (*CoachRiichi) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CoachRiichi) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+// 槓ドラの表示
+type CoachDora struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Indicator string `protobuf:"bytes,1,opt,name=indicator,proto3" json:"indicator,omitempty"` // ドラ表示牌
+}
+
+func (x *CoachDora) Reset() {
+	*x = CoachDora{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachDora) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachDora) ProtoMessage() {}
+
+func (x *CoachDora) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachDora.ProtoReflect.Descriptor instead.
+func (*CoachDora) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CoachDora) GetIndicator() string {
+	if x != nil {
+		return x.Indicator
+	}
+	return ""
+}
+
+// 局の終了
+type CoachRoundEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CoachRoundEnd) Reset() {
+	*x = CoachRoundEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachRoundEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachRoundEnd) ProtoMessage() {}
+
+func (x *CoachRoundEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachRoundEnd.ProtoReflect.Descriptor instead.
+func (*CoachRoundEnd) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{16}
+}
+
+// コーチングの対局の出来事
+type CoachEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//
+	//	*CoachEvent_GameStart
+	//	*CoachEvent_RoundStart
+	//	*CoachEvent_Draw
+	//	*CoachEvent_Discard
+	//	*CoachEvent_Call
+	//	*CoachEvent_Riichi
+	//	*CoachEvent_Dora
+	//	*CoachEvent_RoundEnd
+	Event isCoachEvent_Event `protobuf_oneof:"event"`
+}
+
+func (x *CoachEvent) Reset() {
+	*x = CoachEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachEvent) ProtoMessage() {}
+
+func (x *CoachEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachEvent.ProtoReflect.Descriptor instead.
+func (*CoachEvent) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *CoachEvent) GetEvent() isCoachEvent_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetGameStart() *CoachGameStart {
+	if x, ok := x.GetEvent().(*CoachEvent_GameStart); ok {
+		return x.GameStart
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetRoundStart() *CoachRoundStart {
+	if x, ok := x.GetEvent().(*CoachEvent_RoundStart); ok {
+		return x.RoundStart
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetDraw() *CoachDraw {
+	if x, ok := x.GetEvent().(*CoachEvent_Draw); ok {
+		return x.Draw
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetDiscard() *CoachDiscard {
+	if x, ok := x.GetEvent().(*CoachEvent_Discard); ok {
+		return x.Discard
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetCall() *CoachCall {
+	if x, ok := x.GetEvent().(*CoachEvent_Call); ok {
+		return x.Call
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetRiichi() *CoachRiichi {
+	if x, ok := x.GetEvent().(*CoachEvent_Riichi); ok {
+		return x.Riichi
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetDora() *CoachDora {
+	if x, ok := x.GetEvent().(*CoachEvent_Dora); ok {
+		return x.Dora
+	}
+	return nil
+}
+
+func (x *CoachEvent) GetRoundEnd() *CoachRoundEnd {
+	if x, ok := x.GetEvent().(*CoachEvent_RoundEnd); ok {
+		return x.RoundEnd
+	}
+	return nil
+}
+
+type isCoachEvent_Event interface {
+	isCoachEvent_Event()
+}
+
+type CoachEvent_GameStart struct {
+	GameStart *CoachGameStart `protobuf:"bytes,1,opt,name=game_start,json=gameStart,proto3,oneof"` // 対局の開始
+}
+
+type CoachEvent_RoundStart struct {
+	RoundStart *CoachRoundStart `protobuf:"bytes,2,opt,name=round_start,json=roundStart,proto3,oneof"` // 局の開始
+}
+
+type CoachEvent_Draw struct {
+	Draw *CoachDraw `protobuf:"bytes,3,opt,name=draw,proto3,oneof"` // ツモ
+}
+
+type CoachEvent_Discard struct {
+	Discard *CoachDiscard `protobuf:"bytes,4,opt,name=discard,proto3,oneof"` // 打牌
+}
+
+type CoachEvent_Call struct {
+	Call *CoachCall `protobuf:"bytes,5,opt,name=call,proto3,oneof"` // 副露
+}
+
+type CoachEvent_Riichi struct {
+	Riichi *CoachRiichi `protobuf:"bytes,6,opt,name=riichi,proto3,oneof"` // 立直の宣言
+}
+
+type CoachEvent_Dora struct {
+	Dora *CoachDora `protobuf:"bytes,7,opt,name=dora,proto3,oneof"` // 槓ドラの表示
+}
+
+type CoachEvent_RoundEnd struct {
+	RoundEnd *CoachRoundEnd `protobuf:"bytes,8,opt,name=round_end,json=roundEnd,proto3,oneof"` // 局の終了
+}
+
+func (*CoachEvent_GameStart) isCoachEvent_Event() {}
+
+func (*CoachEvent_RoundStart) isCoachEvent_Event() {}
+
+func (*CoachEvent_Draw) isCoachEvent_Event() {}
+
+func (*CoachEvent_Discard) isCoachEvent_Event() {}
+
+func (*CoachEvent_Call) isCoachEvent_Event() {}
+
+func (*CoachEvent_Riichi) isCoachEvent_Event() {}
+
+func (*CoachEvent_Dora) isCoachEvent_Event() {}
+
+func (*CoachEvent_RoundEnd) isCoachEvent_Event() {}
+
+// 解説の依頼
+type CoachExplainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Question string `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"` // 質問（空の場合は直前の推奨を解説する）
+}
+
+func (x *CoachExplainRequest) Reset() {
+	*x = CoachExplainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachExplainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachExplainRequest) ProtoMessage() {}
+
+func (x *CoachExplainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachExplainRequest.ProtoReflect.Descriptor instead.
+func (*CoachExplainRequest) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CoachExplainRequest) GetQuestion() string {
+	if x != nil {
+		return x.Question
+	}
+	return ""
+}
+
+// エンジンによる推奨
+type CoachAdvice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action CoachAction `protobuf:"varint,1,opt,name=action,proto3,enum=mahjong.ai.v1.CoachAction" json:"action,omitempty"` // 推奨する行動
+	Tile   string      `protobuf:"bytes,2,opt,name=tile,proto3" json:"tile,omitempty"`                                     // 打牌・宣言牌・和了牌
+	Reason string      `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                                 // 推奨の理由の要約（和了の場合は空）
+	State  *GameState  `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`                                   // 推奨した局面（自分の視点）
+}
+
+func (x *CoachAdvice) Reset() {
+	*x = CoachAdvice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachAdvice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachAdvice) ProtoMessage() {}
+
+func (x *CoachAdvice) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachAdvice.ProtoReflect.Descriptor instead.
+func (*CoachAdvice) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CoachAdvice) GetAction() CoachAction {
+	if x != nil {
+		return x.Action
+	}
+	return CoachAction_COACH_ACTION_UNSPECIFIED
+}
+
+func (x *CoachAdvice) GetTile() string {
+	if x != nil {
+		return x.Tile
+	}
+	return ""
+}
+
+func (x *CoachAdvice) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CoachAdvice) GetState() *GameState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// 状況の変化の警告（状況が新たに当てはまったときに1回だけ送る）
+type CoachWarning struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind    CoachWarningKind `protobuf:"varint,1,opt,name=kind,proto3,enum=mahjong.ai.v1.CoachWarningKind" json:"kind,omitempty"` // 種類
+	Player  int32            `protobuf:"varint,2,opt,name=player,proto3" json:"player,omitempty"`                                 // 対象のプレイヤー（起家からの席順）
+	Message string           `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                                // 内容
+}
+
+func (x *CoachWarning) Reset() {
+	*x = CoachWarning{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachWarning) ProtoMessage() {}
+
+func (x *CoachWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachWarning.ProtoReflect.Descriptor instead.
+func (*CoachWarning) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CoachWarning) GetKind() CoachWarningKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CoachWarningKind_COACH_WARNING_KIND_UNSPECIFIED
+}
+
+func (x *CoachWarning) GetPlayer() int32 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *CoachWarning) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// AIによる解説の一部
+type CoachExplanation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExplanationId string `protobuf:"bytes,1,opt,name=explanation_id,json=explanationId,proto3" json:"explanation_id,omitempty"` // 解説ID（同じ解説の一部には同じIDが付く）
+	TextChunk     string `protobuf:"bytes,2,opt,name=text_chunk,json=textChunk,proto3" json:"text_chunk,omitempty"`             // テキストチャンク
+	Done          bool   `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`                                       // 解説の最後か
+	Cancelled     bool   `protobuf:"varint,4,opt,name=cancelled,proto3" json:"cancelled,omitempty"`                             // 新しい出来事が届いたため解説を打ち切ったか
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`                                      // 解説の作成に失敗した理由
+}
+
+func (x *CoachExplanation) Reset() {
+	*x = CoachExplanation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CoachExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CoachExplanation) ProtoMessage() {}
+
+func (x *CoachExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CoachExplanation.ProtoReflect.Descriptor instead.
+func (*CoachExplanation) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CoachExplanation) GetExplanationId() string {
+	if x != nil {
+		return x.ExplanationId
+	}
+	return ""
+}
+
+func (x *CoachExplanation) GetTextChunk() string {
+	if x != nil {
+		return x.TextChunk
+	}
+	return ""
+}
+
+func (x *CoachExplanation) GetDone() bool {
+	if x != nil {
+		return x.Done
+	}
+	return false
+}
+
+func (x *CoachExplanation) GetCancelled() bool {
+	if x != nil {
+		return x.Cancelled
+	}
+	return false
+}
+
+func (x *CoachExplanation) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// 何切る問題
+type Quiz struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuizId    string     `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`          // 問題ID
+	GameState *GameState `protobuf:"bytes,2,opt,name=game_state,json=gameState,proto3" json:"game_state,omitempty"` // 出題する局面（自分の手牌はツモ後の14枚、他家の手牌は含まない。RenderHand で卓の図にできる）
+	Hand      string     `protobuf:"bytes,3,opt,name=hand,proto3" json:"hand,omitempty"`                            // 自分の手牌（MPSZ表記、0 は赤5）
+	Turn      int32      `protobuf:"varint,4,opt,name=turn,proto3" json:"turn,omitempty"`                           // 何巡目のツモか（1始まり）
+	RuleSet   string     `protobuf:"bytes,5,opt,name=rule_set,json=ruleSet,proto3" json:"rule_set,omitempty"`       // ルールセット名
+	Date      string     `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`                            // 日替わりの問題の日付（YYYY-MM-DD、日替わりでない場合は空）
+}
+
+func (x *Quiz) Reset() {
+	*x = Quiz{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quiz) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quiz) ProtoMessage() {}
+
+func (x *Quiz) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quiz.ProtoReflect.Descriptor instead.
+func (*Quiz) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Quiz) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *Quiz) GetGameState() *GameState {
+	if x != nil {
+		return x.GameState
+	}
+	return nil
+}
+
+func (x *Quiz) GetHand() string {
+	if x != nil {
+		return x.Hand
+	}
+	return ""
+}
+
+func (x *Quiz) GetTurn() int32 {
+	if x != nil {
+		return x.Turn
+	}
+	return 0
+}
+
+func (x *Quiz) GetRuleSet() string {
+	if x != nil {
+		return x.RuleSet
+	}
+	return ""
+}
+
+func (x *Quiz) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// 何切る問題の採点結果
+type QuizGrade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuizId      string                  `protobuf:"bytes,1,opt,name=quiz_id,json=quizId,proto3" json:"quiz_id,omitempty"`                // 問題ID
+	Discard     string                  `protobuf:"bytes,2,opt,name=discard,proto3" json:"discard,omitempty"`                            // 回答した打牌
+	Correct     bool                    `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`                           // 正解か（最善と同等の打牌を含む）
+	Score       int32                   `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`                               // 得点（0-100）
+	BestDiscard string                  `protobuf:"bytes,5,opt,name=best_discard,json=bestDiscard,proto3" json:"best_discard,omitempty"` // エンジンが最善とする打牌
+	Accepted    []string                `protobuf:"bytes,6,rep,name=accepted,proto3" json:"accepted,omitempty"`                          // 正解とする打牌
+	Candidates  []*DiscardCandidateInfo `protobuf:"bytes,7,rep,name=candidates,proto3" json:"candidates,omitempty"`                      // エンジンによる推奨順の打牌候補
+	Evaluation  string                  `protobuf:"bytes,8,opt,name=evaluation,proto3" json:"evaluation,omitempty"`                      // エンジンによる評価の要約
+	Explanation string                  `protobuf:"bytes,9,opt,name=explanation,proto3" json:"explanation,omitempty"`                    // AIによる解説（作成に失敗した場合は空）
+	Recorded    bool                    `protobuf:"varint,10,opt,name=recorded,proto3" json:"recorded,omitempty"`                        // 成績に記録したか（同じ問題の2回目以降の回答は記録しない）
+}
+
+func (x *QuizGrade) Reset() {
+	*x = QuizGrade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizGrade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizGrade) ProtoMessage() {}
+
+func (x *QuizGrade) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizGrade.ProtoReflect.Descriptor instead.
+func (*QuizGrade) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuizGrade) GetQuizId() string {
+	if x != nil {
+		return x.QuizId
+	}
+	return ""
+}
+
+func (x *QuizGrade) GetDiscard() string {
+	if x != nil {
+		return x.Discard
+	}
+	return ""
+}
+
+func (x *QuizGrade) GetCorrect() bool {
+	if x != nil {
+		return x.Correct
+	}
+	return false
+}
+
+func (x *QuizGrade) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *QuizGrade) GetBestDiscard() string {
+	if x != nil {
+		return x.BestDiscard
+	}
+	return ""
+}
+
+func (x *QuizGrade) GetAccepted() []string {
+	if x != nil {
+		return x.Accepted
+	}
+	return nil
+}
+
+func (x *QuizGrade) GetCandidates() []*DiscardCandidateInfo {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *QuizGrade) GetEvaluation() string {
+	if x != nil {
+		return x.Evaluation
+	}
+	return ""
+}
+
+func (x *QuizGrade) GetExplanation() string {
+	if x != nil {
+		return x.Explanation
+	}
+	return ""
+}
+
+func (x *QuizGrade) GetRecorded() bool {
+	if x != nil {
+		return x.Recorded
+	}
+	return false
+}
+
+// 日ごとの何切る問題の成績
+type QuizDailyStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date         string  `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`                                       // 日付（YYYY-MM-DD）
+	Attempts     int32   `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`                              // 回答した問題の数
+	Correct      int32   `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`                                // 正解した問題の数
+	AverageScore float32 `protobuf:"fixed32,4,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"` // 平均得点
+}
+
+func (x *QuizDailyStats) Reset() {
+	*x = QuizDailyStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizDailyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizDailyStats) ProtoMessage() {}
+
+func (x *QuizDailyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizDailyStats.ProtoReflect.Descriptor instead.
+func (*QuizDailyStats) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *QuizDailyStats) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *QuizDailyStats) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QuizDailyStats) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *QuizDailyStats) GetAverageScore() float32 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+// ユーザーの何切る問題の成績
+type QuizStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId       string            `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // ユーザーID
+	Attempts     int32             `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`                              // 回答した問題の数
+	Correct      int32             `protobuf:"varint,3,opt,name=correct,proto3" json:"correct,omitempty"`                                // 正解した問題の数
+	Accuracy     float32           `protobuf:"fixed32,4,opt,name=accuracy,proto3" json:"accuracy,omitempty"`                             // 正解率（0-1）
+	AverageScore float32           `protobuf:"fixed32,5,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"` // 平均得点
+	Streak       int32             `protobuf:"varint,6,opt,name=streak,proto3" json:"streak,omitempty"`                                  // 直近の連続正解数
+	Daily        []*QuizDailyStats `protobuf:"bytes,7,rep,name=daily,proto3" json:"daily,omitempty"`                                     // 直近30日の日ごとの成績（古い順、回答のない日は含めない）
+}
+
+func (x *QuizStats) Reset() {
+	*x = QuizStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mahjong_ai_v1_game_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuizStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuizStats) ProtoMessage() {}
+
+func (x *QuizStats) ProtoReflect() protoreflect.Message {
+	mi := &file_mahjong_ai_v1_game_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuizStats.ProtoReflect.Descriptor instead.
+func (*QuizStats) Descriptor() ([]byte, []int) {
+	return file_mahjong_ai_v1_game_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *QuizStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *QuizStats) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *QuizStats) GetCorrect() int32 {
+	if x != nil {
+		return x.Correct
+	}
+	return 0
+}
+
+func (x *QuizStats) GetAccuracy() float32 {
+	if x != nil {
+		return x.Accuracy
+	}
+	return 0
+}
+
+func (x *QuizStats) GetAverageScore() float32 {
+	if x != nil {
+		return x.AverageScore
+	}
+	return 0
+}
+
+func (x *QuizStats) GetStreak() int32 {
+	if x != nil {
+		return x.Streak
+	}
+	return 0
+}
+
+func (x *QuizStats) GetDaily() []*QuizDailyStats {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+var File_mahjong_ai_v1_game_proto protoreflect.FileDescriptor
+
+var file_mahjong_ai_v1_game_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x2f,
+	0x67, 0x61, 0x6d, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x73, 0x69,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x0d, 0x44, 0x69, 0x73, 0x63, 0x61,
+	0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x73, 0x75, 0x6d, 0x6f, 0x67, 0x69, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x67, 0x69, 0x72, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x22, 0xd9, 0x01, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x73, 0x65,
+	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x73,
+	0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x6e,
+	0x64, 0x12, 0x29, 0x0a, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x6c, 0x64, 0x52, 0x05, 0x6d, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x08,
+	0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x54, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x73, 0x22, 0xc8, 0x02, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x09, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x79, 0x6f, 0x6b,
+	0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6b, 0x79, 0x6f, 0x6b, 0x75, 0x12, 0x14,
+	0x0a, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68,
+	0x6f, 0x6e, 0x62, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x5f, 0x73,
+	0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x69, 0x69,
+	0x63, 0x68, 0x69, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x6f, 0x72,
+	0x61, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x66,
+	0x5f, 0x73, 0x65, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64,
+	0x52, 0x08, 0x73, 0x65, 0x6c, 0x66, 0x53, 0x65, 0x61, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x77, 0x61,
+	0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x77, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x22, 0x83, 0x02, 0x0a, 0x0c, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x0a,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6b, 0x79, 0x6f, 0x6b, 0x75, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6b, 0x79, 0x6f, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x12, 0x23, 0x0a, 0x0d,
+	0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x5f, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x53, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65,
+	0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb8, 0x01, 0x0a, 0x07, 0x47, 0x61, 0x6d, 0x65,
+	0x4c, 0x6f, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x61, 0x6e, 0x6d, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73, 0x61, 0x6e,
+	0x6d, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75, 0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x33, 0x0a,
+	0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x73, 0x22, 0x54, 0x0a, 0x0f, 0x47, 0x61, 0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x50, 0x6f, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x22, 0x9e, 0x02, 0x0a, 0x0b, 0x47, 0x61, 0x6d,
+	0x65, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x65, 0x70, 0x12, 0x3a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x4c,
+	0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x04,
+	0x73, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68,
+	0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x73, 0x65, 0x61, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63,
+	0x61, 0x72, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x67, 0x69, 0x72, 0x69,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x73, 0x75, 0x6d, 0x6f, 0x67, 0x69, 0x72,
+	0x69, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x22, 0xf9, 0x02, 0x0a, 0x0d, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x3a, 0x0a, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x4c, 0x6f, 0x67, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x2f, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f,
+	0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x3a, 0x0a, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x64, 0x65,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x6c, 0x6f, 0x73, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x72, 0x79, 0x22, 0xb4, 0x02, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49,
+	0x64, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x38, 0x0a, 0x08, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61,
+	0x69, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4d, 0x69, 0x73, 0x74, 0x61,
+	0x6b, 0x65, 0x52, 0x08, 0x6d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x0e,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x6c, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x65,
+	0x6c, 0x66, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x22, 0xe9, 0x01, 0x0a, 0x0f, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x32, 0x0a,
+	0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x2e, 0x57, 0x69, 0x6e, 0x64, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x57, 0x69, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x79, 0x6f, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6b, 0x79, 0x6f, 0x6b, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x68, 0x6f, 0x6e, 0x62, 0x61, 0x12, 0x23, 0x0a,
+	0x0d, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x5f, 0x73, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x53, 0x74, 0x69, 0x63,
+	0x6b, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x6f,
+	0x72, 0x61, 0x5f, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x64, 0x6f, 0x72, 0x61, 0x49, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x61, 0x6e, 0x64, 0x22, 0x37, 0x0a, 0x09, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x72,
+	0x61, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x58,
+	0x0a, 0x0c, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x73,
+	0x75, 0x6d, 0x6f, 0x67, 0x69, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74,
+	0x73, 0x75, 0x6d, 0x6f, 0x67, 0x69, 0x72, 0x69, 0x22, 0x66, 0x0a, 0x09, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x2b, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x25, 0x0a, 0x0b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69, 0x12,
+	0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x09, 0x43, 0x6f, 0x61, 0x63, 0x68,
+	0x44, 0x6f, 0x72, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x6e, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x6f, 0x72, 0x22, 0x0f, 0x0a, 0x0d, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x6f, 0x75, 0x6e, 0x64,
+	0x45, 0x6e, 0x64, 0x22, 0xd4, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0a, 0x67, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x41, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x72, 0x61, 0x77, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x72, 0x61, 0x77, 0x48, 0x00, 0x52,
+	0x04, 0x64, 0x72, 0x61, 0x77, 0x12, 0x37, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67,
+	0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x69, 0x73, 0x63,
+	0x61, 0x72, 0x64, 0x48, 0x00, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x2e,
+	0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61,
+	0x63, 0x68, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x34,
+	0x0a, 0x06, 0x72, 0x69, 0x69, 0x63, 0x68, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x52, 0x69, 0x69, 0x63, 0x68, 0x69, 0x48, 0x00, 0x52, 0x06, 0x72, 0x69,
+	0x69, 0x63, 0x68, 0x69, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x6f, 0x72, 0x61, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x44, 0x6f, 0x72, 0x61, 0x48, 0x00, 0x52, 0x04,
+	0x64, 0x6f, 0x72, 0x61, 0x12, 0x3b, 0x0a, 0x09, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x65, 0x6e,
+	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e,
+	0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x52, 0x6f, 0x75,
+	0x6e, 0x64, 0x45, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x08, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x45, 0x6e,
+	0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x31, 0x0a, 0x13, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x01,
+	0x0a, 0x0b, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x64, 0x76, 0x69, 0x63, 0x65, 0x12, 0x32, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d,
+	0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x75, 0x0a,
+	0x0c, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x61,
+	0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x61, 0x63,
+	0x68, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0xa0, 0x01, 0x0a, 0x10, 0x43, 0x6f, 0x61, 0x63, 0x68, 0x45, 0x78,
+	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70,
+	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x65, 0x78, 0x74, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x64,
+	0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xaf, 0x01, 0x0a, 0x04, 0x51, 0x75, 0x69, 0x7a,
+	0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64, 0x12, 0x37, 0x0a, 0x0a, 0x67, 0x61, 0x6d,
+	0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61,
+	0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x67, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x68, 0x61, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x75, 0x72, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x75,
+	0x6c, 0x65, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75,
+	0x6c, 0x65, 0x53, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xd0, 0x02, 0x0a, 0x09, 0x51, 0x75,
+	0x69, 0x7a, 0x47, 0x72, 0x61, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x71, 0x75, 0x69, 0x7a, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x71, 0x75, 0x69, 0x7a, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f,
+	0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6f, 0x72,
+	0x72, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x65,
+	0x73, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x62, 0x65, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x63, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x65, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x65, 0x64, 0x22, 0x7f, 0x0a, 0x0e,
+	0x51, 0x75, 0x69, 0x7a, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xe8, 0x01,
+	0x0a, 0x09, 0x51, 0x75, 0x69, 0x7a, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x61, 0x63,
+	0x63, 0x75, 0x72, 0x61, 0x63, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x61,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6b, 0x12, 0x33, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x7a, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x2a, 0x6c, 0x0a, 0x0d, 0x47, 0x61, 0x6d, 0x65,
+	0x4c, 0x6f, 0x67, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x41, 0x4d,
+	0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x41,
+	0x4d, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x54, 0x45,
+	0x4e, 0x48, 0x4f, 0x55, 0x5f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x4f, 0x47, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x4d,
+	0x4a, 0x4c, 0x4f, 0x47, 0x10, 0x02, 0x2a, 0x68, 0x0a, 0x0c, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x19, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x45, 0x46, 0x46, 0x49, 0x43, 0x49, 0x45, 0x4e, 0x43,
+	0x59, 0x10, 0x01, 0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x43, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x46, 0x4f, 0x4c, 0x44, 0x10, 0x02,
+	0x2a, 0x8b, 0x01, 0x0a, 0x0f, 0x4d, 0x69, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x53, 0x65, 0x76, 0x65,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x49, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f,
+	0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4d, 0x49, 0x53, 0x54, 0x41, 0x4b,
+	0x45, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x49, 0x4e, 0x4f, 0x52,
+	0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x49, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x45,
+	0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10,
+	0x02, 0x12, 0x1b, 0x0a, 0x17, 0x4d, 0x49, 0x53, 0x54, 0x41, 0x4b, 0x45, 0x5f, 0x53, 0x45, 0x56,
+	0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x53, 0x45, 0x56, 0x45, 0x52, 0x45, 0x10, 0x03, 0x2a, 0x9a,
+	0x01, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1d, 0x0a, 0x19, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x56,
+	0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x56, 0x49, 0x45, 0x57, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x8c, 0x01, 0x0a, 0x0b,
+	0x43, 0x6f, 0x61, 0x63, 0x68, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x18, 0x43,
+	0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x41,
+	0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x44, 0x49, 0x53, 0x43, 0x41, 0x52,
+	0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x49, 0x49, 0x43, 0x48, 0x49, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12,
+	0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x53, 0x55,
+	0x4d, 0x4f, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x9e, 0x01, 0x0a, 0x10, 0x43,
+	0x6f, 0x61, 0x63, 0x68, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x6e, 0x64, 0x12,
+	0x22, 0x0a, 0x1e, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x43, 0x4f, 0x41, 0x43, 0x48, 0x5f, 0x57, 0x41, 0x52,
+	0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x50, 0x4f, 0x4e, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x45, 0x4e, 0x50, 0x41, 0x49, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x4f, 0x41, 0x43, 0x48, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x46, 0x55, 0x52, 0x49, 0x54, 0x45, 0x4e, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x43,
+	0x4f, 0x41, 0x43, 0x48, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x4e, 0x4f, 0x5f, 0x59, 0x41, 0x4b, 0x55, 0x10, 0x03, 0x42, 0xbd, 0x01, 0x0a, 0x11,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x61, 0x69, 0x2e, 0x76,
+	0x31, 0x42, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x47,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x6e, 0x64, 0x61,
+	0x6d, 0x61, 0x6e, 0x30, 0x32, 0x31, 0x35, 0x2f, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x61,
+	0x69, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x6d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2f, 0x61, 0x69, 0x2f,
+	0x76, 0x31, 0x3b, 0x61, 0x69, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x41, 0x58, 0xaa, 0x02, 0x0d,
+	0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x2e, 0x41, 0x69, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0d,
+	0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x19,
+	0x4d, 0x61, 0x68, 0x6a, 0x6f, 0x6e, 0x67, 0x5c, 0x41, 0x69, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0f, 0x4d, 0x61, 0x68, 0x6a,
+	0x6f, 0x6e, 0x67, 0x3a, 0x3a, 0x41, 0x69, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_mahjong_ai_v1_game_proto_rawDescOnce sync.Once
+	file_mahjong_ai_v1_game_proto_rawDescData = file_mahjong_ai_v1_game_proto_rawDesc
+)
+
+func file_mahjong_ai_v1_game_proto_rawDescGZIP() []byte {
+	file_mahjong_ai_v1_game_proto_rawDescOnce.Do(func() {
+		file_mahjong_ai_v1_game_proto_rawDescData = protoimpl.X.CompressGZIP(file_mahjong_ai_v1_game_proto_rawDescData)
+	})
+	return file_mahjong_ai_v1_game_proto_rawDescData
+}
+
+var file_mahjong_ai_v1_game_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_mahjong_ai_v1_game_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_mahjong_ai_v1_game_proto_goTypes = []interface{}{
+	(GameLogFormat)(0),           // 0: mahjong.ai.v1.GameLogFormat
+	(DecisionKind)(0),            // 1: mahjong.ai.v1.DecisionKind
+	(MistakeSeverity)(0),         // 2: mahjong.ai.v1.MistakeSeverity
+	(ReviewStatus)(0),            // 3: mahjong.ai.v1.ReviewStatus
+	(CoachAction)(0),             // 4: mahjong.ai.v1.CoachAction
+	(CoachWarningKind)(0),        // 5: mahjong.ai.v1.CoachWarningKind
+	(*DiscardedTile)(nil),        // 6: mahjong.ai.v1.DiscardedTile
+	(*PlayerState)(nil),          // 7: mahjong.ai.v1.PlayerState
+	(*GameState)(nil),            // 8: mahjong.ai.v1.GameState
+	(*GameLogRound)(nil),         // 9: mahjong.ai.v1.GameLogRound
+	(*GameLog)(nil),              // 10: mahjong.ai.v1.GameLog
+	(*GameLogPosition)(nil),      // 11: mahjong.ai.v1.GameLogPosition
+	(*GameLogStep)(nil),          // 12: mahjong.ai.v1.GameLogStep
+	(*ReviewMistake)(nil),        // 13: mahjong.ai.v1.ReviewMistake
+	(*GameReview)(nil),           // 14: mahjong.ai.v1.GameReview
+	(*CoachGameStart)(nil),       // 15: mahjong.ai.v1.CoachGameStart
+	(*CoachRoundStart)(nil),      // 16: mahjong.ai.v1.CoachRoundStart
+	(*CoachDraw)(nil),            // 17: mahjong.ai.v1.CoachDraw
+	(*CoachDiscard)(nil),         // 18: mahjong.ai.v1.CoachDiscard
+	(*CoachCall)(nil),            // 19: mahjong.ai.v1.CoachCall
+	(*CoachRiichi)(nil),          // 20: mahjong.ai.v1.CoachRiichi
+	(*CoachDora)(nil),            // 21: mahjong.ai.v1.CoachDora
+	(*CoachRoundEnd)(nil),        // 22: mahjong.ai.v1.CoachRoundEnd
+	(*CoachEvent)(nil),           // 23: mahjong.ai.v1.CoachEvent
+	(*CoachExplainRequest)(nil),  // 24: mahjong.ai.v1.CoachExplainRequest
+	(*CoachAdvice)(nil),          // 25: mahjong.ai.v1.CoachAdvice
+	(*CoachWarning)(nil),         // 26: mahjong.ai.v1.CoachWarning
+	(*CoachExplanation)(nil),     // 27: mahjong.ai.v1.CoachExplanation
+	(*Quiz)(nil),                 // 28: mahjong.ai.v1.Quiz
+	(*QuizGrade)(nil),            // 29: mahjong.ai.v1.QuizGrade
+	(*QuizDailyStats)(nil),       // 30: mahjong.ai.v1.QuizDailyStats
+	(*QuizStats)(nil),            // 31: mahjong.ai.v1.QuizStats
+	(Wind)(0),                    // 32: mahjong.ai.v1.Wind
+	(*Meld)(nil),                 // 33: mahjong.ai.v1.Meld
+	(MeldType)(0),                // 34: mahjong.ai.v1.MeldType
+	(*DiscardCandidateInfo)(nil), // 35: mahjong.ai.v1.DiscardCandidateInfo
+}
+var file_mahjong_ai_v1_game_proto_depIdxs = []int32{
+	32, // 0: mahjong.ai.v1.PlayerState.seat:type_name -> mahjong.ai.v1.Wind
+	33, // 1: mahjong.ai.v1.PlayerState.melds:type_name -> mahjong.ai.v1.Meld
+	6,  // 2: mahjong.ai.v1.PlayerState.discards:type_name -> mahjong.ai.v1.DiscardedTile
+	32, // 3: mahjong.ai.v1.GameState.round_wind:type_name -> mahjong.ai.v1.Wind
+	7,  // 4: mahjong.ai.v1.GameState.players:type_name -> mahjong.ai.v1.PlayerState
+	32, // 5: mahjong.ai.v1.GameState.self_seat:type_name -> mahjong.ai.v1.Wind
+	32, // 6: mahjong.ai.v1.GameLogRound.round_wind:type_name -> mahjong.ai.v1.Wind
+	9,  // 7: mahjong.ai.v1.GameLog.rounds:type_name -> mahjong.ai.v1.GameLogRound
+	11, // 8: mahjong.ai.v1.GameLogStep.position:type_name -> mahjong.ai.v1.GameLogPosition
+	32, // 9: mahjong.ai.v1.GameLogStep.seat:type_name -> mahjong.ai.v1.Wind
+	8,  // 10: mahjong.ai.v1.GameLogStep.state:type_name -> mahjong.ai.v1.GameState
+	11, // 11: mahjong.ai.v1.ReviewMistake.position:type_name -> mahjong.ai.v1.GameLogPosition
+	1,  // 12: mahjong.ai.v1.ReviewMistake.kind:type_name -> mahjong.ai.v1.DecisionKind
+	2,  // 13: mahjong.ai.v1.ReviewMistake.severity:type_name -> mahjong.ai.v1.MistakeSeverity
+	3,  // 14: mahjong.ai.v1.GameReview.status:type_name -> mahjong.ai.v1.ReviewStatus
+	13, // 15: mahjong.ai.v1.GameReview.mistakes:type_name -> mahjong.ai.v1.ReviewMistake
+	32, // 16: mahjong.ai.v1.CoachRoundStart.round_wind:type_name -> mahjong.ai.v1.Wind
+	34, // 17: mahjong.ai.v1.CoachCall.type:type_name -> mahjong.ai.v1.MeldType
+	15, // 18: mahjong.ai.v1.CoachEvent.game_start:type_name -> mahjong.ai.v1.CoachGameStart
+	16, // 19: mahjong.ai.v1.CoachEvent.round_start:type_name -> mahjong.ai.v1.CoachRoundStart
+	17, // 20: mahjong.ai.v1.CoachEvent.draw:type_name -> mahjong.ai.v1.CoachDraw
+	18, // 21: mahjong.ai.v1.CoachEvent.discard:type_name -> mahjong.ai.v1.CoachDiscard
+	19, // 22: mahjong.ai.v1.CoachEvent.call:type_name -> mahjong.ai.v1.CoachCall
+	20, // 23: mahjong.ai.v1.CoachEvent.riichi:type_name -> mahjong.ai.v1.CoachRiichi
+	21, // 24: mahjong.ai.v1.CoachEvent.dora:type_name -> mahjong.ai.v1.CoachDora
+	22, // 25: mahjong.ai.v1.CoachEvent.round_end:type_name -> mahjong.ai.v1.CoachRoundEnd
+	4,  // 26: mahjong.ai.v1.CoachAdvice.action:type_name -> mahjong.ai.v1.CoachAction
+	8,  // 27: mahjong.ai.v1.CoachAdvice.state:type_name -> mahjong.ai.v1.GameState
+	5,  // 28: mahjong.ai.v1.CoachWarning.kind:type_name -> mahjong.ai.v1.CoachWarningKind
+	8,  // 29: mahjong.ai.v1.Quiz.game_state:type_name -> mahjong.ai.v1.GameState
+	35, // 30: mahjong.ai.v1.QuizGrade.candidates:type_name -> mahjong.ai.v1.DiscardCandidateInfo
+	30, // 31: mahjong.ai.v1.QuizStats.daily:type_name -> mahjong.ai.v1.QuizDailyStats
+	32, // [32:32] is the sub-list for method output_type
+	32, // [32:32] is the sub-list for method input_type
+	32, // [32:32] is the sub-list for extension type_name
+	32, // [32:32] is the sub-list for extension extendee
+	0,  // [0:32] is the sub-list for field type_name
+}
+
+func init() { file_mahjong_ai_v1_game_proto_init() }
+func file_mahjong_ai_v1_game_proto_init() {
+	if File_mahjong_ai_v1_game_proto != nil {
+		return
+	}
+	file_mahjong_ai_v1_analysis_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_mahjong_ai_v1_game_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscardedTile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameLogRound); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameLog); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameLogPosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameLogStep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewMistake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GameReview); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachGameStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachRoundStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachDraw); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachDiscard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachCall); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachRiichi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachDora); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachRoundEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachExplainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachAdvice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CoachExplanation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quiz); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizGrade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizDailyStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mahjong_ai_v1_game_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuizStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_mahjong_ai_v1_game_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*CoachEvent_GameStart)(nil),
+		(*CoachEvent_RoundStart)(nil),
+		(*CoachEvent_Draw)(nil),
+		(*CoachEvent_Discard)(nil),
+		(*CoachEvent_Call)(nil),
+		(*CoachEvent_Riichi)(nil),
+		(*CoachEvent_Dora)(nil),
+		(*CoachEvent_RoundEnd)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_mahjong_ai_v1_game_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   26,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mahjong_ai_v1_game_proto_goTypes,
+		DependencyIndexes: file_mahjong_ai_v1_game_proto_depIdxs,
+		EnumInfos:         file_mahjong_ai_v1_game_proto_enumTypes,
+		MessageInfos:      file_mahjong_ai_v1_game_proto_msgTypes,
+	}.Build()
+	File_mahjong_ai_v1_game_proto = out.File
+	file_mahjong_ai_v1_game_proto_rawDesc = nil
+	file_mahjong_ai_v1_game_proto_goTypes = nil
+	file_mahjong_ai_v1_game_proto_depIdxs = nil
+}
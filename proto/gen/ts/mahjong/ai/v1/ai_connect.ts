@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AskMahjongAIRequest, AskMahjongAIResponse, AskMahjongAIStreamResponse, HealthCheckRequest, HealthCheckResponse } from "./ai_pb";
+import { AskMahjongAIRequest, AskMahjongAIResponse, AskMahjongAIStreamResponse, AssessSafetyRequest, AssessSafetyResponse, CalculatePlacementRequest, CalculatePlacementResponse, CoachRequest, CoachResponse, EvaluatePushFoldRequest, EvaluatePushFoldResponse, GameReviewResponse, GetGameLogStepRequest, GetGameLogStepResponse, GetGameReviewRequest, GetQuizRequest, GetQuizResponse, GetWaitsRequest, GetWaitsResponse, HealthCheckRequest, HealthCheckResponse, ImportGameLogRequest, ImportGameLogResponse, RecommendDiscardRequest, RecommendDiscardResponse, ReindexKnowledgeRequest, ReindexKnowledgeResponse, RenderHandRequest, RenderHandResponse, ReviewGameRequest, SimulateHandRequest, SimulateHandResponse, SubmitQuizAnswerRequest, SubmitQuizAnswerResponse } from "./ai_pb";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -36,6 +36,182 @@ export const MahjongAIService = {
       O: AskMahjongAIStreamResponse,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * 聴牌形の待ち・待ちの形・役の有無・フリテンを判定する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.GetWaits
+     */
+    getWaits: {
+      name: "GetWaits",
+      I: GetWaitsRequest,
+      O: GetWaitsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 打牌候補を受け入れ・改良・和了率・打点期待値で評価して推奨順に返す
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.RecommendDiscard
+     */
+    recommendDiscard: {
+      name: "RecommendDiscard",
+      I: RecommendDiscardRequest,
+      O: RecommendDiscardResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 手牌からの展開をモンテカルロ法でシミュレーションし、巡目ごとの聴牌率・和了率・打点期待値を返す
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.SimulateHand
+     */
+    simulateHand: {
+      name: "SimulateHand",
+      I: SimulateHandRequest,
+      O: SimulateHandResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 手牌の牌ごとに立直者への安全度（現物・筋・壁・ワンチャンスなど）を評価して安全な順に返す
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.AssessSafety
+     */
+    assessSafety: {
+      name: "AssessSafety",
+      I: AssessSafetyRequest,
+      O: AssessSafetyResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 手牌の価値・立直者の脅威・点数状況・巡目から押し・回し・降りを期待値で評価する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.EvaluatePushFold
+     */
+    evaluatePushFold: {
+      name: "EvaluatePushFold",
+      I: EvaluatePushFoldRequest,
+      O: EvaluatePushFoldResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * オーラスで各順位に届くために必要な和了を計算する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.CalculatePlacement
+     */
+    calculatePlacement: {
+      name: "CalculatePlacement",
+      I: CalculatePlacementRequest,
+      O: CalculatePlacementResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 天鳳の牌譜（JSON・mjlog）を取り込み、各打牌の判断の直前の局面を再構成して保存する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.ImportGameLog
+     */
+    importGameLog: {
+      name: "ImportGameLog",
+      I: ImportGameLogRequest,
+      O: ImportGameLogResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 取り込んだ牌譜の打牌の判断とその直前の局面を返す
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.GetGameLogStep
+     */
+    getGameLogStep: {
+      name: "GetGameLogStep",
+      I: GetGameLogStepRequest,
+      O: GetGameLogStepResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 牌譜のプレイヤーの全打牌をエンジンで評価し、損失の大きい悪手をAIに解説させる検討をバックグラウンドで開始する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.ReviewGame
+     */
+    reviewGame: {
+      name: "ReviewGame",
+      I: ReviewGameRequest,
+      O: GameReviewResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 牌譜の検討結果を返す
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.GetGameReview
+     */
+    getGameReview: {
+      name: "GetGameReview",
+      I: GetGameReviewRequest,
+      O: GameReviewResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 牌譜の検討結果を更新のたびに返し、検討が終わると終了する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.WatchGameReview
+     */
+    watchGameReview: {
+      name: "WatchGameReview",
+      I: GetGameReviewRequest,
+      O: GameReviewResponse,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * 対局の出来事を受け取りながら、推奨・警告・解説を送る（双方向ストリーミング）
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.Coach
+     */
+    coach: {
+      name: "Coach",
+      I: CoachRequest,
+      O: CoachResponse,
+      kind: MethodKind.BiDiStreaming,
+    },
+    /**
+     * ルールの資料を読み込み直し、全文検索と埋め込みベクトルの索引を作り直す（管理用）
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.ReindexKnowledge
+     */
+    reindexKnowledge: {
+      name: "ReindexKnowledge",
+      I: ReindexKnowledgeRequest,
+      O: ReindexKnowledgeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 手牌・副露・河・局面を牌の図（SVG・PNG）にする
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.RenderHand
+     */
+    renderHand: {
+      name: "RenderHand",
+      I: RenderHandRequest,
+      O: RenderHandResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 何切る問題を出題する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.GetQuiz
+     */
+    getQuiz: {
+      name: "GetQuiz",
+      I: GetQuizRequest,
+      O: GetQuizResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * 何切る問題の回答をエンジンで採点し、AIの解説を付けて成績に記録する
+     *
+     * @generated from rpc mahjong.ai.v1.MahjongAIService.SubmitQuizAnswer
+     */
+    submitQuizAnswer: {
+      name: "SubmitQuizAnswer",
+      I: SubmitQuizAnswerRequest,
+      O: SubmitQuizAnswerResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * ヘルスチェック
      *
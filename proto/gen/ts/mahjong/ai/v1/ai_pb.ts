@@ -4,7 +4,43 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3, protoInt64, Timestamp } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, Struct, Timestamp } from "@bufbuild/protobuf";
+import { CoachAdvice, CoachEvent, CoachExplainRequest, CoachExplanation, CoachWarning, DiscardedTile, GameLog, GameLogFormat, GameLogPosition, GameLogStep, GameReview, GameState, Quiz, QuizGrade, QuizStats } from "./game_pb";
+import { DiscardResult, Meld, OpponentInfo, PlacementResult, PushFoldResult, SafetyResult, SimulationResult, WaitsResult, Wind } from "./analysis_pb";
+
+/**
+ * 図の形式
+ *
+ * @generated from enum mahjong.ai.v1.DiagramFormat
+ */
+export enum DiagramFormat {
+  /**
+   * 未指定（SVG として扱う）
+   *
+   * @generated from enum value: DIAGRAM_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * SVG
+   *
+   * @generated from enum value: DIAGRAM_FORMAT_SVG = 1;
+   */
+  SVG = 1,
+
+  /**
+   * PNG
+   *
+   * @generated from enum value: DIAGRAM_FORMAT_PNG = 2;
+   */
+  PNG = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DiagramFormat)
+proto3.util.setEnumType(DiagramFormat, "mahjong.ai.v1.DiagramFormat", [
+  { no: 0, name: "DIAGRAM_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "DIAGRAM_FORMAT_SVG" },
+  { no: 2, name: "DIAGRAM_FORMAT_PNG" },
+]);
 
 /**
  * エラー情報
@@ -162,6 +198,13 @@ export class ResponseMetadata extends Message<ResponseMetadata> {
    */
   serverVersion = "";
 
+  /**
+   * AIに問い合わせず、キャッシュした回答を返したか
+   *
+   * @generated from field: bool cache_hit = 5;
+   */
+  cacheHit = false;
+
   constructor(data?: PartialMessage<ResponseMetadata>) {
     super();
     proto3.util.initPartial(data, this);
@@ -174,6 +217,7 @@ export class ResponseMetadata extends Message<ResponseMetadata> {
     { no: 2, name: "timestamp", kind: "message", T: Timestamp },
     { no: 3, name: "processing_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 4, name: "server_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "cache_hit", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResponseMetadata {
@@ -234,6 +278,83 @@ export class AskMahjongAIRequest extends Message<AskMahjongAIRequest> {
    */
   context: string[] = [];
 
+  /**
+   * ペルソナ名（空の場合はデフォルト）
+   *
+   * @generated from field: string persona = 6;
+   */
+  persona = "";
+
+  /**
+   * ユーザーのレベル (beginner, intermediate, advanced など)
+   *
+   * @generated from field: string user_level = 7;
+   */
+  userLevel = "";
+
+  /**
+   * 回答言語 (ja, en など)
+   *
+   * @generated from field: string language = 8;
+   */
+  language = "";
+
+  /**
+   * ルールセット名 (tenhou, mleague, wrc, ema, mahjongsoul, sanma)
+   *
+   * @generated from field: string rule_set = 9;
+   */
+  ruleSet = "";
+
+  /**
+   * 会話ID（指定したルールセットを同じ会話の以降のリクエストに引き継ぐ）
+   *
+   * @generated from field: string conversation_id = 10;
+   */
+  conversationId = "";
+
+  /**
+   * 局面（プロンプトに整形して渡し、手牌が分かる場合はエンジンで解析する）
+   *
+   * @generated from field: mahjong.ai.v1.GameState game_state = 11;
+   */
+  gameState?: GameState;
+
+  /**
+   * 取り込んだ牌譜の局面（game_state とは同時に指定できない）
+   *
+   * @generated from field: mahjong.ai.v1.GameLogPosition game_log_position = 12;
+   */
+  gameLogPosition?: GameLogPosition;
+
+  /**
+   * 自己一貫性のために生成して比べる回答の数（0・1 は比べない、最大5、ストリーミングでは指定できない）
+   *
+   * @generated from field: int32 samples = 13;
+   */
+  samples = 0;
+
+  /**
+   * 回答をJSONで出力させる形式 (discard, score, push_fold、空の場合は自由な文章、ストリーミングでは指定できない)
+   *
+   * @generated from field: string response_schema = 14;
+   */
+  responseSchema = "";
+
+  /**
+   * 質問に添付する画像（雀魂のスクリーンショットや牌の写真など）
+   *
+   * @generated from field: repeated mahjong.ai.v1.Attachment attachments = 15;
+   */
+  attachments: Attachment[] = [];
+
+  /**
+   * 回答の前に画像の手牌をMPSZ表記に書き起こし、牌の表記として検証してから質問に添える（attachments が必要）
+   *
+   * @generated from field: bool transcribe_hand = 16;
+   */
+  transcribeHand = false;
+
   constructor(data?: PartialMessage<AskMahjongAIRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -247,6 +368,17 @@ export class AskMahjongAIRequest extends Message<AskMahjongAIRequest> {
     { no: 3, name: "max_tokens", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 4, name: "temperature", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
     { no: 5, name: "context", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "persona", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "user_level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "language", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "conversation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "game_state", kind: "message", T: GameState },
+    { no: 12, name: "game_log_position", kind: "message", T: GameLogPosition },
+    { no: 13, name: "samples", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 14, name: "response_schema", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "attachments", kind: "message", T: Attachment, repeated: true },
+    { no: 16, name: "transcribe_hand", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskMahjongAIRequest {
@@ -266,6 +398,128 @@ export class AskMahjongAIRequest extends Message<AskMahjongAIRequest> {
   }
 }
 
+/**
+ * 質問に添付するファイル
+ *
+ * @generated from message mahjong.ai.v1.Attachment
+ */
+export class Attachment extends Message<Attachment> {
+  /**
+   * ファイルの内容
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * MIMEタイプ (image/png, image/jpeg, image/webp, image/heic, image/heif)
+   *
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType = "";
+
+  constructor(data?: PartialMessage<Attachment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.Attachment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Attachment {
+    return new Attachment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Attachment {
+    return new Attachment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Attachment {
+    return new Attachment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Attachment | PlainMessage<Attachment> | undefined, b: Attachment | PlainMessage<Attachment> | undefined): boolean {
+    return proto3.util.equals(Attachment, a, b);
+  }
+}
+
+/**
+ * 画像の手牌を書き起こした結果
+ *
+ * @generated from message mahjong.ai.v1.HandTranscription
+ */
+export class HandTranscription extends Message<HandTranscription> {
+  /**
+   * 門前の牌（MPSZ表記）
+   *
+   * @generated from field: string hand = 1;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 2;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * 牌の表記と枚数の検証に通ったか（通らなかった場合は質問に添えていない）
+   *
+   * @generated from field: bool valid = 3;
+   */
+  valid = false;
+
+  /**
+   * 検証に通らなかった理由
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  /**
+   * 書き起こしを問い合わせた回数
+   *
+   * @generated from field: int32 attempts = 5;
+   */
+  attempts = 0;
+
+  constructor(data?: PartialMessage<HandTranscription>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.HandTranscription";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 3, name: "valid", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HandTranscription {
+    return new HandTranscription().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HandTranscription {
+    return new HandTranscription().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HandTranscription {
+    return new HandTranscription().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: HandTranscription | PlainMessage<HandTranscription> | undefined, b: HandTranscription | PlainMessage<HandTranscription> | undefined): boolean {
+    return proto3.util.equals(HandTranscription, a, b);
+  }
+}
+
 /**
  * 麻雀AIのレスポンス
  *
@@ -308,12 +562,54 @@ export class AskMahjongAIResponse extends Message<AskMahjongAIResponse> {
   tokensUsed = 0;
 
   /**
-   * 信頼度スコア (0.0-1.0)
+   * 回答の検証結果と複数の回答の結論の一致率から求めた信頼度 (0.0-1.0、0 は検証していない)
    *
    * @generated from field: float confidence = 5;
    */
   confidence = 0;
 
+  /**
+   * 回答の根拠としてAIが呼び出したツール
+   *
+   * @generated from field: repeated mahjong.ai.v1.ToolCallInfo tool_calls = 6;
+   */
+  toolCalls: ToolCallInfo[] = [];
+
+  /**
+   * 回答の向聴数・待ち・点数をエンジンで検証した結果（検証していない場合は未設定）
+   *
+   * @generated from field: mahjong.ai.v1.AnswerVerification verification = 7;
+   */
+  verification?: AnswerVerification;
+
+  /**
+   * 複数の回答の結論を比べた結果（samples が2以上の場合のみ）
+   *
+   * @generated from field: mahjong.ai.v1.SelfConsistency self_consistency = 8;
+   */
+  selfConsistency?: SelfConsistency;
+
+  /**
+   * response_schema を指定した場合に回答のJSONを解析した値（response には同じJSONの文字列）
+   *
+   * @generated from field: google.protobuf.Struct structured = 9;
+   */
+  structured?: Struct;
+
+  /**
+   * 回答の根拠としてAIに渡したルールの資料の抜粋
+   *
+   * @generated from field: repeated mahjong.ai.v1.Citation citations = 10;
+   */
+  citations: Citation[] = [];
+
+  /**
+   * transcribe_hand を指定した場合に画像の手牌を書き起こした結果
+   *
+   * @generated from field: mahjong.ai.v1.HandTranscription transcription = 11;
+   */
+  transcription?: HandTranscription;
+
   constructor(data?: PartialMessage<AskMahjongAIResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -327,6 +623,12 @@ export class AskMahjongAIResponse extends Message<AskMahjongAIResponse> {
     { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
     { no: 4, name: "tokens_used", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
     { no: 5, name: "confidence", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "tool_calls", kind: "message", T: ToolCallInfo, repeated: true },
+    { no: 7, name: "verification", kind: "message", T: AnswerVerification },
+    { no: 8, name: "self_consistency", kind: "message", T: SelfConsistency },
+    { no: 9, name: "structured", kind: "message", T: Struct },
+    { no: 10, name: "citations", kind: "message", T: Citation, repeated: true },
+    { no: 11, name: "transcription", kind: "message", T: HandTranscription },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskMahjongAIResponse {
@@ -347,75 +649,2968 @@ export class AskMahjongAIResponse extends Message<AskMahjongAIResponse> {
 }
 
 /**
- * ストリーミングレスポンス
+ * 回答の根拠としてAIに渡したルールの資料の抜粋
  *
- * @generated from message mahjong.ai.v1.AskMahjongAIStreamResponse
+ * @generated from message mahjong.ai.v1.Citation
  */
-export class AskMahjongAIStreamResponse extends Message<AskMahjongAIStreamResponse> {
+export class Citation extends Message<Citation> {
   /**
-   * @generated from oneof mahjong.ai.v1.AskMahjongAIStreamResponse.chunk
+   * 文書のパス
+   *
+   * @generated from field: string document = 1;
    */
-  chunk: {
-    /**
-     * テキストチャンク
-     *
-     * @generated from field: string text_chunk = 1;
-     */
-    value: string;
-    case: "textChunk";
-  } | {
-    /**
-     * エラー情報
-     *
-     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
-     */
-    value: ErrorInfo;
-    case: "error";
-  } | {
-    /**
-     * 最終メタデータ（ストリーム終了時）
-     *
-     * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
-     */
-    value: ResponseMetadata;
-    case: "metadata";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  document = "";
 
   /**
-   * 最終チャンクかどうか
+   * 文書の題名
    *
-   * @generated from field: bool is_final = 4;
+   * @generated from field: string title = 2;
    */
-  isFinal = false;
+  title = "";
 
-  constructor(data?: PartialMessage<AskMahjongAIStreamResponse>) {
+  /**
+   * 節の見出し（文書の冒頭は空）
+   *
+   * @generated from field: string section = 3;
+   */
+  section = "";
+
+  /**
+   * 抜粋の本文の冒頭
+   *
+   * @generated from field: string snippet = 4;
+   */
+  snippet = "";
+
+  /**
+   * 質問との関連度（検索の得点）
+   *
+   * @generated from field: float score = 5;
+   */
+  score = 0;
+
+  /**
+   * 回答が抜粋の番号（[1] など）を示して引用したか
+   *
+   * @generated from field: bool cited = 6;
+   */
+  cited = false;
+
+  constructor(data?: PartialMessage<Citation>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "mahjong.ai.v1.AskMahjongAIStreamResponse";
+  static readonly typeName = "mahjong.ai.v1.Citation";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "text_chunk", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
-    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "chunk" },
-    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata, oneof: "chunk" },
-    { no: 4, name: "is_final", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "document", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "section", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "snippet", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "score", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "cited", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskMahjongAIStreamResponse {
-    return new AskMahjongAIStreamResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Citation {
+    return new Citation().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AskMahjongAIStreamResponse {
-    return new AskMahjongAIStreamResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Citation {
+    return new Citation().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AskMahjongAIStreamResponse {
-    return new AskMahjongAIStreamResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Citation {
+    return new Citation().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AskMahjongAIStreamResponse | PlainMessage<AskMahjongAIStreamResponse> | undefined, b: AskMahjongAIStreamResponse | PlainMessage<AskMahjongAIStreamResponse> | undefined): boolean {
-    return proto3.util.equals(AskMahjongAIStreamResponse, a, b);
+  static equals(a: Citation | PlainMessage<Citation> | undefined, b: Citation | PlainMessage<Citation> | undefined): boolean {
+    return proto3.util.equals(Citation, a, b);
+  }
+}
+
+/**
+ * ストリーミングで送る引用の一覧
+ *
+ * @generated from message mahjong.ai.v1.CitationList
+ */
+export class CitationList extends Message<CitationList> {
+  /**
+   * @generated from field: repeated mahjong.ai.v1.Citation citations = 1;
+   */
+  citations: Citation[] = [];
+
+  constructor(data?: PartialMessage<CitationList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CitationList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "citations", kind: "message", T: Citation, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CitationList {
+    return new CitationList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CitationList {
+    return new CitationList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CitationList {
+    return new CitationList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CitationList | PlainMessage<CitationList> | undefined, b: CitationList | PlainMessage<CitationList> | undefined): boolean {
+    return proto3.util.equals(CitationList, a, b);
+  }
+}
+
+/**
+ * ルールの資料の索引の情報
+ *
+ * @generated from message mahjong.ai.v1.KnowledgeIndexInfo
+ */
+export class KnowledgeIndexInfo extends Message<KnowledgeIndexInfo> {
+  /**
+   * 索引に含まれる抜粋の数
+   *
+   * @generated from field: int32 passages = 1;
+   */
+  passages = 0;
+
+  /**
+   * 埋め込みベクトルを新たに求めた抜粋の数
+   *
+   * @generated from field: int32 embedded = 2;
+   */
+  embedded = 0;
+
+  /**
+   * 本文が変わっておらず、保存済みのベクトルを使った抜粋の数
+   *
+   * @generated from field: int32 reused = 3;
+   */
+  reused = 0;
+
+  /**
+   * 埋め込みモデルの名前（埋め込みを使わない場合は空）
+   *
+   * @generated from field: string model = 4;
+   */
+  model = "";
+
+  /**
+   * 索引の作成にかかった時間（ミリ秒）
+   *
+   * @generated from field: int64 duration_ms = 5;
+   */
+  durationMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<KnowledgeIndexInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.KnowledgeIndexInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "passages", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "embedded", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "reused", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "model", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "duration_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KnowledgeIndexInfo {
+    return new KnowledgeIndexInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KnowledgeIndexInfo {
+    return new KnowledgeIndexInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KnowledgeIndexInfo {
+    return new KnowledgeIndexInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KnowledgeIndexInfo | PlainMessage<KnowledgeIndexInfo> | undefined, b: KnowledgeIndexInfo | PlainMessage<KnowledgeIndexInfo> | undefined): boolean {
+    return proto3.util.equals(KnowledgeIndexInfo, a, b);
+  }
+}
+
+/**
+ * 結論ごとの回答の数
+ *
+ * @generated from message mahjong.ai.v1.ConclusionVote
+ */
+export class ConclusionVote extends Message<ConclusionVote> {
+  /**
+   * 回答の結論（「打5s」「7700点」など、取り出せなかった場合は空）
+   *
+   * @generated from field: string conclusion = 1;
+   */
+  conclusion = "";
+
+  /**
+   * この結論になった回答の数
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count = 0;
+
+  constructor(data?: PartialMessage<ConclusionVote>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ConclusionVote";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "conclusion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConclusionVote {
+    return new ConclusionVote().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConclusionVote {
+    return new ConclusionVote().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConclusionVote {
+    return new ConclusionVote().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConclusionVote | PlainMessage<ConclusionVote> | undefined, b: ConclusionVote | PlainMessage<ConclusionVote> | undefined): boolean {
+    return proto3.util.equals(ConclusionVote, a, b);
+  }
+}
+
+/**
+ * 同じ質問に対する複数の回答の結論を比べた結果
+ *
+ * @generated from message mahjong.ai.v1.SelfConsistency
+ */
+export class SelfConsistency extends Message<SelfConsistency> {
+  /**
+   * 比べた回答の数（生成に失敗した回答は含まない）
+   *
+   * @generated from field: int32 samples = 1;
+   */
+  samples = 0;
+
+  /**
+   * 多数派の結論（結論を取り出せた回答がない場合は空）
+   *
+   * @generated from field: string conclusion = 2;
+   */
+  conclusion = "";
+
+  /**
+   * 結論ごとの回答の数（多い順）
+   *
+   * @generated from field: repeated mahjong.ai.v1.ConclusionVote votes = 3;
+   */
+  votes: ConclusionVote[] = [];
+
+  /**
+   * 多数派の結論になった回答の割合 (0.0-1.0)
+   *
+   * @generated from field: float agreement = 4;
+   */
+  agreement = 0;
+
+  constructor(data?: PartialMessage<SelfConsistency>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SelfConsistency";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "samples", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "conclusion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "votes", kind: "message", T: ConclusionVote, repeated: true },
+    { no: 4, name: "agreement", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SelfConsistency {
+    return new SelfConsistency().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SelfConsistency {
+    return new SelfConsistency().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SelfConsistency {
+    return new SelfConsistency().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SelfConsistency | PlainMessage<SelfConsistency> | undefined, b: SelfConsistency | PlainMessage<SelfConsistency> | undefined): boolean {
+    return proto3.util.equals(SelfConsistency, a, b);
+  }
+}
+
+/**
+ * 回答に含まれる主張をエンジンで検証した結果
+ *
+ * @generated from message mahjong.ai.v1.ClaimCheckInfo
+ */
+export class ClaimCheckInfo extends Message<ClaimCheckInfo> {
+  /**
+   * 主張の種類（shanten / waits / score）
+   *
+   * @generated from field: string kind = 1;
+   */
+  kind = "";
+
+  /**
+   * 回答中の主張の表現
+   *
+   * @generated from field: string claim = 2;
+   */
+  claim = "";
+
+  /**
+   * エンジンの計算と一致したか
+   *
+   * @generated from field: bool correct = 3;
+   */
+  correct = false;
+
+  /**
+   * 誤っていた場合の訂正文
+   *
+   * @generated from field: string correction = 4;
+   */
+  correction = "";
+
+  constructor(data?: PartialMessage<ClaimCheckInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ClaimCheckInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "correct", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "correction", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClaimCheckInfo {
+    return new ClaimCheckInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClaimCheckInfo {
+    return new ClaimCheckInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClaimCheckInfo {
+    return new ClaimCheckInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClaimCheckInfo | PlainMessage<ClaimCheckInfo> | undefined, b: ClaimCheckInfo | PlainMessage<ClaimCheckInfo> | undefined): boolean {
+    return proto3.util.equals(ClaimCheckInfo, a, b);
+  }
+}
+
+/**
+ * 回答の検証結果
+ *
+ * @generated from message mahjong.ai.v1.AnswerVerification
+ */
+export class AnswerVerification extends Message<AnswerVerification> {
+  /**
+   * 検証した主張
+   *
+   * @generated from field: repeated mahjong.ai.v1.ClaimCheckInfo checks = 1;
+   */
+  checks: ClaimCheckInfo[] = [];
+
+  /**
+   * 誤りを伝えて回答を生成し直したか
+   *
+   * @generated from field: bool regenerated = 2;
+   */
+  regenerated = false;
+
+  /**
+   * 訂正を回答の末尾に追記したか
+   *
+   * @generated from field: bool annotated = 3;
+   */
+  annotated = false;
+
+  /**
+   * 検証結果から求めた信頼度 (0.0-1.0)
+   *
+   * @generated from field: float confidence = 4;
+   */
+  confidence = 0;
+
+  constructor(data?: PartialMessage<AnswerVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.AnswerVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "checks", kind: "message", T: ClaimCheckInfo, repeated: true },
+    { no: 2, name: "regenerated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "annotated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "confidence", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnswerVerification {
+    return new AnswerVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnswerVerification {
+    return new AnswerVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnswerVerification {
+    return new AnswerVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnswerVerification | PlainMessage<AnswerVerification> | undefined, b: AnswerVerification | PlainMessage<AnswerVerification> | undefined): boolean {
+    return proto3.util.equals(AnswerVerification, a, b);
+  }
+}
+
+/**
+ * AIによるツールの呼び出し
+ *
+ * @generated from message mahjong.ai.v1.ToolCallInfo
+ */
+export class ToolCallInfo extends Message<ToolCallInfo> {
+  /**
+   * ツール名
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * 引数（JSON）
+   *
+   * @generated from field: string arguments = 2;
+   */
+  arguments = "";
+
+  /**
+   * 結果（JSON）
+   *
+   * @generated from field: string result = 3;
+   */
+  result = "";
+
+  /**
+   * ツールの実行に失敗した場合のエラー
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<ToolCallInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ToolCallInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "arguments", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "result", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ToolCallInfo {
+    return new ToolCallInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ToolCallInfo {
+    return new ToolCallInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ToolCallInfo {
+    return new ToolCallInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ToolCallInfo | PlainMessage<ToolCallInfo> | undefined, b: ToolCallInfo | PlainMessage<ToolCallInfo> | undefined): boolean {
+    return proto3.util.equals(ToolCallInfo, a, b);
+  }
+}
+
+/**
+ * ストリーミングレスポンス
+ *
+ * @generated from message mahjong.ai.v1.AskMahjongAIStreamResponse
+ */
+export class AskMahjongAIStreamResponse extends Message<AskMahjongAIStreamResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.AskMahjongAIStreamResponse.chunk
+   */
+  chunk: {
+    /**
+     * テキストチャンク
+     *
+     * @generated from field: string text_chunk = 1;
+     */
+    value: string;
+    case: "textChunk";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | {
+    /**
+     * 最終メタデータ（ストリーム終了時）
+     *
+     * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+     */
+    value: ResponseMetadata;
+    case: "metadata";
+  } | {
+    /**
+     * AIが呼び出したツール
+     *
+     * @generated from field: mahjong.ai.v1.ToolCallInfo tool_call = 5;
+     */
+    value: ToolCallInfo;
+    case: "toolCall";
+  } | {
+    /**
+     * 回答の検証結果（最終メタデータの直前）
+     *
+     * @generated from field: mahjong.ai.v1.AnswerVerification verification = 6;
+     */
+    value: AnswerVerification;
+    case: "verification";
+  } | {
+    /**
+     * 回答の根拠としてAIに渡したルールの資料の抜粋（テキストの後）
+     *
+     * @generated from field: mahjong.ai.v1.CitationList citations = 7;
+     */
+    value: CitationList;
+    case: "citations";
+  } | {
+    /**
+     * 画像の手牌を書き起こした結果（transcribe_hand を指定した場合、テキストの前）
+     *
+     * @generated from field: mahjong.ai.v1.HandTranscription transcription = 8;
+     */
+    value: HandTranscription;
+    case: "transcription";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * 最終チャンクかどうか
+   *
+   * @generated from field: bool is_final = 4;
+   */
+  isFinal = false;
+
+  constructor(data?: PartialMessage<AskMahjongAIStreamResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.AskMahjongAIStreamResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "text_chunk", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "chunk" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "chunk" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata, oneof: "chunk" },
+    { no: 5, name: "tool_call", kind: "message", T: ToolCallInfo, oneof: "chunk" },
+    { no: 6, name: "verification", kind: "message", T: AnswerVerification, oneof: "chunk" },
+    { no: 7, name: "citations", kind: "message", T: CitationList, oneof: "chunk" },
+    { no: 8, name: "transcription", kind: "message", T: HandTranscription, oneof: "chunk" },
+    { no: 4, name: "is_final", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AskMahjongAIStreamResponse {
+    return new AskMahjongAIStreamResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AskMahjongAIStreamResponse {
+    return new AskMahjongAIStreamResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AskMahjongAIStreamResponse {
+    return new AskMahjongAIStreamResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AskMahjongAIStreamResponse | PlainMessage<AskMahjongAIStreamResponse> | undefined, b: AskMahjongAIStreamResponse | PlainMessage<AskMahjongAIStreamResponse> | undefined): boolean {
+    return proto3.util.equals(AskMahjongAIStreamResponse, a, b);
+  }
+}
+
+/**
+ * 待ち判定のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.GetWaitsRequest
+ */
+export class GetWaitsRequest extends Message<GetWaitsRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 門前の牌（副露1つにつき3枚少ない13枚）
+   *
+   * @generated from field: string hand = 2;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 3;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * ルールセット名（空の場合はデフォルト）
+   *
+   * @generated from field: string rule_set = 4;
+   */
+  ruleSet = "";
+
+  /**
+   * 自風（未指定の場合は南家）
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat_wind = 5;
+   */
+  seatWind = Wind.UNSPECIFIED;
+
+  /**
+   * 場風（未指定の場合は東場）
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 6;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * 立直しているか
+   *
+   * @generated from field: bool riichi = 7;
+   */
+  riichi = false;
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicators = 8;
+   */
+  doraIndicators = "";
+
+  /**
+   * 自分の捨て牌
+   *
+   * @generated from field: string discards = 9;
+   */
+  discards = "";
+
+  /**
+   * 自分の最後の打牌以降に見逃した牌
+   *
+   * @generated from field: string passed_since_last_discard = 10;
+   */
+  passedSinceLastDiscard = "";
+
+  /**
+   * 立直後に見逃した牌
+   *
+   * @generated from field: string passed_after_riichi = 11;
+   */
+  passedAfterRiichi = "";
+
+  /**
+   * 手牌以外で見えている牌（残り枚数の計算用）
+   *
+   * @generated from field: string visible_tiles = 12;
+   */
+  visibleTiles = "";
+
+  constructor(data?: PartialMessage<GetWaitsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetWaitsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 4, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "seat_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 6, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 7, name: "riichi", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "dora_indicators", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "discards", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "passed_since_last_discard", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "passed_after_riichi", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "visible_tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWaitsRequest {
+    return new GetWaitsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWaitsRequest {
+    return new GetWaitsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWaitsRequest {
+    return new GetWaitsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWaitsRequest | PlainMessage<GetWaitsRequest> | undefined, b: GetWaitsRequest | PlainMessage<GetWaitsRequest> | undefined): boolean {
+    return proto3.util.equals(GetWaitsRequest, a, b);
+  }
+}
+
+/**
+ * 待ち判定のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.GetWaitsResponse
+ */
+export class GetWaitsResponse extends Message<GetWaitsResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.GetWaitsResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.WaitsResult waits = 1;
+     */
+    value: WaitsResult;
+    case: "waits";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<GetWaitsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetWaitsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "waits", kind: "message", T: WaitsResult, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetWaitsResponse {
+    return new GetWaitsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetWaitsResponse {
+    return new GetWaitsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetWaitsResponse {
+    return new GetWaitsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetWaitsResponse | PlainMessage<GetWaitsResponse> | undefined, b: GetWaitsResponse | PlainMessage<GetWaitsResponse> | undefined): boolean {
+    return proto3.util.equals(GetWaitsResponse, a, b);
+  }
+}
+
+/**
+ * 打牌推奨のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.RecommendDiscardRequest
+ */
+export class RecommendDiscardRequest extends Message<RecommendDiscardRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 門前の牌（副露1つにつき3枚少ない14枚）
+   *
+   * @generated from field: string hand = 2;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 3;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * ルールセット名（空の場合はデフォルト）
+   *
+   * @generated from field: string rule_set = 4;
+   */
+  ruleSet = "";
+
+  /**
+   * 自風（未指定の場合は南家）
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat_wind = 5;
+   */
+  seatWind = Wind.UNSPECIFIED;
+
+  /**
+   * 場風（未指定の場合は東場）
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 6;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicators = 7;
+   */
+  doraIndicators = "";
+
+  /**
+   * 自分の捨て牌
+   *
+   * @generated from field: string discards = 8;
+   */
+  discards = "";
+
+  /**
+   * 手牌以外で見えている牌（残り枚数の計算用）
+   *
+   * @generated from field: string visible_tiles = 9;
+   */
+  visibleTiles = "";
+
+  /**
+   * モンテカルロ法の試行回数（0 以下の場合は行わない）
+   *
+   * @generated from field: int32 simulations = 10;
+   */
+  simulations = 0;
+
+  /**
+   * 残りのツモ回数（0 の場合は既定値）
+   *
+   * @generated from field: int32 turns = 11;
+   */
+  turns = 0;
+
+  /**
+   * 乱数の種（同じ種と入力では同じ結果になる）
+   *
+   * @generated from field: uint64 seed = 12;
+   */
+  seed = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RecommendDiscardRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.RecommendDiscardRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 4, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "seat_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 6, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 7, name: "dora_indicators", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "discards", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "visible_tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "simulations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "turns", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "seed", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecommendDiscardRequest {
+    return new RecommendDiscardRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecommendDiscardRequest {
+    return new RecommendDiscardRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecommendDiscardRequest {
+    return new RecommendDiscardRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecommendDiscardRequest | PlainMessage<RecommendDiscardRequest> | undefined, b: RecommendDiscardRequest | PlainMessage<RecommendDiscardRequest> | undefined): boolean {
+    return proto3.util.equals(RecommendDiscardRequest, a, b);
+  }
+}
+
+/**
+ * 打牌推奨のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.RecommendDiscardResponse
+ */
+export class RecommendDiscardResponse extends Message<RecommendDiscardResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.RecommendDiscardResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.DiscardResult discard = 1;
+     */
+    value: DiscardResult;
+    case: "discard";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<RecommendDiscardResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.RecommendDiscardResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "discard", kind: "message", T: DiscardResult, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecommendDiscardResponse {
+    return new RecommendDiscardResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecommendDiscardResponse {
+    return new RecommendDiscardResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecommendDiscardResponse {
+    return new RecommendDiscardResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecommendDiscardResponse | PlainMessage<RecommendDiscardResponse> | undefined, b: RecommendDiscardResponse | PlainMessage<RecommendDiscardResponse> | undefined): boolean {
+    return proto3.util.equals(RecommendDiscardResponse, a, b);
+  }
+}
+
+/**
+ * 手牌のシミュレーションのリクエスト
+ *
+ * @generated from message mahjong.ai.v1.SimulateHandRequest
+ */
+export class SimulateHandRequest extends Message<SimulateHandRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 門前の牌（副露1つにつき3枚少ない13枚または14枚）
+   *
+   * @generated from field: string hand = 2;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 3;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * ルールセット名（空の場合はデフォルト）
+   *
+   * @generated from field: string rule_set = 4;
+   */
+  ruleSet = "";
+
+  /**
+   * 自風（未指定の場合は南家）
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat_wind = 5;
+   */
+  seatWind = Wind.UNSPECIFIED;
+
+  /**
+   * 場風（未指定の場合は東場）
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 6;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicators = 7;
+   */
+  doraIndicators = "";
+
+  /**
+   * 自分の捨て牌
+   *
+   * @generated from field: string discards = 8;
+   */
+  discards = "";
+
+  /**
+   * 手牌以外で見えている牌（残り枚数の計算用）
+   *
+   * @generated from field: string visible_tiles = 9;
+   */
+  visibleTiles = "";
+
+  /**
+   * 立直済みか（立直済みの場合はツモ切りのみ）
+   *
+   * @generated from field: bool riichi = 10;
+   */
+  riichi = false;
+
+  /**
+   * 試行回数（0 の場合は既定値）
+   *
+   * @generated from field: int32 simulations = 11;
+   */
+  simulations = 0;
+
+  /**
+   * 残りのツモ回数（0 の場合は既定値）
+   *
+   * @generated from field: int32 turns = 12;
+   */
+  turns = 0;
+
+  /**
+   * 乱数の種（同じ種と入力では同じ結果になる）
+   *
+   * @generated from field: uint64 seed = 13;
+   */
+  seed = protoInt64.zero;
+
+  /**
+   * 打牌の方針（efficiency / tsumogiri、空の場合は efficiency）
+   *
+   * @generated from field: string policy = 14;
+   */
+  policy = "";
+
+  constructor(data?: PartialMessage<SimulateHandRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SimulateHandRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 4, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "seat_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 6, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 7, name: "dora_indicators", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "discards", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "visible_tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "riichi", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "simulations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "turns", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 13, name: "seed", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 14, name: "policy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulateHandRequest {
+    return new SimulateHandRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulateHandRequest {
+    return new SimulateHandRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulateHandRequest {
+    return new SimulateHandRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulateHandRequest | PlainMessage<SimulateHandRequest> | undefined, b: SimulateHandRequest | PlainMessage<SimulateHandRequest> | undefined): boolean {
+    return proto3.util.equals(SimulateHandRequest, a, b);
+  }
+}
+
+/**
+ * 手牌のシミュレーションのレスポンス
+ *
+ * @generated from message mahjong.ai.v1.SimulateHandResponse
+ */
+export class SimulateHandResponse extends Message<SimulateHandResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.SimulateHandResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.SimulationResult simulation = 1;
+     */
+    value: SimulationResult;
+    case: "simulation";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<SimulateHandResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SimulateHandResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "simulation", kind: "message", T: SimulationResult, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulateHandResponse {
+    return new SimulateHandResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulateHandResponse {
+    return new SimulateHandResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulateHandResponse {
+    return new SimulateHandResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulateHandResponse | PlainMessage<SimulateHandResponse> | undefined, b: SimulateHandResponse | PlainMessage<SimulateHandResponse> | undefined): boolean {
+    return proto3.util.equals(SimulateHandResponse, a, b);
+  }
+}
+
+/**
+ * 安全度評価のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.AssessSafetyRequest
+ */
+export class AssessSafetyRequest extends Message<AssessSafetyRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 門前の牌
+   *
+   * @generated from field: string hand = 2;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 3;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * 他家（立直していない他家は捨て牌を見えている牌として数える）
+   *
+   * @generated from field: repeated mahjong.ai.v1.OpponentInfo opponents = 4;
+   */
+  opponents: OpponentInfo[] = [];
+
+  /**
+   * 他家の副露など、手牌と他家の捨て牌以外で見えている牌
+   *
+   * @generated from field: string visible_tiles = 5;
+   */
+  visibleTiles = "";
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicators = 6;
+   */
+  doraIndicators = "";
+
+  constructor(data?: PartialMessage<AssessSafetyRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.AssessSafetyRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 4, name: "opponents", kind: "message", T: OpponentInfo, repeated: true },
+    { no: 5, name: "visible_tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "dora_indicators", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssessSafetyRequest {
+    return new AssessSafetyRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssessSafetyRequest {
+    return new AssessSafetyRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssessSafetyRequest {
+    return new AssessSafetyRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AssessSafetyRequest | PlainMessage<AssessSafetyRequest> | undefined, b: AssessSafetyRequest | PlainMessage<AssessSafetyRequest> | undefined): boolean {
+    return proto3.util.equals(AssessSafetyRequest, a, b);
+  }
+}
+
+/**
+ * 安全度評価のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.AssessSafetyResponse
+ */
+export class AssessSafetyResponse extends Message<AssessSafetyResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.AssessSafetyResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.SafetyResult safety = 1;
+     */
+    value: SafetyResult;
+    case: "safety";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<AssessSafetyResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.AssessSafetyResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "safety", kind: "message", T: SafetyResult, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AssessSafetyResponse {
+    return new AssessSafetyResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AssessSafetyResponse {
+    return new AssessSafetyResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AssessSafetyResponse {
+    return new AssessSafetyResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AssessSafetyResponse | PlainMessage<AssessSafetyResponse> | undefined, b: AssessSafetyResponse | PlainMessage<AssessSafetyResponse> | undefined): boolean {
+    return proto3.util.equals(AssessSafetyResponse, a, b);
+  }
+}
+
+/**
+ * 押し引き評価のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.EvaluatePushFoldRequest
+ */
+export class EvaluatePushFoldRequest extends Message<EvaluatePushFoldRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 門前の牌（副露1つにつき3枚少ない14枚）
+   *
+   * @generated from field: string hand = 2;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 3;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * ルールセット名（空の場合はデフォルト）
+   *
+   * @generated from field: string rule_set = 4;
+   */
+  ruleSet = "";
+
+  /**
+   * 自風（未指定の場合は南家）
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat_wind = 5;
+   */
+  seatWind = Wind.UNSPECIFIED;
+
+  /**
+   * 場風（未指定の場合は東場）
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 6;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicators = 7;
+   */
+  doraIndicators = "";
+
+  /**
+   * 自分の捨て牌
+   *
+   * @generated from field: string discards = 8;
+   */
+  discards = "";
+
+  /**
+   * 他家の副露など、手牌と捨て牌以外で見えている牌
+   *
+   * @generated from field: string visible_tiles = 9;
+   */
+  visibleTiles = "";
+
+  /**
+   * 他家（少なくとも1人は立直している必要がある）
+   *
+   * @generated from field: repeated mahjong.ai.v1.OpponentInfo opponents = 10;
+   */
+  opponents: OpponentInfo[] = [];
+
+  /**
+   * 現在の巡目（0 の場合は自分の捨て牌の枚数から求める）
+   *
+   * @generated from field: int32 turn = 11;
+   */
+  turn = 0;
+
+  /**
+   * 自分の持ち点
+   *
+   * @generated from field: int32 own_score = 12;
+   */
+  ownScore = 0;
+
+  /**
+   * オーラスか（順位点を含めて評価する）
+   *
+   * @generated from field: bool all_last = 13;
+   */
+  allLast = false;
+
+  /**
+   * 供託の立直棒の本数（今局の立直者の分を含む）
+   *
+   * @generated from field: int32 riichi_sticks = 14;
+   */
+  riichiSticks = 0;
+
+  /**
+   * 積み棒の本数
+   *
+   * @generated from field: int32 honba = 15;
+   */
+  honba = 0;
+
+  /**
+   * 打点を見積もるモンテカルロ法の試行回数（0 の場合は既定値、負の場合は行わない）
+   *
+   * @generated from field: int32 simulations = 16;
+   */
+  simulations = 0;
+
+  /**
+   * 乱数の種
+   *
+   * @generated from field: uint64 seed = 17;
+   */
+  seed = protoInt64.zero;
+
+  constructor(data?: PartialMessage<EvaluatePushFoldRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.EvaluatePushFoldRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 4, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "seat_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 6, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 7, name: "dora_indicators", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "discards", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "visible_tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "opponents", kind: "message", T: OpponentInfo, repeated: true },
+    { no: 11, name: "turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "own_score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 13, name: "all_last", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 14, name: "riichi_sticks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 15, name: "honba", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 16, name: "simulations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 17, name: "seed", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EvaluatePushFoldRequest {
+    return new EvaluatePushFoldRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EvaluatePushFoldRequest {
+    return new EvaluatePushFoldRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EvaluatePushFoldRequest {
+    return new EvaluatePushFoldRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EvaluatePushFoldRequest | PlainMessage<EvaluatePushFoldRequest> | undefined, b: EvaluatePushFoldRequest | PlainMessage<EvaluatePushFoldRequest> | undefined): boolean {
+    return proto3.util.equals(EvaluatePushFoldRequest, a, b);
+  }
+}
+
+/**
+ * 押し引き評価のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.EvaluatePushFoldResponse
+ */
+export class EvaluatePushFoldResponse extends Message<EvaluatePushFoldResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.EvaluatePushFoldResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.PushFoldResult push_fold = 1;
+     */
+    value: PushFoldResult;
+    case: "pushFold";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<EvaluatePushFoldResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.EvaluatePushFoldResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "push_fold", kind: "message", T: PushFoldResult, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EvaluatePushFoldResponse {
+    return new EvaluatePushFoldResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EvaluatePushFoldResponse {
+    return new EvaluatePushFoldResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EvaluatePushFoldResponse {
+    return new EvaluatePushFoldResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: EvaluatePushFoldResponse | PlainMessage<EvaluatePushFoldResponse> | undefined, b: EvaluatePushFoldResponse | PlainMessage<EvaluatePushFoldResponse> | undefined): boolean {
+    return proto3.util.equals(EvaluatePushFoldResponse, a, b);
+  }
+}
+
+/**
+ * オーラスの点数状況の計算のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.CalculatePlacementRequest
+ */
+export class CalculatePlacementRequest extends Message<CalculatePlacementRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 持ち点（起家からの席順）
+   *
+   * @generated from field: repeated int32 scores = 2;
+   */
+  scores: number[] = [];
+
+  /**
+   * 親の席（起家からの席順、四麻のオーラスは通常 3）
+   *
+   * @generated from field: int32 dealer = 3;
+   */
+  dealer = 0;
+
+  /**
+   * 積み棒の本数
+   *
+   * @generated from field: int32 honba = 4;
+   */
+  honba = 0;
+
+  /**
+   * 供託の立直棒の本数
+   *
+   * @generated from field: int32 riichi_sticks = 5;
+   */
+  riichiSticks = 0;
+
+  /**
+   * ルールセット名（空の場合はデフォルト）
+   *
+   * @generated from field: string rule_set = 6;
+   */
+  ruleSet = "";
+
+  /**
+   * 順位ウマ（千点単位、1位から順に。空の場合はルールセットの値）
+   *
+   * @generated from field: repeated int32 uma = 7;
+   */
+  uma: number[] = [];
+
+  /**
+   * 配給原点（0 の場合はルールセットの値）
+   *
+   * @generated from field: int32 starting_points = 8;
+   */
+  startingPoints = 0;
+
+  /**
+   * 返し点（0 の場合はルールセットの値、オカは返し点と配給原点の差）
+   *
+   * @generated from field: int32 return_points = 9;
+   */
+  returnPoints = 0;
+
+  constructor(data?: PartialMessage<CalculatePlacementRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CalculatePlacementRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "scores", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 3, name: "dealer", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "honba", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "riichi_sticks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "uma", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 8, name: "starting_points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "return_points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CalculatePlacementRequest {
+    return new CalculatePlacementRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CalculatePlacementRequest {
+    return new CalculatePlacementRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CalculatePlacementRequest {
+    return new CalculatePlacementRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CalculatePlacementRequest | PlainMessage<CalculatePlacementRequest> | undefined, b: CalculatePlacementRequest | PlainMessage<CalculatePlacementRequest> | undefined): boolean {
+    return proto3.util.equals(CalculatePlacementRequest, a, b);
+  }
+}
+
+/**
+ * オーラスの点数状況の計算のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.CalculatePlacementResponse
+ */
+export class CalculatePlacementResponse extends Message<CalculatePlacementResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.CalculatePlacementResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.PlacementResult placement = 1;
+     */
+    value: PlacementResult;
+    case: "placement";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<CalculatePlacementResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CalculatePlacementResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "placement", kind: "message", T: PlacementResult, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CalculatePlacementResponse {
+    return new CalculatePlacementResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CalculatePlacementResponse {
+    return new CalculatePlacementResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CalculatePlacementResponse {
+    return new CalculatePlacementResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CalculatePlacementResponse | PlainMessage<CalculatePlacementResponse> | undefined, b: CalculatePlacementResponse | PlainMessage<CalculatePlacementResponse> | undefined): boolean {
+    return proto3.util.equals(CalculatePlacementResponse, a, b);
+  }
+}
+
+/**
+ * 牌譜の取り込みのリクエスト
+ *
+ * @generated from message mahjong.ai.v1.ImportGameLogRequest
+ */
+export class ImportGameLogRequest extends Message<ImportGameLogRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 牌譜の形式（未指定の場合は内容から判定する）
+   *
+   * @generated from field: mahjong.ai.v1.GameLogFormat format = 2;
+   */
+  format = GameLogFormat.UNSPECIFIED;
+
+  /**
+   * 牌譜の内容
+   *
+   * @generated from field: string data = 3;
+   */
+  data = "";
+
+  constructor(data?: PartialMessage<ImportGameLogRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ImportGameLogRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "format", kind: "enum", T: proto3.getEnumType(GameLogFormat) },
+    { no: 3, name: "data", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportGameLogRequest {
+    return new ImportGameLogRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportGameLogRequest {
+    return new ImportGameLogRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportGameLogRequest {
+    return new ImportGameLogRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportGameLogRequest | PlainMessage<ImportGameLogRequest> | undefined, b: ImportGameLogRequest | PlainMessage<ImportGameLogRequest> | undefined): boolean {
+    return proto3.util.equals(ImportGameLogRequest, a, b);
+  }
+}
+
+/**
+ * 牌譜の取り込みのレスポンス
+ *
+ * @generated from message mahjong.ai.v1.ImportGameLogResponse
+ */
+export class ImportGameLogResponse extends Message<ImportGameLogResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.ImportGameLogResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.GameLog game_log = 1;
+     */
+    value: GameLog;
+    case: "gameLog";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<ImportGameLogResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ImportGameLogResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "game_log", kind: "message", T: GameLog, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ImportGameLogResponse {
+    return new ImportGameLogResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ImportGameLogResponse {
+    return new ImportGameLogResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ImportGameLogResponse {
+    return new ImportGameLogResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ImportGameLogResponse | PlainMessage<ImportGameLogResponse> | undefined, b: ImportGameLogResponse | PlainMessage<ImportGameLogResponse> | undefined): boolean {
+    return proto3.util.equals(ImportGameLogResponse, a, b);
+  }
+}
+
+/**
+ * 牌譜の打牌の判断の取得のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.GetGameLogStepRequest
+ */
+export class GetGameLogStepRequest extends Message<GetGameLogStepRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 打牌の判断の位置
+   *
+   * @generated from field: mahjong.ai.v1.GameLogPosition position = 2;
+   */
+  position?: GameLogPosition;
+
+  constructor(data?: PartialMessage<GetGameLogStepRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetGameLogStepRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "position", kind: "message", T: GameLogPosition },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGameLogStepRequest {
+    return new GetGameLogStepRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGameLogStepRequest {
+    return new GetGameLogStepRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGameLogStepRequest {
+    return new GetGameLogStepRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGameLogStepRequest | PlainMessage<GetGameLogStepRequest> | undefined, b: GetGameLogStepRequest | PlainMessage<GetGameLogStepRequest> | undefined): boolean {
+    return proto3.util.equals(GetGameLogStepRequest, a, b);
+  }
+}
+
+/**
+ * 牌譜の打牌の判断の取得のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.GetGameLogStepResponse
+ */
+export class GetGameLogStepResponse extends Message<GetGameLogStepResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.GetGameLogStepResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.GameLogStep step = 1;
+     */
+    value: GameLogStep;
+    case: "step";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<GetGameLogStepResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetGameLogStepResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "step", kind: "message", T: GameLogStep, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGameLogStepResponse {
+    return new GetGameLogStepResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGameLogStepResponse {
+    return new GetGameLogStepResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGameLogStepResponse {
+    return new GetGameLogStepResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGameLogStepResponse | PlainMessage<GetGameLogStepResponse> | undefined, b: GetGameLogStepResponse | PlainMessage<GetGameLogStepResponse> | undefined): boolean {
+    return proto3.util.equals(GetGameLogStepResponse, a, b);
+  }
+}
+
+/**
+ * 牌譜の検討のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.ReviewGameRequest
+ */
+export class ReviewGameRequest extends Message<ReviewGameRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 牌譜ID（ImportGameLog の結果）
+   *
+   * @generated from field: string game_id = 2;
+   */
+  gameId = "";
+
+  /**
+   * 検討するプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 3;
+   */
+  player = 0;
+
+  /**
+   * AIに解説させる悪手の数（0 の場合は5、最大20）
+   *
+   * @generated from field: int32 top_n = 4;
+   */
+  topN = 0;
+
+  /**
+   * 解説に使うペルソナ名（空の場合はデフォルト）
+   *
+   * @generated from field: string persona = 5;
+   */
+  persona = "";
+
+  constructor(data?: PartialMessage<ReviewGameRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ReviewGameRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "top_n", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "persona", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewGameRequest {
+    return new ReviewGameRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewGameRequest {
+    return new ReviewGameRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewGameRequest {
+    return new ReviewGameRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReviewGameRequest | PlainMessage<ReviewGameRequest> | undefined, b: ReviewGameRequest | PlainMessage<ReviewGameRequest> | undefined): boolean {
+    return proto3.util.equals(ReviewGameRequest, a, b);
+  }
+}
+
+/**
+ * 牌譜の検討結果の取得のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.GetGameReviewRequest
+ */
+export class GetGameReviewRequest extends Message<GetGameReviewRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 検討ID
+   *
+   * @generated from field: string review_id = 2;
+   */
+  reviewId = "";
+
+  constructor(data?: PartialMessage<GetGameReviewRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetGameReviewRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "review_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetGameReviewRequest {
+    return new GetGameReviewRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetGameReviewRequest {
+    return new GetGameReviewRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetGameReviewRequest {
+    return new GetGameReviewRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetGameReviewRequest | PlainMessage<GetGameReviewRequest> | undefined, b: GetGameReviewRequest | PlainMessage<GetGameReviewRequest> | undefined): boolean {
+    return proto3.util.equals(GetGameReviewRequest, a, b);
+  }
+}
+
+/**
+ * 牌譜の検討のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.GameReviewResponse
+ */
+export class GameReviewResponse extends Message<GameReviewResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.GameReviewResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.GameReview review = 1;
+     */
+    value: GameReview;
+    case: "review";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<GameReviewResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameReviewResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "review", kind: "message", T: GameReview, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameReviewResponse {
+    return new GameReviewResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameReviewResponse {
+    return new GameReviewResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameReviewResponse {
+    return new GameReviewResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameReviewResponse | PlainMessage<GameReviewResponse> | undefined, b: GameReviewResponse | PlainMessage<GameReviewResponse> | undefined): boolean {
+    return proto3.util.equals(GameReviewResponse, a, b);
+  }
+}
+
+/**
+ * コーチングのリクエスト（ストリームで順に送る）
+ *
+ * @generated from message mahjong.ai.v1.CoachRequest
+ */
+export class CoachRequest extends Message<CoachRequest> {
+  /**
+   * リクエストメタデータ（最初のリクエストのみ参照する）
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * @generated from oneof mahjong.ai.v1.CoachRequest.payload
+   */
+  payload: {
+    /**
+     * 対局の出来事
+     *
+     * @generated from field: mahjong.ai.v1.CoachEvent event = 2;
+     */
+    value: CoachEvent;
+    case: "event";
+  } | {
+    /**
+     * 解説の依頼
+     *
+     * @generated from field: mahjong.ai.v1.CoachExplainRequest explain = 3;
+     */
+    value: CoachExplainRequest;
+    case: "explain";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<CoachRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "event", kind: "message", T: CoachEvent, oneof: "payload" },
+    { no: 3, name: "explain", kind: "message", T: CoachExplainRequest, oneof: "payload" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachRequest {
+    return new CoachRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachRequest {
+    return new CoachRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachRequest {
+    return new CoachRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachRequest | PlainMessage<CoachRequest> | undefined, b: CoachRequest | PlainMessage<CoachRequest> | undefined): boolean {
+    return proto3.util.equals(CoachRequest, a, b);
+  }
+}
+
+/**
+ * コーチングのレスポンス
+ *
+ * @generated from message mahjong.ai.v1.CoachResponse
+ */
+export class CoachResponse extends Message<CoachResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.CoachResponse.payload
+   */
+  payload: {
+    /**
+     * エンジンによる推奨
+     *
+     * @generated from field: mahjong.ai.v1.CoachAdvice advice = 1;
+     */
+    value: CoachAdvice;
+    case: "advice";
+  } | {
+    /**
+     * 状況の変化の警告
+     *
+     * @generated from field: mahjong.ai.v1.CoachWarning warning = 2;
+     */
+    value: CoachWarning;
+    case: "warning";
+  } | {
+    /**
+     * AIによる解説の一部
+     *
+     * @generated from field: mahjong.ai.v1.CoachExplanation explanation = 3;
+     */
+    value: CoachExplanation;
+    case: "explanation";
+  } | {
+    /**
+     * リクエストを処理できなかった場合のエラー情報（ストリームは続く）
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 4;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 5;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<CoachResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "advice", kind: "message", T: CoachAdvice, oneof: "payload" },
+    { no: 2, name: "warning", kind: "message", T: CoachWarning, oneof: "payload" },
+    { no: 3, name: "explanation", kind: "message", T: CoachExplanation, oneof: "payload" },
+    { no: 4, name: "error", kind: "message", T: ErrorInfo, oneof: "payload" },
+    { no: 5, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachResponse {
+    return new CoachResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachResponse {
+    return new CoachResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachResponse {
+    return new CoachResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachResponse | PlainMessage<CoachResponse> | undefined, b: CoachResponse | PlainMessage<CoachResponse> | undefined): boolean {
+    return proto3.util.equals(CoachResponse, a, b);
+  }
+}
+
+/**
+ * ルールの資料の索引の作り直しのリクエスト
+ *
+ * @generated from message mahjong.ai.v1.ReindexKnowledgeRequest
+ */
+export class ReindexKnowledgeRequest extends Message<ReindexKnowledgeRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 本文が変わっていない抜粋も埋め込みベクトルを求め直すか
+   *
+   * @generated from field: bool full = 2;
+   */
+  full = false;
+
+  constructor(data?: PartialMessage<ReindexKnowledgeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ReindexKnowledgeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "full", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReindexKnowledgeRequest {
+    return new ReindexKnowledgeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReindexKnowledgeRequest {
+    return new ReindexKnowledgeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReindexKnowledgeRequest {
+    return new ReindexKnowledgeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReindexKnowledgeRequest | PlainMessage<ReindexKnowledgeRequest> | undefined, b: ReindexKnowledgeRequest | PlainMessage<ReindexKnowledgeRequest> | undefined): boolean {
+    return proto3.util.equals(ReindexKnowledgeRequest, a, b);
+  }
+}
+
+/**
+ * ルールの資料の索引の作り直しのレスポンス
+ *
+ * @generated from message mahjong.ai.v1.ReindexKnowledgeResponse
+ */
+export class ReindexKnowledgeResponse extends Message<ReindexKnowledgeResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.ReindexKnowledgeResponse.result
+   */
+  result: {
+    /**
+     * 成功時の結果
+     *
+     * @generated from field: mahjong.ai.v1.KnowledgeIndexInfo index = 1;
+     */
+    value: KnowledgeIndexInfo;
+    case: "index";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<ReindexKnowledgeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ReindexKnowledgeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "index", kind: "message", T: KnowledgeIndexInfo, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReindexKnowledgeResponse {
+    return new ReindexKnowledgeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReindexKnowledgeResponse {
+    return new ReindexKnowledgeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReindexKnowledgeResponse {
+    return new ReindexKnowledgeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReindexKnowledgeResponse | PlainMessage<ReindexKnowledgeResponse> | undefined, b: ReindexKnowledgeResponse | PlainMessage<ReindexKnowledgeResponse> | undefined): boolean {
+    return proto3.util.equals(ReindexKnowledgeResponse, a, b);
+  }
+}
+
+/**
+ * 手牌・河・局面の図の作成のリクエスト
+ * hand・melds・drawn・discards で手牌と河を、game_state または game_log_position で卓全体を描く
+ *
+ * @generated from message mahjong.ai.v1.RenderHandRequest
+ */
+export class RenderHandRequest extends Message<RenderHandRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 門前の牌（MPSZ表記、並べた順に描く）
+   *
+   * @generated from field: string hand = 2;
+   */
+  hand = "";
+
+  /**
+   * 副露（手牌の右に描く）
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 3;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * ツモ牌（手牌から離して描く）
+   *
+   * @generated from field: string drawn = 4;
+   */
+  drawn = "";
+
+  /**
+   * 河（手牌の上に6枚ずつ描く）
+   *
+   * @generated from field: repeated mahjong.ai.v1.DiscardedTile discards = 5;
+   */
+  discards: DiscardedTile[] = [];
+
+  /**
+   * 卓全体を描く局面（手牌・河とは同時に指定できない）
+   *
+   * @generated from field: mahjong.ai.v1.GameState game_state = 6;
+   */
+  gameState?: GameState;
+
+  /**
+   * 卓全体を描く取り込んだ牌譜の局面（game_state とは同時に指定できない）
+   *
+   * @generated from field: mahjong.ai.v1.GameLogPosition game_log_position = 7;
+   */
+  gameLogPosition?: GameLogPosition;
+
+  /**
+   * 局面の検証に使うルールセット名（空の場合はデフォルト、牌譜は対局のルール）
+   *
+   * @generated from field: string rule_set = 8;
+   */
+  ruleSet = "";
+
+  /**
+   * 図の形式
+   *
+   * @generated from field: mahjong.ai.v1.DiagramFormat format = 9;
+   */
+  format = DiagramFormat.UNSPECIFIED;
+
+  /**
+   * 拡大率（0 は1倍、最大4倍）
+   *
+   * @generated from field: float scale = 10;
+   */
+  scale = 0;
+
+  constructor(data?: PartialMessage<RenderHandRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.RenderHandRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 4, name: "drawn", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "discards", kind: "message", T: DiscardedTile, repeated: true },
+    { no: 6, name: "game_state", kind: "message", T: GameState },
+    { no: 7, name: "game_log_position", kind: "message", T: GameLogPosition },
+    { no: 8, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "format", kind: "enum", T: proto3.getEnumType(DiagramFormat) },
+    { no: 10, name: "scale", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenderHandRequest {
+    return new RenderHandRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenderHandRequest {
+    return new RenderHandRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenderHandRequest {
+    return new RenderHandRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenderHandRequest | PlainMessage<RenderHandRequest> | undefined, b: RenderHandRequest | PlainMessage<RenderHandRequest> | undefined): boolean {
+    return proto3.util.equals(RenderHandRequest, a, b);
+  }
+}
+
+/**
+ * 図
+ *
+ * @generated from message mahjong.ai.v1.Diagram
+ */
+export class Diagram extends Message<Diagram> {
+  /**
+   * 図の内容
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data = new Uint8Array(0);
+
+  /**
+   * MIMEタイプ (image/svg+xml, image/png)
+   *
+   * @generated from field: string mime_type = 2;
+   */
+  mimeType = "";
+
+  /**
+   * 幅（ピクセル）
+   *
+   * @generated from field: int32 width = 3;
+   */
+  width = 0;
+
+  /**
+   * 高さ（ピクセル）
+   *
+   * @generated from field: int32 height = 4;
+   */
+  height = 0;
+
+  constructor(data?: PartialMessage<Diagram>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.Diagram";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "data", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 2, name: "mime_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "width", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "height", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Diagram {
+    return new Diagram().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Diagram {
+    return new Diagram().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Diagram {
+    return new Diagram().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Diagram | PlainMessage<Diagram> | undefined, b: Diagram | PlainMessage<Diagram> | undefined): boolean {
+    return proto3.util.equals(Diagram, a, b);
+  }
+}
+
+/**
+ * 手牌・河・局面の図の作成のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.RenderHandResponse
+ */
+export class RenderHandResponse extends Message<RenderHandResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.RenderHandResponse.result
+   */
+  result: {
+    /**
+     * 成功時の図
+     *
+     * @generated from field: mahjong.ai.v1.Diagram diagram = 1;
+     */
+    value: Diagram;
+    case: "diagram";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 3;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<RenderHandResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.RenderHandResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "diagram", kind: "message", T: Diagram, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RenderHandResponse {
+    return new RenderHandResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RenderHandResponse {
+    return new RenderHandResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RenderHandResponse {
+    return new RenderHandResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RenderHandResponse | PlainMessage<RenderHandResponse> | undefined, b: RenderHandResponse | PlainMessage<RenderHandResponse> | undefined): boolean {
+    return proto3.util.equals(RenderHandResponse, a, b);
+  }
+}
+
+/**
+ * 何切る問題の取得のリクエスト
+ * quiz_id を指定すると出題済みの問題を、daily では日替わりの問題を、どちらもなければ新しい問題を返す
+ *
+ * @generated from message mahjong.ai.v1.GetQuizRequest
+ */
+export class GetQuizRequest extends Message<GetQuizRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 出題済みの問題ID
+   *
+   * @generated from field: string quiz_id = 2;
+   */
+  quizId = "";
+
+  /**
+   * 日替わりの問題（同じ日・ルールセットでは誰にでも同じ問題）
+   *
+   * @generated from field: bool daily = 3;
+   */
+  daily = false;
+
+  /**
+   * 新しい問題のルールセット名（空の場合はデフォルト）
+   *
+   * @generated from field: string rule_set = 4;
+   */
+  ruleSet = "";
+
+  /**
+   * 成績を返すユーザーID（空の場合は返さない）
+   *
+   * @generated from field: string user_id = 5;
+   */
+  userId = "";
+
+  constructor(data?: PartialMessage<GetQuizRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetQuizRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "quiz_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "daily", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuizRequest {
+    return new GetQuizRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuizRequest {
+    return new GetQuizRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuizRequest {
+    return new GetQuizRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetQuizRequest | PlainMessage<GetQuizRequest> | undefined, b: GetQuizRequest | PlainMessage<GetQuizRequest> | undefined): boolean {
+    return proto3.util.equals(GetQuizRequest, a, b);
+  }
+}
+
+/**
+ * 何切る問題の取得のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.GetQuizResponse
+ */
+export class GetQuizResponse extends Message<GetQuizResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.GetQuizResponse.result
+   */
+  result: {
+    /**
+     * 成功時の問題
+     *
+     * @generated from field: mahjong.ai.v1.Quiz quiz = 1;
+     */
+    value: Quiz;
+    case: "quiz";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * ユーザーの成績（user_id を指定した場合）
+   *
+   * @generated from field: mahjong.ai.v1.QuizStats stats = 3;
+   */
+  stats?: QuizStats;
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 4;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<GetQuizResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GetQuizResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "quiz", kind: "message", T: Quiz, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "stats", kind: "message", T: QuizStats },
+    { no: 4, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetQuizResponse {
+    return new GetQuizResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetQuizResponse {
+    return new GetQuizResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetQuizResponse {
+    return new GetQuizResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetQuizResponse | PlainMessage<GetQuizResponse> | undefined, b: GetQuizResponse | PlainMessage<GetQuizResponse> | undefined): boolean {
+    return proto3.util.equals(GetQuizResponse, a, b);
+  }
+}
+
+/**
+ * 何切る問題の回答のリクエスト
+ *
+ * @generated from message mahjong.ai.v1.SubmitQuizAnswerRequest
+ */
+export class SubmitQuizAnswerRequest extends Message<SubmitQuizAnswerRequest> {
+  /**
+   * リクエストメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.RequestMetadata metadata = 1;
+   */
+  metadata?: RequestMetadata;
+
+  /**
+   * 問題ID
+   *
+   * @generated from field: string quiz_id = 2;
+   */
+  quizId = "";
+
+  /**
+   * 回答したユーザーID（空の場合は成績に記録しない）
+   *
+   * @generated from field: string user_id = 3;
+   */
+  userId = "";
+
+  /**
+   * 打牌（1枚のMPSZ表記）
+   *
+   * @generated from field: string discard = 4;
+   */
+  discard = "";
+
+  /**
+   * 解説に使うペルソナ名（空の場合はデフォルト）
+   *
+   * @generated from field: string persona = 5;
+   */
+  persona = "";
+
+  constructor(data?: PartialMessage<SubmitQuizAnswerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SubmitQuizAnswerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "metadata", kind: "message", T: RequestMetadata },
+    { no: 2, name: "quiz_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "discard", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "persona", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubmitQuizAnswerRequest {
+    return new SubmitQuizAnswerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubmitQuizAnswerRequest {
+    return new SubmitQuizAnswerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubmitQuizAnswerRequest {
+    return new SubmitQuizAnswerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubmitQuizAnswerRequest | PlainMessage<SubmitQuizAnswerRequest> | undefined, b: SubmitQuizAnswerRequest | PlainMessage<SubmitQuizAnswerRequest> | undefined): boolean {
+    return proto3.util.equals(SubmitQuizAnswerRequest, a, b);
+  }
+}
+
+/**
+ * 何切る問題の回答のレスポンス
+ *
+ * @generated from message mahjong.ai.v1.SubmitQuizAnswerResponse
+ */
+export class SubmitQuizAnswerResponse extends Message<SubmitQuizAnswerResponse> {
+  /**
+   * @generated from oneof mahjong.ai.v1.SubmitQuizAnswerResponse.result
+   */
+  result: {
+    /**
+     * 成功時の採点結果
+     *
+     * @generated from field: mahjong.ai.v1.QuizGrade grade = 1;
+     */
+    value: QuizGrade;
+    case: "grade";
+  } | {
+    /**
+     * エラー情報
+     *
+     * @generated from field: mahjong.ai.v1.ErrorInfo error = 2;
+     */
+    value: ErrorInfo;
+    case: "error";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * 回答を記録した後のユーザーの成績（user_id を指定した場合）
+   *
+   * @generated from field: mahjong.ai.v1.QuizStats stats = 3;
+   */
+  stats?: QuizStats;
+
+  /**
+   * レスポンスメタデータ
+   *
+   * @generated from field: mahjong.ai.v1.ResponseMetadata metadata = 4;
+   */
+  metadata?: ResponseMetadata;
+
+  constructor(data?: PartialMessage<SubmitQuizAnswerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SubmitQuizAnswerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "grade", kind: "message", T: QuizGrade, oneof: "result" },
+    { no: 2, name: "error", kind: "message", T: ErrorInfo, oneof: "result" },
+    { no: 3, name: "stats", kind: "message", T: QuizStats },
+    { no: 4, name: "metadata", kind: "message", T: ResponseMetadata },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubmitQuizAnswerResponse {
+    return new SubmitQuizAnswerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubmitQuizAnswerResponse {
+    return new SubmitQuizAnswerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubmitQuizAnswerResponse {
+    return new SubmitQuizAnswerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubmitQuizAnswerResponse | PlainMessage<SubmitQuizAnswerResponse> | undefined, b: SubmitQuizAnswerResponse | PlainMessage<SubmitQuizAnswerResponse> | undefined): boolean {
+    return proto3.util.equals(SubmitQuizAnswerResponse, a, b);
   }
 }
 
@@ -0,0 +1,1838 @@
+// @generated by protoc-gen-es v1.2.0 with parameter "import_extension=none,target=ts"
+// @generated from file mahjong/ai/v1/analysis.proto (package mahjong.ai.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+
+/**
+ * 副露の種類
+ *
+ * @generated from enum mahjong.ai.v1.MeldType
+ */
+export enum MeldType {
+  /**
+   * @generated from enum value: MELD_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * チー
+   *
+   * @generated from enum value: MELD_TYPE_CHI = 1;
+   */
+  CHI = 1,
+
+  /**
+   * ポン
+   *
+   * @generated from enum value: MELD_TYPE_PON = 2;
+   */
+  PON = 2,
+
+  /**
+   * 大明槓
+   *
+   * @generated from enum value: MELD_TYPE_MINKAN = 3;
+   */
+  MINKAN = 3,
+
+  /**
+   * 暗槓
+   *
+   * @generated from enum value: MELD_TYPE_ANKAN = 4;
+   */
+  ANKAN = 4,
+
+  /**
+   * 加槓
+   *
+   * @generated from enum value: MELD_TYPE_KAKAN = 5;
+   */
+  KAKAN = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MeldType)
+proto3.util.setEnumType(MeldType, "mahjong.ai.v1.MeldType", [
+  { no: 0, name: "MELD_TYPE_UNSPECIFIED" },
+  { no: 1, name: "MELD_TYPE_CHI" },
+  { no: 2, name: "MELD_TYPE_PON" },
+  { no: 3, name: "MELD_TYPE_MINKAN" },
+  { no: 4, name: "MELD_TYPE_ANKAN" },
+  { no: 5, name: "MELD_TYPE_KAKAN" },
+]);
+
+/**
+ * 風
+ *
+ * @generated from enum mahjong.ai.v1.Wind
+ */
+export enum Wind {
+  /**
+   * @generated from enum value: WIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: WIND_EAST = 1;
+   */
+  EAST = 1,
+
+  /**
+   * @generated from enum value: WIND_SOUTH = 2;
+   */
+  SOUTH = 2,
+
+  /**
+   * @generated from enum value: WIND_WEST = 3;
+   */
+  WEST = 3,
+
+  /**
+   * @generated from enum value: WIND_NORTH = 4;
+   */
+  NORTH = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Wind)
+proto3.util.setEnumType(Wind, "mahjong.ai.v1.Wind", [
+  { no: 0, name: "WIND_UNSPECIFIED" },
+  { no: 1, name: "WIND_EAST" },
+  { no: 2, name: "WIND_SOUTH" },
+  { no: 3, name: "WIND_WEST" },
+  { no: 4, name: "WIND_NORTH" },
+]);
+
+/**
+ * 待ちの形
+ *
+ * @generated from enum mahjong.ai.v1.WaitShape
+ */
+export enum WaitShape {
+  /**
+   * @generated from enum value: WAIT_SHAPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 両面
+   *
+   * @generated from enum value: WAIT_SHAPE_RYANMEN = 1;
+   */
+  RYANMEN = 1,
+
+  /**
+   * 嵌張
+   *
+   * @generated from enum value: WAIT_SHAPE_KANCHAN = 2;
+   */
+  KANCHAN = 2,
+
+  /**
+   * 辺張
+   *
+   * @generated from enum value: WAIT_SHAPE_PENCHAN = 3;
+   */
+  PENCHAN = 3,
+
+  /**
+   * 双碰
+   *
+   * @generated from enum value: WAIT_SHAPE_SHANPON = 4;
+   */
+  SHANPON = 4,
+
+  /**
+   * 単騎
+   *
+   * @generated from enum value: WAIT_SHAPE_TANKI = 5;
+   */
+  TANKI = 5,
+
+  /**
+   * 延べ単
+   *
+   * @generated from enum value: WAIT_SHAPE_NOBETAN = 6;
+   */
+  NOBETAN = 6,
+
+  /**
+   * 多面張
+   *
+   * @generated from enum value: WAIT_SHAPE_MULTI_SIDED = 7;
+   */
+  MULTI_SIDED = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(WaitShape)
+proto3.util.setEnumType(WaitShape, "mahjong.ai.v1.WaitShape", [
+  { no: 0, name: "WAIT_SHAPE_UNSPECIFIED" },
+  { no: 1, name: "WAIT_SHAPE_RYANMEN" },
+  { no: 2, name: "WAIT_SHAPE_KANCHAN" },
+  { no: 3, name: "WAIT_SHAPE_PENCHAN" },
+  { no: 4, name: "WAIT_SHAPE_SHANPON" },
+  { no: 5, name: "WAIT_SHAPE_TANKI" },
+  { no: 6, name: "WAIT_SHAPE_NOBETAN" },
+  { no: 7, name: "WAIT_SHAPE_MULTI_SIDED" },
+]);
+
+/**
+ * 立直者に対する牌の安全度の分類
+ *
+ * @generated from enum mahjong.ai.v1.SafetyClass
+ */
+export enum SafetyClass {
+  /**
+   * @generated from enum value: SAFETY_CLASS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 現物
+   *
+   * @generated from enum value: SAFETY_CLASS_GENBUTSU = 1;
+   */
+  GENBUTSU = 1,
+
+  /**
+   * 壁・字牌の残りなしによるノーチャンス
+   *
+   * @generated from enum value: SAFETY_CLASS_NO_CHANCE = 2;
+   */
+  NO_CHANCE = 2,
+
+  /**
+   * 筋
+   *
+   * @generated from enum value: SAFETY_CLASS_SUJI = 3;
+   */
+  SUJI = 3,
+
+  /**
+   * 字牌（残りあり）
+   *
+   * @generated from enum value: SAFETY_CLASS_HONOR = 4;
+   */
+  HONOR = 4,
+
+  /**
+   * ワンチャンス
+   *
+   * @generated from enum value: SAFETY_CLASS_ONE_CHANCE = 5;
+   */
+  ONE_CHANCE = 5,
+
+  /**
+   * 片筋
+   *
+   * @generated from enum value: SAFETY_CLASS_HALF_SUJI = 6;
+   */
+  HALF_SUJI = 6,
+
+  /**
+   * 無筋
+   *
+   * @generated from enum value: SAFETY_CLASS_MUSUJI = 7;
+   */
+  MUSUJI = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SafetyClass)
+proto3.util.setEnumType(SafetyClass, "mahjong.ai.v1.SafetyClass", [
+  { no: 0, name: "SAFETY_CLASS_UNSPECIFIED" },
+  { no: 1, name: "SAFETY_CLASS_GENBUTSU" },
+  { no: 2, name: "SAFETY_CLASS_NO_CHANCE" },
+  { no: 3, name: "SAFETY_CLASS_SUJI" },
+  { no: 4, name: "SAFETY_CLASS_HONOR" },
+  { no: 5, name: "SAFETY_CLASS_ONE_CHANCE" },
+  { no: 6, name: "SAFETY_CLASS_HALF_SUJI" },
+  { no: 7, name: "SAFETY_CLASS_MUSUJI" },
+]);
+
+/**
+ * 押し引きの選択肢
+ *
+ * @generated from enum mahjong.ai.v1.PushFoldAction
+ */
+export enum PushFoldAction {
+  /**
+   * @generated from enum value: PUSH_FOLD_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 押し
+   *
+   * @generated from enum value: PUSH_FOLD_ACTION_PUSH = 1;
+   */
+  PUSH = 1,
+
+  /**
+   * 回し
+   *
+   * @generated from enum value: PUSH_FOLD_ACTION_MAWASHI = 2;
+   */
+  MAWASHI = 2,
+
+  /**
+   * 降り
+   *
+   * @generated from enum value: PUSH_FOLD_ACTION_FOLD = 3;
+   */
+  FOLD = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(PushFoldAction)
+proto3.util.setEnumType(PushFoldAction, "mahjong.ai.v1.PushFoldAction", [
+  { no: 0, name: "PUSH_FOLD_ACTION_UNSPECIFIED" },
+  { no: 1, name: "PUSH_FOLD_ACTION_PUSH" },
+  { no: 2, name: "PUSH_FOLD_ACTION_MAWASHI" },
+  { no: 3, name: "PUSH_FOLD_ACTION_FOLD" },
+]);
+
+/**
+ * 副露
+ *
+ * @generated from message mahjong.ai.v1.Meld
+ */
+export class Meld extends Message<Meld> {
+  /**
+   * 副露の種類
+   *
+   * @generated from field: mahjong.ai.v1.MeldType type = 1;
+   */
+  type = MeldType.UNSPECIFIED;
+
+  /**
+   * 副露した牌（例: "555z"）
+   *
+   * @generated from field: string tiles = 2;
+   */
+  tiles = "";
+
+  constructor(data?: PartialMessage<Meld>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.Meld";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "type", kind: "enum", T: proto3.getEnumType(MeldType) },
+    { no: 2, name: "tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Meld {
+    return new Meld().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Meld {
+    return new Meld().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Meld {
+    return new Meld().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Meld | PlainMessage<Meld> | undefined, b: Meld | PlainMessage<Meld> | undefined): boolean {
+    return proto3.util.equals(Meld, a, b);
+  }
+}
+
+/**
+ * 役
+ *
+ * @generated from message mahjong.ai.v1.YakuInfo
+ */
+export class YakuInfo extends Message<YakuInfo> {
+  /**
+   * 役名
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * 翻数
+   *
+   * @generated from field: int32 han = 2;
+   */
+  han = 0;
+
+  /**
+   * 役満の倍数（通常役は0）
+   *
+   * @generated from field: int32 yakuman = 3;
+   */
+  yakuman = 0;
+
+  constructor(data?: PartialMessage<YakuInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.YakuInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "han", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "yakuman", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): YakuInfo {
+    return new YakuInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): YakuInfo {
+    return new YakuInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): YakuInfo {
+    return new YakuInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: YakuInfo | PlainMessage<YakuInfo> | undefined, b: YakuInfo | PlainMessage<YakuInfo> | undefined): boolean {
+    return proto3.util.equals(YakuInfo, a, b);
+  }
+}
+
+/**
+ * 和了の評価
+ *
+ * @generated from message mahjong.ai.v1.AgariInfo
+ */
+export class AgariInfo extends Message<AgariInfo> {
+  /**
+   * 成立した役（空の場合は役なし）
+   *
+   * @generated from field: repeated mahjong.ai.v1.YakuInfo yaku = 1;
+   */
+  yaku: YakuInfo[] = [];
+
+  /**
+   * ドラを含む翻数
+   *
+   * @generated from field: int32 han = 2;
+   */
+  han = 0;
+
+  /**
+   * 符
+   *
+   * @generated from field: int32 fu = 3;
+   */
+  fu = 0;
+
+  /**
+   * ドラ（赤ドラ・裏ドラを除く）
+   *
+   * @generated from field: int32 dora = 4;
+   */
+  dora = 0;
+
+  /**
+   * 赤ドラ
+   *
+   * @generated from field: int32 aka_dora = 5;
+   */
+  akaDora = 0;
+
+  /**
+   * 裏ドラ
+   *
+   * @generated from field: int32 ura_dora = 6;
+   */
+  uraDora = 0;
+
+  /**
+   * 受け取る点数（ツモは合計）
+   *
+   * @generated from field: int32 points = 7;
+   */
+  points = 0;
+
+  /**
+   * 点数の表記（例: "30符4翻 ロン7700点 / ツモ2000-3900"）
+   *
+   * @generated from field: string score = 8;
+   */
+  score = "";
+
+  constructor(data?: PartialMessage<AgariInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.AgariInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "yaku", kind: "message", T: YakuInfo, repeated: true },
+    { no: 2, name: "han", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "fu", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "dora", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "aka_dora", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "ura_dora", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "score", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AgariInfo {
+    return new AgariInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AgariInfo {
+    return new AgariInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AgariInfo {
+    return new AgariInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AgariInfo | PlainMessage<AgariInfo> | undefined, b: AgariInfo | PlainMessage<AgariInfo> | undefined): boolean {
+    return proto3.util.equals(AgariInfo, a, b);
+  }
+}
+
+/**
+ * 和了牌ごとの待ち
+ *
+ * @generated from message mahjong.ai.v1.WaitInfo
+ */
+export class WaitInfo extends Message<WaitInfo> {
+  /**
+   * 和了牌
+   *
+   * @generated from field: string tile = 1;
+   */
+  tile = "";
+
+  /**
+   * この牌で和了したときにとりうる待ちの形
+   *
+   * @generated from field: repeated mahjong.ai.v1.WaitShape shapes = 2;
+   */
+  shapes: WaitShape[] = [];
+
+  /**
+   * 見えていない残り枚数
+   *
+   * @generated from field: int32 remaining = 3;
+   */
+  remaining = 0;
+
+  /**
+   * ロンでは役がなく和了できない
+   *
+   * @generated from field: bool no_yaku_ron = 4;
+   */
+  noYakuRon = false;
+
+  /**
+   * ツモでも役がなく和了できない
+   *
+   * @generated from field: bool no_yaku_tsumo = 5;
+   */
+  noYakuTsumo = false;
+
+  /**
+   * ロン和了時の評価
+   *
+   * @generated from field: mahjong.ai.v1.AgariInfo ron = 6;
+   */
+  ron?: AgariInfo;
+
+  /**
+   * ツモ和了時の評価
+   *
+   * @generated from field: mahjong.ai.v1.AgariInfo tsumo = 7;
+   */
+  tsumo?: AgariInfo;
+
+  constructor(data?: PartialMessage<WaitInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.WaitInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "shapes", kind: "enum", T: proto3.getEnumType(WaitShape), repeated: true },
+    { no: 3, name: "remaining", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "no_yaku_ron", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "no_yaku_tsumo", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "ron", kind: "message", T: AgariInfo },
+    { no: 7, name: "tsumo", kind: "message", T: AgariInfo },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitInfo {
+    return new WaitInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitInfo {
+    return new WaitInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitInfo {
+    return new WaitInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WaitInfo | PlainMessage<WaitInfo> | undefined, b: WaitInfo | PlainMessage<WaitInfo> | undefined): boolean {
+    return proto3.util.equals(WaitInfo, a, b);
+  }
+}
+
+/**
+ * フリテンの判定
+ *
+ * @generated from message mahjong.ai.v1.FuritenInfo
+ */
+export class FuritenInfo extends Message<FuritenInfo> {
+  /**
+   * 捨て牌フリテン
+   *
+   * @generated from field: bool permanent = 1;
+   */
+  permanent = false;
+
+  /**
+   * 同巡内フリテン
+   *
+   * @generated from field: bool temporary = 2;
+   */
+  temporary = false;
+
+  /**
+   * 立直後の見逃しによるフリテン
+   *
+   * @generated from field: bool riichi = 3;
+   */
+  riichi = false;
+
+  /**
+   * フリテンの原因になった和了牌
+   *
+   * @generated from field: repeated string tiles = 4;
+   */
+  tiles: string[] = [];
+
+  constructor(data?: PartialMessage<FuritenInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.FuritenInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "permanent", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "temporary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "riichi", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "tiles", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FuritenInfo {
+    return new FuritenInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FuritenInfo {
+    return new FuritenInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FuritenInfo {
+    return new FuritenInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FuritenInfo | PlainMessage<FuritenInfo> | undefined, b: FuritenInfo | PlainMessage<FuritenInfo> | undefined): boolean {
+    return proto3.util.equals(FuritenInfo, a, b);
+  }
+}
+
+/**
+ * 待ち判定の結果
+ *
+ * @generated from message mahjong.ai.v1.WaitsResult
+ */
+export class WaitsResult extends Message<WaitsResult> {
+  /**
+   * 聴牌しているか
+   *
+   * @generated from field: bool tenpai = 1;
+   */
+  tenpai = false;
+
+  /**
+   * 手牌全体としての待ちの形
+   *
+   * @generated from field: mahjong.ai.v1.WaitShape shape = 2;
+   */
+  shape = WaitShape.UNSPECIFIED;
+
+  /**
+   * 和了牌ごとの待ち
+   *
+   * @generated from field: repeated mahjong.ai.v1.WaitInfo waits = 3;
+   */
+  waits: WaitInfo[] = [];
+
+  /**
+   * フリテンの判定
+   *
+   * @generated from field: mahjong.ai.v1.FuritenInfo furiten = 4;
+   */
+  furiten?: FuritenInfo;
+
+  /**
+   * フリテンでなく、役のあるロン和了牌があるか
+   *
+   * @generated from field: bool can_ron = 5;
+   */
+  canRon = false;
+
+  /**
+   * 適用したルールセット名
+   *
+   * @generated from field: string rule_set = 6;
+   */
+  ruleSet = "";
+
+  constructor(data?: PartialMessage<WaitsResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.WaitsResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tenpai", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "shape", kind: "enum", T: proto3.getEnumType(WaitShape) },
+    { no: 3, name: "waits", kind: "message", T: WaitInfo, repeated: true },
+    { no: 4, name: "furiten", kind: "message", T: FuritenInfo },
+    { no: 5, name: "can_ron", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WaitsResult {
+    return new WaitsResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WaitsResult {
+    return new WaitsResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WaitsResult {
+    return new WaitsResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WaitsResult | PlainMessage<WaitsResult> | undefined, b: WaitsResult | PlainMessage<WaitsResult> | undefined): boolean {
+    return proto3.util.equals(WaitsResult, a, b);
+  }
+}
+
+/**
+ * 受け入れの牌
+ *
+ * @generated from message mahjong.ai.v1.UkeireTileInfo
+ */
+export class UkeireTileInfo extends Message<UkeireTileInfo> {
+  /**
+   * 向聴数を進める牌
+   *
+   * @generated from field: string tile = 1;
+   */
+  tile = "";
+
+  /**
+   * 見えていない残り枚数
+   *
+   * @generated from field: int32 remaining = 2;
+   */
+  remaining = 0;
+
+  constructor(data?: PartialMessage<UkeireTileInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.UkeireTileInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "remaining", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UkeireTileInfo {
+    return new UkeireTileInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UkeireTileInfo {
+    return new UkeireTileInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UkeireTileInfo {
+    return new UkeireTileInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UkeireTileInfo | PlainMessage<UkeireTileInfo> | undefined, b: UkeireTileInfo | PlainMessage<UkeireTileInfo> | undefined): boolean {
+    return proto3.util.equals(UkeireTileInfo, a, b);
+  }
+}
+
+/**
+ * モンテカルロ法による評価
+ *
+ * @generated from message mahjong.ai.v1.SimulationInfo
+ */
+export class SimulationInfo extends Message<SimulationInfo> {
+  /**
+   * 試行回数
+   *
+   * @generated from field: int32 simulations = 1;
+   */
+  simulations = 0;
+
+  /**
+   * 和了率
+   *
+   * @generated from field: float win_rate = 2;
+   */
+  winRate = 0;
+
+  /**
+   * ツモ和了率
+   *
+   * @generated from field: float tsumo_rate = 3;
+   */
+  tsumoRate = 0;
+
+  /**
+   * 聴牌率（流局時点を含む）
+   *
+   * @generated from field: float tenpai_rate = 4;
+   */
+  tenpaiRate = 0;
+
+  /**
+   * 和了時の平均打点
+   *
+   * @generated from field: float average_win_points = 5;
+   */
+  averageWinPoints = 0;
+
+  /**
+   * 打点期待値（和了率 × 平均打点）
+   *
+   * @generated from field: float expected_value = 6;
+   */
+  expectedValue = 0;
+
+  /**
+   * 自分のツモ回数ごとの累積の集計
+   *
+   * @generated from field: repeated mahjong.ai.v1.SimulationTurnInfo by_turn = 7;
+   */
+  byTurn: SimulationTurnInfo[] = [];
+
+  constructor(data?: PartialMessage<SimulationInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SimulationInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "simulations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "win_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "tsumo_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "tenpai_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 5, name: "average_win_points", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "expected_value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 7, name: "by_turn", kind: "message", T: SimulationTurnInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulationInfo {
+    return new SimulationInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulationInfo {
+    return new SimulationInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulationInfo {
+    return new SimulationInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulationInfo | PlainMessage<SimulationInfo> | undefined, b: SimulationInfo | PlainMessage<SimulationInfo> | undefined): boolean {
+    return proto3.util.equals(SimulationInfo, a, b);
+  }
+}
+
+/**
+ * 自分の N 回目のツモを終えた時点までの累積の集計
+ *
+ * @generated from message mahjong.ai.v1.SimulationTurnInfo
+ */
+export class SimulationTurnInfo extends Message<SimulationTurnInfo> {
+  /**
+   * 自分のツモ回数（1始まり）
+   *
+   * @generated from field: int32 turn = 1;
+   */
+  turn = 0;
+
+  /**
+   * 聴牌率
+   *
+   * @generated from field: float tenpai_rate = 2;
+   */
+  tenpaiRate = 0;
+
+  /**
+   * 和了率
+   *
+   * @generated from field: float win_rate = 3;
+   */
+  winRate = 0;
+
+  /**
+   * 打点期待値
+   *
+   * @generated from field: float expected_value = 4;
+   */
+  expectedValue = 0;
+
+  constructor(data?: PartialMessage<SimulationTurnInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SimulationTurnInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "tenpai_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 3, name: "win_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "expected_value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulationTurnInfo {
+    return new SimulationTurnInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulationTurnInfo {
+    return new SimulationTurnInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulationTurnInfo {
+    return new SimulationTurnInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulationTurnInfo | PlainMessage<SimulationTurnInfo> | undefined, b: SimulationTurnInfo | PlainMessage<SimulationTurnInfo> | undefined): boolean {
+    return proto3.util.equals(SimulationTurnInfo, a, b);
+  }
+}
+
+/**
+ * 手牌のシミュレーション結果
+ *
+ * @generated from message mahjong.ai.v1.SimulationResult
+ */
+export class SimulationResult extends Message<SimulationResult> {
+  /**
+   * 集計結果
+   *
+   * @generated from field: mahjong.ai.v1.SimulationInfo simulation = 1;
+   */
+  simulation?: SimulationInfo;
+
+  /**
+   * 開始時点の向聴数（14枚の場合は打牌前）
+   *
+   * @generated from field: int32 shanten = 2;
+   */
+  shanten = 0;
+
+  /**
+   * 適用した打牌の方針
+   *
+   * @generated from field: string policy = 3;
+   */
+  policy = "";
+
+  /**
+   * 適用したルールセット名
+   *
+   * @generated from field: string rule_set = 4;
+   */
+  ruleSet = "";
+
+  constructor(data?: PartialMessage<SimulationResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SimulationResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "simulation", kind: "message", T: SimulationInfo },
+    { no: 2, name: "shanten", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "policy", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SimulationResult {
+    return new SimulationResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SimulationResult {
+    return new SimulationResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SimulationResult {
+    return new SimulationResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SimulationResult | PlainMessage<SimulationResult> | undefined, b: SimulationResult | PlainMessage<SimulationResult> | undefined): boolean {
+    return proto3.util.equals(SimulationResult, a, b);
+  }
+}
+
+/**
+ * 打牌候補の評価
+ *
+ * @generated from message mahjong.ai.v1.DiscardCandidateInfo
+ */
+export class DiscardCandidateInfo extends Message<DiscardCandidateInfo> {
+  /**
+   * 打牌
+   *
+   * @generated from field: string tile = 1;
+   */
+  tile = "";
+
+  /**
+   * 打牌後の向聴数
+   *
+   * @generated from field: int32 shanten = 2;
+   */
+  shanten = 0;
+
+  /**
+   * 受け入れの牌
+   *
+   * @generated from field: repeated mahjong.ai.v1.UkeireTileInfo ukeire = 3;
+   */
+  ukeire: UkeireTileInfo[] = [];
+
+  /**
+   * 受け入れの残り枚数の合計
+   *
+   * @generated from field: int32 ukeire_count = 4;
+   */
+  ukeireCount = 0;
+
+  /**
+   * 改良牌の残り枚数
+   *
+   * @generated from field: int32 improvement_count = 5;
+   */
+  improvementCount = 0;
+
+  /**
+   * 1向聴以内のとき、良形（2種以上の待ち）で聴牌する割合
+   *
+   * @generated from field: float good_shape_rate = 6;
+   */
+  goodShapeRate = 0;
+
+  /**
+   * モンテカルロ法による評価（評価していない候補は未設定）
+   *
+   * @generated from field: mahjong.ai.v1.SimulationInfo simulation = 7;
+   */
+  simulation?: SimulationInfo;
+
+  constructor(data?: PartialMessage<DiscardCandidateInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.DiscardCandidateInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "shanten", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "ukeire", kind: "message", T: UkeireTileInfo, repeated: true },
+    { no: 4, name: "ukeire_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "improvement_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "good_shape_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 7, name: "simulation", kind: "message", T: SimulationInfo },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardCandidateInfo {
+    return new DiscardCandidateInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardCandidateInfo {
+    return new DiscardCandidateInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardCandidateInfo {
+    return new DiscardCandidateInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiscardCandidateInfo | PlainMessage<DiscardCandidateInfo> | undefined, b: DiscardCandidateInfo | PlainMessage<DiscardCandidateInfo> | undefined): boolean {
+    return proto3.util.equals(DiscardCandidateInfo, a, b);
+  }
+}
+
+/**
+ * 打牌候補の評価結果
+ *
+ * @generated from message mahjong.ai.v1.DiscardResult
+ */
+export class DiscardResult extends Message<DiscardResult> {
+  /**
+   * 打牌前の向聴数（-1 の場合はツモ和了できる）
+   *
+   * @generated from field: int32 shanten = 1;
+   */
+  shanten = 0;
+
+  /**
+   * 推奨順の打牌候補
+   *
+   * @generated from field: repeated mahjong.ai.v1.DiscardCandidateInfo candidates = 2;
+   */
+  candidates: DiscardCandidateInfo[] = [];
+
+  /**
+   * 適用したルールセット名
+   *
+   * @generated from field: string rule_set = 3;
+   */
+  ruleSet = "";
+
+  constructor(data?: PartialMessage<DiscardResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.DiscardResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "shanten", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "candidates", kind: "message", T: DiscardCandidateInfo, repeated: true },
+    { no: 3, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardResult {
+    return new DiscardResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardResult {
+    return new DiscardResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardResult {
+    return new DiscardResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiscardResult | PlainMessage<DiscardResult> | undefined, b: DiscardResult | PlainMessage<DiscardResult> | undefined): boolean {
+    return proto3.util.equals(DiscardResult, a, b);
+  }
+}
+
+/**
+ * 他家の捨て牌と立直の状況
+ *
+ * @generated from message mahjong.ai.v1.OpponentInfo
+ */
+export class OpponentInfo extends Message<OpponentInfo> {
+  /**
+   * 他家の自風（結果の表示用）
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat = 1;
+   */
+  seat = Wind.UNSPECIFIED;
+
+  /**
+   * 捨て牌（捨てた順）
+   *
+   * @generated from field: string discards = 2;
+   */
+  discards = "";
+
+  /**
+   * 立直宣言牌が何枚目の捨て牌か（1始まり、0 は立直していない）
+   *
+   * @generated from field: int32 riichi_turn = 3;
+   */
+  riichiTurn = 0;
+
+  /**
+   * 立直後に他家が捨て、この他家が和了しなかった牌
+   *
+   * @generated from field: string passed_after_riichi = 4;
+   */
+  passedAfterRiichi = "";
+
+  /**
+   * 持ち点（押し引きの評価でオーラスの順位を考慮する場合に使う）
+   *
+   * @generated from field: int32 score = 5;
+   */
+  score = 0;
+
+  constructor(data?: PartialMessage<OpponentInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.OpponentInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "seat", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 2, name: "discards", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "riichi_turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "passed_after_riichi", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OpponentInfo {
+    return new OpponentInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OpponentInfo {
+    return new OpponentInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OpponentInfo {
+    return new OpponentInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OpponentInfo | PlainMessage<OpponentInfo> | undefined, b: OpponentInfo | PlainMessage<OpponentInfo> | undefined): boolean {
+    return proto3.util.equals(OpponentInfo, a, b);
+  }
+}
+
+/**
+ * 1人の立直者に対する牌の安全度
+ *
+ * @generated from message mahjong.ai.v1.OpponentSafetyInfo
+ */
+export class OpponentSafetyInfo extends Message<OpponentSafetyInfo> {
+  /**
+   * 立直者の自風
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat = 1;
+   */
+  seat = Wind.UNSPECIFIED;
+
+  /**
+   * 分類
+   *
+   * @generated from field: mahjong.ai.v1.SafetyClass safety_class = 2;
+   */
+  safetyClass = SafetyClass.UNSPECIFIED;
+
+  /**
+   * 放銃率の目安
+   *
+   * @generated from field: float risk = 3;
+   */
+  risk = 0;
+
+  /**
+   * 判断の理由（例: "筋（4p が現物）"）
+   *
+   * @generated from field: repeated string reasons = 4;
+   */
+  reasons: string[] = [];
+
+  constructor(data?: PartialMessage<OpponentSafetyInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.OpponentSafetyInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "seat", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 2, name: "safety_class", kind: "enum", T: proto3.getEnumType(SafetyClass) },
+    { no: 3, name: "risk", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "reasons", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OpponentSafetyInfo {
+    return new OpponentSafetyInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OpponentSafetyInfo {
+    return new OpponentSafetyInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OpponentSafetyInfo {
+    return new OpponentSafetyInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OpponentSafetyInfo | PlainMessage<OpponentSafetyInfo> | undefined, b: OpponentSafetyInfo | PlainMessage<OpponentSafetyInfo> | undefined): boolean {
+    return proto3.util.equals(OpponentSafetyInfo, a, b);
+  }
+}
+
+/**
+ * 手牌の1種類の牌の安全度
+ *
+ * @generated from message mahjong.ai.v1.TileSafetyInfo
+ */
+export class TileSafetyInfo extends Message<TileSafetyInfo> {
+  /**
+   * 牌
+   *
+   * @generated from field: string tile = 1;
+   */
+  tile = "";
+
+  /**
+   * 手牌にある枚数
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count = 0;
+
+  /**
+   * いずれかの立直者に放銃する確率の目安
+   *
+   * @generated from field: float risk = 3;
+   */
+  risk = 0;
+
+  /**
+   * 立直者ごとの安全度
+   *
+   * @generated from field: repeated mahjong.ai.v1.OpponentSafetyInfo against = 4;
+   */
+  against: OpponentSafetyInfo[] = [];
+
+  constructor(data?: PartialMessage<TileSafetyInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.TileSafetyInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "risk", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 4, name: "against", kind: "message", T: OpponentSafetyInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TileSafetyInfo {
+    return new TileSafetyInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TileSafetyInfo {
+    return new TileSafetyInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TileSafetyInfo {
+    return new TileSafetyInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TileSafetyInfo | PlainMessage<TileSafetyInfo> | undefined, b: TileSafetyInfo | PlainMessage<TileSafetyInfo> | undefined): boolean {
+    return proto3.util.equals(TileSafetyInfo, a, b);
+  }
+}
+
+/**
+ * 安全度の評価結果
+ *
+ * @generated from message mahjong.ai.v1.SafetyResult
+ */
+export class SafetyResult extends Message<SafetyResult> {
+  /**
+   * 安全な順の手牌の牌
+   *
+   * @generated from field: repeated mahjong.ai.v1.TileSafetyInfo tiles = 1;
+   */
+  tiles: TileSafetyInfo[] = [];
+
+  constructor(data?: PartialMessage<SafetyResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.SafetyResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tiles", kind: "message", T: TileSafetyInfo, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SafetyResult {
+    return new SafetyResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SafetyResult {
+    return new SafetyResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SafetyResult {
+    return new SafetyResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SafetyResult | PlainMessage<SafetyResult> | undefined, b: SafetyResult | PlainMessage<SafetyResult> | undefined): boolean {
+    return proto3.util.equals(SafetyResult, a, b);
+  }
+}
+
+/**
+ * 押し引きの選択肢ごとの評価
+ *
+ * @generated from message mahjong.ai.v1.PushFoldOptionInfo
+ */
+export class PushFoldOptionInfo extends Message<PushFoldOptionInfo> {
+  /**
+   * 選択肢
+   *
+   * @generated from field: mahjong.ai.v1.PushFoldAction action = 1;
+   */
+  action = PushFoldAction.UNSPECIFIED;
+
+  /**
+   * 打牌
+   *
+   * @generated from field: string discard = 2;
+   */
+  discard = "";
+
+  /**
+   * 打牌後の向聴数
+   *
+   * @generated from field: int32 shanten = 3;
+   */
+  shanten = 0;
+
+  /**
+   * 打牌後の受け入れ枚数
+   *
+   * @generated from field: int32 ukeire_count = 4;
+   */
+  ukeireCount = 0;
+
+  /**
+   * この打牌で立直者に放銃する確率の目安
+   *
+   * @generated from field: float discard_risk = 5;
+   */
+  discardRisk = 0;
+
+  /**
+   * 局が終わるまでに和了する確率
+   *
+   * @generated from field: float win_rate = 6;
+   */
+  winRate = 0;
+
+  /**
+   * 局が終わるまでに立直者に放銃する確率
+   *
+   * @generated from field: float deal_in_rate = 7;
+   */
+  dealInRate = 0;
+
+  /**
+   * 和了したときの平均打点（供託・積み棒を含む）
+   *
+   * @generated from field: float average_win_points = 8;
+   */
+  averageWinPoints = 0;
+
+  /**
+   * 局の収支の期待値（オーラスでは順位点の増減を含む）
+   *
+   * @generated from field: float expected_value = 9;
+   */
+  expectedValue = 0;
+
+  constructor(data?: PartialMessage<PushFoldOptionInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.PushFoldOptionInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "action", kind: "enum", T: proto3.getEnumType(PushFoldAction) },
+    { no: 2, name: "discard", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "shanten", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "ukeire_count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "discard_risk", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "win_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 7, name: "deal_in_rate", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 8, name: "average_win_points", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 9, name: "expected_value", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PushFoldOptionInfo {
+    return new PushFoldOptionInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PushFoldOptionInfo {
+    return new PushFoldOptionInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PushFoldOptionInfo {
+    return new PushFoldOptionInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PushFoldOptionInfo | PlainMessage<PushFoldOptionInfo> | undefined, b: PushFoldOptionInfo | PlainMessage<PushFoldOptionInfo> | undefined): boolean {
+    return proto3.util.equals(PushFoldOptionInfo, a, b);
+  }
+}
+
+/**
+ * 立直者の脅威の見積もり
+ *
+ * @generated from message mahjong.ai.v1.ThreatInfo
+ */
+export class ThreatInfo extends Message<ThreatInfo> {
+  /**
+   * 立直者の自風
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat = 1;
+   */
+  seat = Wind.UNSPECIFIED;
+
+  /**
+   * 放銃したときの平均失点
+   *
+   * @generated from field: int32 expected_loss = 2;
+   */
+  expectedLoss = 0;
+
+  /**
+   * 自分以外から1巡あたりに和了する確率
+   *
+   * @generated from field: float win_rate_per_turn = 3;
+   */
+  winRatePerTurn = 0;
+
+  constructor(data?: PartialMessage<ThreatInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ThreatInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "seat", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 2, name: "expected_loss", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "win_rate_per_turn", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ThreatInfo {
+    return new ThreatInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ThreatInfo {
+    return new ThreatInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ThreatInfo {
+    return new ThreatInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ThreatInfo | PlainMessage<ThreatInfo> | undefined, b: ThreatInfo | PlainMessage<ThreatInfo> | undefined): boolean {
+    return proto3.util.equals(ThreatInfo, a, b);
+  }
+}
+
+/**
+ * 押し引きの評価結果
+ *
+ * @generated from message mahjong.ai.v1.PushFoldResult
+ */
+export class PushFoldResult extends Message<PushFoldResult> {
+  /**
+   * 期待値が最も高い選択肢
+   *
+   * @generated from field: mahjong.ai.v1.PushFoldAction recommendation = 1;
+   */
+  recommendation = PushFoldAction.UNSPECIFIED;
+
+  /**
+   * 押し・回し・降りの評価（回しが押しより安全にならない場合は含めない）
+   *
+   * @generated from field: repeated mahjong.ai.v1.PushFoldOptionInfo options = 2;
+   */
+  options: PushFoldOptionInfo[] = [];
+
+  /**
+   * 立直者の脅威
+   *
+   * @generated from field: repeated mahjong.ai.v1.ThreatInfo threats = 3;
+   */
+  threats: ThreatInfo[] = [];
+
+  /**
+   * 流局までの残りの巡目
+   *
+   * @generated from field: int32 remaining_turns = 4;
+   */
+  remainingTurns = 0;
+
+  /**
+   * 押した場合に以降ツモ切りする牌の放銃率の平均
+   *
+   * @generated from field: float draw_risk = 5;
+   */
+  drawRisk = 0;
+
+  /**
+   * 適用したルールセット名
+   *
+   * @generated from field: string rule_set = 6;
+   */
+  ruleSet = "";
+
+  constructor(data?: PartialMessage<PushFoldResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.PushFoldResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "recommendation", kind: "enum", T: proto3.getEnumType(PushFoldAction) },
+    { no: 2, name: "options", kind: "message", T: PushFoldOptionInfo, repeated: true },
+    { no: 3, name: "threats", kind: "message", T: ThreatInfo, repeated: true },
+    { no: 4, name: "remaining_turns", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "draw_risk", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PushFoldResult {
+    return new PushFoldResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PushFoldResult {
+    return new PushFoldResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PushFoldResult {
+    return new PushFoldResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PushFoldResult | PlainMessage<PushFoldResult> | undefined, b: PushFoldResult | PlainMessage<PushFoldResult> | undefined): boolean {
+    return proto3.util.equals(PushFoldResult, a, b);
+  }
+}
+
+/**
+ * オーラスのプレイヤーの現在の順位
+ *
+ * @generated from message mahjong.ai.v1.PlacementStandingInfo
+ */
+export class PlacementStandingInfo extends Message<PlacementStandingInfo> {
+  /**
+   * プレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 1;
+   */
+  player = 0;
+
+  /**
+   * 持ち点
+   *
+   * @generated from field: int32 score = 2;
+   */
+  score = 0;
+
+  /**
+   * 順位（1始まり、同点の場合は起家に近い方が上位）
+   *
+   * @generated from field: int32 rank = 3;
+   */
+  rank = 0;
+
+  /**
+   * このまま終局した場合のウマ・オカを含む最終ポイント（千点単位）
+   *
+   * @generated from field: float final_points = 4;
+   */
+  finalPoints = 0;
+
+  constructor(data?: PartialMessage<PlacementStandingInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.PlacementStandingInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "final_points", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementStandingInfo {
+    return new PlacementStandingInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementStandingInfo {
+    return new PlacementStandingInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementStandingInfo {
+    return new PlacementStandingInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlacementStandingInfo | PlainMessage<PlacementStandingInfo> | undefined, b: PlacementStandingInfo | PlainMessage<PlacementStandingInfo> | undefined): boolean {
+    return proto3.util.equals(PlacementStandingInfo, a, b);
+  }
+}
+
+/**
+ * 目標の順位に届く最小の和了
+ *
+ * @generated from message mahjong.ai.v1.PlacementRequirementInfo
+ */
+export class PlacementRequirementInfo extends Message<PlacementRequirementInfo> {
+  /**
+   * 和了するプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 1;
+   */
+  player = 0;
+
+  /**
+   * 目標の順位（1始まり）
+   *
+   * @generated from field: int32 target_rank = 2;
+   */
+  targetRank = 0;
+
+  /**
+   * ツモ和了の条件か
+   *
+   * @generated from field: bool tsumo = 3;
+   */
+  tsumo = false;
+
+  /**
+   * ロンの放銃者（ツモの場合は -1）
+   *
+   * @generated from field: int32 from = 4;
+   */
+  from = 0;
+
+  /**
+   * 役満以下の和了で届くか
+   *
+   * @generated from field: bool possible = 5;
+   */
+  possible = false;
+
+  /**
+   * 必要な最小の和了（例: 30符2翻 ロン2000点）
+   *
+   * @generated from field: string hand = 6;
+   */
+  hand = "";
+
+  /**
+   * 和了で受け取る点数（積み棒・供託を含む）
+   *
+   * @generated from field: int32 points = 7;
+   */
+  points = 0;
+
+  constructor(data?: PartialMessage<PlacementRequirementInfo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.PlacementRequirementInfo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "target_rank", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "tsumo", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "from", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "possible", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "points", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementRequirementInfo {
+    return new PlacementRequirementInfo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementRequirementInfo {
+    return new PlacementRequirementInfo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementRequirementInfo {
+    return new PlacementRequirementInfo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlacementRequirementInfo | PlainMessage<PlacementRequirementInfo> | undefined, b: PlacementRequirementInfo | PlainMessage<PlacementRequirementInfo> | undefined): boolean {
+    return proto3.util.equals(PlacementRequirementInfo, a, b);
+  }
+}
+
+/**
+ * オーラスの点数状況の計算結果
+ *
+ * @generated from message mahjong.ai.v1.PlacementResult
+ */
+export class PlacementResult extends Message<PlacementResult> {
+  /**
+   * 起家からの席順の現在の順位
+   *
+   * @generated from field: repeated mahjong.ai.v1.PlacementStandingInfo standings = 1;
+   */
+  standings: PlacementStandingInfo[] = [];
+
+  /**
+   * 現在より上の順位に届く和了（プレイヤー・目標の順位・放銃者の順）
+   *
+   * @generated from field: repeated mahjong.ai.v1.PlacementRequirementInfo requirements = 2;
+   */
+  requirements: PlacementRequirementInfo[] = [];
+
+  /**
+   * 適用したルールセット名
+   *
+   * @generated from field: string rule_set = 3;
+   */
+  ruleSet = "";
+
+  constructor(data?: PartialMessage<PlacementResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.PlacementResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "standings", kind: "message", T: PlacementStandingInfo, repeated: true },
+    { no: 2, name: "requirements", kind: "message", T: PlacementRequirementInfo, repeated: true },
+    { no: 3, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlacementResult {
+    return new PlacementResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlacementResult {
+    return new PlacementResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlacementResult {
+    return new PlacementResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlacementResult | PlainMessage<PlacementResult> | undefined, b: PlacementResult | PlainMessage<PlacementResult> | undefined): boolean {
+    return proto3.util.equals(PlacementResult, a, b);
+  }
+}
+
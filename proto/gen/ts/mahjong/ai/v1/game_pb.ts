@@ -0,0 +1,2178 @@
+// @generated by protoc-gen-es v1.2.0 with parameter "import_extension=none,target=ts"
+// @generated from file mahjong/ai/v1/game.proto (package mahjong.ai.v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3 } from "@bufbuild/protobuf";
+import { DiscardCandidateInfo, Meld, MeldType, Wind } from "./analysis_pb";
+
+/**
+ * 牌譜の形式
+ *
+ * @generated from enum mahjong.ai.v1.GameLogFormat
+ */
+export enum GameLogFormat {
+  /**
+   * 内容から判定する
+   *
+   * @generated from enum value: GAME_LOG_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 天鳳のJSON形式（tenhou.net/6）
+   *
+   * @generated from enum value: GAME_LOG_FORMAT_TENHOU_JSON = 1;
+   */
+  TENHOU_JSON = 1,
+
+  /**
+   * 天鳳のXML形式（mjlog）
+   *
+   * @generated from enum value: GAME_LOG_FORMAT_MJLOG = 2;
+   */
+  MJLOG = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(GameLogFormat)
+proto3.util.setEnumType(GameLogFormat, "mahjong.ai.v1.GameLogFormat", [
+  { no: 0, name: "GAME_LOG_FORMAT_UNSPECIFIED" },
+  { no: 1, name: "GAME_LOG_FORMAT_TENHOU_JSON" },
+  { no: 2, name: "GAME_LOG_FORMAT_MJLOG" },
+]);
+
+/**
+ * 打牌の判断を評価した観点
+ *
+ * @generated from enum mahjong.ai.v1.DecisionKind
+ */
+export enum DecisionKind {
+  /**
+   * @generated from enum value: DECISION_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 牌効率（立直者がいない場合）
+   *
+   * @generated from enum value: DECISION_KIND_EFFICIENCY = 1;
+   */
+  EFFICIENCY = 1,
+
+  /**
+   * 押し引き・安全度（立直者がいる場合）
+   *
+   * @generated from enum value: DECISION_KIND_PUSH_FOLD = 2;
+   */
+  PUSH_FOLD = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DecisionKind)
+proto3.util.setEnumType(DecisionKind, "mahjong.ai.v1.DecisionKind", [
+  { no: 0, name: "DECISION_KIND_UNSPECIFIED" },
+  { no: 1, name: "DECISION_KIND_EFFICIENCY" },
+  { no: 2, name: "DECISION_KIND_PUSH_FOLD" },
+]);
+
+/**
+ * 悪手の重大度
+ *
+ * @generated from enum mahjong.ai.v1.MistakeSeverity
+ */
+export enum MistakeSeverity {
+  /**
+   * @generated from enum value: MISTAKE_SEVERITY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 軽微（損失の目安300点以上）
+   *
+   * @generated from enum value: MISTAKE_SEVERITY_MINOR = 1;
+   */
+  MINOR = 1,
+
+  /**
+   * 中程度（800点以上）
+   *
+   * @generated from enum value: MISTAKE_SEVERITY_MODERATE = 2;
+   */
+  MODERATE = 2,
+
+  /**
+   * 重大（2000点以上）
+   *
+   * @generated from enum value: MISTAKE_SEVERITY_SEVERE = 3;
+   */
+  SEVERE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MistakeSeverity)
+proto3.util.setEnumType(MistakeSeverity, "mahjong.ai.v1.MistakeSeverity", [
+  { no: 0, name: "MISTAKE_SEVERITY_UNSPECIFIED" },
+  { no: 1, name: "MISTAKE_SEVERITY_MINOR" },
+  { no: 2, name: "MISTAKE_SEVERITY_MODERATE" },
+  { no: 3, name: "MISTAKE_SEVERITY_SEVERE" },
+]);
+
+/**
+ * 検討の進み具合
+ *
+ * @generated from enum mahjong.ai.v1.ReviewStatus
+ */
+export enum ReviewStatus {
+  /**
+   * @generated from enum value: REVIEW_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 開始前
+   *
+   * @generated from enum value: REVIEW_STATUS_PENDING = 1;
+   */
+  PENDING = 1,
+
+  /**
+   * エンジンによる評価・AIによる解説の作成中
+   *
+   * @generated from enum value: REVIEW_STATUS_RUNNING = 2;
+   */
+  RUNNING = 2,
+
+  /**
+   * 完了
+   *
+   * @generated from enum value: REVIEW_STATUS_COMPLETED = 3;
+   */
+  COMPLETED = 3,
+
+  /**
+   * 失敗
+   *
+   * @generated from enum value: REVIEW_STATUS_FAILED = 4;
+   */
+  FAILED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ReviewStatus)
+proto3.util.setEnumType(ReviewStatus, "mahjong.ai.v1.ReviewStatus", [
+  { no: 0, name: "REVIEW_STATUS_UNSPECIFIED" },
+  { no: 1, name: "REVIEW_STATUS_PENDING" },
+  { no: 2, name: "REVIEW_STATUS_RUNNING" },
+  { no: 3, name: "REVIEW_STATUS_COMPLETED" },
+  { no: 4, name: "REVIEW_STATUS_FAILED" },
+]);
+
+/**
+ * 推奨する行動
+ *
+ * @generated from enum mahjong.ai.v1.CoachAction
+ */
+export enum CoachAction {
+  /**
+   * @generated from enum value: COACH_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 打牌
+   *
+   * @generated from enum value: COACH_ACTION_DISCARD = 1;
+   */
+  DISCARD = 1,
+
+  /**
+   * 立直（tile は宣言牌）
+   *
+   * @generated from enum value: COACH_ACTION_RIICHI = 2;
+   */
+  RIICHI = 2,
+
+  /**
+   * ツモ和了
+   *
+   * @generated from enum value: COACH_ACTION_TSUMO = 3;
+   */
+  TSUMO = 3,
+
+  /**
+   * ロン和了
+   *
+   * @generated from enum value: COACH_ACTION_RON = 4;
+   */
+  RON = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CoachAction)
+proto3.util.setEnumType(CoachAction, "mahjong.ai.v1.CoachAction", [
+  { no: 0, name: "COACH_ACTION_UNSPECIFIED" },
+  { no: 1, name: "COACH_ACTION_DISCARD" },
+  { no: 2, name: "COACH_ACTION_RIICHI" },
+  { no: 3, name: "COACH_ACTION_TSUMO" },
+  { no: 4, name: "COACH_ACTION_RON" },
+]);
+
+/**
+ * 警告の種類
+ *
+ * @generated from enum mahjong.ai.v1.CoachWarningKind
+ */
+export enum CoachWarningKind {
+  /**
+   * @generated from enum value: COACH_WARNING_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * 他家が聴牌している可能性が高い
+   *
+   * @generated from enum value: COACH_WARNING_KIND_OPPONENT_TENPAI = 1;
+   */
+  OPPONENT_TENPAI = 1,
+
+  /**
+   * 自分がフリテン
+   *
+   * @generated from enum value: COACH_WARNING_KIND_FURITEN = 2;
+   */
+  FURITEN = 2,
+
+  /**
+   * 自分の聴牌にロンで和了できる役がない
+   *
+   * @generated from enum value: COACH_WARNING_KIND_NO_YAKU = 3;
+   */
+  NO_YAKU = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(CoachWarningKind)
+proto3.util.setEnumType(CoachWarningKind, "mahjong.ai.v1.CoachWarningKind", [
+  { no: 0, name: "COACH_WARNING_KIND_UNSPECIFIED" },
+  { no: 1, name: "COACH_WARNING_KIND_OPPONENT_TENPAI" },
+  { no: 2, name: "COACH_WARNING_KIND_FURITEN" },
+  { no: 3, name: "COACH_WARNING_KIND_NO_YAKU" },
+]);
+
+/**
+ * 河の1枚
+ *
+ * @generated from message mahjong.ai.v1.DiscardedTile
+ */
+export class DiscardedTile extends Message<DiscardedTile> {
+  /**
+   * 牌（1枚のMPSZ表記）
+   *
+   * @generated from field: string tile = 1;
+   */
+  tile = "";
+
+  /**
+   * ツモ切りか
+   *
+   * @generated from field: bool tsumogiri = 2;
+   */
+  tsumogiri = false;
+
+  /**
+   * 他家に鳴かれたか
+   *
+   * @generated from field: bool called = 3;
+   */
+  called = false;
+
+  /**
+   * 立直宣言牌か
+   *
+   * @generated from field: bool riichi = 4;
+   */
+  riichi = false;
+
+  constructor(data?: PartialMessage<DiscardedTile>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.DiscardedTile";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "tsumogiri", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "called", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "riichi", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiscardedTile {
+    return new DiscardedTile().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiscardedTile {
+    return new DiscardedTile().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiscardedTile {
+    return new DiscardedTile().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiscardedTile | PlainMessage<DiscardedTile> | undefined, b: DiscardedTile | PlainMessage<DiscardedTile> | undefined): boolean {
+    return proto3.util.equals(DiscardedTile, a, b);
+  }
+}
+
+/**
+ * プレイヤーの状況
+ *
+ * @generated from message mahjong.ai.v1.PlayerState
+ */
+export class PlayerState extends Message<PlayerState> {
+  /**
+   * 自風
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat = 1;
+   */
+  seat = Wind.UNSPECIFIED;
+
+  /**
+   * プレイヤー名
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * 持ち点
+   *
+   * @generated from field: int32 score = 3;
+   */
+  score = 0;
+
+  /**
+   * 門前の牌（分かる場合のみ。通常は自分のみ）
+   *
+   * @generated from field: string hand = 4;
+   */
+  hand = "";
+
+  /**
+   * 副露
+   *
+   * @generated from field: repeated mahjong.ai.v1.Meld melds = 5;
+   */
+  melds: Meld[] = [];
+
+  /**
+   * 河（捨てた順）
+   *
+   * @generated from field: repeated mahjong.ai.v1.DiscardedTile discards = 6;
+   */
+  discards: DiscardedTile[] = [];
+
+  constructor(data?: PartialMessage<PlayerState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.PlayerState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "seat", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "melds", kind: "message", T: Meld, repeated: true },
+    { no: 6, name: "discards", kind: "message", T: DiscardedTile, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlayerState {
+    return new PlayerState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlayerState {
+    return new PlayerState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlayerState {
+    return new PlayerState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlayerState | PlainMessage<PlayerState> | undefined, b: PlayerState | PlainMessage<PlayerState> | undefined): boolean {
+    return proto3.util.equals(PlayerState, a, b);
+  }
+}
+
+/**
+ * 局の状況
+ *
+ * @generated from message mahjong.ai.v1.GameState
+ */
+export class GameState extends Message<GameState> {
+  /**
+   * 場風
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 1;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * 局（1始まり）
+   *
+   * @generated from field: int32 kyoku = 2;
+   */
+  kyoku = 0;
+
+  /**
+   * 積み棒の本数
+   *
+   * @generated from field: int32 honba = 3;
+   */
+  honba = 0;
+
+  /**
+   * 供託の立直棒の本数
+   *
+   * @generated from field: int32 riichi_sticks = 4;
+   */
+  riichiSticks = 0;
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicators = 5;
+   */
+  doraIndicators = "";
+
+  /**
+   * プレイヤー（四麻は4人、三麻は3人）
+   *
+   * @generated from field: repeated mahjong.ai.v1.PlayerState players = 6;
+   */
+  players: PlayerState[] = [];
+
+  /**
+   * 質問者の自風（未指定の場合は観戦者として扱う）
+   *
+   * @generated from field: mahjong.ai.v1.Wind self_seat = 7;
+   */
+  selfSeat = Wind.UNSPECIFIED;
+
+  /**
+   * 山の残り枚数
+   *
+   * @generated from field: int32 wall_remaining = 8;
+   */
+  wallRemaining = 0;
+
+  constructor(data?: PartialMessage<GameState>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameState";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 2, name: "kyoku", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "honba", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "riichi_sticks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "dora_indicators", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "players", kind: "message", T: PlayerState, repeated: true },
+    { no: 7, name: "self_seat", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 8, name: "wall_remaining", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameState {
+    return new GameState().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameState {
+    return new GameState().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameState {
+    return new GameState().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameState | PlainMessage<GameState> | undefined, b: GameState | PlainMessage<GameState> | undefined): boolean {
+    return proto3.util.equals(GameState, a, b);
+  }
+}
+
+/**
+ * 牌譜の1局
+ *
+ * @generated from message mahjong.ai.v1.GameLogRound
+ */
+export class GameLogRound extends Message<GameLogRound> {
+  /**
+   * 局の番号（0始まり）
+   *
+   * @generated from field: int32 index = 1;
+   */
+  index = 0;
+
+  /**
+   * 局の名前（例: 東1局 0本場）
+   *
+   * @generated from field: string name = 2;
+   */
+  name = "";
+
+  /**
+   * 場風
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 3;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * 局（1始まり）
+   *
+   * @generated from field: int32 kyoku = 4;
+   */
+  kyoku = 0;
+
+  /**
+   * 積み棒の本数
+   *
+   * @generated from field: int32 honba = 5;
+   */
+  honba = 0;
+
+  /**
+   * 局の開始時の供託の立直棒の本数
+   *
+   * @generated from field: int32 riichi_sticks = 6;
+   */
+  riichiSticks = 0;
+
+  /**
+   * 局の開始時の持ち点（起家からの席順）
+   *
+   * @generated from field: repeated int32 scores = 7;
+   */
+  scores: number[] = [];
+
+  /**
+   * 打牌の判断の数
+   *
+   * @generated from field: int32 steps = 8;
+   */
+  steps = 0;
+
+  /**
+   * 局の結果（例: 和了・流局）
+   *
+   * @generated from field: string result = 9;
+   */
+  result = "";
+
+  constructor(data?: PartialMessage<GameLogRound>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameLogRound";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "index", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 4, name: "kyoku", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "honba", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "riichi_sticks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "scores", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 8, name: "steps", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "result", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameLogRound {
+    return new GameLogRound().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameLogRound {
+    return new GameLogRound().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameLogRound {
+    return new GameLogRound().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameLogRound | PlainMessage<GameLogRound> | undefined, b: GameLogRound | PlainMessage<GameLogRound> | undefined): boolean {
+    return proto3.util.equals(GameLogRound, a, b);
+  }
+}
+
+/**
+ * 取り込んだ牌譜
+ *
+ * @generated from message mahjong.ai.v1.GameLog
+ */
+export class GameLog extends Message<GameLog> {
+  /**
+   * 牌譜ID（GetGameLogStep・AskMahjongAI で局面を指定する）
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId = "";
+
+  /**
+   * 対局の名前
+   *
+   * @generated from field: string title = 2;
+   */
+  title = "";
+
+  /**
+   * プレイヤー名（起家からの席順）
+   *
+   * @generated from field: repeated string players = 3;
+   */
+  players: string[] = [];
+
+  /**
+   * 三人麻雀か
+   *
+   * @generated from field: bool sanma = 4;
+   */
+  sanma = false;
+
+  /**
+   * 対局のルールに最も近いルールセット名
+   *
+   * @generated from field: string rule_set = 5;
+   */
+  ruleSet = "";
+
+  /**
+   * 局の一覧
+   *
+   * @generated from field: repeated mahjong.ai.v1.GameLogRound rounds = 6;
+   */
+  rounds: GameLogRound[] = [];
+
+  constructor(data?: PartialMessage<GameLog>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameLog";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "players", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "sanma", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "rounds", kind: "message", T: GameLogRound, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameLog {
+    return new GameLog().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameLog {
+    return new GameLog().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameLog {
+    return new GameLog().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameLog | PlainMessage<GameLog> | undefined, b: GameLog | PlainMessage<GameLog> | undefined): boolean {
+    return proto3.util.equals(GameLog, a, b);
+  }
+}
+
+/**
+ * 牌譜の中の打牌の判断の位置
+ *
+ * @generated from message mahjong.ai.v1.GameLogPosition
+ */
+export class GameLogPosition extends Message<GameLogPosition> {
+  /**
+   * 牌譜ID
+   *
+   * @generated from field: string game_id = 1;
+   */
+  gameId = "";
+
+  /**
+   * 局の番号（0始まり）
+   *
+   * @generated from field: int32 round = 2;
+   */
+  round = 0;
+
+  /**
+   * 局の中の打牌の番号（0始まり）
+   *
+   * @generated from field: int32 step = 3;
+   */
+  step = 0;
+
+  constructor(data?: PartialMessage<GameLogPosition>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameLogPosition";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "round", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "step", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameLogPosition {
+    return new GameLogPosition().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameLogPosition {
+    return new GameLogPosition().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameLogPosition {
+    return new GameLogPosition().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameLogPosition | PlainMessage<GameLogPosition> | undefined, b: GameLogPosition | PlainMessage<GameLogPosition> | undefined): boolean {
+    return proto3.util.equals(GameLogPosition, a, b);
+  }
+}
+
+/**
+ * 牌譜の打牌の判断
+ *
+ * @generated from message mahjong.ai.v1.GameLogStep
+ */
+export class GameLogStep extends Message<GameLogStep> {
+  /**
+   * 打牌の判断の位置
+   *
+   * @generated from field: mahjong.ai.v1.GameLogPosition position = 1;
+   */
+  position?: GameLogPosition;
+
+  /**
+   * 打牌者（起家からの席順）
+   *
+   * @generated from field: int32 player = 2;
+   */
+  player = 0;
+
+  /**
+   * 打牌者の自風
+   *
+   * @generated from field: mahjong.ai.v1.Wind seat = 3;
+   */
+  seat = Wind.UNSPECIFIED;
+
+  /**
+   * 打牌者の何巡目の打牌か（1始まり）
+   *
+   * @generated from field: int32 turn = 4;
+   */
+  turn = 0;
+
+  /**
+   * 打牌の直前の局面（手牌は打牌者の分のみ）
+   *
+   * @generated from field: mahjong.ai.v1.GameState state = 5;
+   */
+  state?: GameState;
+
+  /**
+   * 実際の打牌
+   *
+   * @generated from field: string discard = 6;
+   */
+  discard = "";
+
+  /**
+   * 実際の打牌がツモ切りか
+   *
+   * @generated from field: bool tsumogiri = 7;
+   */
+  tsumogiri = false;
+
+  /**
+   * 実際の打牌で立直を宣言したか
+   *
+   * @generated from field: bool riichi = 8;
+   */
+  riichi = false;
+
+  constructor(data?: PartialMessage<GameLogStep>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameLogStep";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "position", kind: "message", T: GameLogPosition },
+    { no: 2, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "seat", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 4, name: "turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "state", kind: "message", T: GameState },
+    { no: 6, name: "discard", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "tsumogiri", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "riichi", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameLogStep {
+    return new GameLogStep().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameLogStep {
+    return new GameLogStep().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameLogStep {
+    return new GameLogStep().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameLogStep | PlainMessage<GameLogStep> | undefined, b: GameLogStep | PlainMessage<GameLogStep> | undefined): boolean {
+    return proto3.util.equals(GameLogStep, a, b);
+  }
+}
+
+/**
+ * 検討で指摘した悪手
+ *
+ * @generated from message mahjong.ai.v1.ReviewMistake
+ */
+export class ReviewMistake extends Message<ReviewMistake> {
+  /**
+   * 打牌の判断の位置（GetGameLogStep・AskMahjongAI で参照できる）
+   *
+   * @generated from field: mahjong.ai.v1.GameLogPosition position = 1;
+   */
+  position?: GameLogPosition;
+
+  /**
+   * 局の名前（例: 東1局 0本場）
+   *
+   * @generated from field: string round_name = 2;
+   */
+  roundName = "";
+
+  /**
+   * 何巡目の打牌か（1始まり）
+   *
+   * @generated from field: int32 turn = 3;
+   */
+  turn = 0;
+
+  /**
+   * 評価した観点
+   *
+   * @generated from field: mahjong.ai.v1.DecisionKind kind = 4;
+   */
+  kind = DecisionKind.UNSPECIFIED;
+
+  /**
+   * 重大度
+   *
+   * @generated from field: mahjong.ai.v1.MistakeSeverity severity = 5;
+   */
+  severity = MistakeSeverity.UNSPECIFIED;
+
+  /**
+   * 実際の打牌
+   *
+   * @generated from field: string actual = 6;
+   */
+  actual = "";
+
+  /**
+   * エンジンが推奨する打牌
+   *
+   * @generated from field: string recommended = 7;
+   */
+  recommended = "";
+
+  /**
+   * 推奨する打牌と比べた損失の目安（点）
+   *
+   * @generated from field: int32 loss = 8;
+   */
+  loss = 0;
+
+  /**
+   * エンジンによる評価の要約
+   *
+   * @generated from field: string evaluation = 9;
+   */
+  evaluation = "";
+
+  /**
+   * AIによる解説（作成前や作成に失敗した場合は空）
+   *
+   * @generated from field: string commentary = 10;
+   */
+  commentary = "";
+
+  constructor(data?: PartialMessage<ReviewMistake>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.ReviewMistake";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "position", kind: "message", T: GameLogPosition },
+    { no: 2, name: "round_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "kind", kind: "enum", T: proto3.getEnumType(DecisionKind) },
+    { no: 5, name: "severity", kind: "enum", T: proto3.getEnumType(MistakeSeverity) },
+    { no: 6, name: "actual", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "recommended", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "loss", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "evaluation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "commentary", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReviewMistake {
+    return new ReviewMistake().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReviewMistake {
+    return new ReviewMistake().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReviewMistake {
+    return new ReviewMistake().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReviewMistake | PlainMessage<ReviewMistake> | undefined, b: ReviewMistake | PlainMessage<ReviewMistake> | undefined): boolean {
+    return proto3.util.equals(ReviewMistake, a, b);
+  }
+}
+
+/**
+ * 牌譜の検討結果
+ *
+ * @generated from message mahjong.ai.v1.GameReview
+ */
+export class GameReview extends Message<GameReview> {
+  /**
+   * 検討ID
+   *
+   * @generated from field: string review_id = 1;
+   */
+  reviewId = "";
+
+  /**
+   * 牌譜ID
+   *
+   * @generated from field: string game_id = 2;
+   */
+  gameId = "";
+
+  /**
+   * 検討するプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 3;
+   */
+  player = 0;
+
+  /**
+   * プレイヤー名
+   *
+   * @generated from field: string player_name = 4;
+   */
+  playerName = "";
+
+  /**
+   * 進み具合
+   *
+   * @generated from field: mahjong.ai.v1.ReviewStatus status = 5;
+   */
+  status = ReviewStatus.UNSPECIFIED;
+
+  /**
+   * 失敗した理由
+   *
+   * @generated from field: string error = 6;
+   */
+  error = "";
+
+  /**
+   * エンジンで評価した打牌の判断の数
+   *
+   * @generated from field: int32 evaluated = 7;
+   */
+  evaluated = 0;
+
+  /**
+   * 打牌の判断の数
+   *
+   * @generated from field: int32 total = 8;
+   */
+  total = 0;
+
+  /**
+   * 損失の大きい順の悪手
+   *
+   * @generated from field: repeated mahjong.ai.v1.ReviewMistake mistakes = 9;
+   */
+  mistakes: ReviewMistake[] = [];
+
+  constructor(data?: PartialMessage<GameReview>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.GameReview";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "review_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "game_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "player_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "status", kind: "enum", T: proto3.getEnumType(ReviewStatus) },
+    { no: 6, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "evaluated", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "total", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "mistakes", kind: "message", T: ReviewMistake, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GameReview {
+    return new GameReview().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GameReview {
+    return new GameReview().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GameReview {
+    return new GameReview().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GameReview | PlainMessage<GameReview> | undefined, b: GameReview | PlainMessage<GameReview> | undefined): boolean {
+    return proto3.util.equals(GameReview, a, b);
+  }
+}
+
+/**
+ * コーチングの対局の開始
+ *
+ * @generated from message mahjong.ai.v1.CoachGameStart
+ */
+export class CoachGameStart extends Message<CoachGameStart> {
+  /**
+   * 自分の席（起家からの席順）
+   *
+   * @generated from field: int32 self = 1;
+   */
+  self = 0;
+
+  /**
+   * プレイヤー名（起家からの席順）
+   *
+   * @generated from field: repeated string player_names = 2;
+   */
+  playerNames: string[] = [];
+
+  /**
+   * 推奨のたびにAIに解説させるか
+   *
+   * @generated from field: bool explain = 3;
+   */
+  explain = false;
+
+  /**
+   * 解説に使うペルソナ名（空の場合はデフォルト）
+   *
+   * @generated from field: string persona = 4;
+   */
+  persona = "";
+
+  constructor(data?: PartialMessage<CoachGameStart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachGameStart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "self", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "player_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "explain", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "persona", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachGameStart {
+    return new CoachGameStart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachGameStart {
+    return new CoachGameStart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachGameStart {
+    return new CoachGameStart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachGameStart | PlainMessage<CoachGameStart> | undefined, b: CoachGameStart | PlainMessage<CoachGameStart> | undefined): boolean {
+    return proto3.util.equals(CoachGameStart, a, b);
+  }
+}
+
+/**
+ * コーチングの局の開始
+ *
+ * @generated from message mahjong.ai.v1.CoachRoundStart
+ */
+export class CoachRoundStart extends Message<CoachRoundStart> {
+  /**
+   * 場風
+   *
+   * @generated from field: mahjong.ai.v1.Wind round_wind = 1;
+   */
+  roundWind = Wind.UNSPECIFIED;
+
+  /**
+   * 局（1始まり）
+   *
+   * @generated from field: int32 kyoku = 2;
+   */
+  kyoku = 0;
+
+  /**
+   * 積み棒の本数
+   *
+   * @generated from field: int32 honba = 3;
+   */
+  honba = 0;
+
+  /**
+   * 供託の立直棒の本数
+   *
+   * @generated from field: int32 riichi_sticks = 4;
+   */
+  riichiSticks = 0;
+
+  /**
+   * 持ち点（起家からの席順）
+   *
+   * @generated from field: repeated int32 scores = 5;
+   */
+  scores: number[] = [];
+
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string dora_indicator = 6;
+   */
+  doraIndicator = "";
+
+  /**
+   * 自分の配牌（13枚、0 は赤5）
+   *
+   * @generated from field: string hand = 7;
+   */
+  hand = "";
+
+  constructor(data?: PartialMessage<CoachRoundStart>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachRoundStart";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "round_wind", kind: "enum", T: proto3.getEnumType(Wind) },
+    { no: 2, name: "kyoku", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "honba", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "riichi_sticks", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "scores", kind: "scalar", T: 5 /* ScalarType.INT32 */, repeated: true },
+    { no: 6, name: "dora_indicator", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachRoundStart {
+    return new CoachRoundStart().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachRoundStart {
+    return new CoachRoundStart().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachRoundStart {
+    return new CoachRoundStart().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachRoundStart | PlainMessage<CoachRoundStart> | undefined, b: CoachRoundStart | PlainMessage<CoachRoundStart> | undefined): boolean {
+    return proto3.util.equals(CoachRoundStart, a, b);
+  }
+}
+
+/**
+ * ツモ（嶺上牌を含む）
+ *
+ * @generated from message mahjong.ai.v1.CoachDraw
+ */
+export class CoachDraw extends Message<CoachDraw> {
+  /**
+   * ツモしたプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 1;
+   */
+  player = 0;
+
+  /**
+   * ツモ牌（自分のツモのみ、他家の場合は空）
+   *
+   * @generated from field: string tile = 2;
+   */
+  tile = "";
+
+  constructor(data?: PartialMessage<CoachDraw>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachDraw";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachDraw {
+    return new CoachDraw().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachDraw {
+    return new CoachDraw().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachDraw {
+    return new CoachDraw().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachDraw | PlainMessage<CoachDraw> | undefined, b: CoachDraw | PlainMessage<CoachDraw> | undefined): boolean {
+    return proto3.util.equals(CoachDraw, a, b);
+  }
+}
+
+/**
+ * 打牌
+ *
+ * @generated from message mahjong.ai.v1.CoachDiscard
+ */
+export class CoachDiscard extends Message<CoachDiscard> {
+  /**
+   * 打牌したプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 1;
+   */
+  player = 0;
+
+  /**
+   * 打牌（0 は赤5）
+   *
+   * @generated from field: string tile = 2;
+   */
+  tile = "";
+
+  /**
+   * ツモ切りか
+   *
+   * @generated from field: bool tsumogiri = 3;
+   */
+  tsumogiri = false;
+
+  constructor(data?: PartialMessage<CoachDiscard>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachDiscard";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "tsumogiri", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachDiscard {
+    return new CoachDiscard().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachDiscard {
+    return new CoachDiscard().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachDiscard {
+    return new CoachDiscard().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachDiscard | PlainMessage<CoachDiscard> | undefined, b: CoachDiscard | PlainMessage<CoachDiscard> | undefined): boolean {
+    return proto3.util.equals(CoachDiscard, a, b);
+  }
+}
+
+/**
+ * 副露（チー・ポン・大明槓は直前の打牌を鳴く）
+ *
+ * @generated from message mahjong.ai.v1.CoachCall
+ */
+export class CoachCall extends Message<CoachCall> {
+  /**
+   * 副露したプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 1;
+   */
+  player = 0;
+
+  /**
+   * 副露の種類
+   *
+   * @generated from field: mahjong.ai.v1.MeldType type = 2;
+   */
+  type = MeldType.UNSPECIFIED;
+
+  /**
+   * 副露の牌（鳴いた牌を含む。加槓は加えた1枚）
+   *
+   * @generated from field: string tiles = 3;
+   */
+  tiles = "";
+
+  constructor(data?: PartialMessage<CoachCall>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachCall";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(MeldType) },
+    { no: 3, name: "tiles", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachCall {
+    return new CoachCall().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachCall {
+    return new CoachCall().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachCall {
+    return new CoachCall().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachCall | PlainMessage<CoachCall> | undefined, b: CoachCall | PlainMessage<CoachCall> | undefined): boolean {
+    return proto3.util.equals(CoachCall, a, b);
+  }
+}
+
+/**
+ * 立直の宣言（続く打牌が宣言牌になる）
+ *
+ * @generated from message mahjong.ai.v1.CoachRiichi
+ */
+export class CoachRiichi extends Message<CoachRiichi> {
+  /**
+   * 立直したプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 1;
+   */
+  player = 0;
+
+  constructor(data?: PartialMessage<CoachRiichi>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachRiichi";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachRiichi {
+    return new CoachRiichi().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachRiichi {
+    return new CoachRiichi().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachRiichi {
+    return new CoachRiichi().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachRiichi | PlainMessage<CoachRiichi> | undefined, b: CoachRiichi | PlainMessage<CoachRiichi> | undefined): boolean {
+    return proto3.util.equals(CoachRiichi, a, b);
+  }
+}
+
+/**
+ * 槓ドラの表示
+ *
+ * @generated from message mahjong.ai.v1.CoachDora
+ */
+export class CoachDora extends Message<CoachDora> {
+  /**
+   * ドラ表示牌
+   *
+   * @generated from field: string indicator = 1;
+   */
+  indicator = "";
+
+  constructor(data?: PartialMessage<CoachDora>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachDora";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "indicator", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachDora {
+    return new CoachDora().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachDora {
+    return new CoachDora().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachDora {
+    return new CoachDora().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachDora | PlainMessage<CoachDora> | undefined, b: CoachDora | PlainMessage<CoachDora> | undefined): boolean {
+    return proto3.util.equals(CoachDora, a, b);
+  }
+}
+
+/**
+ * 局の終了
+ *
+ * @generated from message mahjong.ai.v1.CoachRoundEnd
+ */
+export class CoachRoundEnd extends Message<CoachRoundEnd> {
+  constructor(data?: PartialMessage<CoachRoundEnd>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachRoundEnd";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachRoundEnd {
+    return new CoachRoundEnd().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachRoundEnd {
+    return new CoachRoundEnd().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachRoundEnd {
+    return new CoachRoundEnd().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachRoundEnd | PlainMessage<CoachRoundEnd> | undefined, b: CoachRoundEnd | PlainMessage<CoachRoundEnd> | undefined): boolean {
+    return proto3.util.equals(CoachRoundEnd, a, b);
+  }
+}
+
+/**
+ * コーチングの対局の出来事
+ *
+ * @generated from message mahjong.ai.v1.CoachEvent
+ */
+export class CoachEvent extends Message<CoachEvent> {
+  /**
+   * @generated from oneof mahjong.ai.v1.CoachEvent.event
+   */
+  event: {
+    /**
+     * 対局の開始
+     *
+     * @generated from field: mahjong.ai.v1.CoachGameStart game_start = 1;
+     */
+    value: CoachGameStart;
+    case: "gameStart";
+  } | {
+    /**
+     * 局の開始
+     *
+     * @generated from field: mahjong.ai.v1.CoachRoundStart round_start = 2;
+     */
+    value: CoachRoundStart;
+    case: "roundStart";
+  } | {
+    /**
+     * ツモ
+     *
+     * @generated from field: mahjong.ai.v1.CoachDraw draw = 3;
+     */
+    value: CoachDraw;
+    case: "draw";
+  } | {
+    /**
+     * 打牌
+     *
+     * @generated from field: mahjong.ai.v1.CoachDiscard discard = 4;
+     */
+    value: CoachDiscard;
+    case: "discard";
+  } | {
+    /**
+     * 副露
+     *
+     * @generated from field: mahjong.ai.v1.CoachCall call = 5;
+     */
+    value: CoachCall;
+    case: "call";
+  } | {
+    /**
+     * 立直の宣言
+     *
+     * @generated from field: mahjong.ai.v1.CoachRiichi riichi = 6;
+     */
+    value: CoachRiichi;
+    case: "riichi";
+  } | {
+    /**
+     * 槓ドラの表示
+     *
+     * @generated from field: mahjong.ai.v1.CoachDora dora = 7;
+     */
+    value: CoachDora;
+    case: "dora";
+  } | {
+    /**
+     * 局の終了
+     *
+     * @generated from field: mahjong.ai.v1.CoachRoundEnd round_end = 8;
+     */
+    value: CoachRoundEnd;
+    case: "roundEnd";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<CoachEvent>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachEvent";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "game_start", kind: "message", T: CoachGameStart, oneof: "event" },
+    { no: 2, name: "round_start", kind: "message", T: CoachRoundStart, oneof: "event" },
+    { no: 3, name: "draw", kind: "message", T: CoachDraw, oneof: "event" },
+    { no: 4, name: "discard", kind: "message", T: CoachDiscard, oneof: "event" },
+    { no: 5, name: "call", kind: "message", T: CoachCall, oneof: "event" },
+    { no: 6, name: "riichi", kind: "message", T: CoachRiichi, oneof: "event" },
+    { no: 7, name: "dora", kind: "message", T: CoachDora, oneof: "event" },
+    { no: 8, name: "round_end", kind: "message", T: CoachRoundEnd, oneof: "event" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachEvent {
+    return new CoachEvent().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachEvent {
+    return new CoachEvent().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachEvent {
+    return new CoachEvent().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachEvent | PlainMessage<CoachEvent> | undefined, b: CoachEvent | PlainMessage<CoachEvent> | undefined): boolean {
+    return proto3.util.equals(CoachEvent, a, b);
+  }
+}
+
+/**
+ * 解説の依頼
+ *
+ * @generated from message mahjong.ai.v1.CoachExplainRequest
+ */
+export class CoachExplainRequest extends Message<CoachExplainRequest> {
+  /**
+   * 質問（空の場合は直前の推奨を解説する）
+   *
+   * @generated from field: string question = 1;
+   */
+  question = "";
+
+  constructor(data?: PartialMessage<CoachExplainRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachExplainRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "question", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachExplainRequest {
+    return new CoachExplainRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachExplainRequest {
+    return new CoachExplainRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachExplainRequest {
+    return new CoachExplainRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachExplainRequest | PlainMessage<CoachExplainRequest> | undefined, b: CoachExplainRequest | PlainMessage<CoachExplainRequest> | undefined): boolean {
+    return proto3.util.equals(CoachExplainRequest, a, b);
+  }
+}
+
+/**
+ * エンジンによる推奨
+ *
+ * @generated from message mahjong.ai.v1.CoachAdvice
+ */
+export class CoachAdvice extends Message<CoachAdvice> {
+  /**
+   * 推奨する行動
+   *
+   * @generated from field: mahjong.ai.v1.CoachAction action = 1;
+   */
+  action = CoachAction.UNSPECIFIED;
+
+  /**
+   * 打牌・宣言牌・和了牌
+   *
+   * @generated from field: string tile = 2;
+   */
+  tile = "";
+
+  /**
+   * 推奨の理由の要約（和了の場合は空）
+   *
+   * @generated from field: string reason = 3;
+   */
+  reason = "";
+
+  /**
+   * 推奨した局面（自分の視点）
+   *
+   * @generated from field: mahjong.ai.v1.GameState state = 4;
+   */
+  state?: GameState;
+
+  constructor(data?: PartialMessage<CoachAdvice>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachAdvice";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "action", kind: "enum", T: proto3.getEnumType(CoachAction) },
+    { no: 2, name: "tile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "message", T: GameState },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachAdvice {
+    return new CoachAdvice().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachAdvice {
+    return new CoachAdvice().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachAdvice {
+    return new CoachAdvice().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachAdvice | PlainMessage<CoachAdvice> | undefined, b: CoachAdvice | PlainMessage<CoachAdvice> | undefined): boolean {
+    return proto3.util.equals(CoachAdvice, a, b);
+  }
+}
+
+/**
+ * 状況の変化の警告（状況が新たに当てはまったときに1回だけ送る）
+ *
+ * @generated from message mahjong.ai.v1.CoachWarning
+ */
+export class CoachWarning extends Message<CoachWarning> {
+  /**
+   * 種類
+   *
+   * @generated from field: mahjong.ai.v1.CoachWarningKind kind = 1;
+   */
+  kind = CoachWarningKind.UNSPECIFIED;
+
+  /**
+   * 対象のプレイヤー（起家からの席順）
+   *
+   * @generated from field: int32 player = 2;
+   */
+  player = 0;
+
+  /**
+   * 内容
+   *
+   * @generated from field: string message = 3;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<CoachWarning>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachWarning";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "kind", kind: "enum", T: proto3.getEnumType(CoachWarningKind) },
+    { no: 2, name: "player", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachWarning {
+    return new CoachWarning().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachWarning {
+    return new CoachWarning().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachWarning {
+    return new CoachWarning().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachWarning | PlainMessage<CoachWarning> | undefined, b: CoachWarning | PlainMessage<CoachWarning> | undefined): boolean {
+    return proto3.util.equals(CoachWarning, a, b);
+  }
+}
+
+/**
+ * AIによる解説の一部
+ *
+ * @generated from message mahjong.ai.v1.CoachExplanation
+ */
+export class CoachExplanation extends Message<CoachExplanation> {
+  /**
+   * 解説ID（同じ解説の一部には同じIDが付く）
+   *
+   * @generated from field: string explanation_id = 1;
+   */
+  explanationId = "";
+
+  /**
+   * テキストチャンク
+   *
+   * @generated from field: string text_chunk = 2;
+   */
+  textChunk = "";
+
+  /**
+   * 解説の最後か
+   *
+   * @generated from field: bool done = 3;
+   */
+  done = false;
+
+  /**
+   * 新しい出来事が届いたため解説を打ち切ったか
+   *
+   * @generated from field: bool cancelled = 4;
+   */
+  cancelled = false;
+
+  /**
+   * 解説の作成に失敗した理由
+   *
+   * @generated from field: string error = 5;
+   */
+  error = "";
+
+  constructor(data?: PartialMessage<CoachExplanation>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.CoachExplanation";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "explanation_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "text_chunk", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "done", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "cancelled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CoachExplanation {
+    return new CoachExplanation().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CoachExplanation {
+    return new CoachExplanation().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CoachExplanation {
+    return new CoachExplanation().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CoachExplanation | PlainMessage<CoachExplanation> | undefined, b: CoachExplanation | PlainMessage<CoachExplanation> | undefined): boolean {
+    return proto3.util.equals(CoachExplanation, a, b);
+  }
+}
+
+/**
+ * 何切る問題
+ *
+ * @generated from message mahjong.ai.v1.Quiz
+ */
+export class Quiz extends Message<Quiz> {
+  /**
+   * 問題ID
+   *
+   * @generated from field: string quiz_id = 1;
+   */
+  quizId = "";
+
+  /**
+   * 出題する局面（自分の手牌はツモ後の14枚、他家の手牌は含まない。RenderHand で卓の図にできる）
+   *
+   * @generated from field: mahjong.ai.v1.GameState game_state = 2;
+   */
+  gameState?: GameState;
+
+  /**
+   * 自分の手牌（MPSZ表記、0 は赤5）
+   *
+   * @generated from field: string hand = 3;
+   */
+  hand = "";
+
+  /**
+   * 何巡目のツモか（1始まり）
+   *
+   * @generated from field: int32 turn = 4;
+   */
+  turn = 0;
+
+  /**
+   * ルールセット名
+   *
+   * @generated from field: string rule_set = 5;
+   */
+  ruleSet = "";
+
+  /**
+   * 日替わりの問題の日付（YYYY-MM-DD、日替わりでない場合は空）
+   *
+   * @generated from field: string date = 6;
+   */
+  date = "";
+
+  constructor(data?: PartialMessage<Quiz>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.Quiz";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "quiz_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "game_state", kind: "message", T: GameState },
+    { no: 3, name: "hand", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "turn", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "rule_set", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Quiz {
+    return new Quiz().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Quiz {
+    return new Quiz().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Quiz {
+    return new Quiz().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Quiz | PlainMessage<Quiz> | undefined, b: Quiz | PlainMessage<Quiz> | undefined): boolean {
+    return proto3.util.equals(Quiz, a, b);
+  }
+}
+
+/**
+ * 何切る問題の採点結果
+ *
+ * @generated from message mahjong.ai.v1.QuizGrade
+ */
+export class QuizGrade extends Message<QuizGrade> {
+  /**
+   * 問題ID
+   *
+   * @generated from field: string quiz_id = 1;
+   */
+  quizId = "";
+
+  /**
+   * 回答した打牌
+   *
+   * @generated from field: string discard = 2;
+   */
+  discard = "";
+
+  /**
+   * 正解か（最善と同等の打牌を含む）
+   *
+   * @generated from field: bool correct = 3;
+   */
+  correct = false;
+
+  /**
+   * 得点（0-100）
+   *
+   * @generated from field: int32 score = 4;
+   */
+  score = 0;
+
+  /**
+   * エンジンが最善とする打牌
+   *
+   * @generated from field: string best_discard = 5;
+   */
+  bestDiscard = "";
+
+  /**
+   * 正解とする打牌
+   *
+   * @generated from field: repeated string accepted = 6;
+   */
+  accepted: string[] = [];
+
+  /**
+   * エンジンによる推奨順の打牌候補
+   *
+   * @generated from field: repeated mahjong.ai.v1.DiscardCandidateInfo candidates = 7;
+   */
+  candidates: DiscardCandidateInfo[] = [];
+
+  /**
+   * エンジンによる評価の要約
+   *
+   * @generated from field: string evaluation = 8;
+   */
+  evaluation = "";
+
+  /**
+   * AIによる解説（作成に失敗した場合は空）
+   *
+   * @generated from field: string explanation = 9;
+   */
+  explanation = "";
+
+  /**
+   * 成績に記録したか（同じ問題の2回目以降の回答は記録しない）
+   *
+   * @generated from field: bool recorded = 10;
+   */
+  recorded = false;
+
+  constructor(data?: PartialMessage<QuizGrade>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.QuizGrade";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "quiz_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "discard", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "correct", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "score", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "best_discard", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "accepted", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "candidates", kind: "message", T: DiscardCandidateInfo, repeated: true },
+    { no: 8, name: "evaluation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "explanation", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "recorded", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizGrade {
+    return new QuizGrade().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizGrade {
+    return new QuizGrade().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizGrade {
+    return new QuizGrade().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuizGrade | PlainMessage<QuizGrade> | undefined, b: QuizGrade | PlainMessage<QuizGrade> | undefined): boolean {
+    return proto3.util.equals(QuizGrade, a, b);
+  }
+}
+
+/**
+ * 日ごとの何切る問題の成績
+ *
+ * @generated from message mahjong.ai.v1.QuizDailyStats
+ */
+export class QuizDailyStats extends Message<QuizDailyStats> {
+  /**
+   * 日付（YYYY-MM-DD）
+   *
+   * @generated from field: string date = 1;
+   */
+  date = "";
+
+  /**
+   * 回答した問題の数
+   *
+   * @generated from field: int32 attempts = 2;
+   */
+  attempts = 0;
+
+  /**
+   * 正解した問題の数
+   *
+   * @generated from field: int32 correct = 3;
+   */
+  correct = 0;
+
+  /**
+   * 平均得点
+   *
+   * @generated from field: float average_score = 4;
+   */
+  averageScore = 0;
+
+  constructor(data?: PartialMessage<QuizDailyStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.QuizDailyStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "date", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "correct", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "average_score", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizDailyStats {
+    return new QuizDailyStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizDailyStats {
+    return new QuizDailyStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizDailyStats {
+    return new QuizDailyStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuizDailyStats | PlainMessage<QuizDailyStats> | undefined, b: QuizDailyStats | PlainMessage<QuizDailyStats> | undefined): boolean {
+    return proto3.util.equals(QuizDailyStats, a, b);
+  }
+}
+
+/**
+ * ユーザーの何切る問題の成績
+ *
+ * @generated from message mahjong.ai.v1.QuizStats
+ */
+export class QuizStats extends Message<QuizStats> {
+  /**
+   * ユーザーID
+   *
+   * @generated from field: string user_id = 1;
+   */
+  userId = "";
+
+  /**
+   * 回答した問題の数
+   *
+   * @generated from field: int32 attempts = 2;
+   */
+  attempts = 0;
+
+  /**
+   * 正解した問題の数
+   *
+   * @generated from field: int32 correct = 3;
+   */
+  correct = 0;
+
+  /**
+   * 正解率（0-1）
+   *
+   * @generated from field: float accuracy = 4;
+   */
+  accuracy = 0;
+
+  /**
+   * 平均得点
+   *
+   * @generated from field: float average_score = 5;
+   */
+  averageScore = 0;
+
+  /**
+   * 直近の連続正解数
+   *
+   * @generated from field: int32 streak = 6;
+   */
+  streak = 0;
+
+  /**
+   * 直近30日の日ごとの成績（古い順、回答のない日は含めない）
+   *
+   * @generated from field: repeated mahjong.ai.v1.QuizDailyStats daily = 7;
+   */
+  daily: QuizDailyStats[] = [];
+
+  constructor(data?: PartialMessage<QuizStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "mahjong.ai.v1.QuizStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "attempts", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "correct", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "accuracy", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 5, name: "average_score", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 6, name: "streak", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "daily", kind: "message", T: QuizDailyStats, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QuizStats {
+    return new QuizStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QuizStats {
+    return new QuizStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QuizStats {
+    return new QuizStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QuizStats | PlainMessage<QuizStats> | undefined, b: QuizStats | PlainMessage<QuizStats> | undefined): boolean {
+    return proto3.util.equals(QuizStats, a, b);
+  }
+}
+